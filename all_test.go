@@ -6,24 +6,29 @@ package ql
 
 import (
 	"bytes"
+	"context"
 	"crypto/md5"
 	"database/sql"
+	"encoding/json"
 	"flag"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"log"
+	"math"
 	"math/big"
 	"math/rand"
 	"os"
 	"path"
 	"path/filepath"
 	"reflect"
+	"regexp"
 	"runtime"
 	"runtime/debug"
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -195,7 +200,7 @@ func (m *fileTestDB) mark() (err error) {
 func (m *fileTestDB) teardown(ctx *TCtx) (err error) {
 	runtime.GOMAXPROCS(m.gmp0)
 	defer func() {
-		f := m.db.store.(*file)
+		f := m.db.store.(*mvcc).storage.(*file)
 		errSet(&err, m.db.Close())
 		os.Remove(f.f0.Name())
 		if f.wal != nil {
@@ -255,7 +260,7 @@ func (m *file2TestDB) mark() (err error) {
 func (m *file2TestDB) teardown(ctx *TCtx) (err error) {
 	runtime.GOMAXPROCS(m.gmp0)
 	defer func() {
-		f := m.db.store.(*storage2)
+		f := m.db.store.(*mvcc).storage.(*storage2)
 		errSet(&err, m.db.Close())
 		os.Remove(f.Name())
 		if f.walName != "" {
@@ -315,7 +320,7 @@ func (m *osFileTestDB) mark() (err error) {
 func (m *osFileTestDB) teardown(ctx *TCtx) (err error) {
 	runtime.GOMAXPROCS(m.gmp0)
 	defer func() {
-		f := m.db.store.(*file)
+		f := m.db.store.(*mvcc).storage.(*file)
 		errSet(&err, m.db.Close())
 		os.Remove(f.f0.Name())
 		if f.wal != nil {
@@ -3104,9 +3109,11 @@ func testMentionedColumns(s stmt) (err error) {
 		*alterTableDropColumnStmt,
 		beginTransactionStmt,
 		*createTableStmt,
+		*createTypeStmt,
 		commitStmt,
 		*dropIndexStmt,
 		*dropTableStmt,
+		*dropTypeStmt,
 		*explainStmt,
 		rollbackStmt,
 		*truncateTableStmt:
@@ -4289,3 +4296,2440 @@ func TestIssue195(t *testing.T) {
 		t.Fatal(err, " index :", index)
 	}
 }
+
+func TestParallel(t *testing.T) {
+	db, err := OpenMem()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	ctx := NewRWCtx()
+	if _, _, err = db.Run(ctx, `
+		BEGIN TRANSACTION;
+			CREATE TABLE t (k int64, g int64, f float64, s string);
+			CREATE TABLE u (k int64);
+			INSERT INTO u VALUES (1), (2), (3);
+	`); err != nil {
+		t.Fatal(err)
+	}
+
+	ins := MustCompile("INSERT INTO t VALUES($1, $2, $3, $4);")
+	rng := rand.New(rand.NewSource(42))
+	for k := 0; k < 20000; k++ {
+		var s interface{}
+		if k%7 != 0 {
+			s = fmt.Sprintf("%c%d", 'a'+rng.Intn(26), rng.Int())
+		}
+		if _, _, err = db.Execute(ctx, ins, int64(k), int64(rng.Intn(300)*rng.Intn(10)), rng.NormFloat64()*1e6, s); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, _, err = db.Run(ctx, "COMMIT;"); err != nil {
+		t.Fatal(err)
+	}
+
+	run := func(n int, q string) (rows [][]interface{}, err error) {
+		db.SetParallelism(n)
+		rs, _, err := db.Run(nil, q)
+		if err != nil {
+			return nil, err
+		}
+
+		return rs[0].Rows(-1, 0)
+	}
+
+	for i, q := range []string{
+		"SELECT * FROM t WHERE k%3 == 0 && s > \"m\";",
+		"SELECT k, s FROM t WHERE s LIKE \"^[a-c]\" LIMIT 100;",
+		"SELECT k FROM t WHERE f > 0 ORDER BY k DESC LIMIT 10 OFFSET 5;",
+		"SELECT k FROM t WHERE 1000000/(k-15000) > 0;",
+		"SELECT k FROM t WHERE k > 19000 && s;",
+		"SELECT id(t), id(u), t.k, u.k FROM t, u WHERE t.k%u.k == 0 && id(u) != 2;",
+		"SELECT g, count(*), count(s), sum(k), min(s), max(k), sum(f), avg(f) FROM t GROUP BY g;",
+		"SELECT count(*), count(s), sum(k), min(k), max(s) FROM t;",
+		"SELECT sum(f), max(f) FROM t WHERE k < 15000;",
+		"SELECT g, k FROM t GROUP BY g;",
+		"SELECT count(*), avg(k) FROM t WHERE k < 0;",
+		"SELECT g, sum(k/(g-100)) FROM t GROUP BY g;",
+	} {
+		e, eErr := run(1, q)
+		for _, n := range []int{2, 3, 8} {
+			g, gErr := run(n, q)
+			if g, e := fmt.Sprint(gErr), fmt.Sprint(eErr); g != e {
+				t.Fatalf("%d: %s\nparallelism %d: got error %s, expected %s", i, q, n, g, e)
+			}
+
+			if g, e := fmt.Sprint(g), fmt.Sprint(e); g != e {
+				t.Fatalf("%d: %s\nparallelism %d: results differ", i, q, n)
+			}
+		}
+	}
+
+	// Functions which are not static are called from a single goroutine.
+	var active, overlap int32
+	if err := RegisterFunc("parallelProbe", func(arg []interface{}) (interface{}, error) {
+		if atomic.AddInt32(&active, 1) > 1 {
+			atomic.StoreInt32(&overlap, 1)
+		}
+		runtime.Gosched()
+		atomic.AddInt32(&active, -1)
+		return arg[0], nil
+	}, FuncOptions{MinArgs: 1, MaxArgs: 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, q := range []string{
+		"SELECT k FROM t WHERE parallelProbe(k)%2 == 0 && random() >= 0;",
+		"SELECT g, sum(parallelProbe(k)) FROM t GROUP BY g;",
+	} {
+		e, err := run(1, q)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		g, err := run(8, q)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if g, e := fmt.Sprint(g), fmt.Sprint(e); g != e || overlap != 0 {
+			t.Fatalf("%s\nparallelism 8: results differ or concurrent calls (%v)", q, overlap != 0)
+		}
+	}
+}
+
+var compileEvalTests = []string{
+	"k > 100 && k <= 2000",
+	"f < 0.5 || s == \"x\"",
+	"!b && s >= \"m\"",
+	"f IS NULL || b",
+	"k*2-1 == 7 || k/3 == 5 || k%1000 == 1",
+	"s+s == \"zz\" && f/2 > 0.1",
+	"10/(k-3)",
+	"k << 2 | 1",
+	"1 << 3 + k",
+	"i+1 > 3",
+	"k > $1",
+	"k == 1.5",
+	"f*2.5-1",
+	"-k + ^k",
+	"NULL && k > 0",
+	"NULL || k > 0",
+	"len(s)+2*3",
+	"s LIKE \"^[a-m]\"",
+	"k IN (1, 2, 3)",
+	"k BETWEEN 10 AND 20",
+	"f > \"a\"",
+	"s + 1",
+	"b && 1",
+	"x > 0",
+	"int32(k) + i",
+	"float64(k) / f",
+	"id() > 0",
+}
+
+func compileEvalRows() (r []map[interface{}]interface{}) {
+	rng := rand.New(rand.NewSource(42))
+	for k := int64(-2); k < 100; k++ {
+		m := map[interface{}]interface{}{"$id": k, "k": k, "i": int32(k), "f": nil, "s": nil, "b": nil}
+		if k%5 != 0 {
+			m["f"] = rng.NormFloat64()
+		}
+		if k%7 != 0 {
+			m["s"] = fmt.Sprintf("%c", 'a'+rng.Intn(26))
+		}
+		if k%11 != 0 {
+			m["b"] = rng.Intn(2) == 0
+		}
+		r = append(r, m)
+	}
+	return r
+}
+
+func TestCompileEval(t *testing.T) {
+	types := map[string]int{"k": qInt64, "i": qInt32, "f": qFloat64, "s": qString, "b": qBool}
+	arg := []interface{}{int64(50)}
+	rows := compileEvalRows()
+	for i, src := range compileEvalTests {
+		e, err := compileExpr(src)
+		if err != nil {
+			t.Fatal(i, err)
+		}
+
+		ctx := &execCtx{arg: arg}
+		f := compileEval(e, types, arg)
+		for j, m := range rows {
+			m2 := map[interface{}]interface{}{}
+			for k, v := range m {
+				m2[k] = v
+			}
+			g, gErr := f(ctx, m)
+			w, wErr := e.eval(ctx, m2)
+			if fmt.Sprint(gErr) != fmt.Sprint(wErr) {
+				t.Fatalf("%d: %s, row %d\ngot error %v\nexp error %v", i, src, j, gErr, wErr)
+			}
+
+			if g != w {
+				t.Fatalf("%d: %s, row %d\ngot %T(%v)\nexp %T(%v)", i, src, j, g, g, w, w)
+			}
+		}
+	}
+}
+
+func benchmarkEval(b *testing.B, compiled bool) {
+	e, err := compileExpr("k > 10 && f*2 < 1.5 || s == \"x\" && i+1 != 3")
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	f := e.eval
+	if compiled {
+		f = compileEval(e, map[string]int{"k": qInt64, "i": qInt32, "f": qFloat64, "s": qString}, nil)
+	}
+	ctx := &execCtx{}
+	rows := compileEvalRows()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := f(ctx, rows[i%len(rows)]); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkEvalTree(b *testing.B) {
+	benchmarkEval(b, false)
+}
+
+func BenchmarkEvalCompiled(b *testing.B) {
+	benchmarkEval(b, true)
+}
+
+// The len(s) calls make the expressions to be evaluated row by row.
+func BenchmarkSelectWhereRowsMem1e4(b *testing.B) {
+	db, err := OpenMem()
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	defer db.Close()
+
+	ctx := NewRWCtx()
+	if _, _, err = db.Run(ctx, "BEGIN TRANSACTION; CREATE TABLE t (k int64, f float64, s string);"); err != nil {
+		b.Fatal(err)
+	}
+
+	ins := MustCompile("INSERT INTO t VALUES($1, $2, $3);")
+	rng := rand.New(rand.NewSource(42))
+	for k := 0; k < 1e4; k++ {
+		if _, _, err = db.Execute(ctx, ins, int64(k), rng.NormFloat64(), fmt.Sprintf("%c", 'a'+rng.Intn(26))); err != nil {
+			b.Fatal(err)
+		}
+	}
+	if _, _, err = db.Run(ctx, "COMMIT;"); err != nil {
+		b.Fatal(err)
+	}
+
+	sel := MustCompile("SELECT k*2+1, f*f-0.5, s+s FROM t WHERE len(s) == 1 && k > 10 && f*2 < 1.5;")
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		rs, _, err := db.Execute(nil, sel)
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		if err = rs[0].Do(false, func(record []interface{}) (bool, error) { return true, nil }); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestPlanCache(t *testing.T) {
+	db, err := OpenMem()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	ctx := NewRWCtx()
+	exec := func(l List, arg ...interface{}) (plan, string) {
+		rs, _, err := db.Execute(ctx, l, arg...)
+		if err != nil {
+			return nil, err.Error()
+		}
+
+		if len(rs) == 0 {
+			return nil, ""
+		}
+
+		rows, err := rs[len(rs)-1].Rows(-1, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		fields, err := rs[len(rs)-1].Fields()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return rs[len(rs)-1].(recordset).plan, fmt.Sprint(fields, rows)
+	}
+
+	exec(MustCompile(`
+		BEGIN TRANSACTION;
+			CREATE TABLE t (a int64, b string);
+			CREATE INDEX xa ON t (a);
+			INSERT INTO t VALUES (1, "a"), (2, "b"), (3, "c");
+			CREATE TABLE u (c int64);
+		COMMIT;
+	`))
+	all := MustCompile("SELECT * FROM t ORDER BY a;")
+	where := MustCompile("SELECT b FROM t WHERE a > $1 ORDER BY b;")
+	exists := MustCompile("SELECT a FROM t WHERE EXISTS (SELECT * FROM u) ORDER BY a;")
+
+	p1, g := exec(all)
+	p2, _ := exec(all)
+	if p1 != p2 {
+		t.Fatal("plan not reused")
+	}
+
+	if e := "[a b] [[1 a] [2 b] [3 c]]"; g != e {
+		t.Fatalf("got %s\nexp %s", g, e)
+	}
+
+	// Transactions not changing the schema keep the plans.
+	for _, v := range []struct {
+		s, e string
+	}{
+		{"BEGIN TRANSACTION; INSERT INTO t VALUES (4, \"d\"); COMMIT;", "[a b] [[1 a] [2 b] [3 c] [4 d]]"},
+		{"BEGIN TRANSACTION; DELETE FROM t WHERE a == 4;", "[a b] [[1 a] [2 b] [3 c]]"},
+		{"ROLLBACK;", "[a b] [[1 a] [2 b] [3 c] [4 d]]"},
+		{"BEGIN TRANSACTION; DELETE FROM t WHERE a == 4; COMMIT;", "[a b] [[1 a] [2 b] [3 c]]"},
+	} {
+		exec(MustCompile(v.s))
+		p, g := exec(all)
+		if p != p1 {
+			t.Fatalf("%s: plan not reused", v.s)
+		}
+
+		if g != v.e {
+			t.Fatalf("%s: got %s\nexp %s", v.s, g, v.e)
+		}
+	}
+
+	for _, v := range []struct {
+		arg interface{}
+		e   string
+	}{
+		{int64(1), "[b] [[b] [c]]"},
+		{int64(2), "[b] [[c]]"},
+		{int64(0), "[b] [[a] [b] [c]]"},
+		{nil, "[b] []"},
+		{int64(3), "[b] []"},
+		{int64(1), "[b] [[b] [c]]"},
+	} {
+		if _, g = exec(where, v.arg); g != v.e {
+			t.Fatalf("%v: got %s\nexp %s", v.arg, g, v.e)
+		}
+	}
+
+	exec(MustCompile(`
+		BEGIN TRANSACTION;
+			CREATE TABLE v (j json);
+			CREATE INDEX xj ON v (j);
+			INSERT INTO v VALUES (json("[1]"));
+		COMMIT;
+	`))
+	whereJSON := MustCompile("SELECT string(j) AS s FROM v WHERE j == $1;")
+	for _, v := range []struct {
+		arg interface{}
+		e   string
+	}{
+		{json.RawMessage("[1]"), "[s] [[[1]]]"},
+		{json.RawMessage("[2]"), "[s] []"},
+		{json.RawMessage(" [ 1 ] "), "[s] [[[1]]]"},
+	} {
+		if _, g = exec(whereJSON, v.arg); g != v.e {
+			t.Fatalf("%s: got %s\nexp %s", v.arg, g, v.e)
+		}
+	}
+
+	// Schema changes invalidate the plans.
+	exec(MustCompile("BEGIN TRANSACTION; ALTER TABLE t ADD c bool; COMMIT;"))
+	if _, g = exec(all); g != "[a b c] [[1 a <nil>] [2 b <nil>] [3 c <nil>]]" {
+		t.Fatal(g)
+	}
+
+	exec(MustCompile("BEGIN TRANSACTION; DROP TABLE t; CREATE TABLE t (a string); INSERT INTO t VALUES (\"x\"); COMMIT;"))
+	if _, g = exec(all); g != "[a] [[x]]" {
+		t.Fatal(g)
+	}
+
+	exec(MustCompile("BEGIN TRANSACTION; DROP TABLE t;"))
+	if _, g = exec(all); g != "table t does not exist" {
+		t.Fatal(g)
+	}
+
+	exec(MustCompile("ROLLBACK;"))
+	if _, g = exec(all); g != "[a] [[x]]" {
+		t.Fatal(g)
+	}
+
+	// Plans depending on data are not reused.
+	exec(MustCompile("BEGIN TRANSACTION;"))
+	if _, g = exec(exists); g != "[a] []" {
+		t.Fatal(g)
+	}
+
+	exec(MustCompile("INSERT INTO u VALUES (1);"))
+	if _, g = exec(exists); g != "[a] [[x]]" {
+		t.Fatal(g)
+	}
+
+	exec(MustCompile("COMMIT;"))
+
+	// Cached plans are shared by concurrent executions.
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				rs, _, err := db.Execute(nil, all)
+				if err != nil {
+					t.Error(err)
+					return
+				}
+
+				if err := rs[0].Do(false, func([]interface{}) (bool, error) { return true, nil }); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestExecuteContext(t *testing.T) {
+	db, err := OpenMem()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	tctx := NewRWCtx()
+	if _, _, err := db.Run(tctx, `
+		BEGIN TRANSACTION;
+			CREATE TABLE t (i int64);
+	`); err != nil {
+		t.Fatal(err)
+	}
+
+	ins := MustCompile("INSERT INTO t VALUES ($1);")
+	for i := 0; i < 1000; i++ {
+		if _, _, err := db.Execute(tctx, ins, int64(i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, _, err := db.Run(tctx, "COMMIT;"); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, _, err := db.RunContext(ctx, nil, "SELECT * FROM t;"); err != context.Canceled {
+		t.Fatalf("got %v, exp %v", err, context.Canceled)
+	}
+
+	// Reading the rows of a Recordset stops when the context is done.
+	for _, q := range []string{
+		"SELECT * FROM t;",
+		"SELECT * FROM t WHERE i%2 == 0;",
+		"SELECT * FROM t ORDER BY i;",
+		"SELECT i, count(*) FROM t GROUP BY i;",
+		"SELECT * FROM t AS a, t AS b;",
+	} {
+		ctx, cancel := context.WithCancel(context.Background())
+		rs, _, err := db.RunContext(ctx, nil, q)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		n := 0
+		err = rs[0].Do(false, func([]interface{}) (bool, error) {
+			if n++; n == 3 {
+				cancel()
+			}
+			return true, nil
+		})
+		if err != context.Canceled || n != 3 {
+			t.Fatalf("%s: got %v after %d rows, exp %v after 3 rows", q, err, n, context.Canceled)
+		}
+	}
+
+	// Waiting for a transaction to finish stops when the context is done.
+	if _, _, err := db.Run(tctx, "BEGIN TRANSACTION;"); err != nil {
+		t.Fatal(err)
+	}
+
+	tctx2 := NewRWCtx()
+	ctx, cancel = context.WithTimeout(context.Background(), 50*time.Millisecond)
+	if _, _, err := db.RunContext(ctx, tctx2, "BEGIN TRANSACTION;"); err != context.DeadlineExceeded {
+		t.Fatalf("got %v, exp %v", err, context.DeadlineExceeded)
+	}
+
+	cancel()
+
+	done := make(chan error, 1)
+	go func() {
+		_, _, err := db.Run(tctx2, "BEGIN TRANSACTION; INSERT INTO t VALUES (-1); COMMIT;")
+		done <- err
+	}()
+	if _, _, err := db.Run(tctx, "COMMIT;"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+
+	rs, _, err := db.Run(nil, "SELECT count(*) FROM t;")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if row, err := rs[0].FirstRow(); err != nil || row[0] != int64(1001) {
+		t.Fatal(row, err)
+	}
+}
+
+func TestSnapshotIsolation(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ql-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	mem, err := OpenMem()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer mem.Close()
+
+	file, err := OpenFile(filepath.Join(dir, "test.db"), &Options{CanCreate: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer file.Close()
+
+	for _, db := range []*DB{mem, file} {
+		testSnapshotIsolation(t, db)
+	}
+}
+
+func testSnapshotIsolation(t *testing.T, db *DB) {
+	sum := func(tctx *TCtx) int64 {
+		rs, _, err := db.Run(tctx, "SELECT sum(i) FROM t;")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		row, err := rs[0].FirstRow()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return row[0].(int64)
+	}
+
+	tctx := NewRWCtx()
+	if _, _, err := db.Run(tctx, `
+		BEGIN TRANSACTION;
+			CREATE TABLE t (i int64, b blob);
+			CREATE INDEX x ON t (i);
+	`); err != nil {
+		t.Fatal(err)
+	}
+
+	ins := MustCompile("INSERT INTO t VALUES ($1, $2);")
+	for i := 1; i <= 100; i++ {
+		if _, _, err := db.Execute(tctx, ins, int64(i), bytes.Repeat([]byte{byte(i)}, 1000)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, _, err := db.Run(tctx, "COMMIT;"); err != nil {
+		t.Fatal(err)
+	}
+
+	// Statements outside of a transaction do not wait for the open one and
+	// do not see its changes.
+	if _, _, err := db.Run(tctx, "BEGIN TRANSACTION; UPDATE t i = i+1000;"); err != nil {
+		t.Fatal(err)
+	}
+
+	if g, e := sum(nil), int64(5050); g != e {
+		t.Fatalf("got %v, exp %v", g, e)
+	}
+
+	if g, e := sum(tctx), int64(105050); g != e {
+		t.Fatalf("got %v, exp %v", g, e)
+	}
+
+	if _, _, err := db.Run(tctx, "ROLLBACK;"); err != nil {
+		t.Fatal(err)
+	}
+
+	// Changes committed while reading a Recordset are not visible to it.
+	for _, q := range []string{
+		"SELECT i, b FROM t;",
+		"SELECT i, b FROM t WHERE i > 50;",
+		"SELECT i, b FROM t WHERE i > 50 ORDER BY i;",
+	} {
+		rs, _, err := db.Run(nil, q)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var got []int64
+		if err := rs[0].Do(false, func(data []interface{}) (bool, error) {
+			if len(got) == 0 {
+				if _, _, err := db.Run(tctx, `
+					BEGIN TRANSACTION;
+						UPDATE t i = i+1000 WHERE i%2 == 0;
+						DELETE FROM t WHERE i%3 == 0;
+						INSERT INTO t VALUES (75, blob("new"));
+					COMMIT;
+				`); err != nil {
+					return false, err
+				}
+			}
+			i := data[0].(int64)
+			if b := data[1].([]byte); len(b) != 1000 || b[0] != byte(i) {
+				return false, fmt.Errorf("%s: bad blob of row %v", q, i)
+			}
+
+			got = append(got, i)
+			return true, nil
+		}); err != nil {
+			t.Fatal(err)
+		}
+
+		exp := int64(100)
+		if strings.Contains(q, "WHERE") {
+			exp = 50
+		}
+		if g, e := int64(len(got)), exp; g != e {
+			t.Fatalf("%s: got %v rows, exp %v", q, g, e)
+		}
+
+		if _, _, err := db.Run(tctx, "BEGIN TRANSACTION; DELETE FROM t;"); err != nil {
+			t.Fatal(err)
+		}
+
+		for i := 1; i <= 100; i++ {
+			if _, _, err := db.Execute(tctx, ins, int64(i), bytes.Repeat([]byte{byte(i)}, 1000)); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if _, _, err := db.Run(tctx, "COMMIT;"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// A Recordset read after a commit sees the committed state.
+	rs, _, err := db.Run(nil, "SELECT sum(i) FROM t;")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := db.Run(tctx, "BEGIN TRANSACTION; INSERT INTO t VALUES (1000, NULL); COMMIT;"); err != nil {
+		t.Fatal(err)
+	}
+
+	if row, err := rs[0].FirstRow(); err != nil || row[0] != int64(6050) {
+		t.Fatal(row, err)
+	}
+
+	if g := len(db.store.(*mvcc).versions); g != 0 {
+		t.Fatalf("got %v retained versions, exp 0", g)
+	}
+
+	// Concurrent readers always see a consistent state.
+	var wg sync.WaitGroup
+	stop := make(chan struct{})
+	errs := make(chan error, 4)
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+				rs, _, err := db.Run(nil, "SELECT sum(i) FROM t WHERE i >= 0;")
+				if err != nil {
+					errs <- err
+					return
+				}
+
+				row, err := rs[0].FirstRow()
+				if err != nil {
+					errs <- err
+					return
+				}
+
+				if g, e := row[0], int64(6050); g != e {
+					errs <- fmt.Errorf("got %v, exp %v", g, e)
+					return
+				}
+			}
+		}()
+	}
+	for i := 1; i < 50; i++ {
+		if _, _, err := db.Execute(tctx, MustCompile(`
+			BEGIN TRANSACTION;
+				UPDATE t i = i+1 WHERE i == $1;
+				UPDATE t i = i-1 WHERE i == $2;
+			COMMIT;
+		`), int64(i), int64(101-i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	close(stop)
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Fatal(err)
+	}
+}
+
+func TestLimits(t *testing.T) {
+	db, err := OpenMem()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	tctx := NewRWCtx()
+	if _, _, err := db.Run(tctx, `
+		BEGIN TRANSACTION;
+			CREATE TABLE t (i int64, s string);
+	`); err != nil {
+		t.Fatal(err)
+	}
+
+	ins := MustCompile("INSERT INTO t VALUES ($1, $2);")
+	for i := 0; i < 100; i++ {
+		if _, _, err := db.Execute(tctx, ins, int64(i), strings.Repeat("x", i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, _, err := db.Run(tctx, "COMMIT;"); err != nil {
+		t.Fatal(err)
+	}
+
+	count := func(tctx *TCtx, q string) (n int, err error) {
+		rs, _, err := db.Run(tctx, q)
+		if err != nil {
+			return 0, err
+		}
+
+		err = rs[0].Do(false, func([]interface{}) (bool, error) {
+			n++
+			return true, nil
+		})
+		return n, err
+	}
+
+	for _, v := range []struct {
+		l   Limits
+		q   string
+		err error
+	}{
+		{Limits{}, "SELECT * FROM t ORDER BY s;", nil},
+		{Limits{MaxRowsExamined: 100}, "SELECT * FROM t;", nil},
+		{Limits{MaxRowsExamined: 99}, "SELECT * FROM t;", ErrMaxRowsExamined},
+		{Limits{MaxRowsExamined: 150}, "SELECT * FROM t WHERE i IN (SELECT i FROM t WHERE i < 10);", ErrMaxRowsExamined},
+		{Limits{MaxResultRows: 100}, "SELECT * FROM t;", nil},
+		{Limits{MaxResultRows: 10}, "SELECT * FROM t;", ErrMaxResultRows},
+		{Limits{MaxResultRows: 10}, "SELECT count(*) FROM t;", nil},
+		{Limits{MaxTempBytes: 1000}, "SELECT * FROM t;", nil},
+		{Limits{MaxTempBytes: 1000}, "SELECT * FROM t ORDER BY s;", ErrMaxTempBytes},
+		{Limits{MaxTempBytes: 1000}, "SELECT DISTINCT s FROM t;", ErrMaxTempBytes},
+		{Limits{MaxTempBytes: 1000}, "SELECT s, count(*) FROM t GROUP BY s;", ErrMaxTempBytes},
+	} {
+		db.SetLimits(v.l)
+		if _, err := count(nil, v.q); err != v.err {
+			t.Fatalf("%+v %s: got %v, exp %v", v.l, v.q, err, v.err)
+		}
+	}
+
+	// The limits of a TCtx replace the DB limits.
+	db.SetLimits(Limits{MaxRowsExamined: 10})
+	tctx.Limits = &Limits{}
+	if n, err := count(tctx, "SELECT * FROM t;"); n != 100 || err != nil {
+		t.Fatal(n, err)
+	}
+
+	// A failing statement rolls back the transaction.
+	tctx.Limits = &Limits{MaxRowsExamined: 99}
+	if _, _, err := db.Run(tctx, `
+		BEGIN TRANSACTION;
+			INSERT INTO t VALUES (-1, "");
+			UPDATE t i = i+1;
+		COMMIT;
+	`); err != ErrMaxRowsExamined {
+		t.Fatalf("got %v, exp %v", err, ErrMaxRowsExamined)
+	}
+
+	tctx.Limits = nil
+	db.SetLimits(Limits{})
+	if n, err := count(nil, "SELECT * FROM t WHERE i < 1;"); n != 1 || err != nil {
+		t.Fatal(n, err)
+	}
+
+	db.SetLimits(Limits{MaxStatementTime: 20 * time.Millisecond})
+	if n, err := count(nil, "SELECT * FROM t;"); n != 100 || err != nil {
+		t.Fatal(n, err)
+	}
+
+	rs, _, err := db.Run(nil, "SELECT * FROM t;")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := rs[0].Do(false, func([]interface{}) (bool, error) {
+		time.Sleep(time.Millisecond)
+		return true, nil
+	}); err != ErrMaxStatementTime {
+		t.Fatalf("got %v, exp %v", err, ErrMaxStatementTime)
+	}
+
+	// Waiting for another transaction counts as well.
+	db.SetLimits(Limits{})
+	if _, _, err := db.Run(tctx, "BEGIN TRANSACTION;"); err != nil {
+		t.Fatal(err)
+	}
+
+	tctx2 := &TCtx{Limits: &Limits{MaxStatementTime: 20 * time.Millisecond}}
+	if _, _, err := db.Run(tctx2, "BEGIN TRANSACTION;"); err != ErrMaxStatementTime {
+		t.Fatalf("got %v, exp %v", err, ErrMaxStatementTime)
+	}
+
+	if _, _, err := db.Run(tctx, "COMMIT;"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := db.Run(tctx2, "BEGIN TRANSACTION; COMMIT;"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBackup(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ql-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	mem, err := OpenMem()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer mem.Close()
+
+	file, err := OpenFile(filepath.Join(dir, "test.db"), &Options{CanCreate: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer file.Close()
+
+	for i, db := range []*DB{mem, file} {
+		testBackup(t, db, filepath.Join(dir, fmt.Sprintf("backup%d.db", i)))
+	}
+}
+
+func testBackup(t *testing.T, db *DB, name string) {
+	tctx := NewRWCtx()
+	if _, _, err := db.Run(tctx, `
+		BEGIN TRANSACTION;
+			CREATE TABLE t (i int64 NOT NULL, s string DEFAULT "x", b blob);
+			CREATE TABLE u (t time, c int64 c > 0);
+			CREATE INDEX xi ON t (i);
+			CREATE UNIQUE INDEX xid ON t (id());
+			CREATE INDEX xe ON t (i, s);
+			CREATE INDEX xe2 ON u (c*2);
+	`); err != nil {
+		t.Fatal(err)
+	}
+
+	ins := MustCompile("INSERT INTO t VALUES ($1, $2, $3);")
+	for i := 0; i < 100; i++ {
+		if _, _, err := db.Execute(tctx, ins, int64(i), fmt.Sprint(i), bytes.Repeat([]byte{byte(i)}, 100*i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, _, err := db.Run(tctx, `
+			DELETE FROM t WHERE i%3 == 0;
+			INSERT INTO u VALUES (date(2017, 1, 2, 3, 4, 5, 6, "UTC"), 42);
+		COMMIT;
+		BEGIN TRANSACTION;
+			INSERT INTO t (i) VALUES (1000);
+	`); err != nil {
+		t.Fatal(err)
+	}
+
+	// The open transaction is not included in the backup.
+	var buf bytes.Buffer
+	if err := db.Backup(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := db.Run(tctx, "COMMIT;"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := Restore(name, &buf); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := db.BackupTo(name); err == nil {
+		t.Fatal("unexpected success")
+	}
+
+	db2, err := OpenFile(name, &Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db2.Close()
+
+	dump := func(db *DB, q string) string {
+		rs, _, err := db.Run(nil, q)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var a []string
+		if err := rs[0].Do(false, func(data []interface{}) (bool, error) {
+			a = append(a, fmt.Sprint(data...))
+			return true, nil
+		}); err != nil {
+			t.Fatal(err)
+		}
+
+		return strings.Join(a, "\n")
+	}
+
+	for _, q := range []string{
+		"SELECT id(), i, s, b FROM t WHERE i != 1000;",
+		"SELECT id(), t, c FROM u;",
+		"SELECT * FROM __Table ORDER BY Name;",
+		"SELECT * FROM __Index WHERE !hasPrefix(TableName, \"__\") ORDER BY Name;",
+		"SELECT i, s FROM t WHERE i > 90 && i < 1000;",
+	} {
+		if g, e := dump(db2, q), dump(db, q); g != e || g == "" {
+			t.Fatalf("%s\n---- got\n%s\n---- exp\n%s", q, g, e)
+		}
+	}
+
+	if g, e := dump(db2, "SELECT count(*) FROM t WHERE i == 1000;"), "0"; g != e {
+		t.Fatalf("got %s, exp %s", g, e)
+	}
+
+	// The constraints, defaults and row IDs are preserved.
+	if _, _, err := db2.Run(tctx, `
+		BEGIN TRANSACTION;
+			INSERT INTO t (i) VALUES (2000);
+		COMMIT;
+	`); err != nil {
+		t.Fatal(err)
+	}
+
+	if g, e := dump(db2, "SELECT s FROM t WHERE i == 2000 && id() > 100;"), "x"; g != e {
+		t.Fatalf("got %s, exp %s", g, e)
+	}
+
+	if _, _, err := db2.Run(tctx, "BEGIN TRANSACTION; INSERT INTO u VALUES (now(), -1); COMMIT;"); err == nil {
+		t.Fatal("unexpected success")
+	}
+}
+
+func TestDump(t *testing.T) {
+	db, err := OpenMem()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	tctx := NewRWCtx()
+	if _, _, err := db.Run(tctx, `
+		BEGIN TRANSACTION;
+			CREATE TABLE t (
+				b blob, c64 complex64, c128 complex128, d duration, f32 float32, f64 float64,
+				i8 int8, i64 int64 NOT NULL, n bigint, r bigrat, s string DEFAULT "x", tm time,
+				u8 uint8, u64 uint64, ok bool,
+			);
+			CREATE TABLE u (i int64 i > 0);
+			CREATE INDEX xi ON t (i64);
+			CREATE UNIQUE INDEX xu ON u (id());
+			CREATE INDEX xe ON t (i64, s);
+	`); err != nil {
+		t.Fatal(err)
+	}
+
+	loc := time.FixedZone("", -7*3600)
+	ins := MustCompile("INSERT INTO t VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15);")
+	for i := 0; i < 10; i++ {
+		n := big.NewInt(int64(i))
+		n.Exp(n, big.NewInt(100), nil)
+		if _, _, err := db.Execute(tctx, ins,
+			[]byte{0, byte(i), 0xff, '"'},
+			complex64(complex(float32(i)/3, -1.5)),
+			complex(float64(i)/7, 1e300),
+			time.Duration(i)*time.Hour+time.Nanosecond,
+			float32(i)/3,
+			-float64(i)/3,
+			int8(-i),
+			int64(i),
+			n,
+			big.NewRat(int64(i), 7),
+			fmt.Sprintf("a\"b\\c\n\x00\xff世界%d", i),
+			time.Date(2017, 1, 2, 3, 4, 5, i, loc),
+			uint8(255-i),
+			uint64(math.MaxUint64)-uint64(i),
+			i%2 == 0,
+		); err != nil {
+			t.Fatal(err)
+		}
+
+		if i == 4 {
+			if _, _, err := db.Run(tctx, "INSERT INTO u VALUES (1), (2);"); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if _, _, err := db.Run(tctx, `
+			INSERT INTO t (i64) VALUES (-1);
+			INSERT INTO u VALUES (3);
+			DELETE FROM t WHERE i64 == 1;
+		COMMIT;
+	`); err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := db.Dump(&buf, &DumpOptions{RowsPerInsert: 4}); err != nil {
+		t.Fatal(err)
+	}
+
+	dump := buf.String()
+	db2, err := OpenMem()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db2.Close()
+
+	if _, _, err := db2.Run(tctx, dump); err != nil {
+		t.Fatalf("%v\n%s", err, dump)
+	}
+
+	buf.Reset()
+	if err := db2.Dump(&buf, &DumpOptions{RowsPerInsert: 4}); err != nil {
+		t.Fatal(err)
+	}
+
+	if g, e := buf.String(), dump; g != e {
+		t.Fatalf("---- got\n%s\n---- exp\n%s", g, e)
+	}
+
+	rows := func(db *DB, q string) (r [][]interface{}) {
+		rs, _, err := db.Run(nil, q)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if r, err = rs[0].Rows(-1, 0); err != nil {
+			t.Fatal(err)
+		}
+
+		return r
+	}
+
+	for _, q := range []string{
+		"SELECT * FROM t;",
+		"SELECT id() FROM t;",
+		"SELECT id(), i FROM u;",
+	} {
+		if g, e := rows(db2, q), rows(db, q); !reflect.DeepEqual(g, e) {
+			t.Fatalf("---- got\n%v\n---- exp\n%v", g, e)
+		}
+	}
+
+	buf.Reset()
+	if err := db.Dump(&buf, &DumpOptions{Tables: regexp.MustCompile("^u$")}); err != nil {
+		t.Fatal(err)
+	}
+
+	if g, e := buf.String(), `BEGIN TRANSACTION;
+CREATE TABLE u (i int64 i > 0);
+CREATE UNIQUE INDEX xu ON u (id());
+INSERT INTO u (id(), i) VALUES
+	(26, 1),
+	(27, 2),
+	(34, 3);
+COMMIT;
+`; g != e {
+		t.Fatalf("---- got\n%s\n---- exp\n%s", g, e)
+	}
+}
+
+func TestCSV(t *testing.T) {
+	db, err := OpenMem()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	if _, _, err := db.Run(NewRWCtx(), `
+		BEGIN TRANSACTION;
+			CREATE TABLE t (
+				b blob, c complex64, d duration, f float32, i int8, n bigint, r bigrat,
+				s string, tm time, u uint64, ok bool, x int64 DEFAULT 42,
+			);
+			CREATE TABLE u (i int64, s string);
+			CREATE UNIQUE INDEX xi ON u (i);
+		COMMIT;
+	`); err != nil {
+		t.Fatal(err)
+	}
+
+	const src = `s;i;u;f;c;d;n;r;tm;ok;b
+"a;""b"";c";-128;18446744073709551615;1.5;(1+2i);1h2m3.5s;123456789012345678901234567890;1/3;2017-01-02T03:04:05.123456789-07:00;true;blob
+\N;\N;\N;\N;\N;\N;\N;\N;\N;\N;\N
+;0;0;0;0;0s;0;0;2017-01-02T03:04:05Z;F;
+`
+	n, err := db.ImportCSV("t", strings.NewReader(src), &CSVOptions{Comma: ';', Header: true, Null: `\N`, BatchSize: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if g, e := n, int64(3); g != e {
+		t.Fatalf("got %v, exp %v", g, e)
+	}
+
+	var buf bytes.Buffer
+	if err := db.ExportCSV(&buf, "SELECT s, i, u, f, c, d, n, r, tm, ok, b, x FROM t ORDER BY i;", &CSVOptions{Header: true, Null: "NULL"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if g, e := buf.String(), `s,i,u,f,c,d,n,r,tm,ok,b,x
+NULL,NULL,NULL,NULL,NULL,NULL,NULL,NULL,NULL,NULL,NULL,42
+"a;""b"";c",-128,18446744073709551615,1.5,(1+2i),1h2m3.5s,123456789012345678901234567890,1/3,2017-01-02T03:04:05.123456789-07:00,true,blob,42
+,0,0,0,(0+0i),0s,0,0,2017-01-02T03:04:05Z,false,,42
+`; g != e {
+		t.Fatalf("---- got\n%s\n---- exp\n%s", g, e)
+	}
+
+	// Round trip, without a header.
+	if _, _, err := db.Run(NewRWCtx(), "BEGIN TRANSACTION; CREATE TABLE t2 (s string, i int8, u uint64, f float32, c complex64, d duration, n bigint, r bigrat, tm time, ok bool, b blob, x int64); COMMIT;"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := db.ImportCSV("t2", strings.NewReader(buf.String()[strings.Index(buf.String(), "\n")+1:]), &CSVOptions{Null: "NULL"}); err != nil {
+		t.Fatal(err)
+	}
+
+	rows := func(q string) [][]interface{} {
+		rs, _, err := db.Run(nil, q)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		r, err := rs[0].Rows(-1, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return r
+	}
+	if g, e := rows("SELECT * FROM t2 ORDER BY i;"), rows("SELECT s, i, u, f, c, d, n, r, tm, ok, b, x FROM t ORDER BY i;"); !reflect.DeepEqual(g, e) {
+		t.Fatalf("---- got\n%v\n---- exp\n%v", g, e)
+	}
+
+	// Batches committed before an error remain.
+	n, err = db.ImportCSV("u", strings.NewReader("1,a\n2,b\n3,c\n4,d\n5,e\n3,f\n"), &CSVOptions{BatchSize: 2})
+	if err == nil || !strings.Contains(err.Error(), "line 6") {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if g, e := n, int64(4); g != e {
+		t.Fatalf("got %v, exp %v", g, e)
+	}
+
+	if g, e := fmt.Sprint(rows("SELECT i FROM u ORDER BY i;")), "[[1] [2] [3] [4]]"; g != e {
+		t.Fatalf("got %v, exp %v", g, e)
+	}
+
+	for _, v := range []struct {
+		table, src, err string
+	}{
+		{"u", "1,a,x\n", "wrong number of fields"},
+		{"u", "i,i\n1,2\n", "duplicate column i"},
+		{"u", "i,j\n1,2\n", "has no column j"},
+		{"u", "a,b\n", `line 1, column i: strconv.ParseInt: parsing "a"`},
+		{"u", "300000000000000000000,a\n", "value out of range"},
+		{"v", "1\n", "table v does not exist"},
+		{"__Table", "1\n", "table __Table does not exist"},
+	} {
+		_, err := db.ImportCSV(v.table, strings.NewReader(v.src), &CSVOptions{Header: strings.HasPrefix(v.src, "i,")})
+		if err == nil || !strings.Contains(err.Error(), v.err) {
+			t.Fatalf("%q: unexpected error %v", v.src, err)
+		}
+	}
+
+	if err := db.ExportCSV(&buf, "DELETE FROM u;", nil); err == nil {
+		t.Fatal("unexpected success")
+	}
+}
+
+func TestDecimal(t *testing.T) {
+	for _, v := range []struct {
+		s     string
+		scale int
+		mode  RoundingMode
+		exp   string
+	}{
+		{"0", 2, RoundHalfUp, "0.00"},
+		{"-0.5", 0, RoundHalfUp, "-1"},
+		{"-0.5", 0, RoundHalfEven, "0"},
+		{"1.5", 0, RoundHalfEven, "2"},
+		{"2.5", 0, RoundHalfDown, "2"},
+		{"1.01", 1, RoundUp, "1.1"},
+		{"-1.09", 1, RoundDown, "-1.0"},
+		{"-1.01", 1, RoundCeiling, "-1.0"},
+		{"-1.01", 1, RoundFloor, "-1.1"},
+		{"1234.5", -2, RoundHalfUp, "1200"},
+		{"1.2e3", 1, RoundHalfUp, "1200.0"},
+		{"-.125E-1", 5, RoundHalfUp, "-0.01250"},
+	} {
+		d, err := ParseDec(v.s)
+		if err != nil {
+			t.Fatal(v.s, err)
+		}
+
+		if g, e := d.Round(v.scale, v.mode).String(), v.exp; g != e {
+			t.Fatalf("%s %d %d: got %s, exp %s", v.s, v.scale, v.mode, g, e)
+		}
+	}
+
+	for _, s := range []string{"", "-", ".", "1.2.3", "1e", "0x10", "1_000"} {
+		if _, err := ParseDec(s); err == nil {
+			t.Fatalf("%q: unexpected success", s)
+		}
+	}
+
+	dir, err := ioutil.TempDir("", "ql-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	name := filepath.Join(dir, "test.db")
+	db, err := OpenFile(name, &Options{CanCreate: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	amount, _ := ParseDec("-12345678901234567890.125")
+	if _, _, err := db.Run(NewRWCtx(), `
+		BEGIN TRANSACTION;
+			CREATE TABLE t (i int64, d decimal(30, 2));
+			CREATE INDEX x ON t (d);
+			INSERT INTO t VALUES (1, $1), (2, 0.1), (3, NULL);
+		COMMIT;
+	`, amount); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := db.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if db, err = OpenFile(name, &Options{}); err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	rs, _, err := db.Run(nil, "SELECT i, d FROM t WHERE d < 1 ORDER BY d;")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rows, err := rs[0].Rows(-1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if g, e := fmt.Sprint(rows), "[[1 -12345678901234567890.13] [2 0.10]]"; g != e {
+		t.Fatalf("got %s, exp %s", g, e)
+	}
+
+	info, err := db.Info()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, ti := range info.Tables {
+		if ti.Name == "t" {
+			if g, e := ti.Columns[1], (ColumnInfo{Name: "d", Type: Decimal, Precision: 30, Scale: 2}); g != e {
+				t.Fatalf("got %+v, exp %+v", g, e)
+			}
+		}
+	}
+
+	RegisterMemDriver()
+	sdb, err := sql.Open("ql-mem", "TestDecimal")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer sdb.Close()
+
+	exec := func(q string, arg ...interface{}) error {
+		tx, err := sdb.Begin()
+		if err != nil {
+			return err
+		}
+
+		if _, err = tx.Exec(q, arg...); err != nil {
+			tx.Rollback()
+			return err
+		}
+
+		return tx.Commit()
+	}
+
+	if err := exec("CREATE TABLE t (d decimal(8, 3));"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := exec("INSERT INTO t VALUES (decimal($1));", amount); err == nil || !strings.Contains(err.Error(), "overflows") {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if err := exec("INSERT INTO t VALUES (decimal($1));", "-1.2345"); err != nil {
+		t.Fatal(err)
+	}
+
+	var d Dec
+	var s string
+	if err := sdb.QueryRow("SELECT d, string(d) FROM t;").Scan(&d, &s); err != nil {
+		t.Fatal(err)
+	}
+
+	if g, e := d.String(), "-1.235"; g != e || s != e {
+		t.Fatalf("got %s %s, exp %s", g, s, e)
+	}
+}
+
+func TestUUIDEnum(t *testing.T) {
+	for _, s := range []string{
+		"6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		"6BA7B8109DAD11D180B400C04FD430C8",
+		"{6ba7b810-9dad-11d1-80b4-00c04fd430c8}",
+		"urn:uuid:6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+	} {
+		u, err := ParseUUID(s)
+		if err != nil {
+			t.Fatal(s, err)
+		}
+
+		if g, e := u.String(), "6ba7b810-9dad-11d1-80b4-00c04fd430c8"; g != e {
+			t.Fatalf("%s: got %s, exp %s", s, g, e)
+		}
+	}
+
+	for _, s := range []string{"", "6ba7b810-9dad-11d1-80b4-00c04fd430c", "6ba7b810+9dad-11d1-80b4-00c04fd430c8", "{6ba7b8109dad11d180b400c04fd430cx}"} {
+		if _, err := ParseUUID(s); err == nil {
+			t.Fatalf("%q: unexpected success", s)
+		}
+	}
+
+	prev, err := newUUIDv7()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 10000; i++ {
+		u, err := newUUIDv7()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if bytes.Compare(u[:], prev[:]) <= 0 {
+			t.Fatalf("%s <= %s", u, prev)
+		}
+
+		prev = u
+	}
+
+	dir, err := ioutil.TempDir("", "ql-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	name := filepath.Join(dir, "test.db")
+	db, err := OpenFile(name, &Options{CanCreate: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	u1, _ := ParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	if _, _, err := db.Run(NewRWCtx(), `
+		BEGIN TRANSACTION;
+			CREATE TYPE status AS ENUM ("new", "active", "closed");
+			CREATE TABLE t (i int64, s status, u uuid);
+			CREATE INDEX xs ON t (s);
+			CREATE INDEX xu ON t (u);
+			INSERT INTO t VALUES (1, "closed", $1), (2, "new", NULL), (3, "active", "00000000-0000-0000-0000-000000000001");
+		COMMIT;
+	`, u1); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := db.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if db, err = OpenFile(name, &Options{}); err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	rs, _, err := db.Run(nil, `SELECT i, s, u FROM t WHERE s > "new" ORDER BY s;`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rows, err := rs[0].Rows(-1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if g, e := fmt.Sprint(rows), "[[3 active 00000000-0000-0000-0000-000000000001] [1 closed 6ba7b810-9dad-11d1-80b4-00c04fd430c8]]"; g != e {
+		t.Fatalf("got %s, exp %s", g, e)
+	}
+
+	e, ok := rows[1][1].(Enum)
+	if !ok || e.Type() != "status" || e.Ordinal() != 2 {
+		t.Fatalf("got %#v", rows[1][1])
+	}
+
+	info, err := db.Info()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if g, e := fmt.Sprint(info.Enums), "[{status [new active closed]}]"; g != e {
+		t.Fatalf("got %s, exp %s", g, e)
+	}
+
+	for _, ti := range info.Tables {
+		if ti.Name == "t" {
+			if g, e := ti.Columns[1], (ColumnInfo{Name: "s", Type: EnumType, Enum: "status"}); g != e {
+				t.Fatalf("got %+v, exp %+v", g, e)
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := db.Dump(&buf, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	dump := buf.String()
+	if !strings.Contains(dump, `CREATE TYPE status AS ENUM ("new", "active", "closed");`) {
+		t.Fatalf("missing CREATE TYPE\n%s", dump)
+	}
+
+	db2, err := OpenMem()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db2.Close()
+
+	if _, _, err := db2.Run(NewRWCtx(), dump); err != nil {
+		t.Fatalf("%v\n%s", err, dump)
+	}
+
+	buf.Reset()
+	if err := db2.Dump(&buf, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if g, e := buf.String(), dump; g != e {
+		t.Fatalf("got\n%s\nexp\n%s", g, e)
+	}
+
+	type T struct {
+		ID int64
+		S  string `ql:"enum status"`
+		U  UUID
+		P  *[16]byte
+	}
+
+	schema, err := Schema((*T)(nil), "", &SchemaOptions{NoTransaction: true, NoIfNotExists: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if g, e := schema.String(), "CREATE TABLE T (S status, U uuid, P uuid);\n"; g != e {
+		t.Fatalf("got %s, exp %s", g, e)
+	}
+
+	u2 := [16]byte(u1)
+	in := &T{S: "active", U: u1, P: &u2}
+	args, err := Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := NewRWCtx()
+	if _, _, err := db2.Execute(ctx, MustCompile("BEGIN TRANSACTION;"+schema.String()+"INSERT INTO T VALUES ($1, $2, $3); COMMIT;"), args...); err != nil {
+		t.Fatal(err)
+	}
+
+	rs, _, err = db2.Run(nil, "SELECT id(), S, U, P FROM T;")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	row, err := rs[0].FirstRow()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var out T
+	if err := Unmarshal(&out, row); err != nil {
+		t.Fatal(err)
+	}
+
+	if out.S != in.S || out.U != in.U || *out.P != *in.P {
+		t.Fatalf("got %+v, exp %+v", out, in)
+	}
+
+	RegisterMemDriver()
+	sdb, err := sql.Open("ql-mem", "TestUUIDEnum")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer sdb.Close()
+
+	tx, err := sdb.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = tx.Exec(`
+		CREATE TYPE status AS ENUM ("new", "active");
+		CREATE TABLE t (s status, u uuid);
+		INSERT INTO t VALUES ($1, $2);
+	`, e, u1); err == nil || !strings.Contains(err.Error(), "invalid value") {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	tx.Rollback()
+	if tx, err = sdb.Begin(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = tx.Exec(`
+		CREATE TYPE status AS ENUM ("new", "active");
+		CREATE TABLE t (s status, u uuid);
+		INSERT INTO t VALUES ($1, $2);
+	`, "active", u1); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	var u UUID
+	var s string
+	if err := sdb.QueryRow("SELECT u, s FROM t WHERE u == $1;", u1.String()).Scan(&u, &s); err != nil {
+		t.Fatal(err)
+	}
+
+	if u != u1 || s != "active" {
+		t.Fatalf("got %s %s", u, s)
+	}
+}
+
+func TestArray(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ql-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	name := filepath.Join(dir, "test.db")
+	db, err := OpenFile(name, &Options{CanCreate: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tm := time.Date(2020, 1, 2, 3, 4, 5, 6, time.UTC)
+	if _, _, err := db.Run(NewRWCtx(), `
+		BEGIN TRANSACTION;
+			CREATE TABLE t (i int64, s []string, n []int64, tm []time, d []duration);
+			CREATE INDEX xs ON t (s);
+			INSERT INTO t VALUES (1, []string{"a", "b"}, []int64{1, 2}, $1, []duration{duration("1s")}), (2, $2, NULL, NULL, NULL);
+		COMMIT;
+	`, []time.Time{tm}, []string{"b", "c"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := db.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if db, err = OpenFile(name, &Options{}); err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	rs, _, err := db.Run(nil, `SELECT i, s, tm FROM t WHERE contains(s, "b") ORDER BY i;`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rows, err := rs[0].Rows(-1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if g, e := fmt.Sprintf("%#v", rows), fmt.Sprintf("%#v", [][]interface{}{{int64(1), []string{"a", "b"}, []time.Time{tm}}, {int64(2), []string{"b", "c"}, nil}}); g != e {
+		t.Fatalf("got %s, exp %s", g, e)
+	}
+
+	info, err := db.Info()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, ti := range info.Tables {
+		if ti.Name == "t" {
+			if g, e := ti.Columns[1], (ColumnInfo{Name: "s", Type: Array, Elem: String}); g != e {
+				t.Fatalf("got %+v, exp %+v", g, e)
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := db.Dump(&buf, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	dump := buf.String()
+	if !strings.Contains(dump, `[]string{"a", "b"}`) {
+		t.Fatalf("missing array literal\n%s", dump)
+	}
+
+	db2, err := OpenMem()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db2.Close()
+
+	if _, _, err := db2.Run(NewRWCtx(), dump); err != nil {
+		t.Fatalf("%v\n%s", err, dump)
+	}
+
+	buf.Reset()
+	if err := db2.Dump(&buf, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if g, e := buf.String(), dump; g != e {
+		t.Fatalf("got\n%s\nexp\n%s", g, e)
+	}
+
+	buf.Reset()
+	if err := db.ExportCSV(&buf, "SELECT i, s, n, tm, d FROM t ORDER BY i;", nil); err != nil {
+		t.Fatal(err)
+	}
+
+	csv := buf.String()
+	if g, e := csv, "1,\"[\"\"a\"\",\"\"b\"\"]\",\"[1,2]\",\"[\"\"2020-01-02T03:04:05.000000006Z\"\"]\",[1000000000]\n2,\"[\"\"b\"\",\"\"c\"\"]\",,,\n"; g != e {
+		t.Fatalf("got %q, exp %q", g, e)
+	}
+
+	ctx := NewRWCtx()
+	if _, _, err := db2.Run(ctx, "BEGIN TRANSACTION; TRUNCATE TABLE t; COMMIT;"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := db2.ImportCSV("t", strings.NewReader(csv), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// The imported rows have new IDs.
+	all := func(db *DB) (r [][]interface{}) {
+		rs, _, err := db.Run(nil, "SELECT * FROM t ORDER BY i;")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if r, err = rs[0].Rows(-1, 0); err != nil {
+			t.Fatal(err)
+		}
+
+		return r
+	}
+
+	if g, e := all(db2), all(db); !reflect.DeepEqual(g, e) {
+		t.Fatalf("got\n%v\nexp\n%v", g, e)
+	}
+
+	type T struct {
+		ID   int64
+		Tags []string
+		N    []int64
+		B    []byte
+	}
+
+	schema, err := Schema((*T)(nil), "", &SchemaOptions{NoTransaction: true, NoIfNotExists: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if g, e := schema.String(), "CREATE TABLE T (Tags []string, N []int64, B blob);\n"; g != e {
+		t.Fatalf("got %s, exp %s", g, e)
+	}
+
+	in := &T{Tags: []string{"x", "y"}, B: []byte("z")}
+	args, err := Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := db2.Execute(ctx, MustCompile("BEGIN TRANSACTION;"+schema.String()+"INSERT INTO T VALUES ($1, $2, $3); COMMIT;"), args...); err != nil {
+		t.Fatal(err)
+	}
+
+	rs, _, err = db2.Run(nil, "SELECT id(), Tags, N, B FROM T;")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	row, err := rs[0].FirstRow()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var out T
+	if err := Unmarshal(&out, row); err != nil {
+		t.Fatal(err)
+	}
+
+	if g, e := fmt.Sprint(out.Tags, " ", out.N == nil, " ", string(out.B)), "[x y] true z"; g != e {
+		t.Fatalf("got %s, exp %s", g, e)
+	}
+
+	RegisterMemDriver()
+	sdb, err := sql.Open("ql-mem", "TestArray")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer sdb.Close()
+
+	tx, err := sdb.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = tx.Exec(`
+		CREATE TABLE t (s []string);
+		INSERT INTO t VALUES ($1);
+	`, []string{"a", "b"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	var s []byte
+	if err := sdb.QueryRow("SELECT s FROM t WHERE s == $1;", []string{"a", "b"}).Scan(&s); err != nil {
+		t.Fatal(err)
+	}
+
+	if g, e := string(s), `["a","b"]`; g != e {
+		t.Fatalf("got %s, exp %s", g, e)
+	}
+}
+
+type pluralStemmer struct{}
+
+func (pluralStemmer) Stem(term string) string { return strings.TrimSuffix(term, "s") }
+
+func TestFullText(t *testing.T) {
+	if err := RegisterTokenizer("plural", SimpleTokenizer{}, pluralStemmer{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := RegisterTokenizer("plural", SimpleTokenizer{}, nil); err == nil {
+		t.Fatal("unexpected success")
+	}
+
+	dir, err := ioutil.TempDir("", "ql-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	name := filepath.Join(dir, "test.db")
+	db, err := OpenFile(name, &Options{CanCreate: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := db.Run(NewRWCtx(), `
+		BEGIN TRANSACTION;
+			CREATE TABLE t (i int64, body string);
+			CREATE FULLTEXT INDEX x ON t (body) USING plural;
+			INSERT INTO t VALUES (1, "Lazy dogs"), (2, "A quick fox"), (3, "The brown dog");
+		COMMIT;
+	`); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := db.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if db, err = OpenFile(name, &Options{}); err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	const q = `SELECT i FROM t WHERE MATCH(body, "dogs", "plural") ORDER BY i;`
+	rs, _, err := db.Run(nil, "EXPLAIN "+q)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := rs[0].Do(false, func(data []interface{}) (bool, error) {
+		fmt.Fprintln(&buf, data...)
+		return true, nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if s := buf.String(); !strings.Contains(s, `using full-text index "x"`) {
+		t.Fatalf("full-text index not used\n%s", s)
+	}
+
+	if rs, _, err = db.Run(nil, q); err != nil {
+		t.Fatal(err)
+	}
+
+	rows, err := rs[0].Rows(-1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if g, e := fmt.Sprint(rows), "[[1] [3]]"; g != e {
+		t.Fatalf("got %s, exp %s", g, e)
+	}
+
+	info, err := db.Info()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, v := range info.Indices {
+		if v.Name == "x" {
+			if g, e := v.Tokenizer, "plural"; g != e {
+				t.Fatalf("got %s, exp %s", g, e)
+			}
+		}
+	}
+
+	buf.Reset()
+	if err := db.Dump(&buf, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	dump := buf.String()
+	if !strings.Contains(dump, "CREATE FULLTEXT INDEX x ON t (body) USING plural;") {
+		t.Fatalf("missing full-text index\n%s", dump)
+	}
+
+	db2, err := OpenMem()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db2.Close()
+
+	if _, _, err := db2.Run(NewRWCtx(), dump); err != nil {
+		t.Fatalf("%v\n%s", err, dump)
+	}
+
+	if rs, _, err = db2.Run(nil, q); err != nil {
+		t.Fatal(err)
+	}
+
+	if rows, err = rs[0].Rows(-1, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	if g, e := fmt.Sprint(rows), "[[1] [3]]"; g != e {
+		t.Fatalf("got %s, exp %s", g, e)
+	}
+}
+
+func TestSpatial(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ql-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	name := filepath.Join(dir, "test.db")
+	db, err := OpenFile(name, &Options{CanCreate: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		if db != nil {
+			db.Close()
+		}
+	}()
+
+	ctx := NewRWCtx()
+	run := func(q string, arg ...interface{}) [][]interface{} {
+		rs, _, err := db.Run(ctx, q, arg...)
+		if err != nil {
+			t.Fatalf("%s: %v", q, err)
+		}
+
+		if len(rs) == 0 {
+			return nil
+		}
+
+		rows, err := rs[len(rs)-1].Rows(-1, 0)
+		if err != nil {
+			t.Fatalf("%s: %v", q, err)
+		}
+
+		return rows
+	}
+
+	run(`
+		BEGIN TRANSACTION;
+			CREATE TABLE t (i int, x float64, y float64);
+			CREATE SPATIAL INDEX xy ON t (x, y);
+		COMMIT;
+	`)
+	rng := rand.New(rand.NewSource(42))
+	run("BEGIN TRANSACTION;")
+	for i := 0; i < 1000; i++ {
+		var x, y interface{} = rng.Float64() * 100, rng.Float64() * 100
+		if i%50 == 0 {
+			x = nil
+		}
+		run("INSERT INTO t VALUES ($1, $2, $3);", int64(i), x, y)
+	}
+	run(`
+		DELETE FROM t WHERE i%3 == 0;
+		UPDATE t x = x/2 WHERE i%5 == 0;
+		COMMIT;
+	`)
+
+	// The index must give the results of the table scan.
+	check := func() {
+		for i := 0; i < 20; i++ {
+			x, y := rng.Float64()*100, rng.Float64()*100
+			box := fmt.Sprintf("box(%v, %v, %v, %v)", x, y, x+rng.Float64()*30, y+rng.Float64()*30)
+			q := "SELECT i FROM t %s WHERE within(point(x, y), " + box + ") ORDER BY i;"
+			g, e := run(fmt.Sprintf(q, "")), run(fmt.Sprintf(q, "IGNORE INDEX (xy)"))
+			if fmt.Sprint(g) != fmt.Sprint(e) {
+				t.Fatalf("%s\ngot %v\nexp %v", box, g, e)
+			}
+
+			q = "SELECT i FROM t %s WHERE nearest(point(x, y), point($1, $2), 10) && i%%2 == 0;"
+			g, e = run(fmt.Sprintf(q, ""), x, y), run(fmt.Sprintf(q, "IGNORE INDEX (xy)"), x, y)
+			if len(g) != 10 || fmt.Sprint(g) != fmt.Sprint(e) {
+				t.Fatalf("point(%v, %v)\ngot %v\nexp %v", x, y, g, e)
+			}
+		}
+	}
+	check()
+
+	// Changes of a rolled back transaction do not remain in the index.
+	n := run("SELECT count(*) FROM t WHERE within(point(x, y), box(0, 0, 100, 100));")
+	run("BEGIN TRANSACTION; DELETE FROM t WHERE i < 500; INSERT INTO t VALUES (-1, 1, 1); ROLLBACK;")
+	if g, e := run("SELECT count(*) FROM t WHERE within(point(x, y), box(0, 0, 100, 100));"), n; fmt.Sprint(g) != fmt.Sprint(e) {
+		t.Fatalf("got %v, exp %v", g, e)
+	}
+
+	if err := db.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if db, err = OpenFile(name, &Options{}); err != nil {
+		t.Fatal(err)
+	}
+
+	check()
+
+	var buf bytes.Buffer
+	if err := db.Dump(&buf, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if s := buf.String(); !strings.Contains(s, "CREATE SPATIAL INDEX xy ON t (x, y);") {
+		t.Fatalf("missing spatial index\n%s", s)
+	}
+
+	run("BEGIN TRANSACTION; TRUNCATE TABLE t; INSERT INTO t VALUES (1, 1, 1); COMMIT;")
+	if g, e := fmt.Sprint(run("SELECT i FROM t WHERE nearest(point(x, y), point(0, 0), 5);")), "[[1]]"; g != e {
+		t.Fatalf("got %s, exp %s", g, e)
+	}
+}
+
+type digitsCollator struct{}
+
+func (digitsCollator) Key(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r < '0' || r > '9' {
+			return -1
+		}
+
+		return r
+	}, s)
+}
+
+func TestCollation(t *testing.T) {
+	if err := RegisterCollation("Digits", digitsCollator{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := RegisterCollation("digits", digitsCollator{}); err == nil {
+		t.Fatal("unexpected success")
+	}
+
+	if err := RegisterCollation("1x", digitsCollator{}); err == nil {
+		t.Fatal("unexpected success")
+	}
+
+	dir, err := ioutil.TempDir("", "ql-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	name := filepath.Join(dir, "test.db")
+	db, err := OpenFile(name, &Options{CanCreate: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := db.Run(NewRWCtx(), `
+		BEGIN TRANSACTION;
+			CREATE TABLE t (i int64, phone string COLLATE digits);
+			CREATE UNIQUE INDEX x ON t (phone);
+			CREATE INDEX y ON t (i, phone COLLATE binary);
+			INSERT INTO t VALUES (1, "+1 (555) 010-0199"), (2, "555 010 0100");
+		COMMIT;
+	`); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := db.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if db, err = OpenFile(name, &Options{}); err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	if _, _, err := db.Run(NewRWCtx(), `
+		BEGIN TRANSACTION;
+			INSERT INTO t VALUES (3, "1-555-010-0199");
+		COMMIT;
+	`); err == nil || !strings.Contains(err.Error(), "duplicate") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	const q = `SELECT i FROM t WHERE phone == "555 010 0100";`
+	rs, _, err := db.Run(nil, "EXPLAIN "+q)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := rs[0].Do(false, func(data []interface{}) (bool, error) {
+		fmt.Fprintln(&buf, data...)
+		return true, nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if s := buf.String(); !strings.Contains(s, `using index "x"`) {
+		t.Fatalf("index not used\n%s", s)
+	}
+
+	if rs, _, err = db.Run(nil, q); err != nil {
+		t.Fatal(err)
+	}
+
+	rows, err := rs[0].Rows(-1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if g, e := fmt.Sprint(rows), "[[2]]"; g != e {
+		t.Fatalf("got %s, exp %s", g, e)
+	}
+
+	if rs, _, err = db.Run(nil, `SELECT i FROM t WHERE phone == "5550100100";`); err != nil {
+		t.Fatal(err)
+	}
+
+	if rows, err = rs[0].Rows(-1, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	if g, e := fmt.Sprint(rows), "[[2]]"; g != e {
+		t.Fatalf("got %s, exp %s", g, e)
+	}
+
+	info, err := db.Info()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, v := range info.Tables {
+		if v.Name == "t" {
+			if g, e := v.Columns[1].Collation, "digits"; g != e {
+				t.Fatalf("got %s, exp %s", g, e)
+			}
+		}
+	}
+
+	buf.Reset()
+	if err := db.Dump(&buf, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	dump := buf.String()
+	if !strings.Contains(dump, "CREATE TABLE t (i int64, phone string COLLATE digits);") {
+		t.Fatalf("missing collation\n%s", dump)
+	}
+
+	if !strings.Contains(dump, "CREATE INDEX y ON t (i, phone COLLATE binary);") {
+		t.Fatalf("missing index\n%s", dump)
+	}
+}
+
+func TestRegexpCompile(t *testing.T) {
+	for i, q := range []string{
+		`SELECT * FROM t WHERE s MATCHES "(";`,
+		`SELECT * FROM t WHERE s LIKE "a#" ESCAPE "#";`,
+		`SELECT regexpSplit(s, "[") FROM t;`,
+	} {
+		if _, err := Compile(q); err == nil {
+			t.Fatalf("%d: %s: unexpected success", i, q)
+		}
+	}
+
+	db, err := OpenMem()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	if _, _, err := db.Run(NewRWCtx(), `
+		BEGIN TRANSACTION;
+			CREATE TABLE t (s string);
+			INSERT INTO t VALUES ("Ab"), ("b");
+		COMMIT;
+	`); err != nil {
+		t.Fatal(err)
+	}
+
+	list, err := Compile(`SELECT s FROM t WHERE s ILIKE $1 ORDER BY s; SELECT regexpReplace(s, $1, "x") AS r FROM t ORDER BY r;`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rs, _, err := db.Execute(nil, list, "^a")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, e := range []string{"[[Ab]]", "[[Ab] [b]]"} {
+		rows, err := rs[i].Rows(-1, 0)
+		if err != nil {
+			t.Fatal(i, err)
+		}
+
+		if g := fmt.Sprint(rows); g != e {
+			t.Fatalf("%d: got %s, exp %s", i, g, e)
+		}
+	}
+
+	if rs, _, err = db.Execute(nil, list, "("); err == nil {
+		_, err = rs[0].Rows(-1, 0)
+	}
+	if err == nil {
+		t.Fatal("unexpected success")
+	}
+}
+
+func TestDateTimeLocation(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+
+	db, err := OpenMem()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	// The clocks go forward on 2024-03-10 at 02:00.
+	ctx := NewRWCtx()
+	if _, _, err = db.Run(ctx, `
+		BEGIN TRANSACTION;
+			CREATE TABLE t (t time);
+			INSERT INTO t VALUES ($1);
+		COMMIT;`,
+		time.Date(2024, 3, 9, 12, 0, 0, 0, loc),
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	rs, _, err := db.Run(nil, `
+		SELECT
+			dateAdd("day", 1, t),
+			t + INTERVAL '1 day',
+			dateTrunc("day", dateAdd("hour", 14, t)),
+			dateDiff("day", t, t + INTERVAL '47 hours'),
+			timeBucket(INTERVAL '1 day', t, $1),
+		FROM t;`,
+		time.Date(2024, 1, 1, 0, 0, 0, 0, loc),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	row, err := rs[0].FirstRow()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, e := range []interface{}{
+		time.Date(2024, 3, 10, 12, 0, 0, 0, loc),
+		time.Date(2024, 3, 10, 13, 0, 0, 0, loc),
+		time.Date(2024, 3, 10, 0, 0, 0, 0, loc),
+		int64(2),
+		time.Date(2024, 3, 9, 0, 0, 0, 0, loc),
+	} {
+		switch g := row[i].(type) {
+		case time.Time:
+			if !g.Equal(e.(time.Time)) {
+				t.Errorf("%d: got %v, exp %v", i, g, e)
+			}
+		default:
+			if g != e {
+				t.Errorf("%d: got %v, exp %v", i, g, e)
+			}
+		}
+	}
+}
+
+func TestRegisterFunc(t *testing.T) {
+	var slugs, ticks int64
+	slug := func(arg []interface{}) (interface{}, error) {
+		atomic.AddInt64(&slugs, 1)
+		switch x := arg[0].(type) {
+		case nil:
+			return nil, nil
+		case string:
+			return strings.Join(strings.Fields(strings.ToLower(x)), "-"), nil
+		default:
+			return nil, fmt.Errorf("slug: invalid argument %v (type %T)", x, x)
+		}
+	}
+	if err := RegisterFunc("slug", slug, FuncOptions{MinArgs: 1, MaxArgs: 1, Deterministic: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	tick := func(arg []interface{}) (interface{}, error) {
+		return int(atomic.AddInt64(&ticks, 1)), nil
+	}
+	if err := RegisterFunc("tick", tick, FuncOptions{MaxArgs: -1}); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, v := range []struct {
+		name string
+		f    Func
+		opts FuncOptions
+	}{
+		{"slug", slug, FuncOptions{MinArgs: 1, MaxArgs: 1}},
+		{"abs", slug, FuncOptions{MinArgs: 1, MaxArgs: 1}},
+		{"1x", slug, FuncOptions{MinArgs: 1, MaxArgs: 1}},
+		{"slug2", slug, FuncOptions{MinArgs: 2, MaxArgs: 1}},
+		{"slug3", nil, FuncOptions{}},
+		{"select", slug, FuncOptions{MinArgs: 1, MaxArgs: 1}},
+		{"LIKE", slug, FuncOptions{MinArgs: 1, MaxArgs: 1}},
+		{"int64", slug, FuncOptions{MinArgs: 1, MaxArgs: 1}},
+		{"bigint", slug, FuncOptions{MinArgs: 1, MaxArgs: 1}},
+		{"blob", slug, FuncOptions{MinArgs: 1, MaxArgs: 1}},
+		{"decimal", slug, FuncOptions{MinArgs: 1, MaxArgs: 1}},
+		{"json", slug, FuncOptions{MinArgs: 1, MaxArgs: 1}},
+		{"string", slug, FuncOptions{MinArgs: 1, MaxArgs: 1}},
+		{"time", slug, FuncOptions{MinArgs: 1, MaxArgs: 1}},
+		{"uuid", slug, FuncOptions{MinArgs: 1, MaxArgs: 1}},
+		{"match", slug, FuncOptions{MinArgs: 1, MaxArgs: 1}},
+		{"Match", slug, FuncOptions{MinArgs: 1, MaxArgs: 1}},
+	} {
+		if err := RegisterFunc(v.name, v.f, v.opts); err == nil {
+			t.Fatalf("%d: %s: unexpected success", i, v.name)
+		}
+	}
+
+	if _, err := Compile("SELECT slug() FROM t;"); err == nil {
+		t.Fatal("unexpected success")
+	}
+
+	db, err := OpenMem()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	if _, _, err := db.Run(NewRWCtx(), `
+		BEGIN TRANSACTION;
+			CREATE TABLE t (s string);
+			CREATE INDEX x ON t (slug(s));
+			INSERT INTO t VALUES ("Hello  World"), ("foo Bar"), (NULL);
+		COMMIT;
+	`); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := db.Run(NewRWCtx(), `
+		BEGIN TRANSACTION;
+			CREATE INDEX y ON t (tick(s));
+		COMMIT;
+	`); err == nil || !strings.Contains(err.Error(), "not deterministic") {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	for i, v := range []struct {
+		q, e string
+	}{
+		{`SELECT s FROM t WHERE slug(s) == "foo-bar";`, "[[foo Bar]]"},
+		{`SELECT slug(s) AS x FROM t ORDER BY x;`, "[[<nil>] [foo-bar] [hello-world]]"},
+		{`SELECT tick() AS n FROM t ORDER BY n;`, "[[1] [2] [3]]"},
+		{`SELECT slug("A  B") FROM t;`, "[[a-b] [a-b] [a-b]]"},
+	} {
+		atomic.StoreInt64(&slugs, 0)
+		rs, _, err := db.Run(nil, v.q)
+		if err != nil {
+			t.Fatal(i, err)
+		}
+
+		rows, err := rs[0].Rows(-1, 0)
+		if err != nil {
+			t.Fatal(i, err)
+		}
+
+		if g := fmt.Sprint(rows); g != v.e {
+			t.Fatalf("%d: got %s, exp %s", i, g, v.e)
+		}
+	}
+
+	// The constant call of the last query is evaluated once, not for every
+	// record.
+	if slugs != 1 {
+		t.Fatalf("slug called %d times", slugs)
+	}
+
+	RegisterMemDriver()
+	sdb, err := sql.Open("ql-mem", "TestRegisterFunc")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer sdb.Close()
+
+	tx, err := sdb.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := tx.Exec(`CREATE TABLE t (s string); INSERT INTO t VALUES ($1);`, "Go  SQL"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	var g string
+	if err := sdb.QueryRow(`SELECT slug(s) FROM t;`).Scan(&g); err != nil {
+		t.Fatal(err)
+	}
+
+	if e := "go-sql"; g != e {
+		t.Fatalf("got %q, exp %q", g, e)
+	}
+}
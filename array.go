@@ -0,0 +1,300 @@
+// Copyright 2026 The ql Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ql
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// arrayTypes maps the element types of arrays to the Go types of the array
+// values. Arrays of bytes are not supported, []byte is the blob type.
+var arrayTypes = map[int]reflect.Type{
+	qBool:       reflect.TypeOf([]bool(nil)),
+	qComplex64:  reflect.TypeOf([]complex64(nil)),
+	qComplex128: reflect.TypeOf([]complex128(nil)),
+	qDuration:   reflect.TypeOf([]time.Duration(nil)),
+	qFloat32:    reflect.TypeOf([]float32(nil)),
+	qFloat64:    reflect.TypeOf([]float64(nil)),
+	qInt8:       reflect.TypeOf([]int8(nil)),
+	qInt16:      reflect.TypeOf([]int16(nil)),
+	qInt32:      reflect.TypeOf([]int32(nil)),
+	qInt64:      reflect.TypeOf([]int64(nil)),
+	qString:     reflect.TypeOf([]string(nil)),
+	qTime:       reflect.TypeOf([]time.Time(nil)),
+	qUint16:     reflect.TypeOf([]uint16(nil)),
+	qUint32:     reflect.TypeOf([]uint32(nil)),
+	qUint64:     reflect.TypeOf([]uint64(nil)),
+}
+
+// arrayElems is the inverse of arrayTypes.
+var arrayElems = map[reflect.Type]int{}
+
+func init() {
+	for k, v := range arrayTypes {
+		arrayElems[v] = k
+	}
+}
+
+// arrayElem returns the element type of the array v or zero if v is not an
+// array.
+func arrayElem(v interface{}) int {
+	if v == nil {
+		return 0
+	}
+
+	return arrayElems[reflect.TypeOf(v)]
+}
+
+// arrayElemType checks that elem, a type of the parser, may be the element
+// type of an array.
+func arrayElemType(elem int) error {
+	if _, ok := arrayTypes[elem]; !ok {
+		return fmt.Errorf("invalid array element type %s", typeStr(elem))
+	}
+
+	return nil
+}
+
+// arrayTypeStr returns the type of an array having elements of type elem.
+func arrayTypeStr(elem int) string { return "[]" + typeStr(elem) }
+
+// arrayValues returns the elements of the array v.
+func arrayValues(v interface{}) []interface{} {
+	a := reflect.ValueOf(v)
+	r := make([]interface{}, a.Len())
+	for i := range r {
+		r[i] = a.Index(i).Interface()
+	}
+	return r
+}
+
+// arrayElement returns v checked for use as an element of an array having
+// elements of type elem. Ideal constants are converted to elem.
+func arrayElement(v interface{}, elem int) (interface{}, error) {
+	if v == nil {
+		return nil, fmt.Errorf("invalid NULL element of %s", arrayTypeStr(elem))
+	}
+
+	t := arrayTypes[elem].Elem()
+	if x, err := typeCheck1(v, &col{typ: elem}); err == nil && reflect.TypeOf(x) == t {
+		return x, nil
+	}
+
+	return nil, fmt.Errorf("cannot use %v (type %T) as element of %s", v, ideal(v), arrayTypeStr(elem))
+}
+
+// newArray returns the array having elements of type elem and values vals.
+func newArray(elem int, vals []interface{}) (interface{}, error) {
+	a := reflect.MakeSlice(arrayTypes[elem], len(vals), len(vals))
+	for i, v := range vals {
+		x, err := arrayElement(v, elem)
+		if err != nil {
+			return nil, err
+		}
+
+		a.Index(i).Set(reflect.ValueOf(x))
+	}
+	return a.Interface(), nil
+}
+
+// cloneArray returns a copy of the array v.
+func cloneArray(v interface{}) interface{} {
+	a := reflect.ValueOf(v)
+	r := reflect.MakeSlice(a.Type(), a.Len(), a.Len())
+	reflect.Copy(r, a)
+	return r.Interface()
+}
+
+// collateArrays compares the arrays a and b, having the same element type,
+// element by element. A shorter array collates before a longer one it is a
+// prefix of.
+func collateArrays(a, b interface{}) int {
+	return collate(arrayValues(a), arrayValues(b))
+}
+
+// arrayContains reports whether the array a has an element equal to v.
+func arrayContains(a, v interface{}) (bool, error) {
+	x, err := arrayElement(v, arrayElem(a))
+	if err != nil {
+		return false, err
+	}
+
+	for _, y := range arrayValues(a) {
+		if collate1(x, y) == 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// arrayEq returns the result of the comparison a op b, op being eq or neq,
+// of the array a with b.
+func arrayEq(a, b interface{}, op int) (interface{}, error) {
+	if arrayElem(b) != arrayElem(a) {
+		return invOp2(a, b, op)
+	}
+
+	return (collateArrays(a, b) == 0) == (op == eq), nil
+}
+
+// arrayString returns the literal of the array v.
+func arrayString(v interface{}) string {
+	a := arrayValues(v)
+	s := make([]string, len(a))
+	for i, x := range a {
+		s[i] = value{x}.String()
+	}
+	return fmt.Sprintf("%s{%s}", arrayTypeStr(arrayElem(v)), strings.Join(s, ", "))
+}
+
+// arrayBytes returns the encoding of the array v used by the storage: the
+// element type followed by the elements encoded by encode2.
+func arrayBytes(v interface{}) ([]byte, error) {
+	buf, err := encode2(arrayValues(v))
+
+	defer buf.Close()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte{byte(arrayElem(v))}, buf.Bytes()...), nil
+}
+
+func arrayDecode(b []byte) (interface{}, error) {
+	if len(b) == 0 || arrayElemType(int(b[0])) != nil {
+		return nil, fmt.Errorf("corrupted DB: invalid array encoding")
+	}
+
+	vals, err := decode2(nil, b[1:])
+	if err != nil {
+		return nil, err
+	}
+
+	t := arrayTypes[int(b[0])]
+	a := reflect.MakeSlice(t, len(vals), len(vals))
+	for i, v := range vals {
+		x := reflect.ValueOf(v)
+		if !x.IsValid() || x.Type() != t.Elem() {
+			return nil, fmt.Errorf("corrupted DB: invalid array element %v (type %T)", v, v)
+		}
+
+		a.Index(i).Set(x)
+	}
+	return a.Interface(), nil
+}
+
+// array returns v checked for assignment to, or comparison with, the array
+// column c.
+func (c *col) array(v interface{}) (interface{}, error) {
+	switch v.(type) {
+	case nil:
+		return nil, nil
+	case chunk:
+		return v, nil // Stored value.
+	}
+
+	if arrayElem(v) != c.elem {
+		return nil, fmt.Errorf("cannot use %v (type %T) in assignment to, or comparison with, column %s (type %s)", v, ideal(v), c.name, c.typeStr())
+	}
+
+	return v, nil
+}
+
+// evalArray returns the slice of the array a.
+func (s *slice) evalArray(execCtx *execCtx, ctx map[interface{}]interface{}, a interface{}) (interface{}, error) {
+	v := reflect.ValueOf(a)
+	n := uint64(v.Len())
+	b := []uint64{0, n}
+	for i, e := range []*expression{s.lo, s.hi} {
+		if e == nil {
+			continue
+		}
+
+		x, err := (*e).eval(execCtx, ctx)
+		if err != nil || x == nil {
+			return nil, err
+		}
+
+		if b[i], err = sliceExpr(nil, x, 0); err != nil {
+			return nil, err
+		}
+
+		if b[i] > n {
+			return nil, fmt.Errorf("invalid slice index %d (out of bounds for %d-element array)", b[i], n)
+		}
+	}
+	if b[0] > b[1] {
+		return nil, fmt.Errorf("invalid slice index: %d > %d", b[0], b[1])
+	}
+
+	return cloneArray(v.Slice(int(b[0]), int(b[1])).Interface()), nil
+}
+
+// arrayLit is an array literal like []string{"a", "b"}.
+type arrayLit struct {
+	elem int
+	list []expression
+}
+
+func newArrayLit(elem int, list []expression) (expression, error) {
+	if err := arrayElemType(elem); err != nil {
+		return nil, err
+	}
+
+	a := &arrayLit{elem, list}
+	if !a.isStatic() {
+		return a, nil
+	}
+
+	v, err := a.eval(nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return value{v}, nil
+}
+
+func (a *arrayLit) clone(arg []interface{}, unqualify ...string) (expression, error) {
+	list, err := cloneExpressionList(arg, a.list, unqualify...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &arrayLit{a.elem, list}, nil
+}
+
+func (a *arrayLit) isStatic() bool {
+	for _, v := range a.list {
+		if !v.isStatic() {
+			return false
+		}
+	}
+	return true
+}
+
+func (a *arrayLit) String() string {
+	s := make([]string, len(a.list))
+	for i, v := range a.list {
+		s[i] = v.String()
+	}
+	return fmt.Sprintf("%s{%s}", arrayTypeStr(a.elem), strings.Join(s, ", "))
+}
+
+func (a *arrayLit) eval(execCtx *execCtx, ctx map[interface{}]interface{}) (interface{}, error) {
+	vals := make([]interface{}, len(a.list))
+	for i, v := range a.list {
+		x, err := v.eval(execCtx, ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		vals[i] = x
+	}
+	return newArray(a.elem, vals)
+}
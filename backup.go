@@ -0,0 +1,231 @@
+// Copyright 2026 The ql Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ql
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// Backup writes to w a DB file holding the last committed state of db. The
+// result can be restored using Restore or copied to a file and opened using
+// OpenFile.
+//
+// Backup reads a snapshot of db, see the Isolation section of the Execute
+// documentation. Transactions committed while Backup runs do not wait for it
+// and are not included in the backup. The backup has the file format of db,
+// or the default file format if db is a memory DB. It is built in a
+// temporary file first.
+//
+// Backup is safe for concurrent use by multiple goroutines.
+func (db *DB) Backup(w io.Writer) (err error) {
+	dir, err := ioutil.TempDir("", "ql-backup-")
+	if err != nil {
+		return err
+	}
+
+	defer os.RemoveAll(dir)
+
+	name := filepath.Join(dir, "backup.db")
+	if err := db.BackupTo(name); err != nil {
+		return err
+	}
+
+	f, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+
+	defer f.Close()
+
+	_, err = io.Copy(w, f)
+	return err
+}
+
+// BackupTo is like Backup but it creates the DB file name, which must not
+// exist. If BackupTo fails, the file is removed.
+func (db *DB) BackupTo(name string) (err error) {
+	db.mu.Lock()
+	if db.store == nil {
+		db.muUnlock()
+		return fmt.Errorf("BackupTo: DB is closed")
+	}
+
+	snap := db.snapshot()
+	db.muUnlock()
+	defer snap.release()
+
+	f, err := os.OpenFile(name, os.O_CREATE|os.O_EXCL|os.O_RDWR, 0666)
+	if err != nil {
+		return err
+	}
+
+	opt := &Options{OSFile: f, RemoveEmptyWAL: true}
+	if _, ok := snap.s.storage.(*storage2); ok {
+		opt.FileFormat = 2
+	}
+	dst, err := OpenFile(name, opt)
+	if err != nil {
+		f.Close()
+		os.Remove(name)
+		return err
+	}
+
+	defer func() {
+		if e := dst.Close(); e != nil && err == nil {
+			err = e
+		}
+		if err != nil {
+			os.Remove(name)
+		}
+	}()
+
+	x := newExecCtx(db, nil)
+	x.snap = snap
+	return db.copyTo(x, dst)
+}
+
+// copyTo copies the enum types, tables, rows and indices read by x to the
+// empty DB dst. Row IDs and the order of the rows and tables are preserved.
+func (db *DB) copyTo(x *execCtx, dst *DB) (err error) {
+	nfo, err := db.info(x)
+	if err != nil {
+		return err
+	}
+
+	tctx := NewRWCtx()
+	if _, _, err = dst.Run(tctx, "BEGIN TRANSACTION;"); err != nil {
+		return err
+	}
+
+	defer func() {
+		if err != nil {
+			dst.Run(tctx, "ROLLBACK;")
+		}
+	}()
+
+	tables := map[string]*TableInfo{}
+	for i := range nfo.Tables {
+		ti := &nfo.Tables[i]
+		tables[ti.Name] = ti
+	}
+	for i := range nfo.Enums {
+		if _, _, err = dst.Run(tctx, nfo.Enums[i].createStmt()); err != nil {
+			return err
+		}
+	}
+	list := x.root().userTables()
+	for _, t := range list {
+		if _, _, err = dst.Run(tctx, tables[t.name].createStmt()); err != nil {
+			return err
+		}
+	}
+	for i := range nfo.Indices {
+		if xi := &nfo.Indices[i]; !isSystemName[xi.Table] {
+			if _, _, err = dst.Run(tctx, xi.createStmt()); err != nil {
+				return err
+			}
+		}
+	}
+
+	dx := newExecCtx(dst, nil)
+	id, err := dst.store.ID()
+	if err != nil {
+		return err
+	}
+
+	for _, t := range list {
+		var hs []int64 // Newest first.
+		for h := t.head; h != 0; {
+			if err = x.canceled(); err != nil {
+				return err
+			}
+
+			rec, err := x.read(nil, h, t.cols...)
+			if err != nil {
+				return err
+			}
+
+			hs = append(hs, h)
+			h = rec[0].(int64)
+		}
+		dt := dst.root.tables[t.name]
+		for i := len(hs) - 1; i >= 0; i-- {
+			rid, rec, err := t.row(x, hs[i])
+			if err != nil {
+				return err
+			}
+
+			if err = expand(rec); err != nil {
+				return err
+			}
+
+			if err = dt.addRecordID(dx, rid, rec); err != nil {
+				return err
+			}
+
+			if rid > id {
+				id = rid
+			}
+		}
+	}
+	if err = dst.store.SetID(id); err != nil {
+		return err
+	}
+
+	_, _, err = dst.Run(tctx, "COMMIT;")
+	return err
+}
+
+// userTables returns the tables of r, except the system tables, oldest
+// first.
+func (r *root) userTables() (list []*table) {
+	for t := r.thead; t != nil; t = t.tnext {
+		if !isSystemName[t.name] {
+			list = append([]*table{t}, list...)
+		}
+	}
+	return list
+}
+
+// Restore creates the DB file name, which must not exist, from a backup
+// written by Backup and read from r. The result is verified to be a DB
+// which can be opened by OpenFile. If Restore fails, the file is removed.
+func Restore(name string, r io.Reader) (err error) {
+	f, err := os.OpenFile(name, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0666)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err != nil {
+			os.Remove(name)
+		}
+	}()
+
+	if _, err = io.Copy(f, r); err != nil {
+		f.Close()
+		return err
+	}
+
+	if err = f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+
+	if err = f.Close(); err != nil {
+		return err
+	}
+
+	db, err := OpenFile(name, &Options{RemoveEmptyWAL: true})
+	if err != nil {
+		return err
+	}
+
+	return db.Close()
+}
@@ -7,6 +7,8 @@ package ql
 import (
 	"bytes"
 	"encoding/gob"
+	"encoding/json"
+	"fmt"
 	"math/big"
 	"sync"
 	"time"
@@ -95,7 +97,19 @@ func isBlobType(v interface{}) (bool, Type) {
 		return true, Time
 	case time.Duration:
 		return true, Duration
+	case json.RawMessage:
+		return true, JSON
+	case Dec:
+		return true, Decimal
+	case Enum:
+		return true, EnumType
+	case UUID:
+		return true, UUIDType
 	default:
+		if arrayElem(v) != 0 {
+			return true, Array
+		}
+
 		return false, -1
 	}
 }
@@ -148,6 +162,22 @@ func (g *gobCoder) decode(b []byte, typ int) (v interface{}, err error) {
 		var x int64
 		err = g.dec.Decode(&x)
 		v = time.Duration(x)
+	case qJSON:
+		return json.RawMessage(b), nil
+	case qDecimal:
+		return decBytes(b)
+	case qEnum:
+		return enumBytes(b)
+	case qUUID:
+		var u UUID
+		if len(b) != len(u) {
+			return nil, fmt.Errorf("corrupted DB: invalid uuid length %d", len(b))
+		}
+
+		copy(u[:], b)
+		return u, nil
+	case qArray:
+		return arrayDecode(b)
 	default:
 		panic("internal error 003")
 	}
@@ -5,60 +5,133 @@
 package ql
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"math"
+	"math/big"
 	"math/rand"
 	"reflect"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
+	"unicode"
+	"unicode/utf8"
 )
 
 //TODO agg bigint, bigrat, time, duration
 
-var builtin = map[string]struct {
+type builtinFunc struct {
 	f           func([]interface{}, map[interface{}]interface{}) (interface{}, error)
 	minArgs     int
 	maxArgs     int
 	isStatic    bool
 	isAggregate bool
-}{
-	"__testBlob":   {builtinTestBlob, 1, 1, true, false},
-	"__testString": {builtinTestString, 1, 1, true, false},
-	"avg":          {builtinAvg, 1, 1, false, true},
-	"complex":      {builtinComplex, 2, 2, true, false},
-	"contains":     {builtinContains, 2, 2, true, false},
-	"count":        {builtinCount, 0, 1, false, true},
-	"date":         {builtinDate, 8, 8, true, false},
-	"day":          {builtinDay, 1, 1, true, false},
-	"formatTime":   {builtinFormatTime, 2, 2, true, false},
-	"formatFloat":  {builtinFormatFloat, 1, 4, true, false},
-	"formatInt":    {builtinFormatInt, 1, 2, true, false},
-	"hasPrefix":    {builtinHasPrefix, 2, 2, true, false},
-	"hasSuffix":    {builtinHasSuffix, 2, 2, true, false},
-	"hour":         {builtinHour, 1, 1, true, false},
-	"hours":        {builtinHours, 1, 1, true, false},
-	"id":           {builtinID, 0, 1, false, false},
-	"imag":         {builtinImag, 1, 1, true, false},
-	"len":          {builtinLen, 1, 1, true, false},
-	"max":          {builtinMax, 1, 1, false, true},
-	"min":          {builtinMin, 1, 1, false, true},
-	"minute":       {builtinMinute, 1, 1, true, false},
-	"minutes":      {builtinMinutes, 1, 1, true, false},
-	"month":        {builtinMonth, 1, 1, true, false},
-	"nanosecond":   {builtinNanosecond, 1, 1, true, false},
-	"nanoseconds":  {builtinNanoseconds, 1, 1, true, false},
-	"now":          {builtinNow, 0, 0, false, false},
-	"parseTime":    {builtinParseTime, 2, 2, true, false},
-	"real":         {builtinReal, 1, 1, true, false},
-	"second":       {builtinSecond, 1, 1, true, false},
-	"seconds":      {builtinSeconds, 1, 1, true, false},
-	"since":        {builtinSince, 1, 1, false, false},
-	"sleep":        {builtinSleep, 1, 1, false, false},
-	"sum":          {builtinSum, 1, 1, false, true},
-	"timeIn":       {builtinTimeIn, 2, 2, true, false},
-	"weekday":      {builtinWeekday, 1, 1, true, false},
-	"year":         {builtinYear, 1, 1, true, false},
-	"yearDay":      {builtinYearday, 1, 1, true, false},
+}
+
+var builtin = map[string]builtinFunc{
+	"__testBlob":      {builtinTestBlob, 1, 1, true, false},
+	"__testString":    {builtinTestString, 1, 1, true, false},
+	"abs":             {builtinAbs, 1, 1, true, false},
+	"acos":            {builtinAcos, 1, 1, true, false},
+	"asin":            {builtinAsin, 1, 1, true, false},
+	"atan":            {builtinAtan, 1, 1, true, false},
+	"atan2":           {builtinAtan2, 2, 2, true, false},
+	"avg":             {builtinAvg, 1, 1, false, true},
+	"box":             {builtinBox, 4, 4, true, false},
+	"ceil":            {builtinCeil, 1, 1, true, false},
+	"complex":         {builtinComplex, 2, 2, true, false},
+	"concat":          {builtinConcat, 1, math.MaxInt32, true, false},
+	"contains":        {builtinContains, 2, 2, true, false},
+	"containsAll":     {builtinContainsAll, 2, 2, true, false},
+	"containsAny":     {builtinContainsAny, 2, 2, true, false},
+	"cos":             {builtinCos, 1, 1, true, false},
+	"count":           {builtinCount, 0, 1, false, true},
+	"date":            {builtinDate, 8, 8, true, false},
+	"dateAdd":         {builtinDateAdd, 3, 3, true, false},
+	"dateDiff":        {builtinDateDiff, 3, 3, true, false},
+	"dateTrunc":       {builtinDateTrunc, 2, 2, true, false},
+	"day":             {builtinDay, 1, 1, true, false},
+	"distance":        {builtinDistance, 2, 2, true, false},
+	"endOf":           {builtinEndOf, 2, 2, true, false},
+	"exp":             {builtinExp, 1, 1, true, false},
+	"floor":           {builtinFloor, 1, 1, true, false},
+	"formatTime":      {builtinFormatTime, 2, 2, true, false},
+	"formatFloat":     {builtinFormatFloat, 1, 4, true, false},
+	"formatInt":       {builtinFormatInt, 1, 2, true, false},
+	"greatest":        {builtinGreatest, 1, math.MaxInt32, true, false},
+	"hasPrefix":       {builtinHasPrefix, 2, 2, true, false},
+	"hasSuffix":       {builtinHasSuffix, 2, 2, true, false},
+	"hour":            {builtinHour, 1, 1, true, false},
+	"hours":           {builtinHours, 1, 1, true, false},
+	"id":              {builtinID, 0, 1, false, false},
+	"imag":            {builtinImag, 1, 1, true, false},
+	"indexOf":         {builtinIndexOf, 2, 2, true, false},
+	"isoWeek":         {builtinISOWeek, 1, 1, true, false},
+	"jsonAgg":         {builtinJSONAgg, 1, 1, false, true},
+	"jsonArray":       {builtinJSONArray, 0, math.MaxInt32, true, false},
+	"jsonArrayLength": {builtinJSONArrayLength, 1, 2, true, false},
+	"jsonExtract":     {builtinJSONExtract, 2, 2, true, false},
+	"jsonObject":      {builtinJSONObject, 0, math.MaxInt32, true, false},
+	"jsonType":        {builtinJSONType, 1, 2, true, false},
+	"least":           {builtinLeast, 1, math.MaxInt32, true, false},
+	"len":             {builtinLen, 1, 1, true, false},
+	"ln":              {builtinLog, 1, 1, true, false},
+	"log":             {builtinLog, 1, 1, true, false},
+	"log10":           {builtinLog10, 1, 1, true, false},
+	"lower":           {builtinLower, 1, 1, true, false},
+	"lpad":            {builtinLpad, 2, 3, true, false},
+	"ltrim":           {builtinLtrim, 1, 2, true, false},
+	"match":           {builtinMatch, 2, 3, true, false},
+	"max":             {builtinMax, 1, 1, false, true},
+	"min":             {builtinMin, 1, 1, false, true},
+	"minute":          {builtinMinute, 1, 1, true, false},
+	"minutes":         {builtinMinutes, 1, 1, true, false},
+	"mod":             {builtinMod, 2, 2, true, false},
+	"month":           {builtinMonth, 1, 1, true, false},
+	"nanosecond":      {builtinNanosecond, 1, 1, true, false},
+	"nanoseconds":     {builtinNanoseconds, 1, 1, true, false},
+	"nearest":         {builtinNearest, 3, 3, false, false},
+	"now":             {builtinNow, 0, 0, false, false},
+	"parseTime":       {builtinParseTime, 2, 2, true, false},
+	"pi":              {builtinPi, 0, 0, true, false},
+	"point":           {builtinPoint, 2, 2, true, false},
+	"pow":             {builtinPow, 2, 2, true, false},
+	"random":          {builtinRandom, 0, 0, false, false},
+	"rank":            {builtinRank, 0, 3, false, false},
+	"real":            {builtinReal, 1, 1, true, false},
+	"regexpExtract":   {builtinRegexpExtract, 2, 3, true, false},
+	"regexpReplace":   {builtinRegexpReplace, 3, 3, true, false},
+	"regexpSplit":     {builtinRegexpSplit, 2, 2, true, false},
+	"repeat":          {builtinRepeat, 2, 2, true, false},
+	"replace":         {builtinReplace, 3, 3, true, false},
+	"round":           {builtinRound, 2, 3, true, false},
+	"rpad":            {builtinRpad, 2, 3, true, false},
+	"rtrim":           {builtinRtrim, 1, 2, true, false},
+	"second":          {builtinSecond, 1, 1, true, false},
+	"seconds":         {builtinSeconds, 1, 1, true, false},
+	"sign":            {builtinSign, 1, 1, true, false},
+	"sin":             {builtinSin, 1, 1, true, false},
+	"since":           {builtinSince, 1, 1, false, false},
+	"sleep":           {builtinSleep, 1, 1, false, false},
+	"splitPart":       {builtinSplitPart, 3, 3, true, false},
+	"sqrt":            {builtinSqrt, 1, 1, true, false},
+	"startOf":         {builtinStartOf, 2, 2, true, false},
+	"substr":          {builtinSubstr, 2, 3, true, false},
+	"sum":             {builtinSum, 1, 1, false, true},
+	"tan":             {builtinTan, 1, 1, true, false},
+	"timeBucket":      {builtinTimeBucket, 2, 3, true, false},
+	"timeIn":          {builtinTimeIn, 2, 2, true, false},
+	"trim":            {builtinTrim, 1, 2, true, false},
+	"trunc":           {builtinTrunc, 1, 1, true, false},
+	"upper":           {builtinUpper, 1, 1, true, false},
+	"uuidNew":         {builtinUUIDNew, 0, 0, false, false},
+	"uuidV7":          {builtinUUIDv7, 0, 0, false, false},
+	"weekday":         {builtinWeekday, 1, 1, true, false},
+	"within":          {builtinWithin, 2, 2, true, false},
+	"year":            {builtinYear, 1, 1, true, false},
+	"yearDay":         {builtinYearday, 1, 1, true, false},
 }
 
 func badNArgs(min int, s string, arg []interface{}) error {
@@ -78,6 +151,319 @@ func invArg(arg interface{}, s string) error {
 	return fmt.Errorf("invalid argument %v (type %T) for %s", arg, arg, s)
 }
 
+// maxStringResult bounds the length in bytes of the strings made by repeat,
+// lpad and rpad.
+const maxStringResult = 1 << 30
+
+func hasNull(arg []interface{}) bool {
+	for _, v := range arg {
+		if v == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// intArg returns the value of v, an integer argument of the function fn.
+func intArg(v interface{}, fn string) (int64, error) {
+	switch x := coerce1(v, int64(0)).(type) {
+	case int8:
+		return int64(x), nil
+	case int16:
+		return int64(x), nil
+	case int32:
+		return int64(x), nil
+	case int64:
+		return x, nil
+	case uint8:
+		return int64(x), nil
+	case uint16:
+		return int64(x), nil
+	case uint32:
+		return int64(x), nil
+	case uint64:
+		if x <= math.MaxInt64 {
+			return int64(x), nil
+		}
+	}
+	return 0, invArg(v, fn)
+}
+
+// stringArgs returns the arguments of the function fn, which must be
+// strings. The result is nil if any of the arguments is NULL.
+func stringArgs(arg []interface{}, fn string) ([]string, error) {
+	a := make([]string, len(arg))
+	for i, v := range arg {
+		switch x := v.(type) {
+		case nil:
+			return nil, nil
+		case string:
+			a[i] = x
+		default:
+			return nil, invArg(x, fn)
+		}
+	}
+	return a, nil
+}
+
+// runeOffset returns the byte offset of the rune number n of s, or len(s) if
+// s has no more than n runes.
+func runeOffset(s string, n int64) int {
+	for i := range s {
+		if n == 0 {
+			return i
+		}
+
+		n--
+	}
+	return len(s)
+}
+
+func repeatString(s string, n int64, fn string) (string, error) {
+	if n < 0 {
+		return "", fmt.Errorf("%s: negative count %d", fn, n)
+	}
+
+	if len(s) != 0 && n > maxStringResult/int64(len(s)) {
+		return "", fmt.Errorf("%s: result too long", fn)
+	}
+
+	return strings.Repeat(s, int(n)), nil
+}
+
+// padString implements lpad and rpad. A string longer than the requested
+// length is truncated to its leading runes.
+func padString(arg []interface{}, fn string, left bool) (interface{}, error) {
+	if hasNull(arg) {
+		return nil, nil
+	}
+
+	s, ok := arg[0].(string)
+	if !ok {
+		return nil, invArg(arg[0], fn)
+	}
+
+	n, err := intArg(arg[1], fn)
+	if err != nil {
+		return nil, err
+	}
+
+	if n < 0 {
+		return nil, fmt.Errorf("%s: negative length %d", fn, n)
+	}
+
+	pad := " "
+	if len(arg) == 3 {
+		if pad, ok = arg[2].(string); !ok {
+			return nil, invArg(arg[2], fn)
+		}
+	}
+
+	c := int64(utf8.RuneCountInString(s))
+	if c >= n || pad == "" {
+		return s[:runeOffset(s, n)], nil
+	}
+
+	fill, err := repeatString(pad, (n-c)/int64(utf8.RuneCountInString(pad))+1, fn)
+	if err != nil {
+		return nil, err
+	}
+
+	fill = fill[:runeOffset(fill, n-c)]
+	if left {
+		return fill + s, nil
+	}
+
+	return s + fill, nil
+}
+
+// trimString implements trim, ltrim and rtrim. The optional second argument
+// is the cutset, white space by default.
+func trimString(arg []interface{}, fn string, trim func(string, string) string, trimSpace func(string, func(rune) bool) string) (interface{}, error) {
+	a, err := stringArgs(arg, fn)
+	if a == nil || err != nil {
+		return nil, err
+	}
+
+	if len(a) == 1 {
+		return trimSpace(a[0], unicode.IsSpace), nil
+	}
+
+	return trim(a[0], a[1]), nil
+}
+
+// maxPowBits bounds the size in bits of the bigint, bigrat and decimal results
+// of pow.
+const maxPowBits = 1 << 20
+
+// two64 is 2**64, the fixed size integer results are computed modulo two64
+// and then truncated to their type.
+var two64 = new(big.Int).Lsh(big.NewInt(1), 64)
+
+// intValue returns the value and the QL type of v if it is a fixed size
+// integer or a duration.
+func intValue(v interface{}) (*big.Int, int, bool) {
+	switch x := v.(type) {
+	case int8:
+		return big.NewInt(int64(x)), qInt8, true
+	case int16:
+		return big.NewInt(int64(x)), qInt16, true
+	case int32:
+		return big.NewInt(int64(x)), qInt32, true
+	case int64:
+		return big.NewInt(x), qInt64, true
+	case uint8:
+		return big.NewInt(int64(x)), qUint8, true
+	case uint16:
+		return big.NewInt(int64(x)), qUint16, true
+	case uint32:
+		return big.NewInt(int64(x)), qUint32, true
+	case uint64:
+		return new(big.Int).SetUint64(x), qUint64, true
+	case time.Duration:
+		return big.NewInt(int64(x)), qDuration, true
+	}
+	return nil, 0, false
+}
+
+// intResult returns x converted to the type typ obtained from intValue. A
+// value overflowing typ wraps around like the results of the arithmetic
+// operators.
+func intResult(x *big.Int, typ int) (interface{}, error) {
+	n := new(big.Int).Mod(x, two64).Uint64()
+	switch typ {
+	case qInt8:
+		return int8(n), nil
+	case qInt16:
+		return int16(n), nil
+	case qInt32:
+		return int32(n), nil
+	case qInt64:
+		return int64(n), nil
+	case qUint8:
+		return uint8(n), nil
+	case qUint16:
+		return uint16(n), nil
+	case qUint32:
+		return uint32(n), nil
+	case qUint64:
+		return n, nil
+	case qDuration:
+		return time.Duration(n), nil
+	default:
+		panic("internal error 080")
+	}
+}
+
+// floatFunc returns f applied to the argument of the function fn, which must
+// be a float. The result has the type of the argument, untyped constants are
+// float64.
+func floatFunc(arg []interface{}, fn string, f func(float64) float64) (interface{}, error) {
+	switch x := ideal(coerce1(arg[0], float64(0))).(type) {
+	case nil:
+		return nil, nil
+	case float32:
+		return float32(f(float64(x))), nil
+	case float64:
+		return f(x), nil
+	default:
+		return nil, invArg(x, fn)
+	}
+}
+
+// roundInt returns the integer part of the argument of the function fn
+// rounded using mode. Floats are rounded by f.
+func roundInt(arg []interface{}, fn string, mode RoundingMode, f func(float64) float64) (interface{}, error) {
+	switch x := ideal(arg[0]).(type) {
+	case nil:
+		return nil, nil
+	case float32:
+		return float32(f(float64(x))), nil
+	case float64:
+		return f(x), nil
+	case Dec:
+		return x.Round(0, mode), nil
+	case *big.Int:
+		return x, nil
+	case *big.Rat:
+		return new(big.Rat).SetInt(quoRound(x.Num(), x.Denom(), mode)), nil
+	default:
+		if _, _, ok := intValue(x); ok {
+			return x, nil
+		}
+
+		return nil, invArg(x, fn)
+	}
+}
+
+// roundFloat returns f rounded to scale decimal digits using mode. NaN and
+// infinities are returned unchanged.
+func roundFloat(f float64, bitSize, scale int, mode RoundingMode) float64 {
+	d, ok := decFloat(f, bitSize)
+	if !ok {
+		return f
+	}
+
+	r, _ := strconv.ParseFloat(d.Round(scale, mode).String(), bitSize)
+	return r
+}
+
+// extremum returns the greatest (op is '>') or the least (op is '<') of arg
+// ignoring NULLs.
+func extremum(arg []interface{}, op int) (interface{}, error) {
+	var r interface{}
+	for _, v := range arg {
+		switch {
+		case v == nil:
+			continue
+		case r == nil:
+			r = v
+			continue
+		}
+
+		b, err := (&binaryOperation{op, value{v}, value{r}}).eval(nil, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		if b == true {
+			r = v
+		}
+	}
+	for _, v := range arg {
+		r = coerce1(r, v)
+	}
+	return ideal(r), nil
+}
+
+// truncTimeArgs implements dateTrunc, startOf and endOf. The end of a unit is
+// the last nanosecond before the start of the next one.
+func truncTimeArgs(arg []interface{}, fn string, end bool) (interface{}, error) {
+	if hasNull(arg) {
+		return nil, nil
+	}
+
+	u, err := timeUnitArg(arg[0], fn)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := timeArg(arg[1], fn, "t")
+	if err != nil {
+		return nil, err
+	}
+
+	if t = truncTime(t, u); !end {
+		return t, nil
+	}
+
+	if t, err = addTime(t, 1, u); err != nil {
+		return nil, fmt.Errorf("%s: %v", fn, err)
+	}
+
+	return t.Add(-1), nil
+}
+
 func builtinTestBlob(arg []interface{}, ctx map[interface{}]interface{}) (v interface{}, err error) {
 	n, err := intExpr(arg[0])
 	if err != nil {
@@ -106,6 +492,66 @@ func builtinTestString(arg []interface{}, ctx map[interface{}]interface{}) (v in
 	return string(b), nil
 }
 
+func builtinAbs(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	switch x := ideal(arg[0]).(type) {
+	case nil:
+		return nil, nil
+	case float32:
+		return float32(math.Abs(float64(x))), nil
+	case float64:
+		return math.Abs(x), nil
+	case Dec:
+		if x.Sign() < 0 {
+			return decNeg(x), nil
+		}
+
+		return x, nil
+	case *big.Int:
+		return new(big.Int).Abs(x), nil
+	case *big.Rat:
+		return new(big.Rat).Abs(x), nil
+	default:
+		if n, typ, ok := intValue(x); ok {
+			return intResult(n.Abs(n), typ)
+		}
+
+		return nil, invArg(x, "abs")
+	}
+}
+
+func builtinAcos(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	return floatFunc(arg, "acos", math.Acos)
+}
+
+func builtinAsin(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	return floatFunc(arg, "asin", math.Asin)
+}
+
+func builtinAtan(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	return floatFunc(arg, "atan", math.Atan)
+}
+
+func builtinAtan2(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	if hasNull(arg) {
+		return nil, nil
+	}
+
+	a, b := coerce(arg[0], arg[1])
+	switch y := ideal(coerce1(a, float64(0))).(type) {
+	case float32:
+		if x, ok := b.(float32); ok {
+			return float32(math.Atan2(float64(y), float64(x))), nil
+		}
+	case float64:
+		if x, ok := ideal(coerce1(b, float64(0))).(float64); ok {
+			return math.Atan2(y, x), nil
+		}
+	default:
+		return nil, invArg(y, "atan2")
+	}
+	return nil, invArg(b, "atan2")
+}
+
 func builtinAvg(arg []interface{}, ctx map[interface{}]interface{}) (v interface{}, err error) {
 	type avg struct {
 		sum interface{}
@@ -148,6 +594,8 @@ func builtinAvg(arg []interface{}, ctx map[interface{}]interface{}) (v interface
 			return uint32(uint64(x) / data.n), nil
 		case uint64:
 			return x / data.n, nil
+		case Dec:
+			return decQuoScale(x, Dec{new(big.Int).SetUint64(data.n), 0}, x.scale)
 		}
 
 	}
@@ -161,7 +609,7 @@ func builtinAvg(arg []interface{}, ctx map[interface{}]interface{}) (v interface
 	switch x := data.sum.(type) {
 	case nil:
 		switch y := y.(type) {
-		case float32, float64, int8, int16, int32, int64, uint8, uint16, uint32, uint64:
+		case float32, float64, int8, int16, int32, int64, uint8, uint16, uint32, uint64, Dec:
 			data = avg{y, 0}
 		default:
 			return nil, fmt.Errorf("avg: cannot accept %v (value if type %T)", y, y)
@@ -190,12 +638,32 @@ func builtinAvg(arg []interface{}, ctx map[interface{}]interface{}) (v interface
 		data.sum = x + y.(uint32)
 	case uint64:
 		data.sum = x + y.(uint64)
+	case Dec:
+		data.sum = decAdd(x, y.(Dec))
 	}
 	data.n++
 	ctx[fn] = data
 	return
 }
 
+func builtinBox(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	var a [4]float64
+	for i, v := range arg {
+		if v == nil {
+			return nil, nil
+		}
+
+		if a[i], err = float64Arg(v, "box"); err != nil {
+			return nil, err
+		}
+	}
+	return []float64{math.Min(a[0], a[2]), math.Min(a[1], a[3]), math.Max(a[0], a[2]), math.Max(a[1], a[3])}, nil
+}
+
+func builtinCeil(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	return roundInt(arg, "ceil", RoundCeiling, math.Ceil)
+}
+
 func builtinComplex(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
 	re, im := arg[0], arg[1]
 	if re == nil || im == nil {
@@ -241,6 +709,21 @@ func builtinComplex(arg []interface{}, _ map[interface{}]interface{}) (v interfa
 	}
 }
 
+func builtinConcat(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	var b strings.Builder
+	for _, v := range arg {
+		switch x := v.(type) {
+		case nil:
+			// nop
+		case string:
+			b.WriteString(x)
+		default:
+			return nil, invArg(x, "concat")
+		}
+	}
+	return b.String(), nil
+}
+
 func builtinContains(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
 	switch s := arg[0].(type) {
 	case nil:
@@ -255,8 +738,57 @@ func builtinContains(arg []interface{}, _ map[interface{}]interface{}) (v interf
 			return nil, invArg(chars, "string")
 		}
 	default:
-		return nil, invArg(s, "string")
+		if arrayElem(s) == 0 {
+			return nil, invArg(s, "string")
+		}
+
+		if arg[1] == nil {
+			return nil, nil
+		}
+
+		return arrayContains(s, arg[1])
+	}
+}
+
+func builtinContainsAll(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	return containsArray(arg, "containsAll", true)
+}
+
+func builtinContainsAny(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	return containsArray(arg, "containsAny", false)
+}
+
+// containsArray reports whether the array arg[0] contains all, or any, of
+// the elements of the array arg[1].
+func containsArray(arg []interface{}, fn string, all bool) (v interface{}, err error) {
+	a, b := arg[0], arg[1]
+	if a == nil || b == nil {
+		return nil, nil
 	}
+
+	if arrayElem(a) == 0 {
+		return nil, invArg(a, fn)
+	}
+
+	if arrayElem(b) != arrayElem(a) {
+		return nil, invArg(b, fn)
+	}
+
+	for _, x := range arrayValues(b) {
+		ok, err := arrayContains(a, x)
+		if err != nil {
+			return nil, err
+		}
+
+		if ok != all {
+			return ok, nil
+		}
+	}
+	return all, nil
+}
+
+func builtinCos(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	return floatFunc(arg, "cos", math.Cos)
 }
 
 func builtinCount(arg []interface{}, ctx map[interface{}]interface{}) (v interface{}, err error) {
@@ -327,45 +859,138 @@ func builtinDate(arg []interface{}, _ map[interface{}]interface{}) (v interface{
 	), nil
 }
 
-func builtinLen(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
-	switch x := arg[0].(type) {
-	case nil:
+func builtinDateAdd(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	if hasNull(arg) {
 		return nil, nil
-	case string:
-		return int64(len(x)), nil
-	default:
-		return nil, invArg(x, "len")
 	}
-}
 
-func builtinDay(arg []interface{}, ctx map[interface{}]interface{}) (v interface{}, err error) {
-	switch x := arg[0].(type) {
-	case nil:
-		return nil, nil
-	case time.Time:
-		return int64(x.Day()), nil
-	default:
-		return nil, invArg(x, "day")
+	u, err := timeUnitArg(arg[0], "dateAdd")
+	if err != nil {
+		return nil, err
 	}
-}
 
-func builtinFormatTime(arg []interface{}, ctx map[interface{}]interface{}) (v interface{}, err error) {
-	switch x := arg[0].(type) {
-	case nil:
-		return nil, nil
-	case time.Time:
-		switch y := arg[1].(type) {
-		case nil:
-			return nil, nil
-		case string:
-			return x.Format(y), nil
-		default:
-			return nil, invArg(y, "formatTime")
-		}
-	default:
-		return nil, invArg(x, "formatTime")
+	n, err := intArg(arg[1], "dateAdd")
+	if err != nil {
+		return nil, argTypeError(arg[1], "dateAdd", "n", "integer")
 	}
-}
+
+	t, err := timeArg(arg[2], "dateAdd", "t")
+	if err != nil {
+		return nil, err
+	}
+
+	if t, err = addTime(t, n, u); err != nil {
+		return nil, fmt.Errorf("dateAdd: %v", err)
+	}
+
+	return t, nil
+}
+
+func builtinDateDiff(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	if hasNull(arg) {
+		return nil, nil
+	}
+
+	u, err := timeUnitArg(arg[0], "dateDiff")
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := timeArg(arg[1], "dateDiff", "t")
+	if err != nil {
+		return nil, err
+	}
+
+	t2, err := timeArg(arg[2], "dateDiff", "u")
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := diffTime(t, t2, u)
+	if err != nil {
+		return nil, fmt.Errorf("dateDiff: %v", err)
+	}
+
+	return n, nil
+}
+
+func builtinDateTrunc(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	return truncTimeArgs(arg, "dateTrunc", false)
+}
+
+func builtinLen(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	switch x := arg[0].(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return int64(len(x)), nil
+	default:
+		if arrayElem(x) != 0 {
+			return int64(reflect.ValueOf(x).Len()), nil
+		}
+
+		return nil, invArg(x, "len")
+	}
+}
+
+func builtinDay(arg []interface{}, ctx map[interface{}]interface{}) (v interface{}, err error) {
+	switch x := arg[0].(type) {
+	case nil:
+		return nil, nil
+	case time.Time:
+		return int64(x.Day()), nil
+	default:
+		return nil, invArg(x, "day")
+	}
+}
+
+func builtinDistance(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	if arg[0] == nil || arg[1] == nil {
+		return nil, nil
+	}
+
+	p, err := pointArg(arg[0], "distance")
+	if err != nil {
+		return nil, err
+	}
+
+	q, err := pointArg(arg[1], "distance")
+	if err != nil {
+		return nil, err
+	}
+
+	return math.Hypot(p[0]-q[0], p[1]-q[1]), nil
+}
+
+func builtinEndOf(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	return truncTimeArgs(arg, "endOf", true)
+}
+
+func builtinExp(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	return floatFunc(arg, "exp", math.Exp)
+}
+
+func builtinFloor(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	return roundInt(arg, "floor", RoundFloor, math.Floor)
+}
+
+func builtinFormatTime(arg []interface{}, ctx map[interface{}]interface{}) (v interface{}, err error) {
+	switch x := arg[0].(type) {
+	case nil:
+		return nil, nil
+	case time.Time:
+		switch y := arg[1].(type) {
+		case nil:
+			return nil, nil
+		case string:
+			return x.Format(y), nil
+		default:
+			return nil, invArg(y, "formatTime")
+		}
+	default:
+		return nil, invArg(x, "formatTime")
+	}
+}
 
 func builtinFormatFloat(arg []interface{}, ctx map[interface{}]interface{}) (v interface{}, err error) {
 	var val float64
@@ -478,6 +1103,10 @@ func builtinFormatInt(arg []interface{}, ctx map[interface{}]interface{}) (v int
 	return strconv.FormatInt(intVal, base), nil
 }
 
+func builtinGreatest(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	return extremum(arg, '>')
+}
+
 func builtinHasPrefix(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
 	switch s := arg[0].(type) {
 	case nil:
@@ -576,6 +1205,240 @@ func builtinImag(arg []interface{}, _ map[interface{}]interface{}) (v interface{
 	}
 }
 
+func builtinIndexOf(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	a, err := stringArgs(arg, "indexOf")
+	if a == nil || err != nil {
+		return nil, err
+	}
+
+	i := strings.Index(a[0], a[1])
+	if i < 0 {
+		return int64(-1), nil
+	}
+
+	return int64(utf8.RuneCountInString(a[0][:i])), nil
+}
+
+func builtinISOWeek(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	switch x := arg[0].(type) {
+	case nil:
+		return nil, nil
+	case time.Time:
+		_, w := x.ISOWeek()
+		return int64(w), nil
+	default:
+		return nil, invArg(x, "isoWeek")
+	}
+}
+
+func builtinJSONAgg(arg []interface{}, ctx map[interface{}]interface{}) (v interface{}, err error) {
+	if _, ok := ctx["$agg0"]; ok {
+		return
+	}
+
+	fn := ctx["$fn"]
+	if _, ok := ctx["$agg"]; ok {
+		a, ok := ctx[fn].([]json.RawMessage)
+		if !ok {
+			return
+		}
+
+		return jsonJoin('[', a, ']'), nil
+	}
+
+	y, err := jsonMarshal(arg[0])
+	if err != nil {
+		return nil, err
+	}
+
+	a, _ := ctx[fn].([]json.RawMessage)
+	ctx[fn] = append(a, y)
+	return
+}
+
+func builtinJSONArray(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	a := make([]json.RawMessage, len(arg))
+	for i, v := range arg {
+		if a[i], err = jsonMarshal(v); err != nil {
+			return nil, err
+		}
+	}
+	return jsonJoin('[', a, ']'), nil
+}
+
+func builtinJSONArrayLength(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	j, err := jsonPathArg(arg, "jsonArrayLength")
+	if j == nil || err != nil {
+		return nil, err
+	}
+
+	if j[0] != '[' {
+		return nil, nil
+	}
+
+	var a []json.RawMessage
+	if err := json.Unmarshal(j, &a); err != nil {
+		return nil, err
+	}
+
+	return int64(len(a)), nil
+}
+
+func builtinJSONExtract(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	j, err := jsonPathArg(arg, "jsonExtract")
+	if j == nil || err != nil {
+		return nil, err
+	}
+
+	return jsonScalar(j)
+}
+
+func builtinJSONObject(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	if len(arg)%2 != 0 {
+		return nil, fmt.Errorf("jsonObject: missing value of key %v", arg[len(arg)-1])
+	}
+
+	a := make([]json.RawMessage, 0, len(arg)/2)
+	keys := map[string]bool{}
+	for i := 0; i < len(arg); i += 2 {
+		k, ok := arg[i].(string)
+		if !ok {
+			return nil, invArg(arg[i], "jsonObject")
+		}
+
+		if keys[k] {
+			return nil, fmt.Errorf("jsonObject: duplicate key %q", k)
+		}
+
+		keys[k] = true
+		kv, err := jsonMarshal(k)
+		if err != nil {
+			return nil, err
+		}
+
+		vv, err := jsonMarshal(arg[i+1])
+		if err != nil {
+			return nil, err
+		}
+
+		a = append(a, append(append(kv, ':'), vv...))
+	}
+	return jsonJoin('{', a, '}'), nil
+}
+
+func builtinJSONType(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	j, err := jsonPathArg(arg, "jsonType")
+	if j == nil || err != nil {
+		return nil, err
+	}
+
+	return jsonTypeOf(j), nil
+}
+
+// jsonPathArg returns the part of the JSON value arg[0] selected by the
+// optional path arg[1] of the function fn.
+func jsonPathArg(arg []interface{}, fn string) (json.RawMessage, error) {
+	for _, v := range arg {
+		if v == nil {
+			return nil, nil
+		}
+	}
+
+	j, err := jsonArg(arg[0], fn)
+	if err != nil || len(arg) == 1 {
+		return j, err
+	}
+
+	path, ok := arg[1].(string)
+	if !ok {
+		return nil, invArg(arg[1], fn)
+	}
+
+	return jsonSelect(j, path)
+}
+
+// jsonJoin returns the JSON array or object of the elements or members a.
+func jsonJoin(open byte, a []json.RawMessage, close byte) json.RawMessage {
+	r := json.RawMessage{open}
+	for i, v := range a {
+		if i != 0 {
+			r = append(r, ',')
+		}
+		r = append(r, v...)
+	}
+	return append(r, close)
+}
+
+func builtinLeast(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	return extremum(arg, '<')
+}
+
+func builtinLog(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	return floatFunc(arg, "log", math.Log)
+}
+
+func builtinLog10(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	return floatFunc(arg, "log10", math.Log10)
+}
+
+func builtinLower(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	switch x := arg[0].(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return strings.ToLower(x), nil
+	default:
+		return nil, invArg(x, "lower")
+	}
+}
+
+func builtinLpad(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	return padString(arg, "lpad", true)
+}
+
+func builtinLtrim(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	return trimString(arg, "ltrim", strings.TrimLeft, strings.TrimLeftFunc)
+}
+
+func builtinMatch(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	s, query, tokenizer, err := fullTextArgs(arg, "match")
+	if err != nil || s == nil {
+		return nil, err
+	}
+
+	ok, _, err := fullTextMatch(*s, query, tokenizer)
+	if err != nil {
+		return nil, err
+	}
+
+	return ok, nil
+}
+
+// fullTextArgs returns the value, the query and the tokenizer name of the
+// arguments of match or rank. The value is nil if any of the arguments is
+// NULL.
+func fullTextArgs(arg []interface{}, fn string) (s *string, query, tokenizer string, err error) {
+	tokenizer = "simple"
+	for i, v := range arg {
+		switch x := v.(type) {
+		case nil:
+			return nil, "", "", nil
+		case string:
+			switch i {
+			case 0:
+				s = &x
+			case 1:
+				query = x
+			default:
+				tokenizer = x
+			}
+		default:
+			return nil, "", "", invArg(x, fn)
+		}
+	}
+	return s, query, tokenizer, nil
+}
+
 func builtinMax(arg []interface{}, ctx map[interface{}]interface{}) (v interface{}, err error) {
 	if _, ok := ctx["$agg0"]; ok {
 		return
@@ -584,6 +1447,9 @@ func builtinMax(arg []interface{}, ctx map[interface{}]interface{}) (v interface
 	fn := ctx["$fn"]
 	if _, ok := ctx["$agg"]; ok {
 		if v, ok = ctx[fn]; ok {
+			if x, ok := v.(collatedValue); ok {
+				v = x.s
+			}
 			return
 		}
 
@@ -598,7 +1464,7 @@ func builtinMax(arg []interface{}, ctx map[interface{}]interface{}) (v interface
 	switch x := max.(type) {
 	case nil:
 		switch y := y.(type) {
-		case float32, float64, string, int8, int16, int32, int64, uint8, uint16, uint32, uint64, time.Time:
+		case float32, float64, string, int8, int16, int32, int64, uint8, uint16, uint32, uint64, time.Time, Dec, Enum, UUID, json.RawMessage, collatedValue:
 			max = y
 		default:
 			return nil, fmt.Errorf("max: cannot accept %v (value if type %T)", y, y)
@@ -615,6 +1481,10 @@ func builtinMax(arg []interface{}, ctx map[interface{}]interface{}) (v interface
 		if y := y.(string); y > x {
 			max = y
 		}
+	case collatedValue:
+		if y := y.(collatedValue); y.key > x.key {
+			max = y
+		}
 	case int8:
 		if y := y.(int8); y > x {
 			max = y
@@ -651,6 +1521,22 @@ func builtinMax(arg []interface{}, ctx map[interface{}]interface{}) (v interface
 		if y := y.(time.Time); y.After(x) {
 			max = y
 		}
+	case Dec:
+		if y := y.(Dec); y.Cmp(x) > 0 {
+			max = y
+		}
+	case Enum:
+		if y := y.(Enum); y.n > x.n {
+			max = y
+		}
+	case UUID:
+		if y := y.(UUID); bytes.Compare(y[:], x[:]) > 0 {
+			max = y
+		}
+	case json.RawMessage:
+		if y := y.(json.RawMessage); bytes.Compare(y, x) > 0 {
+			max = y
+		}
 	}
 	ctx[fn] = max
 	return
@@ -664,6 +1550,9 @@ func builtinMin(arg []interface{}, ctx map[interface{}]interface{}) (v interface
 	fn := ctx["$fn"]
 	if _, ok := ctx["$agg"]; ok {
 		if v, ok = ctx[fn]; ok {
+			if x, ok := v.(collatedValue); ok {
+				v = x.s
+			}
 			return
 		}
 
@@ -678,7 +1567,7 @@ func builtinMin(arg []interface{}, ctx map[interface{}]interface{}) (v interface
 	switch x := min.(type) {
 	case nil:
 		switch y := y.(type) {
-		case float32, float64, string, int8, int16, int32, int64, uint8, uint16, uint32, uint64, time.Time:
+		case float32, float64, string, int8, int16, int32, int64, uint8, uint16, uint32, uint64, time.Time, Dec, Enum, UUID, json.RawMessage, collatedValue:
 			min = y
 		default:
 			return nil, fmt.Errorf("min: cannot accept %v (value if type %T)", y, y)
@@ -695,6 +1584,10 @@ func builtinMin(arg []interface{}, ctx map[interface{}]interface{}) (v interface
 		if y := y.(string); y < x {
 			min = y
 		}
+	case collatedValue:
+		if y := y.(collatedValue); y.key < x.key {
+			min = y
+		}
 	case int8:
 		if y := y.(int8); y < x {
 			min = y
@@ -731,6 +1624,22 @@ func builtinMin(arg []interface{}, ctx map[interface{}]interface{}) (v interface
 		if y := y.(time.Time); y.Before(x) {
 			min = y
 		}
+	case Dec:
+		if y := y.(Dec); y.Cmp(x) < 0 {
+			min = y
+		}
+	case Enum:
+		if y := y.(Enum); y.n < x.n {
+			min = y
+		}
+	case UUID:
+		if y := y.(UUID); bytes.Compare(y[:], x[:]) < 0 {
+			min = y
+		}
+	case json.RawMessage:
+		if y := y.(json.RawMessage); bytes.Compare(y, x) < 0 {
+			min = y
+		}
 	}
 	ctx[fn] = min
 	return
@@ -758,21 +1667,51 @@ func builtinMinutes(arg []interface{}, ctx map[interface{}]interface{}) (v inter
 	}
 }
 
-func builtinMonth(arg []interface{}, ctx map[interface{}]interface{}) (v interface{}, err error) {
-	switch x := arg[0].(type) {
-	case nil:
+func builtinMod(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	if hasNull(arg) {
 		return nil, nil
-	case time.Time:
-		return int64(x.Month()), nil
-	default:
-		return nil, invArg(x, "month")
 	}
-}
 
-func builtinNanosecond(arg []interface{}, ctx map[interface{}]interface{}) (v interface{}, err error) {
-	switch x := arg[0].(type) {
-	case nil:
-		return nil, nil
+	a, b := coerce(arg[0], arg[1])
+	a, b = ideal(a), ideal(b)
+	if reflect.TypeOf(a) == reflect.TypeOf(b) {
+		switch x := a.(type) {
+		case float32:
+			if y := b.(float32); y != 0 {
+				return float32(math.Mod(float64(x), float64(y))), nil
+			}
+
+			return nil, errDivByZero
+		case float64:
+			if y := b.(float64); y != 0 {
+				return math.Mod(x, y), nil
+			}
+
+			return nil, errDivByZero
+		}
+
+		if n, _, ok := intValue(b); ok && n.Sign() == 0 {
+			return nil, errDivByZero
+		}
+	}
+	return (&binaryOperation{'%', value{a}, value{b}}).eval(nil, nil)
+}
+
+func builtinMonth(arg []interface{}, ctx map[interface{}]interface{}) (v interface{}, err error) {
+	switch x := arg[0].(type) {
+	case nil:
+		return nil, nil
+	case time.Time:
+		return int64(x.Month()), nil
+	default:
+		return nil, invArg(x, "month")
+	}
+}
+
+func builtinNanosecond(arg []interface{}, ctx map[interface{}]interface{}) (v interface{}, err error) {
+	switch x := arg[0].(type) {
+	case nil:
+		return nil, nil
 	case time.Time:
 		return int64(x.Nanosecond()), nil
 	default:
@@ -791,6 +1730,10 @@ func builtinNanoseconds(arg []interface{}, ctx map[interface{}]interface{}) (v i
 	}
 }
 
+func builtinNearest(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	return nil, fmt.Errorf("nearest must be a term of the AND expression of a WHERE clause")
+}
+
 func builtinNow(arg []interface{}, ctx map[interface{}]interface{}) (v interface{}, err error) {
 	return time.Now(), nil
 }
@@ -826,6 +1769,122 @@ func builtinParseTime(arg []interface{}, ctx map[interface{}]interface{}) (v int
 	return time.ParseInLocation(a[0], a[1], l)
 }
 
+func builtinPoint(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	if arg[0] == nil || arg[1] == nil {
+		return nil, nil
+	}
+
+	x, err := float64Arg(arg[0], "point")
+	if err != nil {
+		return nil, err
+	}
+
+	y, err := float64Arg(arg[1], "point")
+	if err != nil {
+		return nil, err
+	}
+
+	return []float64{x, y}, nil
+}
+
+func builtinPi(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	return math.Pi, nil
+}
+
+func builtinPow(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	if hasNull(arg) {
+		return nil, nil
+	}
+
+	switch x := ideal(coerce1(arg[0], arg[1])).(type) {
+	case float32:
+		if y, ok := coerce1(arg[1], x).(float32); ok {
+			return float32(math.Pow(float64(x), float64(y))), nil
+		}
+
+		return nil, invArg(arg[1], "pow")
+	case float64:
+		if y, ok := ideal(coerce1(arg[1], x)).(float64); ok {
+			return math.Pow(x, y), nil
+		}
+
+		return nil, invArg(arg[1], "pow")
+	}
+
+	n, err := intArg(arg[1], "pow")
+	if err != nil {
+		return nil, err
+	}
+
+	switch x := ideal(coerce1(arg[0], arg[1])).(type) {
+	case *big.Rat:
+		if n < 0 {
+			if x.Sign() == 0 {
+				return nil, errDivByZero
+			}
+
+			x, n = new(big.Rat).Inv(x), -n
+		}
+		if int64(x.Num().BitLen()+x.Denom().BitLen())*n > maxPowBits {
+			return nil, fmt.Errorf("pow: result too large")
+		}
+
+		e := big.NewInt(n)
+		return new(big.Rat).SetFrac(new(big.Int).Exp(x.Num(), e, nil), new(big.Int).Exp(x.Denom(), e, nil)), nil
+	}
+
+	if n < 0 {
+		return nil, fmt.Errorf("pow: negative exponent %d", n)
+	}
+
+	switch x := ideal(coerce1(arg[0], arg[1])).(type) {
+	case *big.Int:
+		if int64(x.BitLen())*n > maxPowBits {
+			return nil, fmt.Errorf("pow: result too large")
+		}
+
+		return new(big.Int).Exp(x, big.NewInt(n), nil), nil
+	case Dec:
+		u := x.Unscaled()
+		if int64(u.BitLen())*n > maxPowBits || int64(x.Scale())*n > maxPowBits {
+			return nil, fmt.Errorf("pow: result too large")
+		}
+
+		return NewDec(u.Exp(u, big.NewInt(n), nil), x.Scale()*int(n)), nil
+	case time.Duration:
+		return nil, invArg(x, "pow")
+	default:
+		b, typ, ok := intValue(x)
+		if !ok {
+			return nil, invArg(x, "pow")
+		}
+
+		return intResult(b.Exp(b, big.NewInt(n), two64), typ)
+	}
+}
+
+func builtinRandom(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	return rand.Float64(), nil
+}
+
+func builtinRank(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	if len(arg) < 2 {
+		return nil, fmt.Errorf("rank() requires a MATCH predicate in the WHERE clause")
+	}
+
+	s, query, tokenizer, err := fullTextArgs(arg, "rank")
+	if err != nil || s == nil {
+		return nil, err
+	}
+
+	_, r, err := fullTextMatch(*s, query, tokenizer)
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
 func builtinReal(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
 	switch x := arg[0].(type) {
 	case nil:
@@ -841,6 +1900,200 @@ func builtinReal(arg []interface{}, _ map[interface{}]interface{}) (v interface{
 	}
 }
 
+func builtinRegexpExtract(arg []interface{}, ctx map[interface{}]interface{}) (v interface{}, err error) {
+	s, re, err := regexpArgs(arg, ctx, "regexpExtract")
+	if re == nil || err != nil {
+		return nil, err
+	}
+
+	var group int64
+	if len(arg) == 3 {
+		if group, err = intArg(arg[2], "regexpExtract"); err != nil {
+			return nil, err
+		}
+
+		if group < 0 || group > int64(re.NumSubexp()) {
+			return nil, fmt.Errorf("regexpExtract: no group %d in %s", group, re)
+		}
+	}
+
+	m := re.FindStringSubmatchIndex(s)
+	if m == nil || m[2*group] < 0 {
+		return nil, nil
+	}
+
+	return s[m[2*group]:m[2*group+1]], nil
+}
+
+func builtinRegexpReplace(arg []interface{}, ctx map[interface{}]interface{}) (v interface{}, err error) {
+	s, re, err := regexpArgs(arg, ctx, "regexpReplace")
+	if re == nil || err != nil {
+		return nil, err
+	}
+
+	repl, ok := arg[2].(string)
+	if !ok {
+		return nil, invArg(arg[2], "regexpReplace")
+	}
+
+	return re.ReplaceAllString(s, repl), nil
+}
+
+func builtinRegexpSplit(arg []interface{}, ctx map[interface{}]interface{}) (v interface{}, err error) {
+	s, re, err := regexpArgs(arg, ctx, "regexpSplit")
+	if re == nil || err != nil {
+		return nil, err
+	}
+
+	return re.Split(s, -1), nil
+}
+
+// regexpArgs returns the string and the compiled pattern, the first two
+// arguments of the regexp function fn. The pattern is nil if any argument is
+// NULL. A constant pattern is compiled once by newCall.
+func regexpArgs(arg []interface{}, ctx map[interface{}]interface{}, fn string) (string, *regexp.Regexp, error) {
+	if hasNull(arg) {
+		return "", nil, nil
+	}
+
+	s, ok := arg[0].(string)
+	if !ok {
+		return "", nil, invArg(arg[0], fn)
+	}
+
+	if c, ok := ctx["$fn"].(*call); ok && c.re != nil {
+		return s, c.re, nil
+	}
+
+	pattern, ok := arg[1].(string)
+	if !ok {
+		return "", nil, invArg(arg[1], fn)
+	}
+
+	re, err := regexp.Compile(pattern)
+	return s, re, err
+}
+
+func builtinRepeat(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	if hasNull(arg) {
+		return nil, nil
+	}
+
+	s, ok := arg[0].(string)
+	if !ok {
+		return nil, invArg(arg[0], "repeat")
+	}
+
+	n, err := intArg(arg[1], "repeat")
+	if err != nil {
+		return nil, err
+	}
+
+	return repeatString(s, n, "repeat")
+}
+
+func builtinReplace(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	a, err := stringArgs(arg, "replace")
+	if a == nil || err != nil {
+		return nil, err
+	}
+
+	return strings.Replace(a[0], a[1], a[2], -1), nil
+}
+
+func builtinRound(arg []interface{}, ctx map[interface{}]interface{}) (v interface{}, err error) {
+	x := ideal(arg[0])
+	switch x.(type) {
+	case nil:
+		return nil, nil
+	case float32, float64, Dec, *big.Int, *big.Rat:
+		// ok
+	default:
+		if _, _, ok := intValue(x); !ok {
+			return nil, invArg(x, "round")
+		}
+	}
+
+	var scale int
+	switch y := coerce1(arg[1], int64(0)).(type) {
+	case nil:
+		return nil, nil
+	case int64:
+		if y < -maxDecimalPrecision || y > maxDecimalPrecision {
+			return nil, fmt.Errorf("round: scale %d out of range", y)
+		}
+
+		scale = int(y)
+	default:
+		return nil, invArg(y, "round")
+	}
+
+	mode := RoundHalfUp
+	if len(arg) == 3 {
+		switch z := arg[2].(type) {
+		case nil:
+			return nil, nil
+		case string:
+			var ok bool
+			if mode, ok = roundingModes[z]; !ok {
+				return nil, fmt.Errorf("round: unknown rounding mode %q", z)
+			}
+		default:
+			return nil, invArg(z, "round")
+		}
+	}
+
+	switch x := x.(type) {
+	case float32:
+		return float32(roundFloat(float64(x), 32, scale, mode)), nil
+	case float64:
+		return roundFloat(x, 64, scale, mode), nil
+	case Dec:
+		return x.Round(scale, mode), nil
+	case *big.Rat:
+		if scale < 0 {
+			p := pow10(-scale)
+			q := quoRound(x.Num(), new(big.Int).Mul(x.Denom(), p), mode)
+			return new(big.Rat).SetInt(q.Mul(q, p)), nil
+		}
+
+		p := pow10(scale)
+		q := quoRound(new(big.Int).Mul(x.Num(), p), x.Denom(), mode)
+		return new(big.Rat).SetFrac(q, p), nil
+	}
+
+	if scale >= 0 {
+		return x, nil
+	}
+
+	p := pow10(-scale)
+	if n, ok := x.(*big.Int); ok {
+		q := quoRound(n, p, mode)
+		return q.Mul(q, p), nil
+	}
+
+	n, typ, _ := intValue(x)
+	q := quoRound(n, p, mode)
+	q.Mul(q, p)
+	if v, err = intResult(q, typ); err != nil {
+		return nil, err
+	}
+
+	if m, _, _ := intValue(v); m.Cmp(q) != 0 {
+		return nil, fmt.Errorf("round: %v overflows %s", q, typeStr(typ))
+	}
+
+	return v, nil
+}
+
+func builtinRpad(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	return padString(arg, "rpad", false)
+}
+
+func builtinRtrim(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	return trimString(arg, "rtrim", strings.TrimRight, strings.TrimRightFunc)
+}
+
 func builtinSecond(arg []interface{}, ctx map[interface{}]interface{}) (v interface{}, err error) {
 	switch x := arg[0].(type) {
 	case nil:
@@ -894,6 +2147,115 @@ func builtinSleep(arg []interface{}, ctx map[interface{}]interface{}) (v interfa
 	}
 }
 
+func builtinSign(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	switch x := ideal(arg[0]).(type) {
+	case nil:
+		return nil, nil
+	case float32:
+		if x != x || x == 0 {
+			return x, nil
+		}
+
+		return float32(math.Copysign(1, float64(x))), nil
+	case float64:
+		if x != x || x == 0 {
+			return x, nil
+		}
+
+		return math.Copysign(1, x), nil
+	case Dec:
+		return NewDec(big.NewInt(int64(x.Sign())), 0), nil
+	case *big.Int:
+		return big.NewInt(int64(x.Sign())), nil
+	case *big.Rat:
+		return big.NewRat(int64(x.Sign()), 1), nil
+	default:
+		if n, typ, ok := intValue(x); ok {
+			return intResult(big.NewInt(int64(n.Sign())), typ)
+		}
+
+		return nil, invArg(x, "sign")
+	}
+}
+
+func builtinSin(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	return floatFunc(arg, "sin", math.Sin)
+}
+
+func builtinStartOf(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	return truncTimeArgs(arg, "startOf", false)
+}
+
+func builtinSqrt(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	return floatFunc(arg, "sqrt", math.Sqrt)
+}
+
+func builtinSplitPart(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	if hasNull(arg) {
+		return nil, nil
+	}
+
+	s, ok := arg[0].(string)
+	if !ok {
+		return nil, invArg(arg[0], "splitPart")
+	}
+
+	sep, ok := arg[1].(string)
+	if !ok {
+		return nil, invArg(arg[1], "splitPart")
+	}
+
+	n, err := intArg(arg[2], "splitPart")
+	if err != nil {
+		return nil, err
+	}
+
+	a := strings.Split(s, sep)
+	if n < 0 {
+		n += int64(len(a))
+	}
+	if n < 0 || n >= int64(len(a)) {
+		return "", nil
+	}
+
+	return a[n], nil
+}
+
+func builtinSubstr(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	if hasNull(arg) {
+		return nil, nil
+	}
+
+	s, ok := arg[0].(string)
+	if !ok {
+		return nil, invArg(arg[0], "substr")
+	}
+
+	start, err := intArg(arg[1], "substr")
+	if err != nil {
+		return nil, err
+	}
+
+	if start < 0 {
+		return nil, fmt.Errorf("substr: negative start %d", start)
+	}
+
+	s = s[runeOffset(s, start):]
+	if len(arg) == 3 {
+		n, err := intArg(arg[2], "substr")
+		if err != nil {
+			return nil, err
+		}
+
+		if n < 0 {
+			return nil, fmt.Errorf("substr: negative length %d", n)
+		}
+
+		s = s[:runeOffset(s, n)]
+	}
+	return s, nil
+}
+
 func builtinSum(arg []interface{}, ctx map[interface{}]interface{}) (v interface{}, err error) {
 	if _, ok := ctx["$agg0"]; ok {
 		return
@@ -916,7 +2278,7 @@ func builtinSum(arg []interface{}, ctx map[interface{}]interface{}) (v interface
 	switch x := sum.(type) {
 	case nil:
 		switch y := y.(type) {
-		case complex64, complex128, float32, float64, int8, int16, int32, int64, uint8, uint16, uint32, uint64:
+		case complex64, complex128, float32, float64, int8, int16, int32, int64, uint8, uint16, uint32, uint64, Dec:
 			sum = y
 		default:
 			return nil, fmt.Errorf("sum: cannot accept %v (value if type %T)", y, y)
@@ -945,11 +2307,46 @@ func builtinSum(arg []interface{}, ctx map[interface{}]interface{}) (v interface
 		sum = x + y.(uint32)
 	case uint64:
 		sum = x + y.(uint64)
+	case Dec:
+		sum = decAdd(x, y.(Dec))
 	}
 	ctx[fn] = sum
 	return
 }
 
+func builtinTan(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	return floatFunc(arg, "tan", math.Tan)
+}
+
+func builtinTimeBucket(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	if hasNull(arg) {
+		return nil, nil
+	}
+
+	width, ok := arg[0].(time.Duration)
+	if !ok {
+		return nil, argTypeError(arg[0], "timeBucket", "d", "duration")
+	}
+
+	if width <= 0 {
+		return nil, fmt.Errorf("timeBucket: non-positive interval %v", width)
+	}
+
+	t, err := timeArg(arg[1], "timeBucket", "t")
+	if err != nil {
+		return nil, err
+	}
+
+	origin := bucketOrigin
+	if len(arg) == 3 {
+		if origin, err = timeArg(arg[2], "timeBucket", "origin"); err != nil {
+			return nil, err
+		}
+	}
+
+	return bucketTime(t, width, origin), nil
+}
+
 func builtinTimeIn(arg []interface{}, ctx map[interface{}]interface{}) (v interface{}, err error) {
 	switch x := arg[0].(type) {
 	case nil:
@@ -978,6 +2375,33 @@ func builtinTimeIn(arg []interface{}, ctx map[interface{}]interface{}) (v interf
 	}
 }
 
+func builtinTrim(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	return trimString(arg, "trim", strings.Trim, strings.TrimFunc)
+}
+
+func builtinTrunc(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	return roundInt(arg, "trunc", RoundDown, math.Trunc)
+}
+
+func builtinUpper(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	switch x := arg[0].(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return strings.ToUpper(x), nil
+	default:
+		return nil, invArg(x, "upper")
+	}
+}
+
+func builtinUUIDNew(arg []interface{}, ctx map[interface{}]interface{}) (v interface{}, err error) {
+	return newUUIDv4()
+}
+
+func builtinUUIDv7(arg []interface{}, ctx map[interface{}]interface{}) (v interface{}, err error) {
+	return newUUIDv7()
+}
+
 func builtinWeekday(arg []interface{}, ctx map[interface{}]interface{}) (v interface{}, err error) {
 	switch x := arg[0].(type) {
 	case nil:
@@ -989,6 +2413,24 @@ func builtinWeekday(arg []interface{}, ctx map[interface{}]interface{}) (v inter
 	}
 }
 
+func builtinWithin(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	if arg[0] == nil || arg[1] == nil {
+		return nil, nil
+	}
+
+	p, err := pointArg(arg[0], "within")
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := boxArg(arg[1], "within")
+	if err != nil {
+		return nil, err
+	}
+
+	return b.contains(rect{p[0], p[1], p[0], p[1]}), nil
+}
+
 func builtinYear(arg []interface{}, ctx map[interface{}]interface{}) (v interface{}, err error) {
 	switch x := arg[0].(type) {
 	case nil:
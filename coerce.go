@@ -81,6 +81,7 @@ func coerce1(inVal, otherVal interface{}) (coercedInVal interface{}) {
 			//case *big.Rat:
 			//case time.Time:
 			//case time.Duration:
+			//case Dec:
 		}
 	case idealFloat:
 		switch otherVal.(type) {
@@ -112,8 +113,12 @@ func coerce1(inVal, otherVal interface{}) (coercedInVal interface{}) {
 		//case *big.Int:
 		case *big.Rat:
 			return big.NewRat(1, 1).SetFloat64(float64(x))
-			//case time.Time:
-			//case time.Duration:
+		//case time.Time:
+		//case time.Duration:
+		case Dec:
+			if d, ok := decFloat(float64(x), 64); ok {
+				return d
+			}
 		}
 	case idealInt:
 		switch otherVal.(type) {
@@ -175,6 +180,8 @@ func coerce1(inVal, otherVal interface{}) (coercedInVal interface{}) {
 		//case time.Time:
 		case time.Duration:
 			return time.Duration(int64(x))
+		case Dec:
+			return Dec{big.NewInt(int64(x)), 0}
 		}
 	case idealRune:
 		switch otherVal.(type) {
@@ -221,6 +228,8 @@ func coerce1(inVal, otherVal interface{}) (coercedInVal interface{}) {
 		//case time.Time:
 		case time.Duration:
 			return time.Duration(int64(x))
+		case Dec:
+			return Dec{big.NewInt(int64(x)), 0}
 		}
 	case idealUint:
 		switch otherVal.(type) {
@@ -284,6 +293,8 @@ func coerce1(inVal, otherVal interface{}) (coercedInVal interface{}) {
 			if x <= math.MaxInt64 {
 				return time.Duration(int64(x))
 			}
+		case Dec:
+			return Dec{big.NewInt(0).SetUint64(uint64(x)), 0}
 		}
 	}
 	return
@@ -0,0 +1,484 @@
+// Copyright 2026 The ql Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ql
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"golang.org/x/text/cases"
+	collator "golang.org/x/text/collate"
+	"golang.org/x/text/language"
+)
+
+// Collator defines the order and equality of strings of a collation.
+type Collator interface {
+	// Key returns the sort key of s. Strings collate in the byte order of
+	// their keys and strings having equal keys are equal.
+	Key(s string) string
+}
+
+type binaryCollator struct{}
+
+func (binaryCollator) Key(s string) string { return s }
+
+// nocaseCollator orders strings by the bytes of their Unicode case folding.
+type nocaseCollator struct{}
+
+func (nocaseCollator) Key(s string) string { return cases.Fold().String(s) }
+
+// unicodeCollator uses the Unicode Collation Algorithm with the root locale.
+type unicodeCollator struct {
+	mu  sync.Mutex
+	c   *collator.Collator
+	buf collator.Buffer
+}
+
+func (u *unicodeCollator) Key(s string) string {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	k := string(u.c.KeyFromString(&u.buf, s))
+	u.buf.Reset()
+	return k
+}
+
+var (
+	collationsMu sync.RWMutex
+	collations   = map[string]Collator{
+		"binary":  binaryCollator{},
+		"nocase":  nocaseCollator{},
+		"unicode": &unicodeCollator{c: collator.New(language.Und)},
+	}
+)
+
+// RegisterCollation makes the collator c available to column definitions,
+// indices and ORDER BY under name. The name must be an identifier, it is not
+// case sensitive and it cannot be registered more than once.
+//
+// The collation names of columns and indices are stored in the DB. The
+// collator must be registered before they are used, and its keys must not
+// change, otherwise the indices do not match the indexed values.
+//
+// RegisterCollation is safe for concurrent use by multiple goroutines.
+func RegisterCollation(name string, c Collator) error {
+	if c == nil {
+		return fmt.Errorf("RegisterCollation: nil collator %s", name)
+	}
+
+	if !isIdent(name) {
+		return fmt.Errorf("RegisterCollation: invalid name %q", name)
+	}
+
+	name = strings.ToLower(name)
+	collationsMu.Lock()
+	defer collationsMu.Unlock()
+
+	if _, ok := collations[name]; ok {
+		return fmt.Errorf("RegisterCollation: collation %s already registered", name)
+	}
+
+	collations[name] = c
+	return nil
+}
+
+func findCollation(name string) (Collator, error) {
+	collationsMu.RLock()
+	c := collations[name]
+	collationsMu.RUnlock()
+	if c == nil {
+		return nil, fmt.Errorf("unknown collation %s", name)
+	}
+
+	return c, nil
+}
+
+// collationKey returns the key of the value v in the collation name. The key
+// of NULL is NULL.
+func collationKey(name string, v interface{}) (interface{}, error) {
+	switch x := v.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		c, err := findCollation(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return c.Key(x), nil
+	default:
+		return nil, fmt.Errorf("cannot use %v (type %T) with COLLATE %s", v, v, name)
+	}
+}
+
+// collatedValue is a string of a column having a collation together with its
+// collation key. The min and max functions compare the keys.
+type collatedValue struct {
+	s, key string
+}
+
+// colKey returns v, a value of the column c, or its collation key if c has a
+// collation.
+func colKey(c *col, v interface{}) (interface{}, error) {
+	if c.collation == "" {
+		return v, nil
+	}
+
+	return collationKey(c.collation, v)
+}
+
+// collateExpr is an index expression or an ORDER BY term having a COLLATE
+// clause, or an operand collated by the collation of a column. Its value is
+// the collation key of the value of expr, or the collatedValue of it if value
+// is set.
+type collateExpr struct {
+	expr  expression
+	name  string
+	value bool
+}
+
+func (c *collateExpr) clone(arg []interface{}, unqualify ...string) (expression, error) {
+	expr, err := c.expr.clone(arg, unqualify...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &collateExpr{expr, c.name, c.value}, nil
+}
+
+func (c *collateExpr) isStatic() bool { return c.expr.isStatic() }
+
+func (c *collateExpr) String() string { return fmt.Sprintf("%s COLLATE %s", c.expr, c.name) }
+
+func (c *collateExpr) eval(execCtx *execCtx, ctx map[interface{}]interface{}) (interface{}, error) {
+	v, err := expand1(c.expr.eval(execCtx, ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	k, err := collationKey(c.name, v)
+	if k == nil || err != nil || !c.value {
+		return k, err
+	}
+
+	return collatedValue{v.(string), k.(string)}, nil
+}
+
+// colCollations returns the collations of cols having one by name.
+func colCollations(cols []*col) map[string]string {
+	var m map[string]string
+	for _, c := range cols {
+		if c.collation != "" {
+			if m == nil {
+				m = map[string]string{}
+			}
+			m[c.name] = c.collation
+		}
+	}
+	return m
+}
+
+// planCollations returns the collations of the fields of p having one by
+// name, if known.
+func planCollations(p plan) map[string]string {
+	switch x := p.(type) {
+	case *crossJoinDefaultPlan:
+		return joinCollations(x.rsets, x.fields)
+	case *distinctDefaultPlan:
+		return planCollations(x.src)
+	case *filterDefaultPlan:
+		return planCollations(x.plan)
+	case *fullJoinDefaultPlan:
+		return joinCollations(x.rsets, x.fields)
+	case *fulltextPlan:
+		return colCollations(x.src.cols)
+	case *groupByDefaultPlan:
+		return planCollations(x.src)
+	case *indexIntersectionPlan:
+		return planCollations(x.plans[0])
+	case *indexPlan:
+		return colCollations(x.src.cols)
+	case *indexUnionPlan:
+		return planCollations(x.plans[0])
+	case *leftJoinDefaultPlan:
+		return joinCollations(x.rsets, x.fields)
+	case *limitDefaultPlan:
+		return planCollations(x.src)
+	case *nearestDefaultPlan:
+		return planCollations(x.src)
+	case *offsetDefaultPlan:
+		return planCollations(x.src)
+	case *orderByDefaultPlan:
+		return planCollations(x.src)
+	case *rightJoinDefaultPlan:
+		return joinCollations(x.rsets, x.fields)
+	case *selectFieldsDefaultPlan:
+		return fieldCollations(x.flds, planCollations(x.src))
+	case *selectFieldsGroupPlan:
+		return fieldCollations(x.flds, planCollations(x.src))
+	case *spatialPlan:
+		return colCollations(x.src.cols)
+	case *tableDefaultPlan:
+		return colCollations(x.t.cols)
+	}
+	return nil
+}
+
+// joinCollations returns the collations of fields, the qualified field names
+// of the joined rsets.
+func joinCollations(rsets []plan, fields []string) map[string]string {
+	var m map[string]string
+	i := 0
+	for _, p := range rsets {
+		c := planCollations(p)
+		for _, v := range p.fieldNames() {
+			if nm := c[v]; nm != "" {
+				if m == nil {
+					m = map[string]string{}
+				}
+				m[fields[i]] = nm
+			}
+			i++
+		}
+	}
+	return m
+}
+
+// fieldCollations returns the collations of flds which are fields of a record
+// set having the collations m.
+func fieldCollations(flds []*fld, m map[string]string) map[string]string {
+	var r map[string]string
+	for _, v := range flds {
+		if nm := exprCollation(v.expr, m); nm != "" {
+			if r == nil {
+				r = map[string]string{}
+			}
+			r[v.name] = nm
+		}
+	}
+	return r
+}
+
+// exprCollation returns the collation of e if it is a field having a
+// collation in m.
+func exprCollation(e expression, m map[string]string) string {
+	switch x := e.(type) {
+	case *ident:
+		return m[x.s]
+	case *pexpr:
+		return exprCollation(x.expr, m)
+	}
+	return ""
+}
+
+// collateExpression returns e with the operands of every comparison of a
+// field having a collation in m, and the argument of every min and max of
+// such field, collated by it.
+func collateExpression(e expression, m map[string]string) expression {
+	if len(m) == 0 {
+		return e
+	}
+
+	switch x := e.(type) {
+	case *arrayLit:
+		y := *x
+		y.list = collateExpressions(x.list, m)
+		return &y
+	case *binaryOperation:
+		y := *x
+		y.l = collateExpression(x.l, m)
+		y.r = collateExpression(x.r, m)
+		switch x.op {
+		case eq, neq, '<', le, '>', ge:
+			nm := exprCollation(x.l, m)
+			if nm == "" {
+				nm = exprCollation(x.r, m)
+			}
+			if nm != "" {
+				y.l = &collateExpr{expr: y.l, name: nm}
+				y.r = &collateExpr{expr: y.r, name: nm}
+			}
+		}
+		return &y
+	case *call:
+		y := *x
+		y.arg = collateExpressions(x.arg, m)
+		if (x.f == "min" || x.f == "max") && len(x.arg) == 1 {
+			if nm := exprCollation(x.arg[0], m); nm != "" {
+				y.arg = []expression{&collateExpr{x.arg[0], nm, true}}
+			}
+		}
+		return &y
+	case *collateExpr:
+		y := *x
+		y.expr = collateExpression(x.expr, m)
+		return &y
+	case *conversion:
+		y := *x
+		y.val = collateExpression(x.val, m)
+		return &y
+	case *indexOp:
+		y := *x
+		y.expr = collateExpression(x.expr, m)
+		y.x = collateExpression(x.x, m)
+		return &y
+	case *isNull:
+		y := *x
+		y.expr = collateExpression(x.expr, m)
+		return &y
+	case *pexpr:
+		y := *x
+		y.expr = collateExpression(x.expr, m)
+		return &y
+	case *pIn:
+		y := *x
+		y.expr = collateExpression(x.expr, m)
+		y.list = collateExpressions(x.list, m)
+		if nm := exprCollation(x.expr, m); nm != "" && x.sel == nil {
+			y.expr = &collateExpr{expr: y.expr, name: nm}
+			for i, v := range y.list {
+				y.list[i] = &collateExpr{expr: v, name: nm}
+			}
+		}
+		return &y
+	case *pLike:
+		y := *x
+		y.expr = collateExpression(x.expr, m)
+		y.pattern = collateExpression(x.pattern, m)
+		return &y
+	case *slice:
+		y := *x
+		if x.lo != nil {
+			lo := collateExpression(*x.lo, m)
+			y.lo = &lo
+		}
+		if x.hi != nil {
+			hi := collateExpression(*x.hi, m)
+			y.hi = &hi
+		}
+		y.expr = collateExpression(x.expr, m)
+		return &y
+	case *unaryOperation:
+		y := *x
+		y.v = collateExpression(x.v, m)
+		return &y
+	}
+	return e
+}
+
+// collateExpressions is like collateExpression for every item of list.
+func collateExpressions(list []expression, m map[string]string) []expression {
+	r := make([]expression, len(list))
+	for i, v := range list {
+		r[i] = collateExpression(v, m)
+	}
+	return r
+}
+
+// collateFields returns flds with their expressions collated by
+// collateExpression.
+func collateFields(flds []*fld, m map[string]string) []*fld {
+	if len(m) == 0 {
+		return flds
+	}
+
+	r := make([]*fld, len(flds))
+	for i, v := range flds {
+		r[i] = &fld{collateExpression(v.expr, m), v.name}
+	}
+	return r
+}
+
+// collateRow returns the values of row having a collation in colls replaced
+// by their collation keys. Row is returned if there are no such values.
+func collateRow(row []interface{}, colls []string) ([]interface{}, error) {
+	if colls == nil {
+		return row, nil
+	}
+
+	r := make([]interface{}, len(row))
+	for i, v := range row {
+		if nm := colls[i]; nm != "" {
+			var err error
+			if v, err = collationKey(nm, v); err != nil {
+				return nil, err
+			}
+		}
+		r[i] = v
+	}
+	return r, nil
+}
+
+// rowCollations returns the collations of fields in m, or nil if there are
+// none.
+func rowCollations(fields []string, m map[string]string) []string {
+	var r []string
+	for i, v := range fields {
+		if nm := m[v]; nm != "" {
+			if r == nil {
+				r = make([]string, len(fields))
+			}
+			r[i] = nm
+		}
+	}
+	return r
+}
+
+// collatedIndex is the index of a column having a collation. Create and
+// Delete store the collation keys of the indexed values, Seek expects keys.
+type collatedIndex struct {
+	btreeIndex
+	name string
+}
+
+func (x *collatedIndex) keys(indexedValues []interface{}) ([]interface{}, error) {
+	k, err := collationKey(x.name, indexedValues[0])
+	if err != nil {
+		return nil, err
+	}
+
+	return []interface{}{k}, nil
+}
+
+// Create implements btreeIndex.
+func (x *collatedIndex) Create(indexedValues []interface{}, h int64) error {
+	k, err := x.keys(indexedValues)
+	if err != nil {
+		return err
+	}
+
+	return x.btreeIndex.Create(k, h)
+}
+
+// Delete implements btreeIndex.
+func (x *collatedIndex) Delete(indexedValues []interface{}, h int64) error {
+	k, err := x.keys(indexedValues)
+	if err != nil {
+		return err
+	}
+
+	return x.btreeIndex.Delete(k, h)
+}
+
+// collateIndex returns x, the index of the column c, wrapped to use its
+// collation, if any.
+func collateIndex(x btreeIndex, c *col) btreeIndex {
+	if c == nil || c.collation == "" {
+		return x
+	}
+
+	return &collatedIndex{x, c.collation}
+}
+
+// collateClause returns the COLLATE clause of a column having the collation
+// name.
+func collateClause(name string) string {
+	if name == "" {
+		return ""
+	}
+
+	return " COLLATE " + name
+}
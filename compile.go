@@ -0,0 +1,605 @@
+// Copyright 2026 The ql Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ql
+
+import (
+	"fmt"
+)
+
+// compiledExpr evaluates an expression compiled by compileEval. The result is
+// the same as the one of the eval method of the expression.
+type compiledExpr func(ctx *execCtx, m map[interface{}]interface{}) (interface{}, error)
+
+// compileEval turns e into a chain of closures. Operations on values of
+// known types are specialized, constant subexpressions are evaluated once.
+// Types maps column names to their types, it may be nil. Parameters are
+// replaced by the values in arg, if any.
+//
+// Compiled expressions cannot be used to compute aggregate functions.
+func compileEval(e expression, types map[string]int, arg []interface{}) compiledExpr {
+	c := &exprCompiler{types: types, arg: arg}
+	return c.compile(e).f
+}
+
+// compileEvals is like compileEval for every item of list.
+func compileEvals(list []expression, types map[string]int, arg []interface{}) []compiledExpr {
+	r := make([]compiledExpr, len(list))
+	for i, v := range list {
+		if v != nil {
+			r[i] = compileEval(v, types, arg)
+		}
+	}
+	return r
+}
+
+// colTypes returns the types of cols by name.
+func colTypes(cols []*col) map[string]int {
+	m := make(map[string]int, len(cols))
+	for _, c := range cols {
+		m[c.name] = c.typ
+	}
+	return m
+}
+
+// planTypes returns the types of the fields of p, if known.
+func planTypes(p plan) map[string]int {
+	switch x := p.(type) {
+	case *filterDefaultPlan:
+		return planTypes(x.plan)
+	case *indexPlan:
+		return colTypes(x.src.cols)
+	case *spatialPlan:
+		return colTypes(x.src.cols)
+	case *tableDefaultPlan:
+		return colTypes(x.t.cols)
+	}
+	return nil
+}
+
+type exprCompiler struct {
+	types map[string]int
+	arg   []interface{}
+}
+
+// cexpr is a compiled (sub)expression.
+type cexpr struct {
+	f       compiledExpr
+	typ     int         // Type of the non NULL results, zero if not known.
+	isConst bool        // Result is always val.
+	val     interface{} // Value of a constant expression.
+}
+
+func constExpr(v interface{}) cexpr {
+	return cexpr{
+		f:       func(*execCtx, map[interface{}]interface{}) (interface{}, error) { return v, nil },
+		typ:     typeOf(v),
+		isConst: true,
+		val:     v,
+	}
+}
+
+// typeOf returns the type of values specialized by the compiler.
+func typeOf(v interface{}) int {
+	switch v.(type) {
+	case bool:
+		return qBool
+	case float64:
+		return qFloat64
+	case int64:
+		return qInt64
+	case string:
+		return qString
+	}
+	return 0
+}
+
+// fold evaluates r if all of its operands are constant.
+func (c *exprCompiler) fold(r cexpr, operands ...cexpr) (x cexpr) {
+	for _, v := range operands {
+		if !v.isConst {
+			return r
+		}
+	}
+
+	defer func() {
+		if e := recover(); e != nil {
+			x = r
+		}
+	}()
+
+	v, err := r.f(&execCtx{arg: c.arg}, nil)
+	if err != nil { // Reported when evaluated.
+		return r
+	}
+
+	return constExpr(v)
+}
+
+func (c *exprCompiler) compile(e expression) cexpr {
+	switch x := e.(type) {
+	case value:
+		return constExpr(x.val)
+	case parameter:
+		if i := x.n - 1; i < len(c.arg) {
+			return constExpr(c.arg[i])
+		}
+	case *ident:
+		nm := x.s
+		return cexpr{
+			f: func(_ *execCtx, m map[interface{}]interface{}) (interface{}, error) {
+				v, ok := m[nm]
+				if !ok {
+					return nil, fmt.Errorf("unknown field %s", nm)
+				}
+
+				return v, nil
+			},
+			typ: c.types[nm],
+		}
+	case *pexpr:
+		return c.compile(x.expr)
+	case *isNull:
+		v := c.compile(x.expr)
+		not := x.not
+		return c.fold(cexpr{
+			f: func(ctx *execCtx, m map[interface{}]interface{}) (interface{}, error) {
+				val, err := v.f(ctx, m)
+				if err != nil {
+					return nil, err
+				}
+
+				return val == nil != not, nil
+			},
+			typ: qBool,
+		}, v)
+	case *unaryOperation:
+		return c.unaryOperation(x)
+	case *binaryOperation:
+		return c.binaryOperation(x)
+	case *call:
+		return c.call(x)
+	}
+	return cexpr{f: e.eval}
+}
+
+// recoverExpr turns a panic into an error like binaryOperation.eval and
+// unaryOperation.eval do.
+func recoverExpr(v *interface{}, err *error) {
+	if e := recover(); e != nil {
+		switch x := e.(type) {
+		case error:
+			*v, *err = nil, x
+		default:
+			*v, *err = nil, fmt.Errorf("%v", x)
+		}
+	}
+}
+
+func (c *exprCompiler) unaryOperation(u *unaryOperation) cexpr {
+	x := c.compile(u.v)
+	op := u.op
+	r := cexpr{
+		f: func(ctx *execCtx, m map[interface{}]interface{}) (v interface{}, err error) {
+			defer recoverExpr(&v, &err)
+
+			if v, err = expand1(x.f(ctx, m)); err != nil {
+				return nil, err
+			}
+
+			return (&unaryOperation{op, value{v}}).eval(ctx, nil)
+		},
+	}
+	if op == '!' {
+		r.typ = qBool
+		if x.typ == qBool {
+			r.f = func(ctx *execCtx, m map[interface{}]interface{}) (v interface{}, err error) {
+				defer recoverExpr(&v, &err)
+
+				if v, err = expand1(x.f(ctx, m)); err != nil || v == nil {
+					return nil, err
+				}
+
+				if b, ok := v.(bool); ok {
+					return !b, nil
+				}
+
+				return undOp(v, op)
+			}
+		}
+	}
+	return c.fold(r, x)
+}
+
+func (c *exprCompiler) call(x *call) cexpr {
+	fn, ok := findFunc(x.f)
+	if !ok || x.f == "id" {
+		return cexpr{f: x.eval}
+	}
+
+	args := make([]cexpr, len(x.arg))
+	for i, v := range x.arg {
+		args[i] = c.compile(v)
+	}
+	r := cexpr{
+		f: func(ctx *execCtx, m map[interface{}]interface{}) (interface{}, error) {
+			a := make([]interface{}, len(args))
+			for i, arg := range args {
+				v, err := expand1(arg.f(ctx, m))
+				if err != nil {
+					return nil, err
+				}
+
+				a[i] = v
+			}
+			if m != nil {
+				m["$fn"] = x
+			}
+			return fn.f(a, m)
+		},
+	}
+	if !fn.isStatic || fn.isAggregate {
+		return r
+	}
+
+	return c.fold(r, args...)
+}
+
+func (c *exprCompiler) binaryOperation(o *binaryOperation) cexpr {
+	l, r := c.compile(o.l), c.compile(o.r)
+	op := o.op
+	switch op {
+	case andand:
+		return c.fold(c.andand(l, r), l, r)
+	case oror:
+		return c.fold(c.oror(l, r), l, r)
+	}
+
+	// Constants of untyped values get the type of the other operand. The
+	// shift count is converted by the shift itself.
+	switch {
+	case op == lsh || op == rsh:
+		// nop
+	case l.isConst && l.typ == 0 && r.typ != 0:
+		l = c.coerce(l, r.typ)
+	case r.isConst && r.typ == 0 && l.typ != 0:
+		r = c.coerce(r, l.typ)
+	}
+
+	generic := func(ctx *execCtx, m map[interface{}]interface{}) (v interface{}, err error) {
+		defer recoverExpr(&v, &err)
+
+		a, err := expand1(l.f(ctx, m))
+		if err != nil {
+			return nil, err
+		}
+
+		b, err := expand1(r.f(ctx, m))
+		if err != nil {
+			return nil, err
+		}
+
+		return (&binaryOperation{op, value{a}, value{b}}).eval(ctx, nil)
+	}
+	x := cexpr{f: generic}
+	switch op {
+	case eq, neq, '<', le, '>', ge:
+		x.typ = qBool
+	}
+	if l.typ != r.typ {
+		return c.fold(x, l, r)
+	}
+
+	var f func(a, b interface{}) (interface{}, bool)
+	switch l.typ {
+	case qFloat64:
+		f = float64Op(op)
+	case qInt64:
+		f = int64Op(op)
+	case qString:
+		f = stringOp(op)
+	}
+	if f == nil {
+		return c.fold(x, l, r)
+	}
+
+	if x.typ == 0 {
+		x.typ = l.typ
+	}
+	x.f = func(ctx *execCtx, m map[interface{}]interface{}) (v interface{}, err error) {
+		defer recoverExpr(&v, &err)
+
+		a, err := expand1(l.f(ctx, m))
+		if err != nil {
+			return nil, err
+		}
+
+		b, err := expand1(r.f(ctx, m))
+		if err != nil {
+			return nil, err
+		}
+
+		if a == nil || b == nil {
+			return nil, nil
+		}
+
+		if v, ok := f(a, b); ok {
+			return v, nil
+		}
+
+		return (&binaryOperation{op, value{a}, value{b}}).eval(ctx, nil)
+	}
+	return c.fold(x, l, r)
+}
+
+// coerce converts the value of the constant x to type typ, if possible.
+func (c *exprCompiler) coerce(x cexpr, typ int) cexpr {
+	var zero interface{}
+	switch typ {
+	case qFloat64:
+		zero = float64(0)
+	case qInt64:
+		zero = int64(0)
+	default:
+		return x
+	}
+
+	v, _ := coerce(x.val, zero)
+	if typeOf(v) != typ {
+		return x
+	}
+
+	return constExpr(v)
+}
+
+func (c *exprCompiler) andand(l, r cexpr) cexpr {
+	return cexpr{
+		f: func(ctx *execCtx, m map[interface{}]interface{}) (v interface{}, err error) {
+			defer recoverExpr(&v, &err)
+
+			a, err := expand1(l.f(ctx, m))
+			if err != nil {
+				return nil, err
+			}
+
+			switch x := a.(type) {
+			case nil:
+				b, err := expand1(r.f(ctx, m))
+				if err != nil {
+					return nil, err
+				}
+
+				switch y := b.(type) {
+				case nil:
+					return nil, nil
+				case bool:
+					if !y {
+						return false, nil
+					}
+
+					return nil, nil
+				default:
+					return invOp2(x, y, andand)
+				}
+			case bool:
+				if !x {
+					return false, nil
+				}
+
+				b, err := expand1(r.f(ctx, m))
+				if err != nil {
+					return nil, err
+				}
+
+				switch y := b.(type) {
+				case nil:
+					return nil, nil
+				case bool:
+					return y, nil
+				default:
+					return invOp2(x, y, andand)
+				}
+			default:
+				return undOp(x, andand)
+			}
+		},
+		typ: qBool,
+	}
+}
+
+func (c *exprCompiler) oror(l, r cexpr) cexpr {
+	return cexpr{
+		f: func(ctx *execCtx, m map[interface{}]interface{}) (v interface{}, err error) {
+			defer recoverExpr(&v, &err)
+
+			a, err := expand1(l.f(ctx, m))
+			if err != nil {
+				return nil, err
+			}
+
+			switch x := a.(type) {
+			case nil:
+				b, err := expand1(r.f(ctx, m))
+				if err != nil {
+					return nil, err
+				}
+
+				switch y := b.(type) {
+				case nil:
+					return nil, nil
+				case bool:
+					if y {
+						return y, nil
+					}
+
+					return nil, nil
+				default:
+					return invOp2(x, y, oror)
+				}
+			case bool:
+				if x {
+					return x, nil
+				}
+
+				b, err := expand1(r.f(ctx, m))
+				if err != nil {
+					return nil, err
+				}
+
+				switch y := b.(type) {
+				case nil:
+					return nil, nil
+				case bool:
+					return y, nil
+				default:
+					return invOp2(x, y, oror)
+				}
+			default:
+				return undOp(x, oror)
+			}
+		},
+		typ: qBool,
+	}
+}
+
+// float64Op returns a function computing op on float64 operands. The
+// function reports false if the operands are not both float64.
+func float64Op(op int) func(a, b interface{}) (interface{}, bool) {
+	var f func(x, y float64) interface{}
+	switch op {
+	case eq:
+		f = func(x, y float64) interface{} { return x == y }
+	case neq:
+		f = func(x, y float64) interface{} { return x != y }
+	case '<':
+		f = func(x, y float64) interface{} { return x < y }
+	case le:
+		f = func(x, y float64) interface{} { return x <= y }
+	case '>':
+		f = func(x, y float64) interface{} { return x > y }
+	case ge:
+		f = func(x, y float64) interface{} { return x >= y }
+	case '+':
+		f = func(x, y float64) interface{} { return x + y }
+	case '-':
+		f = func(x, y float64) interface{} { return x - y }
+	case '*':
+		f = func(x, y float64) interface{} { return x * y }
+	case '/':
+		f = func(x, y float64) interface{} { return x / y }
+	default:
+		return nil
+	}
+
+	return func(a, b interface{}) (interface{}, bool) {
+		x, ok := a.(float64)
+		if !ok {
+			return nil, false
+		}
+
+		y, ok := b.(float64)
+		if !ok {
+			return nil, false
+		}
+
+		return f(x, y), true
+	}
+}
+
+// int64Op is like float64Op for int64 operands. Division by zero is left to
+// the generic evaluator.
+func int64Op(op int) func(a, b interface{}) (interface{}, bool) {
+	var f func(x, y int64) interface{}
+	switch op {
+	case eq:
+		f = func(x, y int64) interface{} { return x == y }
+	case neq:
+		f = func(x, y int64) interface{} { return x != y }
+	case '<':
+		f = func(x, y int64) interface{} { return x < y }
+	case le:
+		f = func(x, y int64) interface{} { return x <= y }
+	case '>':
+		f = func(x, y int64) interface{} { return x > y }
+	case ge:
+		f = func(x, y int64) interface{} { return x >= y }
+	case '+':
+		f = func(x, y int64) interface{} { return x + y }
+	case '-':
+		f = func(x, y int64) interface{} { return x - y }
+	case '*':
+		f = func(x, y int64) interface{} { return x * y }
+	case '/', '%':
+		return func(a, b interface{}) (interface{}, bool) {
+			x, ok := a.(int64)
+			if !ok {
+				return nil, false
+			}
+
+			y, ok := b.(int64)
+			if !ok || y == 0 {
+				return nil, false
+			}
+
+			if op == '/' {
+				return x / y, true
+			}
+
+			return x % y, true
+		}
+	default:
+		return nil
+	}
+
+	return func(a, b interface{}) (interface{}, bool) {
+		x, ok := a.(int64)
+		if !ok {
+			return nil, false
+		}
+
+		y, ok := b.(int64)
+		if !ok {
+			return nil, false
+		}
+
+		return f(x, y), true
+	}
+}
+
+// stringOp is like float64Op for string operands.
+func stringOp(op int) func(a, b interface{}) (interface{}, bool) {
+	var f func(x, y string) interface{}
+	switch op {
+	case eq:
+		f = func(x, y string) interface{} { return x == y }
+	case neq:
+		f = func(x, y string) interface{} { return x != y }
+	case '<':
+		f = func(x, y string) interface{} { return x < y }
+	case le:
+		f = func(x, y string) interface{} { return x <= y }
+	case '>':
+		f = func(x, y string) interface{} { return x > y }
+	case ge:
+		f = func(x, y string) interface{} { return x >= y }
+	case '+':
+		f = func(x, y string) interface{} { return x + y }
+	default:
+		return nil
+	}
+
+	return func(a, b interface{}) (interface{}, bool) {
+		x, ok := a.(string)
+		if !ok {
+			return nil, false
+		}
+
+		y, ok := b.(string)
+		if !ok {
+			return nil, false
+		}
+
+		return f(x, y), true
+	}
+}
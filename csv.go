@@ -0,0 +1,348 @@
+// Copyright 2026 The ql Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ql
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CSVOptions amend the behavior of ImportCSV and ExportCSV.
+type CSVOptions struct {
+	// Comma is the field delimiter. Zero means ','.
+	Comma rune
+
+	// Header, if true, means the first record holds the column names.
+	Header bool
+
+	// Null is the text of NULL values. Note that the default, empty string,
+	// makes empty fields NULL, so empty strings do not round trip.
+	Null string
+
+	// BatchSize is the maximum number of rows ImportCSV inserts in one
+	// transaction. Zero means all rows are inserted in a single
+	// transaction.
+	BatchSize int
+}
+
+// ImportCSV inserts into table the records read from r in CSV format. It
+// returns the number of rows inserted. Passing nil opts is the same as
+// passing &CSVOptions{}.
+//
+// If opts.Header is true, the fields of the first record name the columns
+// the values of the following records are inserted into, in any order.
+// Columns not named are set to their default values. Otherwise the records
+// must have a field for every column of table, in the order of the columns.
+//
+// Fields equal to opts.Null are NULL. The other fields are converted to the
+// type of their column. Numbers must be decimal, booleans are parsed by
+// strconv.ParseBool, complex numbers by strconv.ParseComplex, durations by
+// time.ParseDuration and times using the RFC 3339 format with optional
+// fractional seconds. Big rationals may be written as fractions, like 1/3.
+// The text of string and blob fields is used as is, JSON fields must hold
+// valid JSON. Arrays are written as JSON arrays, like ["a","b"], of values
+// of the element type, durations being numbers of nanoseconds.
+//
+// The rows are inserted in transactions of at most opts.BatchSize rows. If
+// ImportCSV fails, the rows of the failed transaction are not inserted but
+// the rows of the transactions committed before remain.
+func (db *DB) ImportCSV(table string, r io.Reader, opts *CSVOptions) (n int64, err error) {
+	if opts == nil {
+		opts = &CSVOptions{}
+	}
+
+	cr := csv.NewReader(r)
+	if opts.Comma != 0 {
+		cr.Comma = opts.Comma
+	}
+
+	cols, err := db.csvColumns(table)
+	if err != nil {
+		return 0, err
+	}
+
+	if opts.Header {
+		hdr, err := cr.Read()
+		if err != nil {
+			return 0, fmt.Errorf("ImportCSV: %v", noEOF(err))
+		}
+
+		byName := map[string]*col{}
+		for _, c := range cols {
+			byName[c.name] = c
+		}
+		cols = cols[:0]
+		for _, nm := range hdr {
+			c := byName[nm]
+			if c == nil {
+				return 0, fmt.Errorf("ImportCSV: table %s has no column %s", table, nm)
+			}
+
+			if findCol(cols, nm) != nil {
+				return 0, fmt.Errorf("ImportCSV: duplicate column %s", nm)
+			}
+
+			cols = append(cols, c)
+		}
+	}
+
+	names := make([]string, len(cols))
+	params := make([]string, len(cols))
+	for i, c := range cols {
+		names[i] = c.name
+		params[i] = fmt.Sprintf("$%d", i+1)
+	}
+	ins, err := Compile(fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s);", table, strings.Join(names, ", "), strings.Join(params, ", ")))
+	if err != nil {
+		return 0, err
+	}
+
+	tctx := NewRWCtx()
+	var batch int64
+	defer func() {
+		if err != nil && batch != 0 {
+			db.Run(tctx, "ROLLBACK;")
+		}
+	}()
+
+	cr.FieldsPerRecord = len(cols)
+	arg := make([]interface{}, len(cols))
+	for {
+		rec, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return n, fmt.Errorf("ImportCSV: %v", err)
+		}
+
+		for i, s := range rec {
+			if arg[i], err = csvValue(s, cols[i], opts.Null); err != nil {
+				line, _ := cr.FieldPos(i)
+				return n, fmt.Errorf("ImportCSV: line %d, column %s: %v", line, cols[i].name, err)
+			}
+		}
+
+		if batch == 0 {
+			if _, _, err = db.Run(tctx, "BEGIN TRANSACTION;"); err != nil {
+				return n, err
+			}
+		}
+
+		if _, _, err = db.Execute(tctx, ins, arg...); err != nil {
+			line, _ := cr.FieldPos(0)
+			return n, fmt.Errorf("ImportCSV: line %d: %v", line, err)
+		}
+
+		if batch++; int64(opts.BatchSize) == batch {
+			if _, _, err = db.Run(tctx, "COMMIT;"); err != nil {
+				return n, err
+			}
+
+			n += batch
+			batch = 0
+		}
+	}
+	if batch != 0 {
+		if _, _, err = db.Run(tctx, "COMMIT;"); err != nil {
+			return n, err
+		}
+
+		n += batch
+		batch = 0
+	}
+	return n, nil
+}
+
+// csvColumns returns the columns of the last committed state of table.
+func (db *DB) csvColumns(table string) ([]*col, error) {
+	db.mu.Lock()
+	if db.store == nil {
+		db.muUnlock()
+		return nil, fmt.Errorf("ImportCSV: DB is closed")
+	}
+
+	snap := db.snapshot()
+	db.muUnlock()
+	defer snap.release()
+
+	t := snap.root.tables[table]
+	if t == nil || isSystemName[table] {
+		return nil, fmt.Errorf("ImportCSV: table %s does not exist", table)
+	}
+
+	return append([]*col(nil), t.cols...), nil
+}
+
+// csvValue returns the value for the column c of the CSV field s.
+func csvValue(s string, c *col, null string) (interface{}, error) {
+	if s == null {
+		return nil, nil
+	}
+
+	var v interface{}
+	var err error
+	typ := c.typ
+	switch typ {
+	case qBool:
+		v, err = strconv.ParseBool(s)
+	case qComplex64:
+		v, err = strconv.ParseComplex(s, 64)
+	case qComplex128:
+		v, err = strconv.ParseComplex(s, 128)
+	case qFloat32:
+		v, err = strconv.ParseFloat(s, 32)
+	case qFloat64:
+		v, err = strconv.ParseFloat(s, 64)
+	case qInt8:
+		v, err = strconv.ParseInt(s, 10, 8)
+	case qInt16:
+		v, err = strconv.ParseInt(s, 10, 16)
+	case qInt32:
+		v, err = strconv.ParseInt(s, 10, 32)
+	case qInt64:
+		v, err = strconv.ParseInt(s, 10, 64)
+	case qUint8:
+		v, err = strconv.ParseUint(s, 10, 8)
+	case qUint16:
+		v, err = strconv.ParseUint(s, 10, 16)
+	case qUint32:
+		v, err = strconv.ParseUint(s, 10, 32)
+	case qUint64:
+		v, err = strconv.ParseUint(s, 10, 64)
+	case qBigInt:
+		y, ok := big.NewInt(0).SetString(s, 10)
+		if !ok {
+			return invConv(s, typ)
+		}
+
+		return y, nil
+	case qTime:
+		v, err = time.Parse(time.RFC3339Nano, s)
+	case qEnum:
+		return s, nil // A label, checked by the insert.
+	case qArray:
+		p := reflect.New(arrayTypes[c.elem])
+		if err := json.Unmarshal([]byte(s), p.Interface()); err != nil {
+			return nil, fmt.Errorf("invalid %s value %q", c.typeStr(), s)
+		}
+
+		if p.Elem().IsNil() {
+			return nil, nil
+		}
+
+		return p.Elem().Interface(), nil
+	default:
+		v = s
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return convert(v, typ)
+}
+
+// ExportCSV writes to w in CSV format the rows of the SELECT statement src
+// executed with the arguments arg. The statement reads the last committed
+// state of db. Passing nil opts is the same as passing &CSVOptions{}.
+//
+// If opts.Header is true, the first record holds the names of the result
+// fields. NULL values are written as opts.Null and other values in the
+// formats read by ImportCSV.
+func (db *DB) ExportCSV(w io.Writer, src string, opts *CSVOptions, arg ...interface{}) (err error) {
+	if opts == nil {
+		opts = &CSVOptions{}
+	}
+
+	l, err := Compile(src)
+	if err != nil {
+		return err
+	}
+
+	if len(l.l) != 1 {
+		return fmt.Errorf("ExportCSV: expected a single SELECT statement")
+	}
+
+	if _, ok := l.l[0].(*selectStmt); !ok {
+		return fmt.Errorf("ExportCSV: expected a SELECT statement")
+	}
+
+	rs, _, err := db.Execute(nil, l, arg...)
+	if err != nil {
+		return err
+	}
+
+	cw := csv.NewWriter(w)
+	if opts.Comma != 0 {
+		cw.Comma = opts.Comma
+	}
+
+	if opts.Header {
+		f, err := rs[0].Fields()
+		if err != nil {
+			return err
+		}
+
+		if err = cw.Write(f); err != nil {
+			return err
+		}
+	}
+
+	var rec []string
+	if err = rs[0].Do(false, func(data []interface{}) (bool, error) {
+		rec = rec[:0]
+		for _, v := range data {
+			rec = append(rec, csvField(v, opts.Null))
+		}
+		return true, cw.Write(rec)
+	}); err != nil {
+		return err
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// csvField returns the CSV field of v.
+func csvField(v interface{}, null string) string {
+	switch x := v.(type) {
+	case nil:
+		return null
+	case string:
+		return x
+	case []byte:
+		return string(x)
+	case json.RawMessage:
+		return string(x)
+	case complex64:
+		return strconv.FormatComplex(complex128(x), 'g', -1, 64)
+	case complex128:
+		return strconv.FormatComplex(x, 'g', -1, 128)
+	case float32:
+		return strconv.FormatFloat(float64(x), 'g', -1, 32)
+	case float64:
+		return strconv.FormatFloat(x, 'g', -1, 64)
+	case *big.Rat:
+		return x.RatString()
+	case time.Time:
+		return x.Format(time.RFC3339Nano)
+	default:
+		if arrayElem(x) != 0 {
+			if b, err := jsonMarshal(x); err == nil {
+				return string(b)
+			}
+		}
+
+		return fmt.Sprint(x)
+	}
+}
@@ -0,0 +1,342 @@
+// Copyright 2026 The ql Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ql
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// timeUnit is a calendar or clock unit of the date/time functions and of
+// interval literals.
+type timeUnit int
+
+// Values of timeUnit, from the smallest to the largest.
+const (
+	unitNanosecond timeUnit = iota
+	unitMicrosecond
+	unitMillisecond
+	unitSecond
+	unitMinute
+	unitHour
+	unitDay
+	unitWeek
+	unitMonth
+	unitQuarter
+	unitYear
+)
+
+var unitNames = [...]string{
+	unitNanosecond:  "nanosecond",
+	unitMicrosecond: "microsecond",
+	unitMillisecond: "millisecond",
+	unitSecond:      "second",
+	unitMinute:      "minute",
+	unitHour:        "hour",
+	unitDay:         "day",
+	unitWeek:        "week",
+	unitMonth:       "month",
+	unitQuarter:     "quarter",
+	unitYear:        "year",
+}
+
+func (u timeUnit) String() string { return unitNames[u] }
+
+// timeUnits maps the unit names, their plurals and abbreviations, to units.
+var timeUnits = map[string]timeUnit{
+	"nanosecond":   unitNanosecond,
+	"nanoseconds":  unitNanosecond,
+	"ns":           unitNanosecond,
+	"microsecond":  unitMicrosecond,
+	"microseconds": unitMicrosecond,
+	"us":           unitMicrosecond,
+	"millisecond":  unitMillisecond,
+	"milliseconds": unitMillisecond,
+	"ms":           unitMillisecond,
+	"second":       unitSecond,
+	"seconds":      unitSecond,
+	"sec":          unitSecond,
+	"s":            unitSecond,
+	"minute":       unitMinute,
+	"minutes":      unitMinute,
+	"min":          unitMinute,
+	"m":            unitMinute,
+	"hour":         unitHour,
+	"hours":        unitHour,
+	"h":            unitHour,
+	"day":          unitDay,
+	"days":         unitDay,
+	"d":            unitDay,
+	"week":         unitWeek,
+	"weeks":        unitWeek,
+	"w":            unitWeek,
+	"month":        unitMonth,
+	"months":       unitMonth,
+	"quarter":      unitQuarter,
+	"quarters":     unitQuarter,
+	"year":         unitYear,
+	"years":        unitYear,
+}
+
+// unitDurations holds the length of the units having a fixed one. Days and
+// weeks are fixed only in interval literals, the date/time functions add them
+// to the calendar date.
+var unitDurations = map[timeUnit]time.Duration{
+	unitNanosecond:  time.Nanosecond,
+	unitMicrosecond: time.Microsecond,
+	unitMillisecond: time.Millisecond,
+	unitSecond:      time.Second,
+	unitMinute:      time.Minute,
+	unitHour:        time.Hour,
+	unitDay:         24 * time.Hour,
+	unitWeek:        7 * 24 * time.Hour,
+}
+
+// unitMonths holds the number of months of the month based units.
+var unitMonths = map[timeUnit]int64{
+	unitMonth:   1,
+	unitQuarter: 3,
+	unitYear:    12,
+}
+
+// bucketOrigin is the default origin of timeBucket. It is a Monday, so week
+// buckets start on Mondays.
+var bucketOrigin = time.Date(2000, 1, 3, 0, 0, 0, 0, time.UTC)
+
+// argTypeError reports v, the argument name of the function fn, not being of
+// the type typ.
+func argTypeError(v interface{}, fn, name, typ string) error {
+	return fmt.Errorf("%s: invalid argument %s %v (type %T), expected %s", fn, name, v, v, typ)
+}
+
+// timeUnitArg returns the unit named by v, the unit argument of the function
+// fn.
+func timeUnitArg(v interface{}, fn string) (timeUnit, error) {
+	s, ok := v.(string)
+	if !ok {
+		return 0, argTypeError(v, fn, "unit", "string")
+	}
+
+	u, ok := timeUnits[strings.ToLower(s)]
+	if !ok {
+		return 0, fmt.Errorf("%s: unknown unit %q", fn, s)
+	}
+
+	return u, nil
+}
+
+// timeArg returns v, the argument name of the function fn.
+func timeArg(v interface{}, fn, name string) (time.Time, error) {
+	t, ok := v.(time.Time)
+	if !ok {
+		return time.Time{}, argTypeError(v, fn, name, "time")
+	}
+
+	return t, nil
+}
+
+// addTime returns t plus n units. Days and weeks are added to the calendar
+// date keeping the clock time. Months, quarters and years keep the day of
+// the month unless the resulting month is shorter, then the result is its
+// last day.
+func addTime(t time.Time, n int64, u timeUnit) (time.Time, error) {
+	switch u {
+	case unitDay, unitWeek:
+		days := int64(1)
+		if u == unitWeek {
+			days = 7
+		}
+		if n > math.MaxInt32/days || n < math.MinInt32/days {
+			return time.Time{}, fmt.Errorf("%d %ss out of range", n, u)
+		}
+
+		return t.AddDate(0, 0, int(n*days)), nil
+	case unitMonth, unitQuarter, unitYear:
+		if n > math.MaxInt32 || n < math.MinInt32 {
+			return time.Time{}, fmt.Errorf("%d %ss out of range", n, u)
+		}
+
+		y, m, d := t.Date()
+		months := int64(y)*12 + int64(m) - 1 + n*unitMonths[u]
+		y, m = int(floorDiv(months, 12)), time.Month(months-floorDiv(months, 12)*12+1)
+		if last := time.Date(y, m+1, 0, 0, 0, 0, 0, time.UTC).Day(); d > last {
+			d = last
+		}
+		return time.Date(y, m, d, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location()), nil
+	default:
+		unit := unitDurations[u]
+		if n > math.MaxInt64/int64(unit) || n < math.MinInt64/int64(unit) {
+			return time.Time{}, fmt.Errorf("%d %ss out of range", n, u)
+		}
+
+		return t.Add(time.Duration(n) * unit), nil
+	}
+}
+
+// truncTime returns t truncated to the start of its unit u in the location of
+// t. Weeks start on Mondays.
+func truncTime(t time.Time, u timeUnit) time.Time {
+	y, m, d := t.Date()
+	hh, mm, ss := t.Clock()
+	ns := t.Nanosecond()
+	switch u {
+	case unitNanosecond:
+		return t
+	case unitMicrosecond:
+		ns -= ns % 1e3
+	case unitMillisecond:
+		ns -= ns % 1e6
+	case unitSecond:
+		ns = 0
+	case unitMinute:
+		ss, ns = 0, 0
+	case unitHour:
+		mm, ss, ns = 0, 0, 0
+	case unitDay:
+		hh, mm, ss, ns = 0, 0, 0, 0
+	case unitWeek:
+		d -= (int(t.Weekday()) + 6) % 7
+		hh, mm, ss, ns = 0, 0, 0, 0
+	case unitMonth:
+		d, hh, mm, ss, ns = 1, 0, 0, 0, 0
+	case unitQuarter:
+		m = (m-1)/3*3 + 1
+		d, hh, mm, ss, ns = 1, 0, 0, 0, 0
+	case unitYear:
+		m, d, hh, mm, ss, ns = 1, 1, 0, 0, 0, 0
+	}
+	return time.Date(y, m, d, hh, mm, ss, ns, t.Location())
+}
+
+// diffTime returns the number of whole units from t to u, negative if u is
+// before t.
+func diffTime(t, u time.Time, unit timeUnit) (int64, error) {
+	var n int64
+	switch unit {
+	case unitMonth, unitQuarter, unitYear:
+		y, m, _ := t.Date()
+		y2, m2, _ := u.Date()
+		n = ((int64(y2)-int64(y))*12 + int64(m2) - int64(m)) / unitMonths[unit]
+	default:
+		q := new(big.Int).Quo(nanosBetween(t, u), big.NewInt(int64(unitDurations[unit])))
+		if !q.IsInt64() {
+			return 0, fmt.Errorf("difference out of range")
+		}
+
+		if n = q.Int64(); unit < unitDay {
+			return n, nil
+		}
+	}
+
+	// The estimate of the calendar units may be off by one in either
+	// direction. Adding n units to t must not pass u, adding one more must.
+	passes := func(n int64) (bool, error) {
+		v, err := addTime(t, n, unit)
+		if u.Before(t) {
+			return v.Before(u), err
+		}
+
+		return v.After(u), err
+	}
+
+	step := int64(1)
+	if u.Before(t) {
+		step = -1
+	}
+	for {
+		p, err := passes(n)
+		if err != nil {
+			return 0, err
+		}
+
+		if p {
+			n -= step
+			continue
+		}
+
+		if p, err = passes(n + step); err != nil || p {
+			return n, err
+		}
+
+		n += step
+	}
+}
+
+// bucketTime returns the start of the interval of length width, counted from
+// origin, which contains t. The result is in the location of t.
+func bucketTime(t time.Time, width time.Duration, origin time.Time) time.Time {
+	r := new(big.Int).Mod(nanosBetween(origin, t), big.NewInt(int64(width)))
+	return t.Add(-time.Duration(r.Int64()))
+}
+
+// nanosBetween returns the number of nanoseconds from t to u. Unlike
+// u.Sub(t) it does not saturate.
+func nanosBetween(t, u time.Time) *big.Int {
+	n := big.NewInt(u.Unix() - t.Unix())
+	n.Mul(n, big.NewInt(int64(time.Second)))
+	return n.Add(n, big.NewInt(int64(u.Nanosecond()-t.Nanosecond())))
+}
+
+func floorDiv(a, b int64) int64 {
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
+	return q
+}
+
+var intervalTerm = regexp.MustCompile(`^([-+]?[0-9]+)\s*([a-zA-Z]+)\s*,?\s*`)
+
+// parseInterval returns the duration of the text of an interval literal. The
+// text is a sequence of integers, each followed by a unit of a fixed length,
+// like "1 day 12 hours", or a Go duration like "1h30m".
+func parseInterval(s string) (time.Duration, error) {
+	t := strings.TrimSpace(s)
+	if t == "" {
+		return 0, fmt.Errorf("empty interval")
+	}
+
+	if d, err := time.ParseDuration(t); err == nil {
+		return d, nil
+	}
+
+	var d time.Duration
+
+	for t != "" {
+		m := intervalTerm.FindStringSubmatch(t)
+		if m == nil {
+			return 0, fmt.Errorf("invalid interval %q", s)
+		}
+
+		t = t[len(m[0]):]
+		u, ok := timeUnits[strings.ToLower(m[2])]
+		if !ok {
+			return 0, fmt.Errorf("invalid interval %q: unknown unit %q", s, m[2])
+		}
+
+		unit, ok := unitDurations[u]
+		if !ok {
+			return 0, fmt.Errorf("invalid interval %q: %s is not a fixed duration, use dateAdd", s, m[2])
+		}
+
+		n, err := strconv.ParseInt(m[1], 10, 64)
+		if err != nil || n > math.MaxInt64/int64(unit) || n < math.MinInt64/int64(unit) {
+			return 0, fmt.Errorf("interval %q out of range", s)
+		}
+
+		x := time.Duration(n) * unit
+		if x > 0 && d > math.MaxInt64-x || x < 0 && d < math.MinInt64-x {
+			return 0, fmt.Errorf("interval %q out of range", s)
+		}
+
+		d += x
+	}
+	return d, nil
+}
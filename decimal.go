@@ -0,0 +1,480 @@
+// Copyright 2026 The ql Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ql
+
+import (
+	"database/sql/driver"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+const (
+	// maxDecimalPrecision is the maximum precision of a decimal column.
+	maxDecimalPrecision = 1000
+
+	// decimalQuoScale is the number of digits a decimal quotient has after
+	// the decimal point in addition to those of the more precise operand.
+	decimalQuoScale = 6
+)
+
+var bigTen = big.NewInt(10)
+
+// RoundingMode determines how Dec.Round discards digits.
+type RoundingMode int
+
+// Values of RoundingMode.
+const (
+	RoundHalfUp   RoundingMode = iota // To nearest, ties away from zero.
+	RoundHalfEven                     // To nearest, ties to the even neighbor.
+	RoundHalfDown                     // To nearest, ties toward zero.
+	RoundUp                           // Away from zero.
+	RoundDown                         // Toward zero.
+	RoundCeiling                      // Toward positive infinity.
+	RoundFloor                        // Toward negative infinity.
+)
+
+// roundingModes maps the mode names accepted by the round function.
+var roundingModes = map[string]RoundingMode{
+	"halfUp":   RoundHalfUp,
+	"halfEven": RoundHalfEven,
+	"halfDown": RoundHalfDown,
+	"up":       RoundUp,
+	"down":     RoundDown,
+	"ceiling":  RoundCeiling,
+	"floor":    RoundFloor,
+}
+
+// Dec is a value of the QL decimal type, an exact decimal number having a
+// fixed number of digits after the decimal point, its scale. Dec values are
+// immutable. The zero value is 0 with scale 0.
+//
+// Dec implements driver.Valuer and sql.Scanner, so it can be passed to and
+// read from the database/sql driver as a decimal string.
+type Dec struct {
+	u     *big.Int // Unscaled value, nil means zero. Never modified.
+	scale int
+}
+
+// NewDec returns the Dec unscaled × 10^-scale. A nil unscaled is zero. A
+// negative scale yields a result of scale zero.
+func NewDec(unscaled *big.Int, scale int) Dec {
+	u := new(big.Int)
+	if unscaled != nil {
+		u.Set(unscaled)
+	}
+	if scale < 0 {
+		u.Mul(u, pow10(-scale))
+		scale = 0
+	}
+	return Dec{u, scale}
+}
+
+// ParseDec returns the Dec represented by s, for example "-12.50" or
+// "1.5e3". The scale of the result is the number of digits after the decimal
+// point, adjusted by the exponent, if any, but not less than zero.
+func ParseDec(s string) (Dec, error) {
+	t := s
+	exp := 0
+	if i := strings.IndexAny(t, "eE"); i >= 0 {
+		n, err := strconv.Atoi(t[i+1:])
+		if err != nil || n > maxDecimalPrecision || n < -maxDecimalPrecision {
+			return Dec{}, fmt.Errorf("invalid decimal %q", s)
+		}
+
+		exp = n
+		t = t[:i]
+	}
+
+	sign := ""
+	if t != "" && (t[0] == '-' || t[0] == '+') {
+		sign, t = t[:1], t[1:]
+	}
+	ip, fp := t, ""
+	if i := strings.IndexByte(t, '.'); i >= 0 {
+		ip, fp = t[:i], t[i+1:]
+	}
+	digits := ip + fp
+	if digits == "" || strings.Trim(digits, "0123456789") != "" {
+		return Dec{}, fmt.Errorf("invalid decimal %q", s)
+	}
+
+	u, _ := new(big.Int).SetString(sign+digits, 10)
+	return NewDec(u, len(fp)-exp), nil
+}
+
+// decimalParams checks the precision and scale of a decimal column type.
+func decimalParams(prec, scale interface{}) (int, int, error) {
+	p, ok := prec.(idealInt)
+	s, ok2 := scale.(idealInt)
+	if !ok || !ok2 || p < 1 || p > maxDecimalPrecision || s < 0 || s > p {
+		return 0, 0, fmt.Errorf("invalid decimal type decimal(%v, %v): need 1 <= precision <= %d and 0 <= scale <= precision", prec, scale, maxDecimalPrecision)
+	}
+
+	return int(p), int(s), nil
+}
+
+// colTypeStr is like typeStr but it includes the precision and scale of
+// decimal columns.
+func colTypeStr(typ, prec, scale int) string {
+	if typ == qDecimal {
+		return fmt.Sprintf("decimal(%d, %d)", prec, scale)
+	}
+
+	return typeStr(typ)
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(bigTen, big.NewInt(int64(n)), nil)
+}
+
+func (d Dec) unscaled() *big.Int {
+	if d.u == nil {
+		return new(big.Int)
+	}
+
+	return d.u
+}
+
+// Unscaled returns the unscaled value of d, d × 10^d.Scale().
+func (d Dec) Unscaled() *big.Int { return new(big.Int).Set(d.unscaled()) }
+
+// Scale returns the number of digits of d after the decimal point.
+func (d Dec) Scale() int { return d.scale }
+
+// Sign returns -1, 0 or +1 depending on the sign of d.
+func (d Dec) Sign() int { return d.unscaled().Sign() }
+
+// Cmp compares d and e numerically and returns -1, 0 or +1. The scales do
+// not matter, 1.5 and 1.50 are equal.
+func (d Dec) Cmp(e Dec) int {
+	a, b, _ := decAlign(d, e)
+	return a.Cmp(b)
+}
+
+// Rat returns d as a rational number.
+func (d Dec) Rat() *big.Rat {
+	return new(big.Rat).SetFrac(d.unscaled(), pow10(d.scale))
+}
+
+// String returns d in decimal notation with exactly d.Scale() digits after
+// the decimal point.
+func (d Dec) String() string {
+	u := d.unscaled()
+	s := new(big.Int).Abs(u).String()
+	if d.scale != 0 {
+		if n := d.scale + 1 - len(s); n > 0 {
+			s = strings.Repeat("0", n) + s
+		}
+		s = s[:len(s)-d.scale] + "." + s[len(s)-d.scale:]
+	}
+	if u.Sign() < 0 {
+		s = "-" + s
+	}
+	return s
+}
+
+// Round returns d rounded to scale digits after the decimal point using mode.
+// If scale is greater than the scale of d, the result is d padded with zero
+// digits. A negative scale rounds to a multiple of 10^-scale and yields a
+// result of scale zero.
+func (d Dec) Round(scale int, mode RoundingMode) Dec {
+	switch {
+	case scale == d.scale:
+		return d
+	case scale > d.scale:
+		return Dec{new(big.Int).Mul(d.unscaled(), pow10(scale-d.scale)), scale}
+	case scale < 0:
+		u := quoRound(d.unscaled(), pow10(d.scale-scale), mode)
+		return Dec{u.Mul(u, pow10(-scale)), 0}
+	default:
+		return Dec{quoRound(d.unscaled(), pow10(d.scale-scale), mode), scale}
+	}
+}
+
+// Value implements driver.Valuer.
+func (d Dec) Value() (driver.Value, error) { return d.String(), nil }
+
+// Scan implements sql.Scanner. It accepts decimal strings, []byte holding a
+// decimal string, int64 and float64 values.
+func (d *Dec) Scan(src interface{}) error {
+	if b, ok := src.([]byte); ok {
+		src = string(b)
+	}
+	switch src.(type) {
+	case string, int64, float64:
+		// nop
+	default:
+		return fmt.Errorf("cannot scan %v (type %T) into Dec", src, src)
+	}
+
+	v, err := decConvert(src)
+	if err != nil {
+		return err
+	}
+
+	*d = v.(Dec)
+	return nil
+}
+
+// precision returns the number of digits of the unscaled value of d.
+func (d Dec) precision() int {
+	u := d.unscaled()
+	if u.Sign() == 0 {
+		return 1
+	}
+
+	return len(new(big.Int).Abs(u).String())
+}
+
+// quoRound returns n/m, m > 0, rounded to an integer using mode.
+func quoRound(n, m *big.Int, mode RoundingMode) *big.Int {
+	q, r := new(big.Int).QuoRem(n, m, new(big.Int))
+	if r.Sign() == 0 {
+		return q
+	}
+
+	sign := n.Sign()
+	half := new(big.Int).Lsh(new(big.Int).Abs(r), 1).Cmp(m) // |r| vs m/2
+	up := false
+	switch mode {
+	case RoundHalfUp:
+		up = half >= 0
+	case RoundHalfEven:
+		up = half > 0 || half == 0 && q.Bit(0) != 0
+	case RoundHalfDown:
+		up = half > 0
+	case RoundUp:
+		up = true
+	case RoundDown:
+		// nop
+	case RoundCeiling:
+		up = sign > 0
+	case RoundFloor:
+		up = sign < 0
+	default:
+		panic("internal error 075")
+	}
+	if up {
+		q.Add(q, big.NewInt(int64(sign)))
+	}
+	return q
+}
+
+// decAlign returns the unscaled values of a and b at the scale of the more
+// precise one and that scale.
+func decAlign(a, b Dec) (x, y *big.Int, scale int) {
+	x, y = a.unscaled(), b.unscaled()
+	switch {
+	case a.scale < b.scale:
+		return new(big.Int).Mul(x, pow10(b.scale-a.scale)), y, b.scale
+	case a.scale > b.scale:
+		return x, new(big.Int).Mul(y, pow10(a.scale-b.scale)), a.scale
+	default:
+		return x, y, a.scale
+	}
+}
+
+func decAdd(a, b Dec) Dec {
+	x, y, scale := decAlign(a, b)
+	return Dec{new(big.Int).Add(x, y), scale}
+}
+
+func decSub(a, b Dec) Dec {
+	x, y, scale := decAlign(a, b)
+	return Dec{new(big.Int).Sub(x, y), scale}
+}
+
+func decMul(a, b Dec) Dec {
+	return Dec{new(big.Int).Mul(a.unscaled(), b.unscaled()), a.scale + b.scale}
+}
+
+func decNeg(a Dec) Dec {
+	return Dec{new(big.Int).Neg(a.unscaled()), a.scale}
+}
+
+// decQuo returns a/b rounded half up to decimalQuoScale more digits than the
+// more precise operand has.
+func decQuo(a, b Dec) (Dec, error) {
+	scale := a.scale
+	if b.scale > scale {
+		scale = b.scale
+	}
+	return decQuoScale(a, b, scale+decimalQuoScale)
+}
+
+// decQuoScale returns a/b rounded half up to scale digits.
+func decQuoScale(a, b Dec, scale int) (Dec, error) {
+	if b.Sign() == 0 {
+		return Dec{}, errDivByZero
+	}
+
+	n := new(big.Int).Mul(a.unscaled(), pow10(scale-a.scale+b.scale))
+	m := b.unscaled()
+	if m.Sign() < 0 {
+		n.Neg(n)
+		m = new(big.Int).Neg(m)
+	}
+	return Dec{quoRound(n, m, RoundHalfUp), scale}, nil
+}
+
+// decRem returns the remainder of a/b truncated toward zero.
+func decRem(a, b Dec) (Dec, error) {
+	if b.Sign() == 0 {
+		return Dec{}, errDivByZero
+	}
+
+	x, y, scale := decAlign(a, b)
+	return Dec{new(big.Int).Rem(x, y), scale}, nil
+}
+
+// trunc returns the integer part of d.
+func (d Dec) trunc() *big.Int {
+	return new(big.Int).Quo(d.unscaled(), pow10(d.scale))
+}
+
+// decFloat returns the decimal value of the float f using the fewest digits
+// that represent f exactly.
+func decFloat(f float64, bitSize int) (Dec, bool) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return Dec{}, false
+	}
+
+	d, err := ParseDec(strconv.FormatFloat(f, 'f', -1, bitSize))
+	return d, err == nil
+}
+
+// decRat returns r as a Dec if it has a finite decimal representation.
+func decRat(r *big.Rat) (Dec, bool) {
+	// The denominator must be 2^i × 5^j, the scale is then max(i, j).
+	m := new(big.Int).Set(r.Denom())
+	scale := 0
+	for _, f := range []int64{2, 5} {
+		n := 0
+		for p := big.NewInt(f); new(big.Int).Rem(m, p).Sign() == 0; n++ {
+			m.Quo(m, p)
+		}
+		if n > scale {
+			scale = n
+		}
+	}
+	if !m.IsInt64() || m.Int64() != 1 {
+		return Dec{}, false
+	}
+
+	u := new(big.Int).Mul(r.Num(), pow10(scale))
+	return Dec{u.Quo(u, r.Denom()), scale}, true
+}
+
+// decConvert returns v converted to decimal.
+func decConvert(v interface{}) (interface{}, error) {
+	var d Dec
+	ok := true
+	switch x := v.(type) {
+	case nil:
+		return nil, nil
+	case Dec:
+		return x, nil
+	case idealFloat:
+		d, ok = decFloat(float64(x), 64)
+	case idealInt:
+		d = Dec{big.NewInt(int64(x)), 0}
+	case idealRune:
+		d = Dec{big.NewInt(int64(x)), 0}
+	case idealUint:
+		d = Dec{new(big.Int).SetUint64(uint64(x)), 0}
+	case float32:
+		d, ok = decFloat(float64(x), 32)
+	case float64:
+		d, ok = decFloat(x, 64)
+	case int8:
+		d = Dec{big.NewInt(int64(x)), 0}
+	case int16:
+		d = Dec{big.NewInt(int64(x)), 0}
+	case int32:
+		d = Dec{big.NewInt(int64(x)), 0}
+	case int64:
+		d = Dec{big.NewInt(x), 0}
+	case uint8:
+		d = Dec{new(big.Int).SetUint64(uint64(x)), 0}
+	case uint16:
+		d = Dec{new(big.Int).SetUint64(uint64(x)), 0}
+	case uint32:
+		d = Dec{new(big.Int).SetUint64(uint64(x)), 0}
+	case uint64:
+		d = Dec{new(big.Int).SetUint64(x), 0}
+	case string:
+		var err error
+		d, err = ParseDec(strings.TrimSpace(x))
+		ok = err == nil
+	case *big.Int:
+		d = Dec{new(big.Int).Set(x), 0}
+	case *big.Rat:
+		d, ok = decRat(x)
+	default:
+		ok = false
+	}
+	if !ok {
+		return invConv(v, qDecimal)
+	}
+
+	return d, nil
+}
+
+// decimal returns v, a decimal or an untyped numeric constant, rounded half
+// up to the scale of the decimal column c.
+func (c *col) decimal(v interface{}) (interface{}, error) {
+	switch v.(type) {
+	case nil:
+		return nil, nil
+	case chunk:
+		return v, nil // Stored value.
+	case Dec, idealFloat, idealInt, idealRune, idealUint:
+		// nop
+	default:
+		return nil, fmt.Errorf("cannot use %v (type %T) in assignment to, or comparison with, column %s (type %s)", v, ideal(v), c.name, colTypeStr(c.typ, c.prec, c.scale))
+	}
+
+	x, err := decConvert(v)
+	if err != nil {
+		return nil, err
+	}
+
+	d := x.(Dec).Round(c.scale, RoundHalfUp)
+	if d.precision() > c.prec {
+		return nil, fmt.Errorf("value %v overflows column %s (type %s)", d, c.name, colTypeStr(c.typ, c.prec, c.scale))
+	}
+
+	return d, nil
+}
+
+// bytes returns the encoding of d used by the file back ends: the scale as
+// an uvarint, a sign byte and the big-endian bytes of the absolute value of
+// the unscaled value.
+func (d Dec) bytes() []byte {
+	var b [binary.MaxVarintLen64 + 1]byte
+	n := binary.PutUvarint(b[:], uint64(d.scale))
+	u := d.unscaled()
+	if u.Sign() < 0 {
+		b[n] = 1
+	}
+	return append(b[:n+1], u.Bytes()...)
+}
+
+func decBytes(b []byte) (Dec, error) {
+	scale, n := binary.Uvarint(b)
+	if n <= 0 || n >= len(b) || b[n] > 1 || scale > math.MaxInt32 {
+		return Dec{}, fmt.Errorf("corrupted DB: invalid decimal encoding")
+	}
+
+	u := new(big.Int).SetBytes(b[n+1:])
+	if b[n] != 0 {
+		u.Neg(u)
+	}
+	return Dec{u, int(scale)}, nil
+}
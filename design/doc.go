@@ -71,11 +71,24 @@ type tag and the column name. The type tags are
 	uint16     'v'
 	uint32     'w'
 	uint64     'x', alias uint
+	array      'A'
 	bigInt     'I'
 	bigRat     'R'
 	blob       'B'
+	decimal    'N'
 	duration   'D'
+	enum       'E'
+	json       'J'
 	time       'T'
+	uuid       'U'
+
+The tag of a decimal column is followed by its precision and scale, for example
+"N(12,2)Amount". The tag of an enum column is followed by the name of its type,
+for example "E(status)Status". The labels of enum types are stored in the
+table __Enum. The tag of an array column is followed by the tag of its element
+type, for example "A(s)Tags" for a []string column. The tag of a string column
+having a collation other than binary is followed by the collation name, for
+example "s(nocase)Email".
 
 The scols value is the above described encoded fields joined using "|". For
 example
@@ -228,6 +241,32 @@ values and the B+Tree value is the record handle.
 	| Indexed Values |  ->  | Record Handle |
 	+----------------+      +---------------+
 
+Full-text index
+
+A full-text index is a non unique index having a key for every distinct term
+of every indexed column of a record. The column is identified by its 0-based
+position in the index column list. The tokenizer name is stored in the system
+table __FullText.
+
+	              B+Tree key                       B+Tree value
+	+-----------------+------+---------------+      +--------------+
+	| Column Position | Term | Record Handle |  ->  |   not used   |
+	+-----------------+------+---------------+      +--------------+
+
+Spatial index
+
+A spatial index is an R-tree. Its nodes are records of the DB storage, the
+root node handle being the index root. A node is encoded as
+
+	+------+-----------------------+---------+-----+
+	| Leaf | minX minY maxX maxY H | ...     | ... |
+	+------+-----------------------+---------+-----+
+
+where every entry is a bounding box followed by a handle. H is a record
+handle in leaf nodes and a child node handle otherwise. The box of a leaf
+entry is the indexed point. Spatial indices are listed in the system table
+__Spatial.
+
 Non scalar types
 
 Scalar types of [1] are bool, complex*, float*, int*, uint*, string and []byte
@@ -240,6 +279,10 @@ types. All other types are "blob-like".
 	bigrat          big.Rat
 	time            time.Time
 	duration        time.Duration
+	json            json.RawMessage
+	decimal         ql.Dec
+	uuid            ql.UUID
+	[]T             []T, for example []string
 
 Memory back-end stores the Go type directly. File back-end must resort to
 encode all of the above as (tagged) []byte due to the lack of more types
@@ -251,11 +294,25 @@ Blob encoding
 The values of the blob-like types are first encoded into a []byte slice:
 
 	+-----------------------+-------------------+
-	| blob                  | raw               |
+	| blob, json            | raw               |
 	| bigint, bigrat, time	| gob encoded       |
 	| duration		| gob encoded int64 |
+	| decimal		| see below         |
+	| uuid			| raw 16 bytes      |
+	| array			| see below         |
 	+-----------------------+-------------------+
 
+A decimal value is encoded as its scale (uvarint), a sign byte (0 or 1) and the
+big-endian bytes of the absolute value of its unscaled integer.
+
+Enum values are stored in table records as the int64 ordinal of their label.
+In index keys and temporary records, where the enum type cannot be looked up,
+an enum value is blob-like. It is encoded as its ordinal (uvarint) followed by
+the name of its type and its labels, each prefixed by its length (uvarint).
+
+An array value is encoded as the tag of its element type (one byte) followed by
+its elements encoded as a record of temporary tables, see encode2.go.
+
 The gob encoding is "differential" wrt an initial encoding of all of the
 blob-like type. IOW, the initial type descriptors which gob encoding must write
 out are stripped off and "resupplied" on decoding transparently. See also
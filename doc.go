@@ -299,28 +299,33 @@
 //
 //	__Column
 //	__Column2
+//	__Enum
+//	__FullText
 //	__Index
+//	__Spatial
 //	__Table
 //
 // Keywords
 //
 // The following keywords are reserved and may not be used as identifiers.
 //
-//	ADD	      complex128    FROM	  LEFT		string
-//	ALTER	      complex64	    FULL	  LIKE		TABLE
-//	AND	      CREATE	    GROUP	  LIMIT		time
-//	AS	      DEFAULT	    IF		  NOT		TRANSACTION
-//	ASC	      DELETE	    IN		  NULL		true
-//	BEGIN	      DESC	    INDEX	  OFFSET	TRUNCATE
-//	BETWEEN	      DISTINCT	    INSERT	  ON		uint
-//	bigint	      DROP	    int		  OR		uint16
-//	bigrat	      duration	    int16	  ORDER		uint32
-//	blob	      EXISTS	    int32	  OUTER		uint64
-//	bool	      EXPLAIN	    int64	  RIGHT		uint8
-//	BY	      false	    int8	  ROLLBACK	UNIQUE
-//	byte	      float	    INTO	  rune		UPDATE
-//	COLUMN	      float32	    IS		  SELECT	VALUES
-//	COMMIT	      float64	    JOIN	  SET		WHERE
+//	ADD	      complex64	    FULL	  LIKE		TABLE
+//	ALTER	      CREATE	    GROUP	  LIMIT		time
+//	AND	      decimal	    IF		  MATCHES	TRANSACTION
+//	AS	      DEFAULT	    ILIKE	  NOT		true
+//	ASC	      DELETE	    IN		  NULL		TRUNCATE
+//	BEGIN	      DESC	    INDEX	  OFFSET	uint
+//	BETWEEN	      DISTINCT	    INSERT	  ON		uint16
+//	bigint	      DROP	    int		  OR		uint32
+//	bigrat	      duration	    int16	  ORDER		uint64
+//	blob	      ESCAPE	    int32	  OUTER		uint8
+//	bool	      EXISTS	    int64	  REGEXP	UNIQUE
+//	BY	      EXPLAIN	    int8	  RIGHT		UPDATE
+//	byte	      false	    INTO	  ROLLBACK	uuid
+//	COLLATE	      float	    IS		  rune		VALUES
+//	COLUMN	      float32	    JOIN	  SELECT	WHERE
+//	COMMIT	      float64	    json	  SET
+//	complex128    FROM	    LEFT	  string
 //
 // Keywords are not case sensitive.
 //
@@ -546,6 +551,30 @@
 // if placed in a rune literal (it is not a single code point), and will appear
 // as two code points if placed in a string literal.
 //
+// Interval literals
+//
+// An interval literal represents a duration constant. It is the word INTERVAL,
+// which is not a keyword, followed by the text of the interval between single
+// or double quotes.
+//
+//  interval_lit = "INTERVAL" ( "'" interval_text "'" | interpreted_string_lit ) .
+//
+// The text is a sequence of signed integers, each followed by a unit and
+// optionally separated by commas, or a Go duration as accepted by
+// time.ParseDuration. The units are nanosecond, microsecond, millisecond,
+// second, minute, hour, day and week, their plurals and the abbreviations ns,
+// us, ms, s, sec, m, min, h, d and w, not case sensitive. A day is 24 hours
+// and a week 7 days. Months, quarters and years have no fixed duration and
+// are not allowed, use dateAdd to add them to a time.
+//
+// For example
+//
+//	INTERVAL '3 days'		// 72h0m0s
+//	INTERVAL '1 day 12 hours'	// 36h0m0s
+//	INTERVAL '-2 weeks, 3 d'	// -264h0m0s
+//	INTERVAL "1h30m"		// 1h30m0s
+//	INTERVAL '1 month'		// illegal: not a fixed duration
+//
 // QL parameters
 //
 // Literals are assigned their values from the respective text representation
@@ -597,6 +626,7 @@
 //       | "int32"
 //       | "int64"
 //       | "int8"
+//       | "json"        // http://golang.org/pkg/encoding/json/#RawMessage
 //       | "rune"        // alias for int32
 //       | "string"
 //       | "time"        // http://golang.org/pkg/time/#Time
@@ -604,26 +634,107 @@
 //       | "uint16"
 //       | "uint32"
 //       | "uint64"
-//       | "uint8" .
+//       | "uint8"
+//       | "uuid" .
 //
 // Named instances of the boolean, numeric, and string types are keywords. The
-// names are not case sensitive.
+// names are not case sensitive. Enum types are named by identifiers, see
+// CREATE TYPE.
 //
 // Note: The blob type is exchanged between the back end and the API as []byte.
 // On 32 bit platforms this limits the size which the implementation can handle
 // to 2G.
 //
+// Array types
+//
+// An array type represents ordered lists of values of its element type. An
+// array column is declared by prefixing the element type with []
+//
+//	tags []string
+//
+// The element type may be any of the bool, complex, duration, float, int,
+// string, time, uint16, uint32 or uint64 types. Arrays of bytes are not
+// supported, use blob instead. Elements of an array cannot be NULL, but an
+// array column may be NULL and an array may have no elements.
+//
+// Arrays are comparable but not ordered. Two arrays are equal if they have
+// the same length and equal elements. Arrays can be indexed, sliced and
+// passed to the built-in functions len, contains, containsAll and
+// containsAny. The table function unnest turns the elements of an array into
+// a record set, see SELECT FROM.
+//
+// Array values are exchanged between the back end and the API as Go slices,
+// for example []string or []int64.
+//
 // Boolean types
 //
 // A boolean type represents the set of Boolean truth values denoted by the
 // predeclared constants true and false. The predeclared boolean type is bool.
 //
+// Decimal type
+//
+// A decimal type represents exact decimal numbers having a fixed number of
+// digits after the decimal point. A decimal column is declared with its
+// precision, the maximum number of significant digits, and its scale, the
+// number of digits after the decimal point
+//
+//	amount decimal(12, 2)	// -9999999999.99 to 9999999999.99
+//
+// The precision must be in [1, 1000] and the scale in [0, precision]. Values
+// assigned to a decimal column are rounded half away from zero to the scale
+// of the column. Assigning a value whose rounded value has more digits than
+// the precision of the column is an error. Only decimal values and untyped
+// numeric constants can be assigned to decimal columns, other numeric values
+// must be converted first.
+//
+// Decimal arithmetic is exact. The scale of a sum or difference is the larger
+// scale of the operands, the scale of a product is the sum of the scales of
+// the operands. A quotient has the larger scale of the operands plus 6 digits
+// and is rounded half away from zero. The remainder has the sign of the
+// dividend. The built-in function round rounds decimal values using other
+// rounding modes.
+//
+// Decimal values compare numerically, 1.5 == 1.50. They are exchanged
+// between the back end and the API as Dec.
+//
 // Duration type
 //
 // A duration type represents the elapsed time between two instants as an int64
 // nanosecond count. The representation limits the largest representable
 // duration to approximately 290 years.
 //
+// Enum types
+//
+// An enum type, created by the CREATE TYPE statement, represents a fixed,
+// ordered set of string labels. A column of an enum type is declared using the
+// name of the type
+//
+//	CREATE TYPE status AS ENUM ("new", "active", "closed");
+//	CREATE TABLE ticket (Title string, Status status);
+//
+// Values of enum columns are assigned and compared as strings. Assigning a
+// string which is not a label of the type is an error. Enum values are
+// ordered by the position of their labels in the declaration of the type, so
+// "new" < "active" < "closed" when compared with a status value. Comparing
+// values of different enum types is an error.
+//
+// Enum values are stored as their ordinals. They are exchanged between the
+// back end and the API as Enum.
+//
+// JSON type
+//
+// A JSON type represents the set of valid JSON texts, see [RFC 8259]. The
+// values are stored in their compact form, insignificant white space removed.
+// Assigning a value which is not valid JSON to a JSON column is an error.
+// Parts of a JSON value are selected using the built-in functions
+// jsonExtract, jsonType and jsonArrayLength, JSON values are constructed by
+// jsonArray, jsonObject and jsonAgg.
+//
+// JSON values are ordered by the bytes of their compact form. Values of
+// different types selected by jsonExtract collate NULL < bool < float64 <
+// string < json, in an index and by ORDER BY, which does not accept bool
+// values.
+//
 // Numeric types
 //
 // A numeric type represents sets of integer or floating-point values. The
@@ -643,6 +754,8 @@
 //
 //	bigrat      the set of all rational numbers
 //
+//	decimal     the set of all decimal numbers of a given precision and scale
+//
 // 	float32     the set of all IEEE-754 32-bit floating-point numbers
 // 	float64     the set of all IEEE-754 64-bit floating-point numbers
 //
@@ -676,17 +789,50 @@
 // time has associated with it a location, consulted when computing the
 // presentation form of the time.
 //
+// UUID type
+//
+// A uuid type represents universally unique identifiers, see [RFC 9562], as
+// 16 bytes. A string can be assigned to, or compared with, a uuid column if
+// it holds a UUID in the canonical form
+//
+//	"6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+//
+// or in the same form without hyphens, enclosed in braces or prefixed by
+// urn:uuid:. UUIDs are ordered by their bytes and they are converted to
+// strings in the canonical form using lower case hex digits. The built-in
+// functions uuidNew and uuidV7 return new UUIDs. UUID values are exchanged
+// between the back end and the API as UUID.
+//
 // Predeclared functions
 //
 // The following functions are implicitly declared
 //
-//	avg          complex     contains    count       date
-//	day          formatTime  formatFloat formatInt
-//	hasPrefix    hasSuffix   hour        hours       id
-//	imag         len         max         min         minute
-//	minutes      month       nanosecond  nanoseconds now
-//	parseTime    real        second      seconds     since
-//	sum          timeIn      weekday     year        yearDay
+//	abs         acos        asin        atan            atan2
+//	avg         box         ceil        complex         concat
+//	contains    containsAll containsAny cos             count
+//	date        dateAdd     dateDiff    dateTrunc       day
+//	distance    endOf       exp         floor           formatTime
+//	formatFloat formatInt   greatest    hasPrefix       hasSuffix
+//	hour        hours       id          imag            indexOf
+//	isoWeek     jsonAgg     jsonArray   jsonArrayLength jsonExtract
+//	jsonObject  jsonType    least       len             ln
+//	log         log10       lower       lpad            ltrim
+//	match       max         min         minute          minutes
+//	mod         month       nanosecond  nanoseconds     nearest
+//	now         parseTime   pi          point           pow
+//	random      rank        real        regexpExtract   regexpReplace
+//	regexpSplit repeat      replace     round           rpad
+//	rtrim       second      seconds     sign            sin
+//	since       splitPart   sqrt        startOf         substr
+//	sum         tan         timeBucket  timeIn          trim
+//	trunc       upper       uuidNew     uuidV7          weekday
+//	within      year        yearDay
+//
+// Go programs can declare more functions using RegisterFunc. A registered
+// function cannot have the name of a predeclared one. Calls of a registered
+// function with constant arguments are evaluated at compile time only if the
+// function is registered as deterministic, and only deterministic registered
+// functions can be used in index expressions.
 //
 // Expressions
 //
@@ -699,10 +845,23 @@
 // literal, a (possibly qualified) identifier denoting a constant or a function
 // or a table/record set column, or a parenthesized expression.
 //
-//  Operand = Literal | QualifiedIdent | "(" Expression ")" .
+//  Operand = Literal | ArrayLit | QualifiedIdent | "(" Expression ")" .
 //  Literal = "FALSE" | "NULL" | "TRUE"
-//  	| float_lit | imaginary_lit | int_lit | rune_lit | string_lit
-//  	| ql_parameter .
+//  	| float_lit | imaginary_lit | int_lit | interval_lit | rune_lit
+//  	| string_lit | ql_parameter .
+//
+// Array literals
+//
+// An array literal constructs an array of the element type Type from the
+// values of its expressions, which must be assignable to Type and not NULL.
+//
+//  ArrayLit = "[" "]" Type "{" [ ExpressionList ] "}" .
+//
+// For example
+//
+//	[]string{"red", "green"}
+//	[]int64{}
+//	[]time{now(), $1}
 //
 // Qualified identifiers
 //
@@ -760,6 +919,9 @@
 //
 // If s is NULL or x is NULL then the result is NULL.
 //
+// For an array a, a[x] is the element of a at index x and its type is the
+// element type of a. The index must be in range, 0 <= x < len(a).
+//
 // Otherwise s[x] is illegal.
 //
 // Slices
@@ -791,6 +953,9 @@
 // If s is NULL the result is NULL. If low or high is not omitted and is NULL
 // then the result is NULL.
 //
+// Slicing an array constructs a new array of the same type holding the
+// selected elements, using the same rules.
+//
 // Calls
 //
 // Given an identifier f denoting a predeclared function,
@@ -817,7 +982,8 @@
 //  Expression = Term { ( oror | "OR" ) Term } .
 //
 //  ExpressionList = Expression { "," Expression } [ "," ].
-//  Factor =  PrimaryFactor  { ( ge | ">" | le | "<" | neq | eq | "LIKE" ) PrimaryFactor } [ Predicate ] .
+//  Factor =  PrimaryFactor  { ( ge | ">" | le | "<" | neq | eq | "MATCHES" | "REGEXP" ) PrimaryFactor
+//  	| ( "LIKE" | "ILIKE" ) PrimaryFactor [ "ESCAPE" string_lit ] } [ Predicate ] .
 //  PrimaryFactor = PrimaryTerm  { ( "^" | "|" | "-" | "+" ) PrimaryTerm } .
 //  PrimaryTerm = UnaryExpr { ( andnot | "&" | lsh | rsh | "%" | "/" | "*" ) UnaryExpr } .
 //  Term = Factor { ( andand | "AND" ) Factor } .
@@ -848,6 +1014,22 @@
 // (see also [6]).  Both expression must be of type string. If any one of the
 // expressions is NULL the result is NULL.
 //
+// The operators MATCHES and REGEXP are synonyms of LIKE. ILIKE ignores the
+// case of letters.
+//
+//	expr1 MATCHES expr2
+//	expr1 REGEXP expr2
+//	expr1 ILIKE expr2		// the same as expr1 LIKE "(?i)" + expr2
+//
+// The optional ESCAPE clause of LIKE and ILIKE names a character making the
+// next character of the pattern match itself literally. To match the escape
+// character, it must be doubled.
+//
+//	s LIKE "^a#.b$" ESCAPE "#"	// matches "a.b", but not "axb"
+//
+// A pattern which is a string constant is compiled once, an invalid constant
+// pattern is reported by Compile.
+//
 // Predicates
 //
 // Predicates are special form expressions having a boolean result type.
@@ -1115,6 +1297,13 @@
 //
 // - Time values are comparable and ordered.
 //
+// - JSON values are comparable and ordered, lexically byte-wise in their
+// compact form. Objects having the same members in a different order are not
+// equal.
+//
+// - Array values are comparable. Two arrays are equal if they have the same
+// element type, the same length and their corresponding elements are equal.
+//
 // Whenever any operand of any comparison operation is NULL, the result is
 // NULL.
 //
@@ -1159,7 +1348,7 @@
 // Conversions are expressions of the form T(x) where T is a type and x is an
 // expression that can be converted to type T.
 //
-//  Conversion = Type "(" Expression ")" .
+//  Conversion = ( Type | "decimal" ) "(" Expression ")" .
 //
 // A constant value x can be converted to type T in any of these cases:
 //
@@ -1193,6 +1382,14 @@
 //
 // - x is an integer, except bigint or duration, and T is a string type.
 //
+// - x is a string accepted by a uuid column, or a blob of 16 bytes, and T is
+// uuid.
+//
+// - x is a uuid or an enum value and T is a string type. The result is the
+// canonical form of the UUID or the label of the enum value.
+//
+// - x is a uuid and T is blob.
+//
 // Specific rules apply to (non-constant) conversions between numeric types or
 // to and from a string type. These conversions may change the representation
 // of x and incur a run-time cost. All other conversions only change the type
@@ -1301,6 +1498,31 @@
 //
 //	"2006-01-02 15:04:05.999999999 -0700 MST"
 //
+// 11. Converting a string or blob value to a json value yields the compacted
+// JSON text. An error occurs if the value is not valid JSON.
+//
+//	json(`{"a": [1, 2]}`)	// {"a":[1,2]}
+//
+// 12. Converting a json value to a string or blob yields its JSON text.
+//
+// 13. Converting a numeric value to a decimal yields the exact value of the
+// number. Floating-point values convert to the shortest decimal representation
+// which converts back to the same value. Converting a bigrat whose value has
+// no finite decimal representation is an error. A string converts to the
+// number it represents in decimal notation, optionally followed by an
+// exponent.
+//
+//	decimal(1.1)		// 1.1
+//	decimal("-12.50")	// -12.50
+//	decimal("1e-3")		// 0.001
+//
+// 14. Converting a decimal to an integer or bigint type truncates the
+// fraction toward zero. Converting a decimal to a string yields its decimal
+// representation with exactly scale digits after the decimal point.
+//
+//	string(decimal("3.10"))	// "3.10"
+//	int(decimal("-3.99"))	// -3
+//
 // Order of evaluation
 //
 // When evaluating the operands of an expression or of function calls,
@@ -1322,9 +1544,10 @@
 // Statements control execution.
 //
 //  Statement =  EmptyStmt | AlterTableStmt | BeginTransactionStmt | CommitStmt
-//  	| CreateIndexStmt | CreateTableStmt | DeleteFromStmt | DropIndexStmt
-//  	| DropTableStmt | InsertIntoStmt | RollbackStmt | SelectStmt
-//  	| TruncateTableStmt | UpdateStmt | ExplainStmt.
+//  	| CreateIndexStmt | CreateTableStmt | CreateTypeStmt | DeleteFromStmt
+//  	| DropIndexStmt | DropTableStmt | DropTypeStmt | InsertIntoStmt
+//  	| RollbackStmt | SelectStmt | TruncateTableStmt | UpdateStmt
+//  	| ExplainStmt.
 //
 //  StatementList = Statement { ";" Statement } .
 //
@@ -1399,6 +1622,12 @@
 //	BEGIN TRANSACTION
 //	SELECT FROM
 //
+// A SELECT statement executed outside of a transaction sees the database as
+// it was after the last successful commit of the outermost transaction, even
+// while another transaction is open and updating the database. Indices
+// modified after the SELECT took its snapshot are not used, see the Isolation
+// paragraph of DB.Execute.
+//
 // COMMIT
 //
 // The commit statement closes the innermost transaction nesting level. If
@@ -1423,7 +1652,13 @@
 // column name of the table the index is on.
 //
 //  CreateIndexStmt = "CREATE" [ "UNIQUE" ] "INDEX" [ "IF" "NOT" "EXISTS" ]
-//  	IndexName "ON" TableName "(" ExpressionList ")" .
+//  	IndexName "ON" TableName "(" CollatedExpressionList ")"
+//  	| "CREATE" "FULLTEXT" "INDEX" [ "IF" "NOT" "EXISTS" ]
+//  	IndexName "ON" TableName "(" ColumnNameList ")" [ "USING" Tokenizer ]
+//  	| "CREATE" "SPATIAL" "INDEX" [ "IF" "NOT" "EXISTS" ]
+//  	IndexName "ON" TableName "(" ColumnNameList ")" .
+//  CollatedExpressionList = Expression [ Collation ] { "," Expression [ Collation ] } [ "," ] .
+//  Tokenizer = identifier .
 //
 // For example
 //
@@ -1458,7 +1693,64 @@
 // this case the type of all expressions in the list must be one of the non
 // blob-like types.
 //
-// Note: Blob-like types are blob, bigint, bigrat, time and duration.
+// Note: Blob-like types are blob, bigint, bigrat, time, duration, json and
+// decimal.
+//
+// An expression list index consisting of a single jsonExtract call is used by
+// the query planner for comparisons of the call with a bool, numeric or string
+// constant. A record for which a jsonExtract expression of an index selects a
+// JSON array or object has no entry in the index.
+//
+// An expression list index consisting of a single call of a string function,
+// for example lower(Name) or substr(Code, 0, 2), is used for comparisons of
+// the call with a string constant, except != comparisons. The string functions
+// are concat, lower, lpad, ltrim, repeat, replace, rpad, rtrim, splitPart,
+// substr, trim and upper.
+//
+//	BEGIN TRANSACTION;
+//		CREATE TABLE Users (Name string);
+//		CREATE INDEX UsersName ON Users (lower(Name));
+//	COMMIT;
+//	SELECT * FROM Users WHERE lower(Name) == "joe";
+//
+// Full-text index
+//
+// A full-text index is an inverted index of the words in one or more string
+// columns. The words are found by a tokenizer, "simple" if the USING clause is
+// not present, which splits text into runs of letters and digits and converts
+// them to lower case. Other tokenizers, optionally reducing the words to their
+// stems, are registered by the Go API function RegisterTokenizer before they
+// are used. FULLTEXT and USING are not keywords.
+//
+//	BEGIN TRANSACTION;
+//		CREATE TABLE Articles (Title string, Body string);
+//		CREATE FULLTEXT INDEX ArticlesText ON Articles (Title, Body);
+//	COMMIT;
+//
+// The query planner uses a full-text index for a MATCH predicate of one of its
+// columns having a constant query and the tokenizer of the index.
+//
+//	SELECT Title, rank() AS r FROM Articles
+//	WHERE MATCH(Body, `"brown fox" OR (lazy AND dog)`)
+//	ORDER BY r DESC;
+//
+// Spatial index
+//
+// A spatial index is an R-tree of the points {x, y} of two float64 columns.
+// Records having a NULL or NaN coordinate are not indexed. SPATIAL is not a
+// keyword.
+//
+//	BEGIN TRANSACTION;
+//		CREATE TABLE Places (Name string, Lon float64, Lat float64);
+//		CREATE SPATIAL INDEX PlacesLonLat ON Places (Lon, Lat);
+//	COMMIT;
+//
+// The query planner uses a spatial index for a within predicate, or a nearest
+// predicate of the WHERE clause, having a point of the index columns and a
+// constant box or point.
+//
+//	SELECT Name FROM Places WHERE within(point(Lon, Lat), box(14, 49, 15, 51));
+//	SELECT Name FROM Places WHERE nearest(point(Lon, Lat), point($1, $2), 10);
 //
 // CREATE TABLE
 //
@@ -1469,7 +1761,9 @@
 //  CreateTableStmt = "CREATE" "TABLE" [ "IF" "NOT" "EXISTS" ] TableName
 //  	"(" ColumnDef { "," ColumnDef } [ "," ] ")" .
 //
-//  ColumnDef = ColumnName Type [ "NOT" "NULL" | Expression ] [ "DEFAULT" Expression ] .
+//  ColumnDef = ColumnName ( Type [ Collation ] | "[" "]" Type | DecimalType | TypeName ) [ "NOT" "NULL" | Expression ] [ "DEFAULT" Expression ] .
+//  DecimalType = "decimal" "(" int_lit "," int_lit ")" .
+//  TypeName = identifier .
 //  ColumnName = identifier .
 //  TableName = identifier .
 //
@@ -1487,7 +1781,8 @@
 //	COMMIT;
 //
 // The optional IF NOT EXISTS clause makes the statement a no operation if the
-// table already exists.
+// table already exists. A TypeName must name an enum type created by CREATE
+// TYPE.
 //
 // The optional constraint clause has two forms. The first one is found in many
 // SQL dialects.
@@ -1564,6 +1859,61 @@
 // have that constraint checked. If any constraint violation is detected, the
 // overall operation fails and no changes to the table are made.
 //
+// Collations
+//
+// A collation defines the order and equality of strings. The predeclared collations are binary, the default, which
+// orders strings by their bytes, nocase, which ignores the case of letters
+// using Unicode case folding, and unicode, which uses the Unicode Collation
+// Algorithm with the root locale. Other collations are registered by the Go
+// API function RegisterCollation before they are used. Collation names are
+// not case sensitive.
+//
+//  Collation = "COLLATE" identifier .
+//
+// The collation of a string column governs the index of the column and every
+// comparison and ordering of the column: the comparison operators and the IN
+// predicate having the column as an operand, the ORDER BY, GROUP BY and
+// DISTINCT clauses and the min and max functions. The same applies to a field
+// of a record set selecting the column by its name. For example the unique
+// index below rejects e-mail addresses differing only in case and the query
+// finds the e-mail address "joe@example.com".
+//
+//	BEGIN TRANSACTION;
+//		CREATE TABLE User (Email string COLLATE nocase);
+//		CREATE UNIQUE INDEX UserEmail ON User (Email);
+//		INSERT INTO User VALUES ("joe@example.com");
+//	COMMIT;
+//	SELECT Email FROM User WHERE Email == "Joe@Example.com";
+//
+// Rows grouped together or made distinct by a collation produce the values
+// of one of them. An expression of an index expression list or of an ORDER BY
+// clause is collated by the collation of its COLLATE clause, if present,
+// instead.
+//
+//	SELECT Name FROM Person ORDER BY Name COLLATE unicode;
+//
+// Strings not involving a column having a collation compare by their bytes.
+// The query planner uses the index of a column having a collation only for
+// the == operator.
+//
+// CREATE TYPE
+//
+// Create type statements create new enum types. The labels are string
+// literals, they must be distinct and there must be at least one. A type of
+// the same name must not exist in the DB.
+//
+//  CreateTypeStmt = "CREATE" "TYPE" TypeName "AS" "ENUM" "(" string_lit { "," string_lit } [ "," ] ")" .
+//
+// For example
+//
+//	BEGIN TRANSACTION;
+//		CREATE TYPE status AS ENUM ("new", "active", "closed");
+//	COMMIT;
+//
+// TYPE and ENUM are not keywords, they are recognized by the statement only.
+// Enum types cannot be altered. The types are recorded in the system table
+// __Enum.
+//
 // DELETE FROM
 //
 // Delete from statements remove rows from a table, which must exist.
@@ -1611,6 +1961,22 @@
 // The optional IF EXISTS clause makes the statement a no operation if the
 // table does not exist.
 //
+// DROP TYPE
+//
+// Drop type statements remove enum types from the DB. The type must exist and
+// no column of any table may be of the type.
+//
+//  DropTypeStmt = "DROP" "TYPE" [ "IF" "EXISTS" ] TypeName .
+//
+// For example
+//
+//	BEGIN TRANSACTION;
+//		DROP TYPE status;
+//	COMMIT;
+//
+// The optional IF EXISTS clause makes the statement a no operation if the
+// type does not exist.
+//
 // INSERT INTO
 //
 // Insert into statements insert new rows into tables. New rows come from
@@ -1625,7 +1991,7 @@
 // assigned to a column must be the same as is the column's type or the value
 // must be NULL.
 //
-//  InsertIntoStmt = "INSERT" "INTO" TableName [ "(" ColumnNameList ")" ] ( Values | SelectStmt ) .
+//  InsertIntoStmt = "INSERT" "INTO" TableName [ "(" [ "id" "(" ")" "," ] ColumnNameList ")" ] ( Values | SelectStmt ) .
 //
 //  ColumnNameList = ColumnName { "," ColumnName } [ "," ] .
 //  Values = "VALUES" "(" ExpressionList ")" { "," "(" ExpressionList ")" } [ "," ] .
@@ -1661,6 +2027,21 @@
 // on a per row basis. The details are discussed in the "Constraints and
 // defaults" chapter below the CREATE TABLE statement documentation.
 //
+// A column name list starting with id() inserts rows having the given IDs
+// instead of new ones, for example when restoring the output of DB.Dump. The
+// first value of every row is then its ID, a positive integer no other row of
+// the table may have. Later inserted rows get IDs greater than any ID given
+// this way. An ID not greater than all IDs used so far by the DB is looked up
+// in the index on id() of the table, if any, otherwise the IDs of the table
+// are read once per statement.
+//
+//	BEGIN TRANSACTION;
+//		INSERT INTO department (id(), DepartmentID, DepartmentName) VALUES
+//			(7, 42, "R&D"),
+//			(9, 17, "Sales"),
+//		;
+//	COMMIT;
+//
 // Explain statement
 //
 // Explain statement produces a recordset consisting of lines of text which
@@ -1765,8 +2146,10 @@
 //
 //  JoinClause = ( "LEFT" | "RIGHT" | "FULL" ) [ "OUTER" ] "JOIN" RecordSet "ON" Expression .
 //
-//  RecordSet = ( TableName | "(" SelectStmt [ ";" ] ")" ) [ "AS" identifier ] .
+//  RecordSet = ( TableName | "(" SelectStmt [ ";" ] ")" | "unnest" "(" Expression ")" ) [ "AS" identifier ] { RecordSetHint } .
 //  RecordSetList = RecordSet { "," RecordSet } [ "," ] .
+//  RecordSetHint = ( "USE" | "IGNORE" ) "INDEX" "(" IndexName { "," IndexName } ")"
+//  	| "FORCE" "JOIN" "ORDER" .
 //
 // For example
 //
@@ -1793,6 +2176,45 @@
 // 		) AS c
 //	WHERE a.e > c.e;
 //
+// Unnest
+//
+// The table function unnest produces a record set having a single field,
+// value, and a row for every element of the array its expression evaluates
+// to, in the order of the elements. A NULL array produces no rows.
+//
+//	SELECT value FROM unnest([]int64{3, 1, 2}) ORDER BY value;
+//
+// In a cross join, the expression may refer to the fields of the record sets
+// preceding unnest in the FROM clause. The array is then evaluated for every
+// combination of their rows
+//
+//	SELECT value AS tag, count(*) AS n
+//	FROM post, unnest(post.tags)
+//	GROUP BY value;
+//
+// unnest is not a keyword.
+//
+// Hints
+//
+// Record set hints overrule the choices of the execution planner. USE INDEX
+// restricts the indices the planner may use for a table to those listed,
+// IGNORE INDEX prevents it from using the listed indices. Naming an index the
+// table doesn't have is an error, so is a hint of a record set other than a
+// table and an index both used and ignored.
+//
+//	SELECT * FROM employee USE INDEX (xLastName) WHERE LastName == "Smith";
+//
+// Record sets of the FROM clause are joined in the order of appearance, the
+// first one in the outer loop. The FORCE JOIN ORDER hint, which may follow any
+// record set of a cross join, states that a query relies on that order. It
+// has no effect on the plan, the hint is an error in a FROM clause having an
+// OUTER JOIN.
+//
+//	SELECT * FROM employee, department FORCE JOIN ORDER
+//	WHERE department.DepartmentID == 42;
+//
+// USE, IGNORE and FORCE are not keywords.
+//
 // Fields naming rules
 //
 // A field is an named expression. Identifiers, not used as a type in
@@ -1870,7 +2292,7 @@
 // expression list left to right until a collating order is determined. Any
 // possibly remaining expressions are not evaluated.
 //
-//  OrderBy = "ORDER" "BY" ExpressionList [ "ASC" | "DESC" ] .
+//  OrderBy = "ORDER" "BY" CollatedExpressionList [ "ASC" | "DESC" ] .
 //
 // All of the expression values must yield an ordered type or NULL. Ordered
 // types are defined in "Comparison operators". Collating of elements having a
@@ -2100,10 +2522,52 @@
 // The IsUnique columns reflects if the index was created using the optional
 // UNIQUE clause. This table is virtual.
 //
+// Enums table
+//
+// The table __Enum lists the labels of all enum types in the DB. The schema
+// is
+//
+//	CREATE TABLE __Enum (Name string, Ordinal int, Label string);
+//
+// The Ordinal column defines the 0-based position of Label in the declaration
+// of the type Name. The table exists once the first enum type is created.
+//
+// Full-text indices table
+//
+// The table __FullText lists the full-text indices in the DB. The schema is
+//
+//	CREATE TABLE __FullText (TableName string, IndexName string, Tokenizer string);
+//
+// The table exists once the first full-text index is created.
+//
+// Spatial indices table
+//
+// The table __Spatial lists the spatial indices in the DB. The schema is
+//
+//	CREATE TABLE __Spatial (TableName string, IndexName string);
+//
+// The table exists once the first spatial index is created.
+//
 // Built-in functions
 //
 // Built-in functions are predeclared.
 //
+// Absolute value and sign
+//
+// The built-in function abs returns the absolute value of x. The built-in
+// function sign returns -1, 0 or 1 of the type of x if x is negative, zero or
+// positive. The sign of a float NaN is NaN.
+//
+//	func abs(x numeric) typeof(x)
+//	func sign(x numeric) typeof(x)
+//
+// The argument may be of any numeric type except complex, or a duration.
+// Abs of the smallest value of a signed integer type is that value, like -x,
+// see Integer overflow.
+// An untyped constant argument is converted to its default type first.
+//
+// If the argument to abs or sign is NULL the result is NULL.
+//
 // Average
 //
 // The built-in aggregate function avg returns the average of values of an
@@ -2112,18 +2576,50 @@
 //
 // 	func avg(e numeric) typeof(e)
 //
-// The column values must be of a numeric type.
+// The column values must be of a numeric type. The average of decimal values
+// has the scale of their sum and is rounded half away from zero.
 //
 //	SELECT salesperson, avg(sales) FROM salesforce GROUP BY salesperson;
 //
+// Box
+//
+// The built-in function box returns the box having the corners {x1, y1} and
+// {x2, y2}. A box is a []float64{minX, minY, maxX, maxY}.
+//
+//	func box(x1, y1, x2, y2 float64) []float64
+//
+// If any argument to box is NULL the result is NULL.
+//
+// Concat
+//
+// The built-in function concat returns the concatenation of its arguments.
+// Unlike the + operator, concat skips NULL arguments and returns an empty
+// string if all of them are NULL.
+//
+//	func concat(s ...string) string
+//
 // Contains
 //
-// The built-in function contains returns true if substr is within s.
+// The built-in function contains returns true if substr is within s or, if
+// its first argument is an array, if the array has an element equal to x.
 //
 //	func contains(s, substr string) bool
+//	func contains(a []T, x T) bool
 //
 // If any argument to contains is NULL the result is NULL.
 //
+// ContainsAll and containsAny
+//
+// The built-in function containsAll returns true if every element of b is an
+// element of a, containsAny returns true if any element of b is an element of
+// a. The arrays must have the same element type.
+//
+//	func containsAll(a, b []T) bool
+//	func containsAny(a, b []T) bool
+//
+// containsAll returns true and containsAny returns false if b has no
+// elements. If any argument is NULL the result is NULL.
+//
 // Count
 //
 // The built-in aggregate function count returns how many times an expression
@@ -2177,6 +2673,65 @@
 //
 // If any argument to date is NULL the result is NULL.
 //
+// Date add
+//
+// The built-in function dateAdd returns t plus n units.
+//
+//	func dateAdd(unit string, n int, t time) time
+//
+// The unit is one of "year", "quarter", "month", "week", "day", "hour",
+// "minute", "second", "millisecond", "microsecond" and "nanosecond", their
+// plurals or the abbreviations of interval literals, not case sensitive.
+//
+// Days and weeks are added to the calendar date in the location of t and keep
+// the clock time, so a day may be 23 or 25 hours long when the clocks change.
+// Months, quarters and years keep the day of the month unless the resulting
+// month is shorter, then the result is its last day. The smaller units are
+// added as durations, like adding an interval to t.
+//
+// For example
+//
+//	dateAdd("month", 1, t)	// 2024-01-31 → 2024-02-29
+//	dateAdd("year", -1, t)	// 2024-02-29 → 2023-02-28
+//	dateAdd("day", 1, t)	// 2024-03-09 12:00 EST → 2024-03-10 12:00 EDT
+//
+// If any argument to dateAdd is NULL the result is NULL.
+//
+// Date diff
+//
+// The built-in function dateDiff returns the number of whole units from t to
+// u, the largest n such that dateAdd(unit, n, t) does not pass u. The result
+// is negative if u is before t. The units are those of dateAdd.
+//
+//	func dateDiff(unit string, t, u time) int
+//
+// For example
+//
+//	dateDiff("month", t, u)	// 2024-01-31, 2024-02-29 → 1
+//	dateDiff("year", t, u)	// 2024-03-15, 2023-03-15 00:00:01 → 0
+//	dateDiff("hour", t, u)	// 10:59, 12:00 → 1
+//
+// If any argument to dateDiff is NULL the result is NULL.
+//
+// Date trunc, startOf and endOf
+//
+// The built-in function dateTrunc returns t truncated to the start of its
+// unit, in the location of t. StartOf is a synonym of dateTrunc. The built-in
+// function endOf returns the last nanosecond of the unit of t. The units are
+// those of dateAdd, weeks start on Mondays.
+//
+//	func dateTrunc(unit string, t time) time
+//	func startOf(unit string, t time) time
+//	func endOf(unit string, t time) time
+//
+// For example
+//
+//	dateTrunc("quarter", t)	// 2024-08-17 10:20:30 → 2024-07-01 00:00:00
+//	startOf("week", t)	// 2024-02-14 10:20:30 → 2024-02-12 00:00:00
+//	endOf("month", t)	// 2024-02-14 10:20:30 → 2024-02-29 23:59:59.999999999
+//
+// If any argument is NULL the result is NULL.
+//
 // Day
 //
 // The built-in function day returns the day of the month specified by t.
@@ -2185,6 +2740,49 @@
 //
 // If the argument to day is NULL the result is NULL.
 //
+// Distance
+//
+// The built-in function distance returns the Euclidean distance of the points
+// p and q.
+//
+//	func distance(p, q []float64) float64
+//
+// If any argument to distance is NULL the result is NULL.
+//
+//
+// Exp, log and sqrt
+//
+// The built-in functions exp, log and log10 return e**x, the natural
+// logarithm and the decimal logarithm of x. Ln is a synonym of log. The
+// built-in function sqrt returns the square root of x.
+//
+//	func exp(x float) typeof(x)
+//	func log(x float) typeof(x)
+//	func ln(x float) typeof(x)
+//	func log10(x float) typeof(x)
+//	func sqrt(x float) typeof(x)
+//
+// The argument must be a float32 or a float64, an untyped constant is
+// converted to float64. An argument outside of the domain of the function,
+// like the square root of a negative number, produces NaN.
+//
+// If the argument is NULL the result is NULL.
+//
+// Floor, ceil and trunc
+//
+// The built-in functions floor, ceil and trunc return the greatest integer
+// value less than or equal to x, the least integer value greater than or
+// equal to x and the integer part of x.
+//
+//	func floor(x numeric) typeof(x)
+//	func ceil(x numeric) typeof(x)
+//	func trunc(x numeric) typeof(x)
+//
+// The result has the type of x. A decimal result has scale zero. Integers
+// are returned unchanged.
+//
+// If the argument is NULL the result is NULL.
+//
 // Format time
 //
 // The built-in function formatTime returns a textual representation of the
@@ -2249,6 +2847,18 @@
 // Unlike the `strconv` equivalent, the formatInt function handles all integer
 // types, both signed and unsigned.
 //
+// Greatest and least
+//
+// The built-in functions greatest and least return the largest and the
+// smallest of their arguments. NULL arguments are ignored, the result is NULL
+// only if all of them are NULL.
+//
+//	func greatest(x ...T) T
+//	func least(x ...T) T
+//
+// The arguments must be of the same ordered type. They are compared as by the
+// > and < operators, so mixing types is an error except for untyped constants.
+//
 // HasPrefix
 //
 // The built-in function hasPrefix tests whether the string s begins with prefix.
@@ -2325,17 +2935,167 @@
 // 	WHERE bar.fooID == id(foo)
 // 	ORDER BY id(foo);
 //
+// IndexOf
+//
+// The built-in function indexOf returns the index, in runes, of the first
+// instance of substr in s, or -1 if substr is not present in s.
+//
+//	func indexOf(s, substr string) int
+//
+// If any argument to indexOf is NULL the result is NULL.
+//
+// ISO week
+//
+// The built-in function isoWeek returns the ISO 8601 week number of t, in the
+// range [1, 53]. Week 1 is the week, starting on Monday, which contains the
+// first Thursday of the year, so the first days of January may belong to the
+// last week of the previous year.
+//
+//	func isoWeek(t time) int
+//
+// If the argument to isoWeek is NULL the result is NULL.
+//
+// JSONAgg
+//
+// The built-in aggregate function jsonAgg returns a JSON array of the values
+// of an expression in a record set, in the order of the records. NULL values
+// are included as JSON null. If jsonAgg is applied to an empty record set the
+// result is NULL.
+//
+//	func jsonAgg(e expression) json
+//
+// For example
+//
+//	SELECT department, jsonAgg(name) FROM employee GROUP BY department;
+//
+// JSONArray
+//
+// The built-in function jsonArray returns a JSON array of its arguments.
+//
+//	func jsonArray(args ...interface{}) json
+//
+// NULL arguments are encoded as JSON null, json arguments as is and blobs as
+// base64 encoded strings. The other values are encoded as by the Go package
+// encoding/json, in particular times as RFC 3339 strings and durations as
+// numbers of nanoseconds. Complex values cannot be encoded.
+//
+//	jsonArray(1, "a", NULL, true)	// [1,"a",null,true]
+//
+// JSONArrayLength
+//
+// The built-in function jsonArrayLength returns the number of elements of the
+// JSON array j or, if a path is given, of the array selected by the path. The
+// result is NULL if the value is not an array.
+//
+//	func jsonArrayLength(j json [, path string]) int
+//
+// If any argument to jsonArrayLength is NULL the result is NULL.
+//
+// JSONExtract
+//
+// The built-in function jsonExtract returns the part of the JSON value j
+// selected by path.
+//
+//	func jsonExtract(j json, path string) interface{}
+//
+// A path starts with $, denoting the whole value, followed by any number of
+// member selectors .name or ["name"] and array element selectors [index],
+// where index is zero based. The result is NULL if the path does not select
+// any part of j. Selected strings, numbers and booleans are returned as
+// values of type string, float64 and bool, JSON null as NULL. Selected arrays
+// and objects are returned as json values.
+//
+//	jsonExtract(j /* {"a": {"b c": [1, "x"]}} */, `$.a["b c"][1]`)	// "x"
+//
+// The first argument of jsonExtract and of the other functions taking a json
+// argument may also be a string holding a valid JSON text.
+//
+// If any argument to jsonExtract is NULL the result is NULL.
+//
+// JSONObject
+//
+// The built-in function jsonObject returns a JSON object of its arguments,
+// which are pairs of a member name and its value.
+//
+//	func jsonObject(name string, value interface{}, ...) json
+//
+// The values are encoded as by jsonArray. The names must be strings and must
+// be distinct.
+//
+//	jsonObject("a", 1, "b", jsonArray())	// {"a":1,"b":[]}
+//
+// JSONType
+//
+// The built-in function jsonType returns the type of the JSON value j or, if
+// a path is given, of the part of j selected by the path. The result is one
+// of "object", "array", "string", "number", "boolean" or "null".
+//
+//	func jsonType(j json [, path string]) string
+//
+// The result is NULL if any argument is NULL or if the path does not select
+// any part of j.
+//
 // Length
 //
 // The built-in function len takes a string argument and returns the lentgh of
-// the string in bytes.
+// the string in bytes or an array argument and returns the number of its
+// elements.
 //
 // 	func len(s string) int
+// 	func len(a []T) int
 //
 // The expression len(s) is constant if s is a string constant.
 //
 // If the argument to len is NULL the result is NULL.
 //
+// Lower and upper
+//
+// The built-in function lower returns s with all Unicode letters mapped to
+// their lower case, upper maps them to their upper case.
+//
+//	func lower(s string) string
+//	func upper(s string) string
+//
+// If the argument is NULL the result is NULL.
+//
+// Lpad and rpad
+//
+// The built-in function lpad returns s padded on the left to n runes by
+// repeating pad, a space by default. Rpad pads s on the right. If s is longer
+// than n runes, both return its first n runes.
+//
+//	func lpad(s string, n int [, pad string]) string
+//	func rpad(s string, n int [, pad string]) string
+//
+// For example
+//
+//	lpad("42", 5, "0")	// "00042"
+//	rpad("ab", 5, "xy")	// "abxyx"
+//	lpad("abc", 2)		// "ab"
+//
+// If any argument is NULL the result is NULL.
+//
+// Match
+//
+// The built-in function match reports whether the string s matches the
+// full-text query using the named tokenizer, "simple" by default. MATCH may
+// be written in upper case.
+//
+// 	func match(s, query string [, tokenizer string]) bool
+//
+// The query consists of words and of phrases in double quotes, which match
+// the text having all their words in sequence. Adjacent words and phrases must
+// all match, unless separated by OR. AND can be written explicitly,
+// parentheses group subqueries and AND binds tighter than OR. The operators
+// are recognized only in upper case.
+//
+//	MATCH(Body, "fox")			// Body contains the word fox.
+//	MATCH(Body, "quick fox")		// quick and fox.
+//	MATCH(Body, `"quick fox"`)		// quick immediately followed by fox.
+//	MATCH(Body, "(fox OR dog) AND lazy")	// lazy and at least one of fox, dog.
+//
+// If any argument to match is NULL the result is NULL.
+//
 // Maximum
 //
 // The built-in aggregate function max returns the largest value of an
@@ -2382,6 +3142,19 @@
 //
 // If the argument to minutes is NULL the result is NULL.
 //
+// Mod
+//
+// The built-in function mod returns the remainder of x divided by y.
+//
+//	func mod(x, y numeric) typeof(x)
+//
+// For integer, bigint and decimal operands the result is that of the %
+// operator. Unlike the operator, mod accepts floats, the result then has the
+// sign of x as computed by Go's math.Mod. Both arguments must have the same
+// type and a zero y is a division by zero error.
+//
+// If any argument to mod is NULL the result is NULL.
+//
 // Month
 //
 // The built-in function month returns the month of the year specified by t
@@ -2409,6 +3182,21 @@
 //
 // If the argument to nanoseconds is NULL the result is NULL.
 //
+// Nearest
+//
+// The built-in function nearest selects the k records, satisfying the other
+// terms of the WHERE clause, having the point p nearest to q. The records are
+// in the order of increasing distance unless the SELECT statement has an
+// ORDER BY clause.
+//
+//	func nearest(p, q []float64, k int) bool
+//
+// Nearest must be a term of the AND expression of a WHERE clause, q and k must
+// not depend on the record. Records having p NULL are not selected.
+//
+//	SELECT Name FROM Places WHERE nearest(point(Lon, Lat), point(14.4, 50.1), 3);
+//
+//
 // Now
 //
 // The built-in function now returns the current local time.
@@ -2453,6 +3241,147 @@
 //
 // If any argument to parseTime is NULL the result is NULL.
 //
+// Pi
+//
+// The built-in function pi returns the float64 closest to π.
+//
+//	func pi() float64
+//
+// Point
+//
+// The built-in function point returns the point {x, y}. A point is a
+// []float64{x, y}.
+//
+//	func point(x, y float64) []float64
+//
+// If any argument to point is NULL the result is NULL.
+//
+//
+// Pow
+//
+// The built-in function pow returns x**y.
+//
+//	func pow(x float, y typeof(x)) typeof(x)
+//	func pow(x integer, y int) typeof(x)
+//
+// Floats are raised to a float exponent of the same type. Integers, bigints
+// and decimals are raised to a non negative integer exponent of any integer
+// type, bigrats to any integer exponent. A result which does not fit the
+// integer type of x wraps around like the result of the * operator, see
+// Integer overflow.
+//
+// For example
+//
+//	pow(2, 10)		// 1024
+//	pow(int8(2), 7)		// -128
+//	pow(bigrat(2)/3, -2)	// 9/4
+//	pow(2.0, 0.5)		// 1.4142135623730951
+//
+// If any argument to pow is NULL the result is NULL.
+//
+// Random
+//
+// The built-in function random returns a pseudo-random float64 in [0, 1).
+//
+//	func random() float64
+//
+// Rank
+//
+// The built-in function rank returns the relevance of the record to the MATCH
+// predicate in the WHERE clause of the same SELECT statement. It is the number
+// of occurrences of the words and phrases of the query in the matched value
+// divided by the square root of the number of its words. Rank is zero if the
+// value does not match.
+//
+// 	func rank() float64
+//
+// Rank is valid only in the field list and in the ORDER BY clause of a SELECT
+// statement with exactly one MATCH predicate in its WHERE clause. In the ORDER
+// BY clause, rank() is computed from the matched column even if it is not
+// among the fields of the result, except in a SELECT DISTINCT or a SELECT
+// having aggregate functions or a GROUP BY clause.
+//
+//	SELECT Title FROM Articles WHERE MATCH(Body, "fox") ORDER BY rank() DESC;
+//
+// Regular expressions
+//
+// The built-in function regexpExtract returns the text of the leftmost match
+// of the regular expression pattern in s or, if group is present, the text of
+// its capturing group number group. The result is NULL if there is no such
+// match.
+//
+//	func regexpExtract(s, pattern string [, group int]) string
+//
+// The built-in function regexpReplace returns s with the matches of pattern
+// replaced by repl. Inside repl, $ signs are interpreted as in the Expand
+// method of the Go regexp.Regexp type, for instance $1 is the text of the
+// first capturing group.
+//
+//	func regexpReplace(s, pattern, repl string) string
+//
+// The built-in function regexpSplit returns the parts of s separated by the
+// matches of pattern.
+//
+//	func regexpSplit(s, pattern string) []string
+//
+// For example
+//
+//	regexpExtract("id: AB-12", "([A-Z]+)-([0-9]+)", 2)	// "12"
+//	regexpReplace("a1b22", "[0-9]+", "#")			// "a#b#"
+//	regexpSplit("a, b,c", ", *")				// []string{"a", "b", "c"}
+//
+// The patterns use the syntax of the Go regexp package. A pattern which is a
+// string constant is compiled once. If any argument is NULL the result is
+// NULL.
+//
+// Repeat
+//
+// The built-in function repeat returns n copies of the string s.
+//
+//	func repeat(s string, n int) string
+//
+// If any argument to repeat is NULL the result is NULL.
+//
+// Replace
+//
+// The built-in function replace returns s with all non-overlapping instances
+// of old replaced by new.
+//
+//	func replace(s, old, new string) string
+//
+// If any argument to replace is NULL the result is NULL.
+//
+// Round
+//
+// The built-in function round returns x rounded to scale digits after the
+// decimal point. A negative scale rounds to a multiple of a power of ten.
+//
+// 	func round(x numeric, scale int [, mode string]) typeof(x)
+//
+// The argument x may be a decimal, a float, a bigrat or an integer. Floats are
+// rounded in decimal, as they are printed, and the result is the closest
+// float to the rounded value. Rounding an integer to a value overflowing its
+// type is an error.
+//
+// The optional mode selects how x is rounded, it is one of
+//
+//	"halfUp"	to nearest, ties away from zero (the default)
+//	"halfEven"	to nearest, ties to the even neighbor
+//	"halfDown"	to nearest, ties toward zero
+//	"up"		away from zero
+//	"down"		toward zero
+//	"ceiling"	toward positive infinity
+//	"floor"		toward negative infinity
+//
+// For example
+//
+//	round(decimal("2.345"), 2)		// 2.35
+//	round(decimal("2.345"), 2, "halfEven")	// 2.34
+//	round(decimal("1250"), -2)		// 1300
+//	round(2.675, 2)				// 2.68
+//
+// If any argument to round is NULL the result is NULL.
+//
 // Second
 //
 // The built-in function second returns the second offset within the minute
@@ -2480,6 +3409,37 @@
 //
 // If the argument to since is NULL the result is NULL.
 //
+// Split part
+//
+// The built-in function splitPart splits s around the instances of sep and
+// returns the part number n, counted from zero. A negative n counts the parts
+// from the end, -1 is the last one. If there is no such part the result is an
+// empty string.
+//
+//	func splitPart(s, sep string, n int) string
+//
+// For example
+//
+//	splitPart("a,b,c", ",", 1)	// "b"
+//	splitPart("a,b,c", ",", -1)	// "c"
+//
+// If any argument to splitPart is NULL the result is NULL.
+//
+// Substr
+//
+// The built-in function substr returns the part of s beginning at the rune
+// start, counted from zero, and having at most n runes, by default all the
+// remaining ones.
+//
+//	func substr(s string, start int [, n int]) string
+//
+// Unlike slicing a string, which counts bytes, substr never splits a UTF-8
+// encoded rune.
+//
+//	substr("žluťoučký", 2, 3)	// "uťo"
+//
+// If any argument to substr is NULL the result is NULL.
+//
 // Sum
 //
 // The built-in aggregate function sum returns the sum of values of an
@@ -2489,10 +3449,32 @@
 //
 // 	func sum(e expression) typeof(e) // The sum of the values of the expression.
 //
-// The column values must be of a numeric type.
+// The column values must be of a numeric type. The sum of decimal values has
+// the largest scale of the values.
 //
 //	SELECT salesperson, sum(sales) FROM salesforce GROUP BY salesperson;
 //
+// Time bucket
+//
+// The built-in function timeBucket returns the start of the interval of
+// length d which contains t. The intervals are counted from origin, by
+// default 2000-01-03 00:00:00 UTC, a Monday. It is intended for grouping
+// time series.
+//
+//	func timeBucket(d duration, t time [, origin time]) time
+//
+// The buckets are consecutive instants d apart, regardless of the clock
+// changes in the location of t, in which the result is expressed. Use dateTrunc
+// for calendar days, months and years. It is an error if d is not positive.
+//
+// For example
+//
+//	SELECT b, avg(v) FROM (
+//		SELECT timeBucket(INTERVAL '15 minutes', t) AS b, v FROM samples
+//	) GROUP BY b ORDER BY b;
+//
+// If any argument to timeBucket is NULL the result is NULL.
+//
 // Time in a specific zone
 //
 // The built-in function timeIn returns t with the location information set to
@@ -2502,6 +3484,52 @@
 //
 // If any argument to timeIn is NULL the result is NULL.
 //
+// Trigonometric functions
+//
+// The built-in functions sin, cos, tan, asin, acos and atan return the sine,
+// cosine, tangent, arcsine, arccosine and arctangent of x. The built-in
+// function atan2 returns the arctangent of y/x using the signs of both to
+// determine the quadrant.
+//
+//	func sin(x float) typeof(x)
+//	func cos(x float) typeof(x)
+//	func tan(x float) typeof(x)
+//	func asin(x float) typeof(x)
+//	func acos(x float) typeof(x)
+//	func atan(x float) typeof(x)
+//	func atan2(y, x float) typeof(y)
+//
+// Angles are in radians. The arguments must be float32 or float64 values of
+// the same type, untyped constants are converted to float64.
+//
+// If any argument is NULL the result is NULL.
+//
+// Trim, ltrim and rtrim
+//
+// The built-in function trim returns s without its leading and trailing runes
+// contained in cutset, ltrim removes only the leading ones and rtrim the
+// trailing ones. The default cutset is the Unicode white space.
+//
+//	func trim(s string [, cutset string]) string
+//	func ltrim(s string [, cutset string]) string
+//	func rtrim(s string [, cutset string]) string
+//
+// If any argument is NULL the result is NULL.
+//
+// UUID
+//
+// The built-in function uuidNew returns a new random UUID, version 4 as
+// defined by RFC 9562.
+//
+//	func uuidNew() uuid
+//
+// The built-in function uuidV7 returns a new time ordered UUID, version 7.
+// Its first 48 bits hold the current Unix time in milliseconds. Values
+// returned by the same process are increasing, which keeps indices on uuid
+// columns filled by uuidV7 compact.
+//
+//	func uuidV7() uuid
+//
 // Weekday
 //
 // The built-in function weekday returns the day of the week specified by t.
@@ -2511,6 +3539,16 @@
 //
 // If the argument to weekday is NULL the result is NULL.
 //
+// Within
+//
+// The built-in function within reports whether the point p is within the box
+// b, including its boundary.
+//
+//	func within(p, b []float64) bool
+//
+// If any argument to within is NULL the result is NULL.
+//
+//
 // Year
 //
 // The built-in function year returns the year in which t occurs.
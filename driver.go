@@ -8,8 +8,10 @@ package ql
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"database/sql/driver"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -259,6 +261,7 @@ type driverConn struct {
 	driver *sqlDriver
 	stop   map[*driverStmt]struct{}
 	tnl    int
+	ro     bool // The transaction is read-only.
 }
 
 func newDriverConn(d *sqlDriver, ddb *driverDB) driver.Conn {
@@ -306,11 +309,15 @@ func (c *driverConn) Close() error {
 
 // Begin starts and returns a new transaction.
 func (c *driverConn) Begin() (driver.Tx, error) {
+	return c.begin(context.Background())
+}
+
+func (c *driverConn) begin(ctx context.Context) (driver.Tx, error) {
 	if c.ctx == nil {
 		c.ctx = NewRWCtx()
 	}
 
-	if _, _, err := c.db.db.Execute(c.ctx, txBegin); err != nil {
+	if _, _, err := c.db.db.ExecuteContext(ctx, c.ctx, txBegin); err != nil {
 		return nil, err
 	}
 
@@ -323,6 +330,10 @@ func (c *driverConn) Commit() error {
 		return errCommitNotInTransaction
 	}
 
+	if c.ro {
+		return c.endReadOnly()
+	}
+
 	if _, _, err := c.db.db.Execute(c.ctx, txCommit); err != nil {
 		return err
 	}
@@ -339,6 +350,10 @@ func (c *driverConn) Rollback() error {
 		return errRollbackNotInTransaction
 	}
 
+	if c.ro {
+		return c.endReadOnly()
+	}
+
 	if _, _, err := c.db.db.Execute(c.ctx, txRollback); err != nil {
 		return err
 	}
@@ -350,6 +365,14 @@ func (c *driverConn) Rollback() error {
 	return nil
 }
 
+func (c *driverConn) endReadOnly() error {
+	err := c.db.db.endReadOnly(c.ctx)
+	c.tnl--
+	c.ro = false
+	c.ctx = nil
+	return err
+}
+
 // Execer is an optional interface that may be implemented by a Conn.
 //
 // If a Conn does not implement Execer, the sql package's DB.Exec will first
@@ -362,11 +385,11 @@ func (c *driverConn) Exec(query string, args []driver.Value) (driver.Result, err
 		return nil, err
 	}
 
-	return driverExec(c.db, c.ctx, list, args)
+	return driverExec(context.Background(), c.db, c.ctx, list, args)
 }
 
-func driverExec(db *driverDB, ctx *TCtx, list List, args []driver.Value) (driver.Result, error) {
-	if _, _, err := db.db.Execute(ctx, list, params(args)...); err != nil {
+func driverExec(ctx context.Context, db *driverDB, tctx *TCtx, list List, args []driver.Value) (driver.Result, error) {
+	if _, _, err := db.db.ExecuteContext(ctx, tctx, list, params(args)...); err != nil {
 		return nil, err
 	}
 
@@ -379,8 +402,8 @@ func driverExec(db *driverDB, ctx *TCtx, list List, args []driver.Value) (driver
 	}
 
 	r := &driverResult{}
-	if ctx != nil {
-		r.lastInsertID, r.rowsAffected = ctx.LastInsertID, ctx.RowsAffected
+	if tctx != nil {
+		r.lastInsertID, r.rowsAffected = tctx.LastInsertID, tctx.RowsAffected
 	}
 	return r, nil
 }
@@ -397,11 +420,11 @@ func (c *driverConn) Query(query string, args []driver.Value) (driver.Rows, erro
 		return nil, err
 	}
 
-	return driverQuery(c.db, c.ctx, list, args)
+	return driverQuery(context.Background(), c.db, c.ctx, list, args)
 }
 
-func driverQuery(db *driverDB, ctx *TCtx, list List, args []driver.Value) (driver.Rows, error) {
-	rss, _, err := db.db.Execute(ctx, list, params(args)...)
+func driverQuery(ctx context.Context, db *driverDB, tctx *TCtx, list List, args []driver.Value) (driver.Rows, error) {
+	rss, _, err := db.db.ExecuteContext(ctx, tctx, list, params(args)...)
 	if err != nil {
 		return nil, err
 	}
@@ -501,7 +524,7 @@ func (r *driverRows) Next(dest []driver.Value) error {
 				switch v := xi.(type) {
 				case nil, int64, float64, bool, []byte, time.Time:
 					dest[i] = v
-				case complex64, complex128, *big.Int, *big.Rat, idealComplex:
+				case complex64, complex128, *big.Int, *big.Rat, Dec, Enum, UUID, idealComplex:
 					var buf bytes.Buffer
 					fmt.Fprintf(&buf, "%v", v)
 					dest[i] = buf.Bytes()
@@ -525,6 +548,8 @@ func (r *driverRows) Next(dest []driver.Value) error {
 					dest[i] = int64(v)
 				case time.Duration:
 					dest[i] = int64(v)
+				case json.RawMessage:
+					dest[i] = []byte(v)
 				case string:
 					dest[i] = []byte(v)
 				case idealInt:
@@ -534,7 +559,16 @@ func (r *driverRows) Next(dest []driver.Value) error {
 				case idealFloat:
 					dest[i] = float64(v)
 				default:
-					return fmt.Errorf("internal error 004")
+					if arrayElem(v) == 0 {
+						return fmt.Errorf("internal error 004")
+					}
+
+					b, err := jsonMarshal(v)
+					if err != nil {
+						return err
+					}
+
+					dest[i] = []byte(b)
 				}
 			}
 			return nil
@@ -617,11 +651,11 @@ func (s *driverStmt) NumInput() int {
 // Exec executes a query that doesn't return rows, such as an INSERT or UPDATE.
 func (s *driverStmt) Exec(args []driver.Value) (driver.Result, error) {
 	c := s.conn
-	return driverExec(c.db, c.ctx, s.stmt, args)
+	return driverExec(context.Background(), c.db, c.ctx, s.stmt, args)
 }
 
 // Exec executes a query that may return rows, such as a SELECT.
 func (s *driverStmt) Query(args []driver.Value) (driver.Rows, error) {
 	c := s.conn
-	return driverQuery(c.db, c.ctx, s.stmt, args)
+	return driverQuery(context.Background(), c.db, c.ctx, s.stmt, args)
 }
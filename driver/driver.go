@@ -49,6 +49,9 @@ A skeleton program using ql/driver.
 
 This package exports nothing.
 
+Functions registered by ql.RegisterFunc can be called in the statements
+executed through the drivers.
+
 Links
 
 Referenced from above:
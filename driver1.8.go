@@ -4,6 +4,7 @@ package ql
 
 import (
 	"context"
+	"database/sql"
 	"database/sql/driver"
 	"fmt"
 	"strconv"
@@ -18,7 +19,12 @@ func (c *driverConn) ExecContext(ctx context.Context, query string, args []drive
 		return nil, err
 	}
 
-	return c.Exec(query, vals)
+	list, err := Compile(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return driverExec(ctx, c.db, c.ctx, list, vals)
 }
 
 func replaceNamed(query string, args []driver.NamedValue) (string, []driver.Value, error) {
@@ -57,7 +63,52 @@ func (c *driverConn) QueryContext(ctx context.Context, query string, args []driv
 		return nil, err
 	}
 
-	return c.Query(query, vals)
+	list, err := Compile(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return driverQuery(ctx, c.db, c.ctx, list, vals)
+}
+
+// BeginTx starts and returns a new transaction. Waiting for other
+// transactions to finish stops when ctx is done. Transactions are always
+// serializable.
+//
+// A read-only transaction, requested by opts.ReadOnly, never waits. All of its
+// queries read the DB as it was last committed when the transaction started,
+// statements updating the DB fail.
+func (c *driverConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	switch sql.IsolationLevel(opts.Isolation) {
+	case sql.LevelDefault, sql.LevelSerializable:
+		// ok
+	default:
+		return nil, fmt.Errorf("unsupported isolation level %v", sql.IsolationLevel(opts.Isolation))
+	}
+
+	if opts.ReadOnly {
+		return c.beginReadOnly(ctx)
+	}
+
+	return c.begin(ctx)
+}
+
+func (c *driverConn) beginReadOnly(ctx context.Context) (driver.Tx, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if c.ctx == nil {
+		c.ctx = NewRWCtx()
+	}
+
+	if err := c.db.db.beginReadOnly(c.ctx); err != nil {
+		return nil, err
+	}
+
+	c.tnl++
+	c.ro = true
+	return c, nil
 }
 
 func (c *driverConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
@@ -69,6 +120,16 @@ func (c *driverConn) PrepareContext(ctx context.Context, query string) (driver.S
 	return c.Prepare(query)
 }
 
+// CheckNamedValue implements driver.NamedValueChecker. It passes arrays, like
+// []string, to QL unchanged. Other values are converted by database/sql.
+func (c *driverConn) CheckNamedValue(nv *driver.NamedValue) error {
+	if arrayElem(nv.Value) != 0 {
+		return nil
+	}
+
+	return driver.ErrSkip
+}
+
 func filterNamedArgs(query string) (string, error) {
 	toks, err := tokenize(query)
 	if err != nil {
@@ -106,7 +167,8 @@ func (s *driverStmt) ExecContext(ctx context.Context, args []driver.NamedValue)
 	for k, v := range args {
 		a[k] = v.Value
 	}
-	return s.Exec(a)
+	c := s.conn
+	return driverExec(ctx, c.db, c.ctx, s.stmt, a)
 }
 
 func (s *driverStmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
@@ -114,7 +176,8 @@ func (s *driverStmt) QueryContext(ctx context.Context, args []driver.NamedValue)
 	for k, v := range args {
 		a[k] = v.Value
 	}
-	return s.Query(a)
+	c := s.conn
+	return driverQuery(ctx, c.db, c.ctx, s.stmt, a)
 }
 
 func tokenize(s string) (r []string, _ error) {
@@ -0,0 +1,299 @@
+// Copyright 2026 The ql Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ql
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DumpOptions amend the behavior of Dump.
+type DumpOptions struct {
+	// RowsPerInsert is the maximum number of rows inserted by a single
+	// INSERT statement. Zero means 100.
+	RowsPerInsert int
+
+	// Tables, if not nil, selects the tables to dump by name.
+	Tables *regexp.Regexp
+}
+
+// Dump writes to w a QL script recreating the last committed state of db.
+// The script consists of a transaction creating the enum types, tables and
+// indices and inserting the rows of the tables. Executing it on an empty DB,
+// for example using Run, produces a DB with the same tables, indices and
+// rows, in the same order and having the same IDs. Passing nil opts is the
+// same as passing &DumpOptions{}.
+//
+// The CREATE TABLE statements are those of the Schema column of the __Table
+// system table. If opts.Tables is not nil, only the enum types used by the
+// selected tables are created. Values are written as literals, using a
+// conversion where the type of the literal is not that of the value, like in
+//
+//	INSERT INTO t (id(), b, d, i, n, r, s, t) VALUES
+//		(1, blob("\x00\x01"), duration("1h0m0s"), 42, bigint("123"), bigrat("1/3"), "foo", parseTime("2006-01-02T15:04:05.999999999Z07:00", "2017-01-02T03:04:05Z")),
+//		...
+//
+// Floating point values which are not finite cannot be dumped.
+//
+// Dump reads a snapshot of db like Backup. It is safe for concurrent use by
+// multiple goroutines.
+func (db *DB) Dump(w io.Writer, opts *DumpOptions) (err error) {
+	if opts == nil {
+		opts = &DumpOptions{}
+	}
+	n := opts.RowsPerInsert
+	if n <= 0 {
+		n = 100
+	}
+
+	db.mu.Lock()
+	if db.store == nil {
+		db.muUnlock()
+		return fmt.Errorf("Dump: DB is closed")
+	}
+
+	snap := db.snapshot()
+	db.muUnlock()
+	defer snap.release()
+
+	x := newExecCtx(db, nil)
+	x.snap = snap
+	nfo, err := db.info(x)
+	if err != nil {
+		return err
+	}
+
+	tables := map[string]*TableInfo{}
+	for i := range nfo.Tables {
+		ti := &nfo.Tables[i]
+		tables[ti.Name] = ti
+	}
+	var ts []*dumpTable
+	b := bufio.NewWriter(w)
+	b.WriteString("BEGIN TRANSACTION;\n")
+	for i := range nfo.Enums {
+		if e := &nfo.Enums[i]; opts.Tables == nil || usesEnum(nfo, opts.Tables, e.Name) {
+			fmt.Fprintf(b, "%s\n", e.createStmt())
+		}
+	}
+	for _, t := range x.root().userTables() {
+		if opts.Tables != nil && !opts.Tables.MatchString(t.name) {
+			continue
+		}
+
+		fmt.Fprintf(b, "%s\n", tables[t.name].createStmt())
+		for i := range nfo.Indices {
+			if xi := &nfo.Indices[i]; xi.Table == t.name {
+				fmt.Fprintf(b, "%s\n", xi.createStmt())
+			}
+		}
+		d, err := newDumpTable(x, t)
+		if err != nil {
+			return err
+		}
+
+		ts = append(ts, d)
+	}
+	if err = dumpRows(x, b, ts, n); err != nil {
+		return err
+	}
+
+	b.WriteString("COMMIT;\n")
+	return b.Flush()
+}
+
+// usesEnum reports whether a table selected by re has a column of the enum
+// type nm.
+func usesEnum(nfo *DbInfo, re *regexp.Regexp, nm string) bool {
+	for _, t := range nfo.Tables {
+		if !re.MatchString(t.Name) {
+			continue
+		}
+
+		for _, c := range t.Columns {
+			if c.Type == EnumType && c.Enum == nm {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// dumpTable is a table being dumped.
+type dumpTable struct {
+	t   *table
+	hs  []int64 // Record handles, oldest first.
+	ids []int64 // Record IDs, oldest first.
+}
+
+func newDumpTable(x *execCtx, t *table) (*dumpTable, error) {
+	r := &dumpTable{t: t}
+	for h := t.head; h != 0; {
+		if err := x.canceled(); err != nil {
+			return nil, err
+		}
+
+		rec, err := x.read(nil, h, t.cols...)
+		if err != nil {
+			return nil, err
+		}
+
+		r.hs = append(r.hs, h)
+		r.ids = append(r.ids, rec[1].(int64))
+		h = rec[0].(int64)
+	}
+	for i, j := 0, len(r.hs)-1; i < j; i, j = i+1, j-1 {
+		r.hs[i], r.hs[j] = r.hs[j], r.hs[i]
+		r.ids[i], r.ids[j] = r.ids[j], r.ids[i]
+	}
+	return r, nil
+}
+
+// dumpRows writes the INSERT statements of the rows of ts, n rows per
+// statement at most. The rows of every table are written oldest first, the
+// rows of different tables are merged in the order of their IDs. A script
+// inserting the IDs in ascending order need not check them for duplicates.
+func dumpRows(x *execCtx, w *bufio.Writer, ts []*dumpTable, n int) error {
+	var a []string
+	for {
+		var d *dumpTable
+		for _, v := range ts {
+			if len(v.ids) != 0 && (d == nil || v.ids[0] < d.ids[0]) {
+				d = v
+			}
+		}
+		if d == nil {
+			return nil
+		}
+
+		lim := int64(math.MaxInt64) // The next ID of the other tables.
+		for _, v := range ts {
+			if v != d && len(v.ids) != 0 && v.ids[0] < lim {
+				lim = v.ids[0]
+			}
+		}
+		t := d.t
+		fmt.Fprintf(w, "INSERT INTO %s (id(), %s) VALUES\n", t.name, strings.Join(t.fieldNames(), ", "))
+		for i := 0; i < n && len(d.ids) != 0 && (i == 0 || d.ids[0] < lim); i++ {
+			if err := x.canceled(); err != nil {
+				return err
+			}
+
+			id, rec, err := t.row(x, d.hs[0])
+			if err != nil {
+				return err
+			}
+
+			if err = expand(rec); err != nil {
+				return err
+			}
+
+			a = append(a[:0], fmt.Sprint(id))
+			for _, v := range rec {
+				s, err := literal(v)
+				if err != nil {
+					return fmt.Errorf("Dump: table %s: %v", t.name, err)
+				}
+
+				a = append(a, s)
+			}
+
+			if i != 0 {
+				w.WriteString(",\n")
+			}
+			fmt.Fprintf(w, "\t(%s)", strings.Join(a, ", "))
+			d.hs, d.ids = d.hs[1:], d.ids[1:]
+		}
+		w.WriteString(";\n")
+	}
+}
+
+// literal returns an expression evaluating to v.
+func literal(v interface{}) (string, error) {
+	switch x := v.(type) {
+	case nil:
+		return "NULL", nil
+	case bool:
+		return strconv.FormatBool(x), nil
+	case complex64:
+		return complexLiteral(complex128(x), 32)
+	case complex128:
+		return complexLiteral(x, 64)
+	case float32:
+		return floatLiteral(float64(x), 32)
+	case float64:
+		return floatLiteral(x, 64)
+	case int8, int16, int32, int64, uint8, uint16, uint32, uint64:
+		return fmt.Sprint(x), nil
+	case string:
+		return strconv.Quote(x), nil
+	case []byte:
+		return fmt.Sprintf("blob(%s)", strconv.Quote(string(x))), nil
+	case *big.Int:
+		return fmt.Sprintf("bigint(%q)", x.String()), nil
+	case *big.Rat:
+		return fmt.Sprintf("bigrat(%q)", x.RatString()), nil
+	case time.Duration:
+		return fmt.Sprintf("duration(%q)", x.String()), nil
+	case time.Time:
+		return fmt.Sprintf("parseTime(%q, %q)", time.RFC3339Nano, x.Format(time.RFC3339Nano)), nil
+	case json.RawMessage:
+		return fmt.Sprintf("json(%s)", strconv.Quote(string(x))), nil
+	case Dec:
+		return fmt.Sprintf("decimal(%q)", x.String()), nil
+	case Enum:
+		return strconv.Quote(x.Label()), nil
+	case UUID:
+		return fmt.Sprintf("uuid(%q)", x.String()), nil
+	default:
+		if elem := arrayElem(v); elem != 0 {
+			a := arrayValues(v)
+			s := make([]string, len(a))
+			for i, v := range a {
+				var err error
+				if s[i], err = literal(v); err != nil {
+					return "", err
+				}
+			}
+			return fmt.Sprintf("%s{%s}", arrayTypeStr(elem), strings.Join(s, ", ")), nil
+		}
+
+		return "", fmt.Errorf("cannot dump value of type %T", v)
+	}
+}
+
+func floatLiteral(f float64, bits int) (string, error) {
+	if math.IsInf(f, 0) || math.IsNaN(f) {
+		return "", fmt.Errorf("cannot dump value %v", f)
+	}
+
+	if f == 0 && math.Signbit(f) {
+		return "-0.0", nil
+	}
+
+	return strconv.FormatFloat(f, 'g', -1, bits), nil
+}
+
+func complexLiteral(c complex128, bits int) (string, error) {
+	re, err := floatLiteral(real(c), bits)
+	if err != nil {
+		return "", err
+	}
+
+	im, err := floatLiteral(imag(c), bits)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("complex(%s, %s)", re, im), nil
+}
@@ -6,6 +6,7 @@ package ql
 
 import (
 	"encoding/binary"
+	"encoding/json"
 	"fmt"
 	"math"
 	"math/big"
@@ -51,6 +52,11 @@ const (
 	tag2u64Zero
 	tag2u8
 	tag2u8Zero
+	tag2json // Appended, tags are persistent.
+	tag2decimal
+	tag2enum
+	tag2uuid
+	tag2array
 )
 
 func encode2(data []interface{}) (r buffer.Bytes, error error) {
@@ -252,8 +258,40 @@ func encode2(data []interface{}) (r buffer.Bytes, error error) {
 				n := binary.PutVarint(b[1:], int64(x))
 				r.Write(b[:n+1])
 			}
+		case json.RawMessage:
+			b[0] = tag2json
+			n := binary.PutUvarint(b[1:], uint64(len(x)))
+			r.Write(b[:n+1])
+			r.Write(x)
+		case Dec:
+			buf := x.bytes()
+			b[0] = tag2decimal
+			n := binary.PutUvarint(b[1:], uint64(len(buf)))
+			r.Write(b[:n+1])
+			r.Write(buf)
+		case Enum:
+			buf := x.bytes()
+			b[0] = tag2enum
+			n := binary.PutUvarint(b[1:], uint64(len(buf)))
+			r.Write(b[:n+1])
+			r.Write(buf)
+		case UUID:
+			r.WriteByte(tag2uuid)
+			r.Write(x[:])
 		default:
-			return r, fmt.Errorf("encode2: unexpected data %T(%v)", x, x)
+			if arrayElem(x) == 0 {
+				return r, fmt.Errorf("encode2: unexpected data %T(%v)", x, x)
+			}
+
+			buf, err := arrayBytes(x)
+			if err != nil {
+				return r, err
+			}
+
+			b[0] = tag2array
+			n := binary.PutUvarint(b[1:], uint64(len(buf)))
+			r.Write(b[:n+1])
+			r.Write(buf)
 		}
 	}
 	return r, nil
@@ -477,6 +515,82 @@ func decode2(dst []interface{}, b []byte) ([]interface{}, error) {
 
 			dst = append(dst, time.Duration(n))
 			b = b[nlen:]
+		case tag2json:
+			n, nlen := binary.Uvarint(b)
+			if nlen <= 0 {
+				return nil, fmt.Errorf("decode2: corrupted DB")
+			}
+
+			b = b[nlen:]
+			if uint64(len(b)) < n {
+				return nil, fmt.Errorf("decode2: corrupted DB")
+			}
+
+			dst = append(dst, append(json.RawMessage(nil), b[:n]...))
+			b = b[n:]
+		case tag2decimal:
+			n, nlen := binary.Uvarint(b)
+			if nlen <= 0 {
+				return nil, fmt.Errorf("decode2: corrupted DB")
+			}
+
+			b = b[nlen:]
+			if uint64(len(b)) < n {
+				return nil, fmt.Errorf("decode2: corrupted DB")
+			}
+
+			d, err := decBytes(b[:n])
+			if err != nil {
+				return nil, fmt.Errorf("decode2: corrupted DB")
+			}
+
+			dst = append(dst, d)
+			b = b[n:]
+		case tag2enum:
+			n, nlen := binary.Uvarint(b)
+			if nlen <= 0 {
+				return nil, fmt.Errorf("decode2: corrupted DB")
+			}
+
+			b = b[nlen:]
+			if uint64(len(b)) < n {
+				return nil, fmt.Errorf("decode2: corrupted DB")
+			}
+
+			e, err := enumBytes(b[:n])
+			if err != nil {
+				return nil, fmt.Errorf("decode2: corrupted DB")
+			}
+
+			dst = append(dst, e)
+			b = b[n:]
+		case tag2uuid:
+			var u UUID
+			if len(b) < len(u) {
+				return nil, fmt.Errorf("decode2: corrupted DB")
+			}
+
+			copy(u[:], b)
+			dst = append(dst, u)
+			b = b[len(u):]
+		case tag2array:
+			n, nlen := binary.Uvarint(b)
+			if nlen <= 0 {
+				return nil, fmt.Errorf("decode2: corrupted DB")
+			}
+
+			b = b[nlen:]
+			if uint64(len(b)) < n {
+				return nil, fmt.Errorf("decode2: corrupted DB")
+			}
+
+			a, err := arrayDecode(b[:n])
+			if err != nil {
+				return nil, fmt.Errorf("decode2: corrupted DB")
+			}
+
+			dst = append(dst, a)
+			b = b[n:]
 		default:
 			return nil, fmt.Errorf("decode2: unexpected tag %v", tag)
 		}
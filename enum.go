@@ -0,0 +1,317 @@
+// Copyright 2026 The ql Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ql
+
+import (
+	"database/sql/driver"
+	"encoding/binary"
+	"fmt"
+)
+
+// enumType is a type created by CREATE TYPE name AS ENUM. Enum types cannot
+// be altered, so the columns of a type and the values read from them share
+// the enumType.
+type enumType struct {
+	name   string
+	labels []string       // In the order of declaration.
+	ords   map[string]int // Label: ordinal.
+}
+
+func newEnumType(name string, labels []string) (*enumType, error) {
+	if len(labels) == 0 {
+		return nil, fmt.Errorf("enum type %s has no labels", name)
+	}
+
+	t := &enumType{name: name, labels: labels, ords: map[string]int{}}
+	for i, v := range labels {
+		if _, ok := t.ords[v]; ok {
+			return nil, fmt.Errorf("enum type %s: duplicate label %q", name, v)
+		}
+
+		t.ords[v] = i
+	}
+	return t, nil
+}
+
+// value returns the Enum of t having the label s.
+func (t *enumType) value(s string) (Enum, error) {
+	n, ok := t.ords[s]
+	if !ok {
+		return Enum{}, fmt.Errorf("invalid value %q for enum type %s", s, t.name)
+	}
+
+	return Enum{t, n}, nil
+}
+
+// at returns the Enum of t having the ordinal n.
+func (t *enumType) at(n int64) (Enum, error) {
+	if n < 0 || n >= int64(len(t.labels)) {
+		return Enum{}, fmt.Errorf("corrupted DB: ordinal %d of enum type %s out of range", n, t.name)
+	}
+
+	return Enum{t, int(n)}, nil
+}
+
+// Enum is a value of an enum type created by
+//
+//	CREATE TYPE name AS ENUM (label1, label2, ...);
+//
+// Enum values are ordered by the position of their labels in the declaration
+// of the type. Values of enum columns are assigned and compared using the
+// labels, as strings. The zero value is not a valid Enum.
+//
+// Enum implements driver.Valuer, so it can be passed to the database/sql
+// driver as its label.
+type Enum struct {
+	t *enumType
+	n int
+}
+
+// Type returns the name of the enum type of e.
+func (e Enum) Type() string {
+	if e.t == nil {
+		return ""
+	}
+
+	return e.t.name
+}
+
+// Label returns the label of e.
+func (e Enum) Label() string {
+	if e.t == nil {
+		return ""
+	}
+
+	return e.t.labels[e.n]
+}
+
+// Ordinal returns the zero based position of the label of e in the
+// declaration of its type.
+func (e Enum) Ordinal() int { return e.n }
+
+// String implements fmt.Stringer. It returns the label of e.
+func (e Enum) String() string { return e.Label() }
+
+// Value implements driver.Valuer.
+func (e Enum) Value() (driver.Value, error) { return e.Label(), nil }
+
+// enumValue returns v checked for assignment to, or comparison with, the enum
+// column c. Strings must be labels of the type of c.
+func (c *col) enumValue(v interface{}) (interface{}, error) {
+	switch x := v.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return c.enum.value(x)
+	case Enum:
+		if x.Type() == c.enum.name {
+			return c.enum.value(x.Label())
+		}
+	}
+
+	return nil, fmt.Errorf("cannot use %v (type %T) in assignment to, or comparison with, column %s (type %s)", v, ideal(v), c.name, c.typeStr())
+}
+
+// typeStr returns the type of c as written in a column definition.
+func (c *col) typeStr() string {
+	switch c.typ {
+	case qEnum:
+		return c.enum.name
+	case qArray:
+		return arrayTypeStr(c.elem)
+	}
+
+	return colTypeStr(c.typ, c.prec, c.scale)
+}
+
+// cmpEnum compares a and b, one of which is an Enum. It reports false if the
+// other one is not an Enum or a string.
+func cmpEnum(a, b interface{}) (int, bool, error) {
+	x, ok := a.(Enum)
+	y, ok2 := b.(Enum)
+	switch {
+	case ok && ok2:
+		if x.Type() != y.Type() {
+			return 0, true, fmt.Errorf("invalid operation: mismatched enum types %s and %s", x.Type(), y.Type())
+		}
+	case ok:
+		s, ok := b.(string)
+		if !ok {
+			return 0, false, nil
+		}
+
+		var err error
+		if y, err = x.t.value(s); err != nil {
+			return 0, true, err
+		}
+	default:
+		s, ok := a.(string)
+		if !ok {
+			return 0, false, nil
+		}
+
+		var err error
+		if x, err = y.t.value(s); err != nil {
+			return 0, true, err
+		}
+	}
+	return x.n - y.n, true, nil
+}
+
+// compactEnums replaces the Enum values of data, a record to be written to
+// the storage, by their ordinals, the form enum values are stored in. The
+// returned function restores the Enum values. The other values of data are
+// left as the storage sets them, the indices of the record use them.
+func compactEnums(data []interface{}) (restore func()) {
+	var enums map[int]Enum
+	for i, v := range data {
+		if e, ok := v.(Enum); ok {
+			if enums == nil {
+				enums = map[int]Enum{}
+			}
+			enums[i] = e
+			data[i] = int64(e.n)
+		}
+	}
+	return func() {
+		for i, e := range enums {
+			data[i] = e
+		}
+	}
+}
+
+// expandEnums replaces the stored ordinals of the enum columns of rec, a
+// record read from the storage, by their Enum values.
+func expandEnums(rec []interface{}, cols []*col) (err error) {
+	for _, c := range cols {
+		if i := c.index + 2; c.typ == qEnum && i < len(rec) {
+			if n, ok := rec[i].(int64); ok {
+				if rec[i], err = c.enum.at(n); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// bytes returns the encoding of e used for index keys and temporary storage:
+// the ordinal, the name of the type and its labels. The lengths of the
+// strings and the ordinal are encoded as uvarints.
+func (e Enum) bytes() []byte {
+	var b []byte
+	var buf [binary.MaxVarintLen64]byte
+	put := func(n int) { b = append(b, buf[:binary.PutUvarint(buf[:], uint64(n))]...) }
+	put(e.n)
+	for _, s := range append([]string{e.t.name}, e.t.labels...) {
+		put(len(s))
+		b = append(b, s...)
+	}
+	return b
+}
+
+func enumBytes(b []byte) (Enum, error) {
+	errCorrupted := fmt.Errorf("corrupted DB: invalid enum encoding")
+	n, l := binary.Uvarint(b)
+	if l <= 0 {
+		return Enum{}, errCorrupted
+	}
+
+	var a []string
+	for b = b[l:]; len(b) != 0; {
+		m, l := binary.Uvarint(b)
+		if l <= 0 || uint64(len(b)-l) < m {
+			return Enum{}, errCorrupted
+		}
+
+		a = append(a, string(b[l:l+int(m)]))
+		b = b[l+int(m):]
+	}
+	if len(a) < 2 {
+		return Enum{}, errCorrupted
+	}
+
+	t, err := newEnumType(a[0], a[1:])
+	if err != nil {
+		return Enum{}, fmt.Errorf("corrupted DB: %v", err)
+	}
+
+	return t.at(int64(n))
+}
+
+// enumTypes returns the enum types of the DB as read by ctx.
+func (db *DB) enumTypes(ctx *execCtx) (map[string]*enumType, error) {
+	m := map[string]*enumType{}
+	if _, ok := ctx.root().tables["__Enum"]; !ok {
+		return m, nil
+	}
+
+	rs, err := selectEnum.l[0].exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	labels := map[string][]string{}
+	if err := rs.(recordset).do(ctx, func(id interface{}, data []interface{}) (bool, error) {
+		nm, n, s := data[0].(string), int(data[1].(int64)), data[2].(string)
+		a := labels[nm]
+		for len(a) <= n {
+			a = append(a, "")
+		}
+		a[n] = s
+		labels[nm] = a
+		return true, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	for nm, a := range labels {
+		if m[nm], err = newEnumType(nm, a); err != nil {
+			return nil, fmt.Errorf("corrupted DB: %v", err)
+		}
+	}
+	return m, nil
+}
+
+// loadEnums sets the types of the enum columns of the tables read from the
+// DB.
+func (db *DB) loadEnums(ctx *execCtx) (err error) {
+	var m map[string]*enumType
+	for _, t := range db.root.tables {
+		for _, c := range t.cols {
+			if c.typ != qEnum {
+				continue
+			}
+
+			if m == nil {
+				if m, err = db.enumTypes(ctx); err != nil {
+					return err
+				}
+			}
+
+			e := m[c.enum.name]
+			if e == nil {
+				return fmt.Errorf("corrupted DB: enum type %s of column %s.%s does not exist", c.enum.name, t.name, c.name)
+			}
+
+			c.enum = e
+		}
+	}
+	return nil
+}
+
+// findEnumType returns the enum type nm as seen by the open transaction.
+func (db *DB) findEnumType(nm string) (*enumType, error) {
+	m, err := db.enumTypes(newExecCtx(db, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	if t := m[nm]; t != nil {
+		return t, nil
+	}
+
+	return nil, fmt.Errorf("type %s does not exist", nm)
+}
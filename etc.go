@@ -6,10 +6,12 @@ package ql
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"io"
 	"math"
 	"math/big"
+	"regexp/syntax"
 	"time"
 )
 
@@ -30,34 +32,44 @@ const (
 	qUint32     = 0x77 // 'w'
 	qUint64     = 0x78 // 'x', alias uint
 
+	qArray    = 0x41 // 'A'
 	qBigInt   = 0x49 // 'I'
 	qBigRat   = 0x52 // 'R'
 	qBlob     = 0x42 // 'B'
+	qDecimal  = 0x4e // 'N'
 	qDuration = 0x44 // 'D'
+	qEnum     = 0x45 // 'E'
+	qJSON     = 0x4a // 'J'
 	qTime     = 0x54 // 'T'
+	qUUID     = 0x55 // 'U'
 )
 
 var (
 	type2Str = map[int]string{
+		qArray:      "array",
 		qBigInt:     "bigint",
 		qBigRat:     "bigrat",
 		qBlob:       "blob",
 		qBool:       "bool",
 		qComplex128: "complex128",
 		qComplex64:  "complex64",
+		qDecimal:    "decimal",
 		qDuration:   "duration",
+		qEnum:       "enum",
 		qFloat32:    "float32",
 		qFloat64:    "float64",
 		qInt16:      "int16",
 		qInt32:      "int32",
 		qInt64:      "int64",
 		qInt8:       "int8",
+		qJSON:       "json",
 		qString:     "string",
 		qTime:       "time",
 		qUint16:     "uint16",
 		qUint32:     "uint32",
 		qUint64:     "uint64",
 		qUint8:      "uint8",
+		qUUID:       "uuid",
 	}
 )
 
@@ -498,6 +510,40 @@ func invOp2(x, y interface{}, o int) (interface{}, error) {
 	return nil, fmt.Errorf("invalid operation: %v %v %v (mismatched types %T and %T)", x, iop(o), y, ideal(x), ideal(y))
 }
 
+// cmpOp returns the result of the comparison x o y where x or y is an Enum or
+// a UUID. The other operand may be a string holding a label or a UUID.
+func cmpOp(x, y interface{}, o int) (interface{}, error) {
+	cmp := cmpUUID
+	_, ok := x.(Enum)
+	if _, ok2 := y.(Enum); ok || ok2 {
+		cmp = cmpEnum
+	}
+	n, ok, err := cmp(x, y)
+	switch {
+	case !ok:
+		return invOp2(x, y, o)
+	case err != nil:
+		return nil, err
+	}
+
+	switch o {
+	case '<':
+		return n < 0, nil
+	case le:
+		return n <= 0, nil
+	case '>':
+		return n > 0, nil
+	case ge:
+		return n >= 0, nil
+	case eq:
+		return n == 0, nil
+	case neq:
+		return n != 0, nil
+	default:
+		return undOp2(x, y, o)
+	}
+}
+
 func undOp(x interface{}, o int) (interface{}, error) {
 	return nil, fmt.Errorf("invalid operation: %v%v (operator %v not defined on %T)", iop(o), x, iop(o), x)
 }
@@ -654,6 +700,9 @@ func convert(val interface{}, typ int) (v interface{}, err error) { //NTYPE
 			return float32(v), nil
 		case time.Duration:
 			return float32(x), nil
+		case Dec:
+			v, _ := x.Rat().Float64()
+			return float32(v), nil
 		default:
 			return invConv(val, typ)
 		}
@@ -701,6 +750,9 @@ func convert(val interface{}, typ int) (v interface{}, err error) { //NTYPE
 			return v, nil
 		case time.Duration:
 			return float64(x), nil
+		case Dec:
+			v, _ := x.Rat().Float64()
+			return v, nil
 		default:
 			return invConv(val, typ)
 		}
@@ -748,6 +800,8 @@ func convert(val interface{}, typ int) (v interface{}, err error) { //NTYPE
 			return int8(x.Int64()), nil
 		case time.Duration:
 			return int8(x), nil
+		case Dec:
+			return int8(x.trunc().Int64()), nil
 		default:
 			return invConv(val, typ)
 		}
@@ -795,6 +849,8 @@ func convert(val interface{}, typ int) (v interface{}, err error) { //NTYPE
 			return int16(x.Int64()), nil
 		case time.Duration:
 			return int16(x), nil
+		case Dec:
+			return int16(x.trunc().Int64()), nil
 		default:
 			return invConv(val, typ)
 		}
@@ -842,6 +898,8 @@ func convert(val interface{}, typ int) (v interface{}, err error) { //NTYPE
 			return int32(x.Int64()), nil
 		case time.Duration:
 			return int32(x), nil
+		case Dec:
+			return int32(x.trunc().Int64()), nil
 		default:
 			return invConv(val, typ)
 		}
@@ -889,6 +947,8 @@ func convert(val interface{}, typ int) (v interface{}, err error) { //NTYPE
 			return x.Int64(), nil
 		case time.Duration:
 			return int64(x), nil
+		case Dec:
+			return x.trunc().Int64(), nil
 		default:
 			return invConv(val, typ)
 		}
@@ -934,6 +994,14 @@ func convert(val interface{}, typ int) (v interface{}, err error) { //NTYPE
 			return x.String(), nil
 		case time.Duration:
 			return x.String(), nil
+		case json.RawMessage:
+			return string(x), nil
+		case Dec:
+			return x.String(), nil
+		case Enum:
+			return x.Label(), nil
+		case UUID:
+			return x.String(), nil
 		default:
 			return invConv(val, typ)
 		}
@@ -981,6 +1049,8 @@ func convert(val interface{}, typ int) (v interface{}, err error) { //NTYPE
 			return uint8(x.Int64()), nil
 		case time.Duration:
 			return uint8(x), nil
+		case Dec:
+			return uint8(x.trunc().Uint64()), nil
 		default:
 			return invConv(val, typ)
 		}
@@ -1028,6 +1098,8 @@ func convert(val interface{}, typ int) (v interface{}, err error) { //NTYPE
 			return uint16(x.Int64()), nil
 		case time.Duration:
 			return uint16(x), nil
+		case Dec:
+			return uint16(x.trunc().Uint64()), nil
 		default:
 			return invConv(val, typ)
 		}
@@ -1075,6 +1147,8 @@ func convert(val interface{}, typ int) (v interface{}, err error) { //NTYPE
 			return uint32(x.Int64()), nil
 		case time.Duration:
 			return uint32(x), nil
+		case Dec:
+			return uint32(x.trunc().Uint64()), nil
 		default:
 			return invConv(val, typ)
 		}
@@ -1122,6 +1196,8 @@ func convert(val interface{}, typ int) (v interface{}, err error) { //NTYPE
 			return x.Uint64(), nil
 		case time.Duration:
 			return uint64(x), nil
+		case Dec:
+			return x.trunc().Uint64(), nil
 		default:
 			return invConv(val, typ)
 		}
@@ -1131,6 +1207,38 @@ func convert(val interface{}, typ int) (v interface{}, err error) { //NTYPE
 			return []byte(x), nil
 		case []byte:
 			return x, nil
+		case json.RawMessage:
+			return []byte(x), nil
+		case UUID:
+			return append([]byte(nil), x[:]...), nil
+		default:
+			return invConv(val, typ)
+		}
+	case qDecimal:
+		return decConvert(val)
+	case qEnum:
+		switch x := val.(type) {
+		case Enum:
+			return x, nil
+		default:
+			return invConv(val, typ)
+		}
+	case qUUID:
+		return uuidConvert(val)
+	case qArray:
+		if arrayElem(val) == 0 {
+			return invConv(val, typ)
+		}
+
+		return val, nil
+	case qJSON:
+		switch x := val.(type) {
+		case string:
+			return jsonValue([]byte(x))
+		case []byte:
+			return jsonValue(x)
+		case json.RawMessage:
+			return x, nil
 		default:
 			return invConv(val, typ)
 		}
@@ -1195,6 +1303,8 @@ func convert(val interface{}, typ int) (v interface{}, err error) { //NTYPE
 			ii := big.NewInt(0).Set(x.Num())
 			ii.Div(ii, x.Denom())
 			return ii, nil
+		case Dec:
+			return x.trunc(), nil
 		default:
 			return invConv(val, typ)
 		}
@@ -1244,6 +1354,8 @@ func convert(val interface{}, typ int) (v interface{}, err error) { //NTYPE
 			return big.NewRat(1, 1).SetInt(x), nil
 		case *big.Rat:
 			return x, nil
+		case Dec:
+			return x.Rat(), nil
 		default:
 			return invConv(val, typ)
 		}
@@ -1342,6 +1454,15 @@ func overflow(v interface{}, typ int) error {
 }
 
 func typeCheck1(val interface{}, c *col) (interface{}, error) {
+	if c.typ == qDecimal { // Compared exactly, not rounded to the column scale.
+		switch val.(type) {
+		case nil, Dec, idealFloat, idealInt, idealRune, idealUint:
+			return decConvert(val)
+		default:
+			return nil, fmt.Errorf("cannot use %v (type %T) in assignment to, or comparison with, column %s (type %s)", val, ideal(val), c.name, colTypeStr(c.typ, c.prec, c.scale))
+		}
+	}
+
 	rec := []interface{}{val}
 	c = c.clone()
 	c.index = 0
@@ -1355,6 +1476,33 @@ func typeCheck1(val interface{}, c *col) (interface{}, error) {
 func typeCheck(rec []interface{}, cols []*col) (err error) {
 	for _, c := range cols {
 		i := c.index
+		switch c.typ {
+		case qDecimal:
+			if rec[i], err = c.decimal(rec[i]); err != nil {
+				return err
+			}
+
+			continue
+		case qEnum:
+			if rec[i], err = c.enumValue(rec[i]); err != nil {
+				return err
+			}
+
+			continue
+		case qUUID:
+			if rec[i], err = c.uuid(rec[i]); err != nil {
+				return err
+			}
+
+			continue
+		case qArray:
+			if rec[i], err = c.array(rec[i]); err != nil {
+				return err
+			}
+
+			continue
+		}
+
 		if v := rec[i]; !c.typeCheck(v) {
 			switch v.(type) {
 			case idealComplex:
@@ -1738,6 +1886,10 @@ func typeCheck(rec []interface{}, cols []*col) (err error) {
 
 //TODO collate1 should return errors instead of panicing
 func collate1(a, b interface{}) int {
+	if ka, kb := jsonKind(a), jsonKind(b); ka != 0 && kb != 0 && ka != kb {
+		return ka - kb
+	}
+
 	switch x := a.(type) {
 	case nil:
 		if b != nil {
@@ -2696,6 +2848,57 @@ func collate1(a, b interface{}) int {
 		default:
 			panic("internal error 034")
 		}
+	case json.RawMessage:
+		switch y := b.(type) {
+		case nil:
+			return 1
+		case json.RawMessage:
+			return bytes.Compare(x, y)
+		default:
+			panic("internal error 074")
+		}
+	case Dec:
+		switch y := b.(type) {
+		case nil:
+			return 1
+		case Dec:
+			return x.Cmp(y)
+		case idealFloat, idealInt, idealUint:
+			d, err := decConvert(y)
+			if err != nil {
+				panic(err)
+			}
+
+			return x.Cmp(d.(Dec))
+		default:
+			panic("internal error 076")
+		}
+	case Enum:
+		switch y := b.(type) {
+		case nil:
+			return 1
+		case Enum:
+			if x.n < y.n {
+				return -1
+			}
+
+			if x.n == y.n {
+				return 0
+			}
+
+			return 1
+		default:
+			panic("internal error 077")
+		}
+	case UUID:
+		switch y := b.(type) {
+		case nil:
+			return 1
+		case UUID:
+			return bytes.Compare(x[:], y[:])
+		default:
+			panic("internal error 078")
+		}
 	case chunk:
 		switch y := b.(type) {
 		case nil:
@@ -2716,6 +2919,17 @@ func collate1(a, b interface{}) int {
 			panic("internal error 035")
 		}
 	default:
+		if arrayElem(a) != 0 {
+			switch {
+			case b == nil:
+				return 1
+			case arrayElem(b) == arrayElem(a):
+				return collateArrays(a, b)
+			default:
+				panic("internal error 079")
+			}
+		}
+
 		//dbg("%T(%v) %T(%v)", a, a, b, b)
 		panic("internal error 036")
 	}
@@ -2770,7 +2984,7 @@ func isOrderedType(v interface{}) (y interface{}, r bool, err error) {
 		uint8, uint16, uint32, uint64,
 		string:
 		return v, true, nil
-	case *big.Int, *big.Rat, time.Time, time.Duration:
+	case *big.Int, *big.Rat, time.Time, time.Duration, Dec, Enum, UUID, json.RawMessage:
 		return x, true, nil
 	case chunk:
 		if y, err = x.expand(); err != nil {
@@ -2786,10 +3000,13 @@ func isOrderedType(v interface{}) (y interface{}, r bool, err error) {
 var isSystemName = map[string]bool{
 	"__Column":        true,
 	"__Column2":       true,
+	"__Enum":          true,
+	"__FullText":      true,
 	"__Index":         true,
 	"__Index2":        true,
 	"__Index2_Column": true,
 	"__Index2_Expr":   true,
+	"__Spatial":       true,
 	"__Table":         true,
 }
 
@@ -2800,3 +3017,41 @@ func qnames(l []string) []string {
 	}
 	return r
 }
+
+// regexpPrefix returns the literal prefix of every string matched by the
+// regular expression pattern. The result is "" if there's no such prefix, in
+// particular when pattern is not anchored at the beginning of text.
+func regexpPrefix(pattern string) string {
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return ""
+	}
+
+	re = re.Simplify()
+	if re.Op != syntax.OpConcat || len(re.Sub) < 2 || re.Sub[0].Op != syntax.OpBeginText {
+		return ""
+	}
+
+	var a []rune
+	for _, v := range re.Sub[1:] {
+		if v.Op != syntax.OpLiteral || v.Flags&syntax.FoldCase != 0 {
+			break
+		}
+
+		a = append(a, v.Rune...)
+	}
+	return string(a)
+}
+
+// prefixSuccessor returns the least string collating after all strings having
+// prefix s or "" if there's no such string.
+func prefixSuccessor(s string) string {
+	b := []byte(s)
+	for i := len(b) - 1; i >= 0; i-- {
+		if b[i] != 0xff {
+			b[i]++
+			return string(b[:i+1])
+		}
+	}
+	return ""
+}
@@ -6,14 +6,17 @@ package ql
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"math/big"
 	"regexp"
 	"strings"
 	"time"
+	"unicode/utf8"
 )
 
 var (
+	_ expression = (*arrayLit)(nil)
 	_ expression = (*binaryOperation)(nil)
 	_ expression = (*call)(nil)
 	_ expression = (*conversion)(nil)
@@ -82,6 +85,10 @@ func mentionedColumns0(e expression, q, nq bool, m map[string]struct{}) {
 	case parameter,
 		value:
 		// nop
+	case *arrayLit:
+		for _, e := range x.list {
+			mentionedColumns0(e, q, nq, m)
+		}
 	case *binaryOperation:
 		mentionedColumns0(x.l, q, nq, m)
 		mentionedColumns0(x.r, q, nq, m)
@@ -91,6 +98,8 @@ func mentionedColumns0(e expression, q, nq bool, m map[string]struct{}) {
 				mentionedColumns0(e, q, nq, m)
 			}
 		}
+	case *collateExpr:
+		mentionedColumns0(x.expr, q, nq, m)
 	case *conversion:
 		mentionedColumns0(x.val, q, nq, m)
 	case *ident:
@@ -130,12 +139,132 @@ func mentionedColumns0(e expression, q, nq bool, m map[string]struct{}) {
 	}
 }
 
+// visitExpression calls f for e and then for every subexpression of e in
+// depth-first order. The subqueries of IN predicates are not visited.
+func visitExpression(e expression, f func(expression)) {
+	f(e)
+	switch x := e.(type) {
+	case parameter,
+		value,
+		*ident:
+		// nop
+	case *arrayLit:
+		for _, e := range x.list {
+			visitExpression(e, f)
+		}
+	case *binaryOperation:
+		visitExpression(x.l, f)
+		visitExpression(x.r, f)
+	case *call:
+		for _, e := range x.arg {
+			visitExpression(e, f)
+		}
+	case *collateExpr:
+		visitExpression(x.expr, f)
+	case *conversion:
+		visitExpression(x.val, f)
+	case *indexOp:
+		visitExpression(x.expr, f)
+		visitExpression(x.x, f)
+	case *isNull:
+		visitExpression(x.expr, f)
+	case *pexpr:
+		visitExpression(x.expr, f)
+	case *pIn:
+		visitExpression(x.expr, f)
+		for _, e := range x.list {
+			visitExpression(e, f)
+		}
+	case *pLike:
+		visitExpression(x.expr, f)
+		visitExpression(x.pattern, f)
+	case *slice:
+		visitExpression(x.expr, f)
+		if y := x.lo; y != nil {
+			visitExpression(*y, f)
+		}
+		if y := x.hi; y != nil {
+			visitExpression(*y, f)
+		}
+	case *unaryOperation:
+		visitExpression(x.v, f)
+	default:
+		panic("internal error 072")
+	}
+}
+
 func mentionedColumns(e expression) map[string]struct{} {
 	m := map[string]struct{}{}
 	mentionedColumns0(e, false, true, m)
 	return m
 }
 
+// renameColumns returns a copy of e where every column name found in m is
+// replaced by the associated value.
+func renameColumns(e expression, m map[string]string) (expression, error) {
+	e, err := e.clone(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	renameColumns0(e, m)
+	return e, nil
+}
+
+func renameColumns0(e expression, m map[string]string) {
+	switch x := e.(type) {
+	case parameter,
+		value:
+		// nop
+	case *arrayLit:
+		for _, e := range x.list {
+			renameColumns0(e, m)
+		}
+	case *binaryOperation:
+		renameColumns0(x.l, m)
+		renameColumns0(x.r, m)
+	case *call:
+		for _, e := range x.arg {
+			renameColumns0(e, m)
+		}
+	case *collateExpr:
+		renameColumns0(x.expr, m)
+	case *conversion:
+		renameColumns0(x.val, m)
+	case *ident:
+		if s, ok := m[x.s]; ok {
+			x.s = s
+		}
+	case *indexOp:
+		renameColumns0(x.expr, m)
+		renameColumns0(x.x, m)
+	case *isNull:
+		renameColumns0(x.expr, m)
+	case *pexpr:
+		renameColumns0(x.expr, m)
+	case *pIn:
+		renameColumns0(x.expr, m)
+		for _, e := range x.list {
+			renameColumns0(e, m)
+		}
+	case *pLike:
+		renameColumns0(x.expr, m)
+		renameColumns0(x.pattern, m)
+	case *slice:
+		renameColumns0(x.expr, m)
+		if y := x.lo; y != nil {
+			renameColumns0(*y, m)
+		}
+		if y := x.hi; y != nil {
+			renameColumns0(*y, m)
+		}
+	case *unaryOperation:
+		renameColumns0(x.v, m)
+	default:
+		panic("internal error 070")
+	}
+}
+
 func staticExpr(e expression) (expression, error) {
 	if e.isStatic() {
 		v, err := e.eval(nil, nil)
@@ -244,10 +373,77 @@ func newBetween(expr, lo, hi interface{}, not bool) (expression, error) {
 type pLike struct {
 	expr    expression
 	pattern expression
+	op      string // LIKE, ILIKE, MATCHES or REGEXP.
+	escape  string // ESCAPE character or "".
 	re      *regexp.Regexp
 	sexpr   *string
 }
 
+func newLike(expr, pattern expression, op, escape string) (expression, error) {
+	if escape != "" && utf8.RuneCountInString(escape) != 1 {
+		return nil, fmt.Errorf("%s ESCAPE %q: escape must be a single character", op, escape)
+	}
+
+	p := &pLike{expr: expr, pattern: pattern, op: op, escape: escape}
+	if err := p.compileConst(); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// compileConst compiles the pattern of p if it is a string constant.
+func (p *pLike) compileConst() (err error) {
+	v, ok := p.pattern.(value)
+	if !ok {
+		return nil
+	}
+
+	if s, ok := v.val.(string); ok {
+		p.re, err = p.compile(s)
+	}
+	return err
+}
+
+func (p *pLike) compile(pattern string) (*regexp.Regexp, error) {
+	src, err := p.regexpSource(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	return regexp.Compile(src)
+}
+
+// regexpSource returns the regular expression matching the strings p matches
+// for pattern.
+func (p *pLike) regexpSource(pattern string) (string, error) {
+	if p.escape != "" {
+		var b strings.Builder
+		for {
+			i := strings.Index(pattern, p.escape)
+			if i < 0 {
+				b.WriteString(pattern)
+				break
+			}
+
+			b.WriteString(pattern[:i])
+			pattern = pattern[i+len(p.escape):]
+			if pattern == "" {
+				return "", fmt.Errorf("%s pattern ends with the escape character %s", p.op, p.escape)
+			}
+
+			_, n := utf8.DecodeRuneInString(pattern)
+			b.WriteString(regexp.QuoteMeta(pattern[:n]))
+			pattern = pattern[n:]
+		}
+		pattern = b.String()
+	}
+	if p.op == "ILIKE" {
+		pattern = "(?i)" + pattern
+	}
+	return pattern, nil
+}
+
 func (p *pLike) clone(arg []interface{}, unqualify ...string) (expression, error) {
 	expr, err := p.expr.clone(arg, unqualify...)
 	if err != nil {
@@ -259,16 +455,32 @@ func (p *pLike) clone(arg []interface{}, unqualify ...string) (expression, error
 		return nil, err
 	}
 
-	return &pLike{
+	r := &pLike{
 		expr:    expr,
 		pattern: pattern,
+		op:      p.op,
+		escape:  p.escape,
 		re:      p.re,
 		sexpr:   p.sexpr,
-	}, nil
+	}
+	if r.re == nil {
+		if err := r.compileConst(); err != nil {
+			return nil, err
+		}
+	}
+
+	return r, nil
 }
 
 func (p *pLike) isStatic() bool { return p.expr.isStatic() && p.pattern.isStatic() }
-func (p *pLike) String() string { return fmt.Sprintf("%s LIKE %s", p.expr, p.pattern) }
+
+func (p *pLike) String() string {
+	if p.escape != "" {
+		return fmt.Sprintf("%s %s %s ESCAPE %q", p.expr, p.op, p.pattern, p.escape)
+	}
+
+	return fmt.Sprintf("%s %s %s", p.expr, p.op, p.pattern)
+}
 
 func (p *pLike) eval(execCtx *execCtx, ctx map[interface{}]interface{}) (v interface{}, err error) {
 	var sexpr string
@@ -288,7 +500,7 @@ func (p *pLike) eval(execCtx *execCtx, ctx map[interface{}]interface{}) (v inter
 
 		sexpr, ok = expr.(string)
 		if !ok {
-			return nil, fmt.Errorf("non-string expression in LIKE: %v (value of type %T)", expr, expr)
+			return nil, fmt.Errorf("non-string expression in %s: %v (value of type %T)", p.op, expr, expr)
 		}
 
 		if p.expr.isStatic() {
@@ -310,10 +522,10 @@ func (p *pLike) eval(execCtx *execCtx, ctx map[interface{}]interface{}) (v inter
 
 		spattern, ok := pattern.(string)
 		if !ok {
-			return nil, fmt.Errorf("non-string pattern in LIKE: %v (value of type %T)", pattern, pattern)
+			return nil, fmt.Errorf("non-string pattern in %s: %v (value of type %T)", p.op, pattern, pattern)
 		}
 
-		if re, err = regexp.Compile(spattern); err != nil {
+		if re, err = p.compile(spattern); err != nil {
 			return nil, err
 		}
 
@@ -721,6 +933,8 @@ func (o *binaryOperation) eval(execCtx *execCtx, ctx map[interface{}]interface{}
 			switch y := b.(type) {
 			case string:
 				return x > y, nil
+			case Enum, UUID:
+				return cmpOp(x, y, op)
 			default:
 				return invOp2(x, y, op)
 			}
@@ -766,6 +980,15 @@ func (o *binaryOperation) eval(execCtx *execCtx, ctx map[interface{}]interface{}
 			default:
 				return invOp2(x, y, op)
 			}
+		case Dec:
+			switch y := b.(type) {
+			case Dec:
+				return x.Cmp(y) > 0, nil
+			default:
+				return invOp2(x, y, op)
+			}
+		case Enum, UUID:
+			return cmpOp(x, b, op)
 		case time.Duration:
 			switch y := b.(type) {
 			case time.Duration:
@@ -787,6 +1010,13 @@ func (o *binaryOperation) eval(execCtx *execCtx, ctx map[interface{}]interface{}
 			default:
 				return invOp2(x, y, op)
 			}
+		case json.RawMessage:
+			switch y := b.(type) {
+			case json.RawMessage:
+				return bytes.Compare(x, y) > 0, nil
+			default:
+				return invOp2(x, y, op)
+			}
 		default:
 			return invOp2(a, b, op)
 		}
@@ -879,6 +1109,8 @@ func (o *binaryOperation) eval(execCtx *execCtx, ctx map[interface{}]interface{}
 			switch y := b.(type) {
 			case string:
 				return x < y, nil
+			case Enum, UUID:
+				return cmpOp(x, y, op)
 			default:
 				return invOp2(x, y, op)
 			}
@@ -924,6 +1156,15 @@ func (o *binaryOperation) eval(execCtx *execCtx, ctx map[interface{}]interface{}
 			default:
 				return invOp2(x, y, op)
 			}
+		case Dec:
+			switch y := b.(type) {
+			case Dec:
+				return x.Cmp(y) < 0, nil
+			default:
+				return invOp2(x, y, op)
+			}
+		case Enum, UUID:
+			return cmpOp(x, b, op)
 		case time.Duration:
 			switch y := b.(type) {
 			case time.Duration:
@@ -945,6 +1186,13 @@ func (o *binaryOperation) eval(execCtx *execCtx, ctx map[interface{}]interface{}
 			default:
 				return invOp2(x, y, op)
 			}
+		case json.RawMessage:
+			switch y := b.(type) {
+			case json.RawMessage:
+				return bytes.Compare(x, y) < 0, nil
+			default:
+				return invOp2(x, y, op)
+			}
 		default:
 			return invOp2(a, b, op)
 		}
@@ -1037,6 +1285,8 @@ func (o *binaryOperation) eval(execCtx *execCtx, ctx map[interface{}]interface{}
 			switch y := b.(type) {
 			case string:
 				return x <= y, nil
+			case Enum, UUID:
+				return cmpOp(x, y, op)
 			default:
 				return invOp2(x, y, op)
 			}
@@ -1082,6 +1332,15 @@ func (o *binaryOperation) eval(execCtx *execCtx, ctx map[interface{}]interface{}
 			default:
 				return invOp2(x, y, op)
 			}
+		case Dec:
+			switch y := b.(type) {
+			case Dec:
+				return x.Cmp(y) <= 0, nil
+			default:
+				return invOp2(x, y, op)
+			}
+		case Enum, UUID:
+			return cmpOp(x, b, op)
 		case time.Duration:
 			switch y := b.(type) {
 			case time.Duration:
@@ -1103,6 +1362,13 @@ func (o *binaryOperation) eval(execCtx *execCtx, ctx map[interface{}]interface{}
 			default:
 				return invOp2(x, y, op)
 			}
+		case json.RawMessage:
+			switch y := b.(type) {
+			case json.RawMessage:
+				return bytes.Compare(x, y) <= 0, nil
+			default:
+				return invOp2(x, y, op)
+			}
 		default:
 			return invOp2(a, b, op)
 		}
@@ -1195,6 +1461,8 @@ func (o *binaryOperation) eval(execCtx *execCtx, ctx map[interface{}]interface{}
 			switch y := b.(type) {
 			case string:
 				return x >= y, nil
+			case Enum, UUID:
+				return cmpOp(x, y, op)
 			default:
 				return invOp2(x, y, op)
 			}
@@ -1240,6 +1508,15 @@ func (o *binaryOperation) eval(execCtx *execCtx, ctx map[interface{}]interface{}
 			default:
 				return invOp2(x, y, op)
 			}
+		case Dec:
+			switch y := b.(type) {
+			case Dec:
+				return x.Cmp(y) >= 0, nil
+			default:
+				return invOp2(x, y, op)
+			}
+		case Enum, UUID:
+			return cmpOp(x, b, op)
 		case time.Duration:
 			switch y := b.(type) {
 			case time.Duration:
@@ -1261,6 +1538,13 @@ func (o *binaryOperation) eval(execCtx *execCtx, ctx map[interface{}]interface{}
 			default:
 				return invOp2(x, y, op)
 			}
+		case json.RawMessage:
+			switch y := b.(type) {
+			case json.RawMessage:
+				return bytes.Compare(x, y) >= 0, nil
+			default:
+				return invOp2(x, y, op)
+			}
 		default:
 			return invOp2(a, b, op)
 		}
@@ -1373,6 +1657,8 @@ func (o *binaryOperation) eval(execCtx *execCtx, ctx map[interface{}]interface{}
 			switch y := b.(type) {
 			case string:
 				return x != y, nil
+			case Enum, UUID:
+				return cmpOp(x, y, op)
 			default:
 				return invOp2(x, y, op)
 			}
@@ -1418,6 +1704,15 @@ func (o *binaryOperation) eval(execCtx *execCtx, ctx map[interface{}]interface{}
 			default:
 				return invOp2(x, y, op)
 			}
+		case Dec:
+			switch y := b.(type) {
+			case Dec:
+				return x.Cmp(y) != 0, nil
+			default:
+				return invOp2(x, y, op)
+			}
+		case Enum, UUID:
+			return cmpOp(x, b, op)
 		case time.Duration:
 			switch y := b.(type) {
 			case time.Duration:
@@ -1439,7 +1734,18 @@ func (o *binaryOperation) eval(execCtx *execCtx, ctx map[interface{}]interface{}
 			default:
 				return invOp2(x, y, op)
 			}
+		case json.RawMessage:
+			switch y := b.(type) {
+			case json.RawMessage:
+				return !bytes.Equal(x, y), nil
+			default:
+				return invOp2(x, y, op)
+			}
 		default:
+			if arrayElem(a) != 0 {
+				return arrayEq(a, b, op)
+			}
+
 			return invOp2(a, b, op)
 		}
 	case eq:
@@ -1551,6 +1857,8 @@ func (o *binaryOperation) eval(execCtx *execCtx, ctx map[interface{}]interface{}
 			switch y := b.(type) {
 			case string:
 				return x == y, nil
+			case Enum, UUID:
+				return cmpOp(x, y, op)
 			default:
 				return invOp2(x, y, op)
 			}
@@ -1596,6 +1904,15 @@ func (o *binaryOperation) eval(execCtx *execCtx, ctx map[interface{}]interface{}
 			default:
 				return invOp2(x, y, op)
 			}
+		case Dec:
+			switch y := b.(type) {
+			case Dec:
+				return x.Cmp(y) == 0, nil
+			default:
+				return invOp2(x, y, op)
+			}
+		case Enum, UUID:
+			return cmpOp(x, b, op)
 		case time.Duration:
 			switch y := b.(type) {
 			case time.Duration:
@@ -1617,7 +1934,18 @@ func (o *binaryOperation) eval(execCtx *execCtx, ctx map[interface{}]interface{}
 			default:
 				return invOp2(x, y, op)
 			}
+		case json.RawMessage:
+			switch y := b.(type) {
+			case json.RawMessage:
+				return bytes.Equal(x, y), nil
+			default:
+				return invOp2(x, y, op)
+			}
 		default:
+			if arrayElem(a) != 0 {
+				return arrayEq(a, b, op)
+			}
+
 			return invOp2(a, b, op)
 		}
 	case '+':
@@ -1771,6 +2099,13 @@ func (o *binaryOperation) eval(execCtx *execCtx, ctx map[interface{}]interface{}
 			default:
 				return invOp2(x, y, op)
 			}
+		case Dec:
+			switch y := b.(type) {
+			case Dec:
+				return decAdd(x, y), nil
+			default:
+				return invOp2(x, y, op)
+			}
 		case time.Duration:
 			switch y := b.(type) {
 			case time.Duration:
@@ -1936,6 +2271,13 @@ func (o *binaryOperation) eval(execCtx *execCtx, ctx map[interface{}]interface{}
 			default:
 				return invOp2(x, y, op)
 			}
+		case Dec:
+			switch y := b.(type) {
+			case Dec:
+				return decSub(x, y), nil
+			default:
+				return invOp2(x, y, op)
+			}
 		case time.Duration:
 			switch y := b.(type) {
 			case time.Duration:
@@ -2737,6 +3079,13 @@ func (o *binaryOperation) eval(execCtx *execCtx, ctx map[interface{}]interface{}
 			default:
 				return invOp2(x, y, op)
 			}
+		case Dec:
+			switch y := b.(type) {
+			case Dec:
+				return decRem(x, y)
+			default:
+				return invOp2(x, y, op)
+			}
 		case time.Duration:
 			switch y := b.(type) {
 			case time.Duration:
@@ -2901,6 +3250,13 @@ func (o *binaryOperation) eval(execCtx *execCtx, ctx map[interface{}]interface{}
 			default:
 				return invOp2(x, y, op)
 			}
+		case Dec:
+			switch y := b.(type) {
+			case Dec:
+				return decQuo(x, y)
+			default:
+				return invOp2(x, y, op)
+			}
 		case time.Duration:
 			switch y := b.(type) {
 			case time.Duration:
@@ -3057,6 +3413,13 @@ func (o *binaryOperation) eval(execCtx *execCtx, ctx map[interface{}]interface{}
 			default:
 				return invOp2(x, y, op)
 			}
+		case Dec:
+			switch y := b.(type) {
+			case Dec:
+				return decMul(x, y), nil
+			default:
+				return invOp2(x, y, op)
+			}
 		case time.Duration:
 			switch y := b.(type) {
 			case time.Duration:
@@ -3307,7 +3670,19 @@ func (l value) String() string {
 		return fmt.Sprintf("bigrat(%q)", l.val)
 	case *big.Int:
 		return fmt.Sprintf(`bigint("%v")`, l.val)
+	case json.RawMessage:
+		return fmt.Sprintf("json(%q)", x)
+	case Dec:
+		return fmt.Sprintf("decimal(%q)", x.String())
+	case Enum:
+		return fmt.Sprintf("%q", x.Label())
+	case UUID:
+		return fmt.Sprintf("uuid(%q)", x.String())
 	default:
+		if arrayElem(x) != 0 {
+			return arrayString(x)
+		}
+
 		return fmt.Sprintf("%v", l.val)
 	}
 }
@@ -3564,6 +3939,8 @@ func (u *unaryOperation) eval(execCtx *execCtx, ctx map[interface{}]interface{})
 		case *big.Rat:
 			var z big.Rat
 			return z.Set(x), nil
+		case Dec:
+			return x, nil
 		case time.Duration:
 			return x, nil
 		default:
@@ -3621,6 +3998,8 @@ func (u *unaryOperation) eval(execCtx *execCtx, ctx map[interface{}]interface{})
 		case *big.Rat:
 			var z big.Rat
 			return z.Neg(x), nil
+		case Dec:
+			return decNeg(x), nil
 		case time.Duration:
 			return -x, nil
 		default:
@@ -3634,11 +4013,15 @@ func (u *unaryOperation) eval(execCtx *execCtx, ctx map[interface{}]interface{})
 type call struct {
 	f   string
 	arg []expression
+	re  *regexp.Regexp // Compiled constant pattern of a regexp function.
 }
 
 func newCall(f string, arg []expression) (v expression, isAgg bool, err error) {
-	x := builtin[f]
-	if x.f == nil {
+	if strings.EqualFold(f, "match") {
+		f = "match" // MATCH is customarily written in upper case.
+	}
+	x, ok := findFunc(f)
+	if !ok {
 		return nil, false, fmt.Errorf("undefined: %s", f)
 	}
 
@@ -3666,21 +4049,46 @@ func newCall(f string, arg []expression) (v expression, isAgg bool, err error) {
 		c.arg = append(c.arg, value{eval})
 	}
 
+	if err := c.compileRegexp(); err != nil {
+		return nil, isAgg, err
+	}
+
 	return &c, isAgg, nil
 }
 
+// compileRegexp compiles the pattern of c if c calls regexpExtract,
+// regexpReplace or regexpSplit and the pattern, the second argument, is a
+// string constant.
+func (c *call) compileRegexp() (err error) {
+	if !strings.HasPrefix(c.f, "regexp") || c.re != nil {
+		return nil
+	}
+
+	if v, ok := c.arg[1].(value); ok {
+		if s, ok := v.val.(string); ok {
+			c.re, err = regexp.Compile(s)
+		}
+	}
+	return err
+}
+
 func (c *call) clone(arg []interface{}, unqualify ...string) (expression, error) {
 	list, err := cloneExpressionList(arg, c.arg)
 	if err != nil {
 		return nil, err
 	}
 
-	return &call{f: c.f, arg: list}, nil
+	r := &call{f: c.f, arg: list, re: c.re}
+	if err := r.compileRegexp(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
 }
 
 func (c *call) isStatic() bool {
-	v := builtin[c.f]
-	if v.f == nil || !v.isStatic {
+	v, ok := findFunc(c.f)
+	if !ok || !v.isStatic {
 		return false
 	}
 
@@ -3701,7 +4109,7 @@ func (c *call) String() string {
 }
 
 func (c *call) eval(execCtx *execCtx, ctx map[interface{}]interface{}) (v interface{}, err error) {
-	f, ok := builtin[c.f]
+	f, ok := findFunc(c.f)
 	if !ok {
 		return nil, fmt.Errorf("unknown function %s", c.f)
 	}
@@ -3810,11 +4218,11 @@ func newIndex(sv, xv expression) (v expression, err error) {
 			return value{nil}, nil
 		}
 
-		if s, fs = v.(string); !fs {
+		if s, fs = v.(string); !fs && arrayElem(v) == 0 {
 			return nil, invXOp(sv, xv)
 		}
 
-		x.expr = value{s}
+		x.expr = value{v}
 	}
 
 	if x.x.isStatic() {
@@ -3862,13 +4270,17 @@ func (x *indexOp) isStatic() bool {
 func (x *indexOp) String() string { return fmt.Sprintf("%s[%s]", x.expr, x.x) }
 
 func (x *indexOp) eval(execCtx *execCtx, ctx map[interface{}]interface{}) (v interface{}, err error) {
-	s0, err := x.expr.eval(execCtx, ctx)
+	s0, err := expand1(x.expr.eval(execCtx, ctx))
 	if err != nil {
 		return nil, runErr(err)
 	}
 
+	if s0 == nil {
+		return nil, nil
+	}
+
 	s, ok := s0.(string)
-	if !ok {
+	if !ok && arrayElem(s0) == 0 {
 		return nil, runErr(invXOp(s0, x.x))
 	}
 
@@ -3881,6 +4293,20 @@ func (x *indexOp) eval(execCtx *execCtx, ctx map[interface{}]interface{}) (v int
 		return nil, nil
 	}
 
+	if !ok {
+		a := arrayValues(s0)
+		i, err := indexExpr(nil, i0)
+		if err != nil {
+			return nil, runErr(err)
+		}
+
+		if i >= uint64(len(a)) {
+			return nil, runErr(fmt.Errorf("invalid array index %d (out of bounds for %d-element array)", i, len(a)))
+		}
+
+		return a[i], nil
+	}
+
 	i, err := indexExpr(&s, i0)
 	if err != nil {
 		return nil, runErr(err)
@@ -3968,7 +4394,7 @@ func (s *slice) clone(arg []interface{}, unqualify ...string) (expression, error
 }
 
 func (s *slice) eval(execCtx *execCtx, ctx map[interface{}]interface{}) (v interface{}, err error) {
-	s0, err := s.expr.eval(execCtx, ctx)
+	s0, err := expand1(s.expr.eval(execCtx, ctx))
 	if err != nil {
 		return
 	}
@@ -3979,6 +4405,10 @@ func (s *slice) eval(execCtx *execCtx, ctx map[interface{}]interface{}) (v inter
 
 	ss, ok := s0.(string)
 	if !ok {
+		if arrayElem(s0) != 0 {
+			return s.evalArray(execCtx, ctx, s0)
+		}
+
 		return nil, runErr(invSOp(s0))
 	}
 
@@ -11,6 +11,7 @@ package ql
 import (
 	"bytes"
 	"crypto/sha1"
+	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -43,6 +44,11 @@ type chunk struct { // expanded to blob types lazily
 }
 
 func (c chunk) expand() (v interface{}, err error) {
+	if l := c.f.latch; l != nil {
+		l.Lock()
+		defer l.Unlock()
+	}
+
 	return c.f.loadChunks(c.b)
 }
 
@@ -68,6 +74,19 @@ func expand(data []interface{}) (err error) {
 	return
 }
 
+// expand0 is like expand but the caller holds the latch of the files of the
+// chunks.
+func expand0(data []interface{}) (err error) {
+	for i, v := range data {
+		if c, ok := v.(chunk); ok {
+			if data[i], err = c.f.loadChunks(c.b); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
 // OpenFile returns a DB backed by a named file. The back end limits the size
 // of a record to about 64 kB.
 func OpenFile(name string, opt *Options) (db *DB, err error) {
@@ -248,6 +267,10 @@ func (it *fileBTreeIterator) Next() (k, v []interface{}, err error) {
 
 func enforce(val []interface{}, cols []*col) (err error) {
 	for i, v := range val {
+		if cols[i].typ < 0 { // Mixed types, decoded as stored.
+			continue
+		}
+
 		if val[i], err = convert(v, cols[i].typ); err != nil {
 			return
 		}
@@ -265,6 +288,7 @@ func infer(from []interface{}, to *[]*col) {
 	}
 	for i, c := range *to {
 		if f := from[i]; f != nil {
+			typ := c.typ
 			switch x := f.(type) {
 			//case nil:
 			case idealComplex:
@@ -320,6 +344,14 @@ func infer(from []interface{}, to *[]*col) {
 				c.typ = qTime
 			case time.Duration:
 				c.typ = qDuration
+			case json.RawMessage:
+				c.typ = qJSON
+			case Dec:
+				c.typ = qDecimal
+			case Enum:
+				c.typ = qEnum
+			case UUID:
+				c.typ = qUUID
 			case chunk:
 				vals, err := lldb.DecodeScalars(x.b)
 				if err != nil {
@@ -338,7 +370,14 @@ func infer(from []interface{}, to *[]*col) {
 				c.typ = int(i)
 			case map[string]interface{}: // map of ids of a cross join
 			default:
-				panic("internal error 042")
+				if c.elem = arrayElem(x); c.elem == 0 {
+					panic("internal error 042")
+				}
+
+				c.typ = qArray
+			}
+			if typ < 0 || typ != 0 && typ != c.typ { // Eg. jsonExtract values.
+				c.typ = -1
 			}
 		}
 	}
@@ -440,6 +479,7 @@ type file struct {
 	f              lldb.Filer
 	f0             lldb.OSFile
 	id             int64
+	latch          sync.Locker // Held by callers of the methods of s, see chunk.expand.
 	lck            io.Closer
 	mu             sync.Mutex
 	name           string
@@ -760,7 +800,7 @@ func (s *file) Commit() (err error) {
 }
 
 func (s *file) Create(data ...interface{}) (h int64, err error) {
-	if err = expand(data); err != nil {
+	if err = expand0(data); err != nil {
 		return
 	}
 
@@ -796,6 +836,28 @@ func (s *file) ID() (int64, error) {
 	defer s.lock()()
 
 	s.id++
+	return s.id, s.writeID()
+}
+
+func (s *file) SetID(id int64) error {
+	defer s.lock()()
+
+	s.id = id
+	return s.writeID()
+}
+
+func (s *file) UseID(id int64) (bool, error) {
+	defer s.lock()()
+
+	if id <= s.id {
+		return false, nil
+	}
+
+	s.id = id
+	return true, s.writeID()
+}
+
+func (s *file) writeID() error {
 	b := make([]byte, 8)
 	id := s.id
 	for i := 7; i >= 0; i-- {
@@ -803,7 +865,7 @@ func (s *file) ID() (int64, error) {
 		id >>= 8
 	}
 
-	return s.id, s.a.Realloc(2, b)
+	return s.a.Realloc(2, b)
 }
 
 func (s *file) free(h int64, blobCols []*col) (err error) {
@@ -879,13 +941,17 @@ func (s *file) Read(dst []interface{}, h int64, cols ...*col) (data []interface{
 		case qUint32:
 			rec[i] = uint32(rec[i].(uint64))
 		case qUint64:
-		case qBlob, qBigInt, qBigRat, qTime, qDuration:
+		case qBlob, qBigInt, qBigRat, qTime, qDuration, qJSON, qDecimal, qUUID, qArray:
 			switch x := rec[i].(type) {
 			case []byte:
 				rec[i] = chunk{f: s, b: x}
 			default:
 				return nil, fmt.Errorf("(file-006) corrupted DB: non nil chunk type is not []byte")
 			}
+		case qEnum: // Ordinal of a table record or a chunk of a temporary one.
+			if x, ok := rec[i].([]byte); ok {
+				rec[i] = chunk{f: s, b: x}
+			}
 		default:
 			panic("internal error 045")
 		}
@@ -1031,7 +1097,7 @@ func (s *file) UpdateRow(h int64, blobCols []*col, data ...interface{}) (err err
 		return s.Update(h, data...)
 	}
 
-	if err = expand(data); err != nil {
+	if err = expand0(data); err != nil {
 		return
 	}
 
@@ -1081,8 +1147,25 @@ func (s *file) flatten(data []interface{}) (err error) {
 		case time.Duration:
 			tag = qDuration
 			b, err = s.codec.encode(x)
+		case json.RawMessage:
+			tag = qJSON
+			b = x
+		case Dec:
+			tag = qDecimal
+			b = x.bytes()
+		case Enum:
+			tag = qEnum
+			b = x.bytes()
+		case UUID:
+			tag = qUUID
+			b = x[:]
 		default:
-			continue
+			if arrayElem(x) == 0 {
+				continue
+			}
+
+			tag = qArray
+			b, err = arrayBytes(x)
 		}
 		if err != nil {
 			return
@@ -1181,9 +1264,13 @@ func isIndexNull(data []interface{}) bool {
 // the value stored in the record.
 func (x *fileIndex) Create(indexedValues []interface{}, h int64) error {
 	for i, indexedValue := range indexedValues {
-		chunk, ok := indexedValue.(chunk)
-		if ok {
-			indexedValues[i] = chunk.b
+		switch v := indexedValue.(type) {
+		case chunk:
+			indexedValues[i] = v.b
+		case Enum: // The record holds the ordinal, see compactEnums.
+			if err := x.flatten(indexedValues[i : i+1]); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -1227,9 +1314,13 @@ func (x *fileIndex) Create(indexedValues []interface{}, h int64) error {
 
 func (x *fileIndex) Delete(indexedValues []interface{}, h int64) error {
 	for i, indexedValue := range indexedValues {
-		chunk, ok := indexedValue.(chunk)
-		if ok {
-			indexedValues[i] = chunk.b
+		switch v := indexedValue.(type) {
+		case chunk:
+			indexedValues[i] = v.b
+		case Enum: // The record holds the ordinal, see compactEnums.
+			if err := x.flatten(indexedValues[i : i+1]); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -1280,8 +1371,25 @@ func (x *fileIndex) flatten(data []interface{}) (err error) {
 		case time.Duration:
 			tag = qDuration
 			b, err = x.codec.encode(xx)
+		case json.RawMessage:
+			tag = qJSON
+			b = xx
+		case Dec:
+			tag = qDecimal
+			b = xx.bytes()
+		case Enum:
+			tag = qEnum
+			b = xx.bytes()
+		case UUID:
+			tag = qUUID
+			b = xx[:]
 		default:
-			continue
+			if arrayElem(xx) == 0 {
+				continue
+			}
+
+			tag = qArray
+			b, err = arrayBytes(xx)
 		}
 		if err != nil {
 			return
@@ -1346,7 +1454,7 @@ func (i *fileIndexIterator) nextPrev(f func() ([]byte, []byte, error)) ([]interf
 	b, ok := dk[0].([]byte)
 	if ok {
 		dk[0] = chunk{i.f, b}
-		if expand(dk[:1]); err != nil {
+		if expand0(dk[:1]); err != nil {
 			return nil, -1, err
 		}
 	}
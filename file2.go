@@ -434,6 +434,30 @@ func (s *storage2) ResetID() (err error) {
 	return nil
 }
 
+func (s *storage2) SetID(id int64) error {
+	if s.dbs.txLevel == 0 {
+		return fmt.Errorf("%T.SetID: not in transaction", s)
+	}
+
+	s.id = id
+	s.idDirty = true
+	return nil
+}
+
+func (s *storage2) UseID(id int64) (bool, error) {
+	if s.dbs.txLevel == 0 {
+		return false, fmt.Errorf("%T.UseID: not in transaction", s)
+	}
+
+	if id <= s.id {
+		return false, nil
+	}
+
+	s.id = id
+	s.idDirty = true
+	return true, nil
+}
+
 func (s *storage2) Rollback() (err error) {
 	if s.dbs.txLevel == 0 {
 		return fmt.Errorf("%T.Rollback: not in transaction", s)
@@ -0,0 +1,582 @@
+// Copyright 2026 The ql Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ql
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// Tokenizer splits text into the terms stored in full-text indices and
+// searched for by MATCH.
+type Tokenizer interface {
+	// Tokens returns the terms of text in the order of appearance.
+	Tokens(text string) []string
+}
+
+// Stemmer reduces the terms produced by a Tokenizer to their stems, so that
+// for example "connected" and "connecting" are indexed and searched for as
+// the same term.
+type Stemmer interface {
+	Stem(term string) string
+}
+
+// SimpleTokenizer is the default tokenizer of full-text indices, registered
+// as "simple". It splits text into runs of letters and digits and converts
+// them to lower case.
+type SimpleTokenizer struct{}
+
+// Tokens implements Tokenizer.
+func (SimpleTokenizer) Tokens(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+type tokenizer struct {
+	t Tokenizer
+	s Stemmer
+}
+
+var (
+	tokenizersMu sync.RWMutex
+	tokenizers   = map[string]*tokenizer{"simple": {t: SimpleTokenizer{}}}
+)
+
+// RegisterTokenizer makes the tokenizer t available to full-text indices and
+// MATCH under name. If s is not nil, the terms produced by t are replaced by
+// their stems. The name must be an identifier and it cannot be registered
+// more than once.
+//
+// The name of the tokenizer of a full-text index is stored in the DB. The
+// tokenizer must be registered before the index is used, and its results must
+// not change, otherwise the index does not match the indexed values.
+//
+// RegisterTokenizer is safe for concurrent use by multiple goroutines.
+func RegisterTokenizer(name string, t Tokenizer, s Stemmer) error {
+	if t == nil {
+		return fmt.Errorf("RegisterTokenizer: nil tokenizer %s", name)
+	}
+
+	if !isIdent(name) {
+		return fmt.Errorf("RegisterTokenizer: invalid name %q", name)
+	}
+
+	tokenizersMu.Lock()
+	defer tokenizersMu.Unlock()
+
+	if _, ok := tokenizers[name]; ok {
+		return fmt.Errorf("RegisterTokenizer: tokenizer %s already registered", name)
+	}
+
+	tokenizers[name] = &tokenizer{t, s}
+	return nil
+}
+
+func isIdent(s string) bool {
+	for i, c := range s {
+		if c != '_' && !unicode.IsLetter(c) && (i == 0 || !unicode.IsDigit(c)) {
+			return false
+		}
+	}
+	return s != ""
+}
+
+func findTokenizer(name string) (*tokenizer, error) {
+	tokenizersMu.RLock()
+	t := tokenizers[name]
+	tokenizersMu.RUnlock()
+	if t == nil {
+		return nil, fmt.Errorf("unknown tokenizer %s", name)
+	}
+
+	return t, nil
+}
+
+// usingTokenizer returns the USING clause of a full-text index having the
+// tokenizer name.
+func usingTokenizer(name string) string {
+	if name == "simple" {
+		return ""
+	}
+
+	return " USING " + name
+}
+
+// terms returns the non empty, possibly stemmed, terms of text.
+func (t *tokenizer) terms(text string) []string {
+	a := t.t.Tokens(text)
+	r := a[:0:0]
+	for _, v := range a {
+		if t.s != nil {
+			v = t.s.Stem(v)
+		}
+		if v != "" {
+			r = append(r, v)
+		}
+	}
+	return r
+}
+
+// fullTextKeys returns the keys of a full-text index for the values vlist of
+// its columns: {int64(i), term} for every distinct term of the i-th value.
+func fullTextKeys(name string, vlist []interface{}) ([][]interface{}, error) {
+	t, err := findTokenizer(name)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys [][]interface{}
+	for i, v := range vlist {
+		s, ok := v.(string)
+		if !ok {
+			continue // NULL
+		}
+
+		m := map[string]bool{}
+		for _, term := range t.terms(s) {
+			if !m[term] {
+				m[term] = true
+				keys = append(keys, []interface{}{int64(i), term})
+			}
+		}
+	}
+	return keys, nil
+}
+
+const (
+	ftTerms = iota
+	ftAnd
+	ftOr
+)
+
+// ftQuery is a node of a parsed full-text query. A ftTerms node matches the
+// values having its terms in sequence, a phrase if there is more than one.
+type ftQuery struct {
+	op    int
+	terms []string   // ftTerms.
+	list  []*ftQuery // ftAnd, ftOr.
+}
+
+// parseFullTextQuery parses the full-text query s. Words and phrases in
+// double quotes are split into terms by the tokenizer name.
+//
+//	query  = and { "OR" and } .
+//	and    = primary { [ "AND" ] primary } .
+//	primary = word | `"` phrase `"` | "(" query ")" .
+func parseFullTextQuery(name, s string) (*ftQuery, error) {
+	t, err := findTokenizer(name)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &ftParser{src: s, t: t}
+	p.next()
+	q, err := p.or()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.tok != "" {
+		return nil, fmt.Errorf("invalid full-text query %q: unexpected %s", s, p.tok)
+	}
+
+	if q == nil {
+		return nil, fmt.Errorf("invalid full-text query %q: no terms", s)
+	}
+
+	return q, nil
+}
+
+type ftParser struct {
+	src string
+	off int
+	t   *tokenizer
+	tok string // "(", ")", `"phrase`, "AND", "OR" or a word. Empty at EOF.
+}
+
+func (p *ftParser) next() {
+	s := p.src
+	for p.off < len(s) && s[p.off] <= ' ' {
+		p.off++
+	}
+	if p.off == len(s) {
+		p.tok = ""
+		return
+	}
+
+	i := p.off
+	switch s[i] {
+	case '(', ')':
+		p.off++
+	case '"':
+		p.off++
+		for p.off < len(s) && s[p.off] != '"' {
+			p.off++
+		}
+		p.tok = s[i:p.off]
+		if p.off < len(s) {
+			p.off++
+		}
+		return
+	default:
+		for p.off < len(s) && s[p.off] > ' ' && !strings.ContainsRune(`()"`, rune(s[p.off])) {
+			p.off++
+		}
+	}
+	p.tok = s[i:p.off]
+}
+
+func (p *ftParser) or() (*ftQuery, error) {
+	var list []*ftQuery
+	for {
+		q, err := p.and()
+		if err != nil {
+			return nil, err
+		}
+
+		if q != nil {
+			list = append(list, q)
+		}
+		if p.tok != "OR" {
+			return newFtQuery(ftOr, list), nil
+		}
+
+		p.next()
+	}
+}
+
+func (p *ftParser) and() (*ftQuery, error) {
+	var list []*ftQuery
+	for {
+		switch p.tok {
+		case "", ")", "OR":
+			return newFtQuery(ftAnd, list), nil
+		case "AND":
+			p.next()
+		}
+
+		q, err := p.primary()
+		if err != nil {
+			return nil, err
+		}
+
+		if q != nil {
+			list = append(list, q)
+		}
+	}
+}
+
+func (p *ftParser) primary() (*ftQuery, error) {
+	switch tok := p.tok; {
+	case tok == "" || tok == ")" || tok == "AND" || tok == "OR":
+		return nil, fmt.Errorf("invalid full-text query %q: unexpected %s", p.src, p.tokStr())
+	case tok == "(":
+		p.next()
+		q, err := p.or()
+		if err != nil {
+			return nil, err
+		}
+
+		if p.tok != ")" {
+			return nil, fmt.Errorf("invalid full-text query %q: missing )", p.src)
+		}
+
+		p.next()
+		return q, nil
+	default:
+		p.next()
+		if tok[0] == '"' {
+			tok = tok[1:]
+		}
+		if terms := p.t.terms(tok); len(terms) != 0 {
+			return &ftQuery{op: ftTerms, terms: terms}, nil
+		}
+
+		return nil, nil // Eg. only punctuation.
+	}
+}
+
+func (p *ftParser) tokStr() string {
+	if p.tok == "" {
+		return "end of query"
+	}
+
+	return p.tok
+}
+
+func newFtQuery(op int, list []*ftQuery) *ftQuery {
+	switch len(list) {
+	case 0:
+		return nil
+	case 1:
+		return list[0]
+	default:
+		return &ftQuery{op: op, list: list}
+	}
+}
+
+// hasPhrase reports whether q has a phrase.
+func (q *ftQuery) hasPhrase() bool {
+	if q.op == ftTerms {
+		return len(q.terms) > 1
+	}
+
+	for _, v := range q.list {
+		if v.hasPhrase() {
+			return true
+		}
+	}
+	return false
+}
+
+// ftDoc is a tokenized value.
+type ftDoc struct {
+	n   int              // Number of terms.
+	pos map[string][]int // Term: positions.
+}
+
+func newFtDoc(terms []string) *ftDoc {
+	d := &ftDoc{len(terms), map[string][]int{}}
+	for i, v := range terms {
+		d.pos[v] = append(d.pos[v], i)
+	}
+	return d
+}
+
+// count returns the number of occurrences of the sequence terms in d.
+func (d *ftDoc) count(terms []string) (n int) {
+outer:
+	for _, i := range d.pos[terms[0]] {
+		for j, v := range terms[1:] {
+			if !d.has(v, i+j+1) {
+				continue outer
+			}
+		}
+		n++
+	}
+	return n
+}
+
+func (d *ftDoc) has(term string, pos int) bool {
+	a := d.pos[term]
+	i := sort.SearchInts(a, pos)
+	return i < len(a) && a[i] == pos
+}
+
+// match reports whether q matches d and the number of occurrences of the
+// terms and phrases of q in d.
+func (q *ftQuery) match(d *ftDoc) (bool, int) {
+	switch q.op {
+	case ftTerms:
+		n := d.count(q.terms)
+		return n != 0, n
+	case ftAnd:
+		var n int
+		for _, v := range q.list {
+			ok, m := v.match(d)
+			if !ok {
+				return false, 0
+			}
+
+			n += m
+		}
+		return true, n
+	default: // ftOr
+		var r bool
+		var n int
+		for _, v := range q.list {
+			ok, m := v.match(d)
+			r = r || ok
+			n += m
+		}
+		return r, n
+	}
+}
+
+// fullTextMatch reports whether the value s matches the full-text query using
+// the tokenizer name and the rank of s.
+func fullTextMatch(s, query, name string) (bool, float64, error) {
+	q, err := parseFullTextQuery(name, query)
+	if err != nil {
+		return false, 0, err
+	}
+
+	t, _ := findTokenizer(name)
+	d := newFtDoc(t.terms(s))
+	ok, n := q.match(d)
+	if !ok {
+		return false, 0, nil
+	}
+
+	return true, float64(n) / math.Sqrt(float64(d.n)), nil
+}
+
+// handles returns the handles of the records having the terms of q in the
+// postings returned by f. The phrases of q are not checked.
+func (q *ftQuery) handles(f func(term string) (map[int64]bool, error)) (map[int64]bool, error) {
+	var r map[int64]bool
+	switch q.op {
+	case ftTerms:
+		for _, v := range q.terms {
+			m, err := f(v)
+			if err != nil {
+				return nil, err
+			}
+
+			r = intersectHandles(r, m)
+		}
+	case ftAnd:
+		for _, v := range q.list {
+			m, err := v.handles(f)
+			if err != nil {
+				return nil, err
+			}
+
+			r = intersectHandles(r, m)
+		}
+	default: // ftOr
+		r = map[int64]bool{}
+		for _, v := range q.list {
+			m, err := v.handles(f)
+			if err != nil {
+				return nil, err
+			}
+
+			for h := range m {
+				r[h] = true
+			}
+		}
+	}
+	return r, nil
+}
+
+// intersectHandles returns the intersection of a and b. Nil a stands for the
+// set of all handles.
+func intersectHandles(a, b map[int64]bool) map[int64]bool {
+	if a == nil {
+		return b
+	}
+
+	for h := range a {
+		if !b[h] {
+			delete(a, h)
+		}
+	}
+	return a
+}
+
+// bindRank sets the arguments of the rank() calls in the fields and in the
+// ORDER BY clause of s to those of the MATCH predicate of its WHERE clause.
+func (s *selectStmt) bindRank() error {
+	var ranks []*call
+	f := func(e expression) {
+		if c, ok := e.(*call); ok && c.f == "rank" && len(c.arg) == 0 {
+			ranks = append(ranks, c)
+		}
+	}
+	for _, v := range s.flds {
+		visitExpression(v.expr, f)
+	}
+	if o := s.order; o != nil {
+		for _, v := range o.by {
+			visitExpression(v, f)
+		}
+	}
+	if len(ranks) == 0 {
+		return nil
+	}
+
+	var matches []*call
+	if w := s.where; w != nil && w.expr != nil {
+		visitExpression(w.expr, func(e expression) {
+			if c, ok := e.(*call); ok && c.f == "match" {
+				matches = append(matches, c)
+			}
+		})
+	}
+	switch len(matches) {
+	case 0:
+		return fmt.Errorf("rank() requires a MATCH predicate in the WHERE clause")
+	case 1:
+		for _, v := range ranks {
+			v.arg = matches[0].arg
+		}
+		return nil
+	default:
+		return fmt.Errorf("rank() is ambiguous with more than one MATCH predicate in the WHERE clause")
+	}
+}
+
+// rankFields returns flds followed by the columns mentioned in the rank()
+// calls of the ORDER BY expressions by, which flds doesn't name. ORDER BY
+// refers to the fields of the result, these are selected only for ordering.
+func rankFields(flds []*fld, by []expression) []*fld {
+	m := map[string]struct{}{}
+	for _, v := range by {
+		visitExpression(v, func(e expression) {
+			if c, ok := e.(*call); ok && c.f == "rank" {
+				mentionedColumns0(c, true, true, m)
+			}
+		})
+	}
+	for _, v := range flds {
+		delete(m, v.name)
+	}
+	if len(m) == 0 {
+		return flds
+	}
+
+	var a []string
+	for k := range m {
+		a = append(a, k)
+	}
+	sort.Strings(a)
+	r := append([]*fld(nil), flds...)
+	for _, v := range a {
+		r = append(r, &fld{&ident{v}, v})
+	}
+	return r
+}
+
+// fullTextTokenizers returns the tokenizers of the full-text indices of the
+// DB by index name.
+func (db *DB) fullTextTokenizers() (map[string]string, error) {
+	m := map[string]string{}
+	if _, ok := db.root.tables["__FullText"]; !ok {
+		return m, nil
+	}
+
+	ctx := newExecCtx(db, nil)
+	rs, err := selectFullText.l[0].exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := rs.(recordset).do(ctx, func(id interface{}, data []interface{}) (bool, error) {
+		m[data[0].(string)] = data[1].(string)
+		return true, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+func (db *DB) insertFullText(tableName, indexName, tokenizer string) error {
+	ctx := newExecCtx(db, []interface{}{tableName, indexName, tokenizer})
+	for _, s := range createFullText.l {
+		if _, err := s.exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	_, err := insertFullText.l[0].exec(ctx)
+	return err
+}
@@ -0,0 +1,182 @@
+// Copyright 2026 The ql Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ql
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Func is a scalar function registered by RegisterFunc. It is passed the
+// values of the arguments of a call, NULL is nil, and returns the value of the
+// call. The arguments are of the types documented for the values passed to
+// DB.Execute, untyped constants are passed as int64, uint64, float64 or
+// complex128. Func must not modify them.
+//
+// The result must be nil or of a type which may be passed to DB.Execute. Go
+// int and uint results are converted to int64 and uint64.
+//
+// Func must be safe for concurrent use by multiple goroutines. Statements may
+// be executed concurrently and the calls of a deterministic function may be
+// evaluated by several goroutines of one query, see DB.SetParallelism.
+type Func func(arg []interface{}) (interface{}, error)
+
+// FuncOptions are the options of a function registered by RegisterFunc.
+type FuncOptions struct {
+	MinArgs int // Minimum number of arguments.
+	MaxArgs int // Maximum number of arguments, -1 means no limit.
+
+	// A deterministic function returns the same result for the same
+	// arguments. Calls of a deterministic function having constant
+	// arguments are evaluated only once, when the statement is compiled,
+	// and the function can be used in index expressions.
+	Deterministic bool
+}
+
+var (
+	funcsMu sync.RWMutex
+	funcs   = map[string]builtinFunc{}
+)
+
+// RegisterFunc makes f callable in expressions under name. The name must be
+// an identifier, not a keyword or a type name, and it cannot be the name of a
+// built-in function or be registered more than once. Names of functions are
+// case sensitive, except for MATCH which is not accepted in any case.
+//
+// Statements and index expressions calling f are checked when they are
+// compiled. The function must be registered before, in particular before
+// opening a DB having an index using it, and its results must not change,
+// otherwise the index does not match the indexed values.
+//
+// The registered functions are global, they are available to every DB
+// including those opened by the database/sql drivers.
+//
+// RegisterFunc is safe for concurrent use by multiple goroutines.
+func RegisterFunc(name string, f Func, opts FuncOptions) error {
+	if f == nil {
+		return fmt.Errorf("RegisterFunc: nil function %s", name)
+	}
+
+	if !isFuncName(name) {
+		return fmt.Errorf("RegisterFunc: invalid name %q", name)
+	}
+
+	min, max := opts.MinArgs, opts.MaxArgs
+	if max < 0 {
+		max = math.MaxInt32
+	}
+	if min < 0 || max < min {
+		return fmt.Errorf("RegisterFunc: invalid number of arguments of %s: [%d, %d]", name, opts.MinArgs, opts.MaxArgs)
+	}
+
+	if _, ok := builtin[name]; ok {
+		return fmt.Errorf("RegisterFunc: %s is a built-in function", name)
+	}
+
+	funcsMu.Lock()
+	defer funcsMu.Unlock()
+
+	if _, ok := funcs[name]; ok {
+		return fmt.Errorf("RegisterFunc: function %s already registered", name)
+	}
+
+	funcs[name] = builtinFunc{
+		f: func(arg []interface{}, _ map[interface{}]interface{}) (interface{}, error) {
+			for i, v := range arg {
+				arg[i] = ideal(v)
+			}
+			v, err := f(arg)
+			if err != nil {
+				return nil, err
+			}
+
+			return funcResult(name, v)
+		},
+		minArgs:  min,
+		maxArgs:  max,
+		isStatic: opts.Deterministic,
+	}
+	return nil
+}
+
+// isFuncName reports whether name is an identifier which is not a keyword, a
+// type name or MATCH, which is parsed as the built-in function in any case.
+func isFuncName(name string) bool {
+	if !isIdent(name) || strings.EqualFold(name, "match") {
+		return false
+	}
+
+	lx, err := newLexer(name)
+	if err != nil {
+		return false
+	}
+
+	var lval yySymType
+	return lx.Lex(&lval) == identifier && lx.Lex(&lval) == 0
+}
+
+// findFunc returns the built-in or registered function name.
+func findFunc(name string) (builtinFunc, bool) {
+	if f, ok := builtin[name]; ok {
+		return f, true
+	}
+
+	funcsMu.RLock()
+	f, ok := funcs[name]
+	funcsMu.RUnlock()
+	return f, ok
+}
+
+// funcResult returns the result v of the registered function fn as a QL
+// value.
+func funcResult(fn string, v interface{}) (interface{}, error) {
+	switch x := v.(type) {
+	case nil, bool, complex64, complex128, float32, float64, string,
+		int8, int16, int32, int64,
+		uint8, uint16, uint32, uint64,
+		*big.Int, *big.Rat, []byte, time.Duration, time.Time, Dec, Enum, UUID:
+		return v, nil
+	case int:
+		return int64(x), nil
+	case uint:
+		return uint64(x), nil
+	case big.Int:
+		return &x, nil
+	case big.Rat:
+		return &x, nil
+	case json.RawMessage:
+		return jsonValue(x)
+	default:
+		if arrayElem(v) != 0 {
+			return cloneArray(v), nil
+		}
+
+		return nil, fmt.Errorf("%s: unsupported result type %T", fn, v)
+	}
+}
+
+// checkIndexFuncs returns an error if the index expression e calls a
+// registered function which is not deterministic.
+func checkIndexFuncs(e expression) (err error) {
+	visitExpression(e, func(e expression) {
+		x, ok := e.(*call)
+		if !ok || err != nil {
+			return
+		}
+
+		funcsMu.RLock()
+		f, ok := funcs[x.f]
+		funcsMu.RUnlock()
+		if ok && !f.isStatic {
+			err = fmt.Errorf("function %s is not deterministic", x.f)
+		}
+	})
+	return err
+}
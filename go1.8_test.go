@@ -6,6 +6,7 @@ import (
 	"context"
 	"database/sql"
 	"testing"
+	"time"
 )
 
 func TestMultiResultSet(t *testing.T) {
@@ -154,3 +155,147 @@ func TestNamedArgs(t *testing.T) {
 		t.Errorf("expected 1 got %d", n)
 	}
 }
+
+func TestDriverContext(t *testing.T) {
+	RegisterMemDriver()
+	db, err := sql.Open("ql-mem", "TestDriverContext")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	tx, err := db.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := tx.Exec("create table t (i int); insert into t values (1), (2), (3);"); err != nil {
+		t.Fatal(err)
+	}
+
+	// Another transaction is pending.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := db.BeginTx(ctx, nil); err != context.DeadlineExceeded {
+		t.Fatalf("got %v, exp %v", err, context.DeadlineExceeded)
+	}
+
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := db.BeginTx(context.Background(), &sql.TxOptions{Isolation: sql.LevelReadUncommitted}); err == nil {
+		t.Fatal("unexpected success")
+	}
+
+	ctx, cancel = context.WithCancel(context.Background())
+	rows, err := db.QueryContext(ctx, "select i from t;")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cancel()
+	for rows.Next() {
+	}
+	if err := rows.Err(); err != context.Canceled {
+		t.Fatalf("got %v, exp %v", err, context.Canceled)
+	}
+
+	stmt, err := db.Prepare("select i from t;")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer stmt.Close()
+	if _, err := stmt.QueryContext(ctx); err != context.Canceled {
+		t.Fatalf("got %v, exp %v", err, context.Canceled)
+	}
+}
+
+func TestDriverReadOnlyTx(t *testing.T) {
+	RegisterMemDriver()
+	db, err := sql.Open("ql-mem", "TestDriverReadOnlyTx")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	tx, err := db.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := tx.Exec("create table t (i int); insert into t values (1), (2), (3);"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	ro, err := db.BeginTx(context.Background(), &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sum := func(q interface {
+		QueryRow(string, ...interface{}) *sql.Row
+	}) int64 {
+		var n int64
+		if err := q.QueryRow("select sum(i) from t;").Scan(&n); err != nil {
+			t.Fatal(err)
+		}
+
+		return n
+	}
+
+	if g, e := sum(ro), int64(6); g != e {
+		t.Fatalf("got %v, exp %v", g, e)
+	}
+
+	// The read-only transaction does not block the writer.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	tx, err = db.BeginTx(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := tx.Exec("delete from t where i == 1; insert into t values (10);"); err != nil {
+		t.Fatal(err)
+	}
+
+	if g, e := sum(ro), int64(6); g != e {
+		t.Fatalf("got %v, exp %v", g, e)
+	}
+
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	if g, e := sum(ro), int64(6); g != e {
+		t.Fatalf("got %v, exp %v", g, e)
+	}
+
+	if g, e := sum(db), int64(15); g != e {
+		t.Fatalf("got %v, exp %v", g, e)
+	}
+
+	for _, s := range []string{
+		"insert into t values (100);",
+		"begin transaction;",
+		"commit;",
+	} {
+		if _, err := ro.Exec(s); err == nil {
+			t.Fatalf("%s: unexpected success", s)
+		}
+	}
+
+	if err := ro.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := ro.Query("select * from t;"); err != sql.ErrTxDone {
+		t.Fatalf("got %v, exp %v", err, sql.ErrTxDone)
+	}
+}
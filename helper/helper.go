@@ -42,6 +42,7 @@ const (
 	qBigRat
 	qTime
 	qDuration
+	qDecimal
 
 	qEnd
 )
@@ -96,6 +97,8 @@ func (n t) String() string {
 		return "time.Time"
 	case qDuration:
 		return "time.Duration"
+	case qDecimal:
+		return "Dec"
 	default:
 		panic("internal error 046")
 	}
@@ -122,6 +125,8 @@ func coerceIdealFloat(typ t) string {
 		return fmt.Sprintf("return %s(float64(x))\n", typ)
 	case qBigRat:
 		return fmt.Sprintf("return big.NewRat(1, 1).SetFloat64(float64(x))\n")
+	case qDecimal:
+		return fmt.Sprintf("if d, ok := decFloat(float64(x), 64); ok { return d }\n")
 	default:
 		return ""
 	}
@@ -160,6 +165,8 @@ func coerceIdealInt(typ t) string {
 		return fmt.Sprintf("return big.NewRat(1, 1).SetInt64(int64(x))\n")
 	case qDuration:
 		return fmt.Sprintf("return time.Duration(int64(x))\n")
+	case qDecimal:
+		return fmt.Sprintf("return Dec{big.NewInt(int64(x)), 0}\n")
 	default:
 		return ""
 	}
@@ -182,6 +189,8 @@ func coerceIdealRune(typ t) string {
 		return fmt.Sprintf("return big.NewRat(1, 1).SetInt64(int64(x))\n")
 	case qDuration:
 		return fmt.Sprintf("return time.Duration(int64(x))\n")
+	case qDecimal:
+		return fmt.Sprintf("return Dec{big.NewInt(int64(x)), 0}\n")
 	default:
 		return ""
 	}
@@ -220,6 +229,8 @@ func coerceIdealUint(typ t) string {
 		return fmt.Sprintf("return big.NewRat(1, 1).SetInt(big.NewInt(0).SetUint64(uint64(x)))\n")
 	case qDuration:
 		return fmt.Sprintf("if x <= math.MaxInt64 { return time.Duration(int64(x)) }\n")
+	case qDecimal:
+		return fmt.Sprintf("return Dec{big.NewInt(0).SetUint64(uint64(x)), 0}\n")
 	default:
 		return ""
 	}
@@ -37,6 +37,7 @@ type StructIndex struct {
 
 // StructField describes a considered field of a struct type.
 type StructField struct {
+	Elem          Type              // QL element type of an Array field.
 	Index         int               // Index is the index of the field for reflect.Value.Field.
 	IsID          bool              // Whether the field corresponds to record id().
 	IsPtr         bool              // Whether the field is a pointer type.
@@ -165,6 +166,10 @@ func StructSchema(v interface{}) (*StructInfo, error) {
 			fk = sf.ReflectType.Kind()
 		}
 
+		if _, ok := tags["enum"]; ok && fk != reflect.String {
+			return nil, fmt.Errorf("enum QL struct tag on a field of type %s (%v)", sf.ReflectType.Name(), fk)
+		}
+
 		switch fk {
 		case reflect.Bool:
 			sf.Type = Bool
@@ -239,9 +244,35 @@ func StructSchema(v interface{}) (*StructInfo, error) {
 				return nil, err
 			}
 		case reflect.Slice:
-			sf.Type = Blob
-			if err := sf.check([]byte(nil)); err != nil {
-				return nil, err
+			if sf.ReflectType.Elem().Kind() == reflect.Uint8 {
+				sf.Type = Blob
+				if err := sf.check([]byte(nil)); err != nil {
+					return nil, err
+				}
+
+				break
+			}
+
+			for elem, t := range arrayTypes {
+				if sf.ReflectType.ConvertibleTo(t) {
+					sf.Type, sf.Elem = Array, Type(elem)
+					if err := sf.check(reflect.Zero(t).Interface()); err != nil {
+						return nil, err
+					}
+
+					break
+				}
+			}
+		case reflect.Array:
+			if sf.ReflectType.Len() == len(UUID{}) && sf.ReflectType.Elem().Kind() == reflect.Uint8 {
+				sf.Type = UUIDType
+				if err := sf.check(UUID{}); err != nil {
+					return nil, err
+				}
+
+				if t := reflect.TypeOf(UUID{}); sf.ReflectType != t {
+					sf.MarshalType = t // [16]byte is assignable to UUID.
+				}
 			}
 		case reflect.Struct:
 			switch sf.ReflectType.PkgPath() {
@@ -260,6 +291,9 @@ func StructSchema(v interface{}) (*StructInfo, error) {
 			}
 		case reflect.String:
 			sf.Type = String
+			if tags["enum"] != "" {
+				sf.Type = EnumType
+			}
 			if err := sf.check(""); err != nil {
 				return nil, err
 			}
@@ -327,8 +361,12 @@ var zeroSchemaOptions SchemaOptions
 // ID, having type int64, corresponds to id() - and is thus not a part of the
 // CREATE statement. A field QL tag containing "index name" or "uindex name"
 // triggers additionally creating an index or unique index on the respective
-// field.  Fields can be renamed using a QL tag "name newName".  Fields are
-// considered in the order of appearance. A QL tag is a struct tag part
+// field. A string field with a QL tag "enum typeName" is a column of the enum
+// type typeName, which must be created before executing the schema. An array
+// of 16 bytes, like UUID, is a uuid column. A slice, other than a slice of
+// bytes, is an array column, like []string for a field of type []string.
+// Fields can be renamed using a QL tag "name newName".  Fields are considered
+// in the order of appearance. A QL tag is a struct tag part
 // prefixed by "ql:". Tags can be combined, for example:
 //
 //	type T struct {
@@ -384,7 +422,14 @@ func Schema(v interface{}, name string, opt *SchemaOptions) (List, error) {
 			continue
 		}
 
-		buf.WriteString(fmt.Sprintf("%s %s, ", v.Name, v.Type))
+		typ := v.Type.String()
+		switch v.Type {
+		case EnumType:
+			typ = v.Tags["enum"]
+		case Array:
+			typ = arrayTypeStr(int(v.Elem))
+		}
+		buf.WriteString(fmt.Sprintf("%s %s, ", v.Name, typ))
 	}
 	buf.WriteString("); ")
 	for _, v := range s.Indices {
@@ -432,7 +477,8 @@ func MustSchema(v interface{}, name string, opt *SchemaOptions) List {
 // used. Only exported fields are considered. If an exported field QL tag
 // contains "-" then such field is not considered. A QL tag is a struct tag
 // part prefixed by "ql:".  Field with name ID, having type int64, corresponds
-// to id() - and is thus not part of the result.
+// to id() - and is thus not part of the result. A nil slice of an array
+// field is marshaled as NULL.
 //
 // Marshal is safe for concurrent use by multiple goroutines.
 func Marshal(v interface{}) ([]interface{}, error) {
@@ -466,6 +512,12 @@ func Marshal(v interface{}) ([]interface{}, error) {
 
 			f = f.Elem()
 		}
+		if v.Type == Array && f.IsNil() {
+			r[j] = nil
+			j++
+			continue
+		}
+
 		if m := v.MarshalType; m != nil {
 			f = f.Convert(m)
 		}
@@ -500,7 +552,7 @@ func MustMarshal(v interface{}) []interface{} {
 // contains "-" then such field is not considered. A QL tag is a struct tag
 // part prefixed by "ql:".  Fields are considered in the order of appearance.
 // Types of values in data must be compatible with the corresponding considered
-// field of v.
+// field of v. A NULL array sets its slice field to nil.
 //
 // If the struct has no ID field then the number of values in data must be equal
 // to the number of considered fields of v.
@@ -598,10 +650,18 @@ func Unmarshal(v interface{}, data []interface{}) (err error) {
 		}
 
 		d := data[j]
+		if e, ok := d.(Enum); ok {
+			d = e.Label()
+		}
 		val := reflect.ValueOf(d)
 		j++
 
 		fVal := vVal.Field(sf.Index)
+		if d == nil && sf.Type == Array && !sf.IsPtr { // NULL is a nil slice.
+			fVal.Set(reflect.Zero(sf.ReflectType))
+			continue
+		}
+
 		if u := sf.UnmarshalType; u != nil {
 			val = val.Convert(u)
 		}
@@ -0,0 +1,237 @@
+// Copyright 2026 The ql Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ql
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// jsonValue returns the compacted b if it is valid JSON.
+func jsonValue(b []byte) (json.RawMessage, error) {
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, b); err != nil {
+		return nil, fmt.Errorf("invalid JSON value: %v", err)
+	}
+
+	return json.RawMessage(buf.Bytes()), nil
+}
+
+// jsonArg returns the JSON value of the argument v of function fn. A string
+// argument must be valid JSON.
+func jsonArg(v interface{}, fn string) (json.RawMessage, error) {
+	switch x := v.(type) {
+	case json.RawMessage:
+		return x, nil
+	case string:
+		return jsonValue([]byte(x))
+	default:
+		return nil, invArg(x, fn)
+	}
+}
+
+// jsonMarshal returns the JSON encoding of the QL value v.
+func jsonMarshal(v interface{}) (json.RawMessage, error) {
+	switch x := v.(type) {
+	case nil:
+		return json.RawMessage("null"), nil
+	case json.RawMessage:
+		return x, nil
+	case Dec:
+		return json.RawMessage(x.String()), nil
+	case Enum:
+		v = x.Label()
+	case UUID:
+		v = x.String()
+	case complex64, complex128, idealComplex:
+		return nil, fmt.Errorf("cannot encode %v (type %T) as JSON", x, x)
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+
+	return json.RawMessage(bytes.TrimSuffix(buf.Bytes(), []byte{'\n'})), nil
+}
+
+// jsonPathStep is a member name or, if key is false, an array index.
+type jsonPathStep struct {
+	name  string
+	index int
+	key   bool
+}
+
+// parseJSONPath parses paths like $, $.a.b, $.a[2] or $["a b"].c.
+func parseJSONPath(path string) (r []jsonPathStep, err error) {
+	if !strings.HasPrefix(path, "$") {
+		return nil, fmt.Errorf("invalid JSON path %q", path)
+	}
+
+	for s := path[1:]; s != ""; {
+		switch s[0] {
+		case '.':
+			s = s[1:]
+			n := strings.IndexAny(s, ".[")
+			if n < 0 {
+				n = len(s)
+			}
+			if n == 0 {
+				return nil, fmt.Errorf("invalid JSON path %q", path)
+			}
+
+			r = append(r, jsonPathStep{name: s[:n], key: true})
+			s = s[n:]
+		case '[':
+			n := strings.IndexByte(s, ']')
+			if n < 0 {
+				return nil, fmt.Errorf("invalid JSON path %q", path)
+			}
+
+			sel := s[1:n]
+			if strings.HasPrefix(sel, `"`) {
+				if n = closingQuote(s); n < 0 || n+1 >= len(s) || s[n+1] != ']' {
+					return nil, fmt.Errorf("invalid JSON path %q", path)
+				}
+
+				name, err := strconv.Unquote(s[1 : n+1])
+				if err != nil {
+					return nil, fmt.Errorf("invalid JSON path %q", path)
+				}
+
+				r = append(r, jsonPathStep{name: name, key: true})
+				s = s[n+2:]
+				break
+			}
+
+			i, err := strconv.Atoi(sel)
+			if err != nil || i < 0 {
+				return nil, fmt.Errorf("invalid JSON path %q", path)
+			}
+
+			r = append(r, jsonPathStep{index: i})
+			s = s[n+1:]
+		default:
+			return nil, fmt.Errorf("invalid JSON path %q", path)
+		}
+	}
+	return r, nil
+}
+
+// closingQuote returns the index of the quote closing the string starting at
+// s[1], or -1.
+func closingQuote(s string) int {
+	for i := 2; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '"':
+			return i
+		}
+	}
+	return -1
+}
+
+// jsonSelect returns the part of the JSON value v selected by path or nil if
+// there is no such part.
+func jsonSelect(v json.RawMessage, path string) (json.RawMessage, error) {
+	steps, err := parseJSONPath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, step := range steps {
+		switch {
+		case step.key:
+			if len(v) == 0 || v[0] != '{' {
+				return nil, nil
+			}
+
+			var m map[string]json.RawMessage
+			if err := json.Unmarshal(v, &m); err != nil {
+				return nil, err
+			}
+
+			if v = m[step.name]; v == nil {
+				return nil, nil
+			}
+		default:
+			if len(v) == 0 || v[0] != '[' {
+				return nil, nil
+			}
+
+			var a []json.RawMessage
+			if err := json.Unmarshal(v, &a); err != nil {
+				return nil, err
+			}
+
+			if step.index >= len(a) {
+				return nil, nil
+			}
+
+			v = a[step.index]
+		}
+	}
+	return v, nil
+}
+
+// jsonTypeOf returns the kind of the JSON value v.
+func jsonTypeOf(v json.RawMessage) string {
+	switch v[0] {
+	case '{':
+		return "object"
+	case '[':
+		return "array"
+	case '"':
+		return "string"
+	case 't', 'f':
+		return "boolean"
+	case 'n':
+		return "null"
+	default:
+		return "number"
+	}
+}
+
+// jsonScalar returns the QL value of the JSON value v. Strings, numbers,
+// booleans and null are returned as string, float64, bool and nil. Arrays and
+// objects are returned as JSON.
+func jsonScalar(v json.RawMessage) (interface{}, error) {
+	switch jsonTypeOf(v) {
+	case "string":
+		var s string
+		err := json.Unmarshal(v, &s)
+		return s, err
+	case "number":
+		return strconv.ParseFloat(string(v), 64)
+	case "boolean":
+		return v[0] == 't', nil
+	case "null":
+		return nil, nil
+	default:
+		return v, nil
+	}
+}
+
+// jsonKind orders the values of different types jsonExtract may return.
+func jsonKind(v interface{}) int {
+	switch v.(type) {
+	case bool:
+		return 1
+	case float64:
+		return 2
+	case string:
+		return 3
+	case json.RawMessage:
+		return 4
+	default:
+		return 0
+	}
+}
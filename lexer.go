@@ -11,6 +11,7 @@ import (
 	"math"
 	"strconv"
 	"strings"
+	"time"
 	"unicode"
 
 	"github.com/cznic/golex/lex"
@@ -148,6 +149,30 @@ func (l *lexer) str(lval *yySymType, pref string) int {
 	return stringLit
 }
 
+func (l *lexer) interval(lval *yySymType) int {
+	val := string(l.TokenBytes(nil))
+	s := val[strings.IndexAny(val, "'\""):]
+	switch s[0] {
+	case '\'':
+		s = s[1 : len(s)-1]
+	default:
+		var err error
+		if s, err = strconv.Unquote(s); err != nil {
+			l.err("interval literal: %v", err)
+			lval.item = time.Duration(0)
+			return intervalLit
+		}
+	}
+
+	d, err := parseInterval(s)
+	if err != nil {
+		l.err("interval literal: %v", err)
+	}
+
+	lval.item = d
+	return intervalLit
+}
+
 func (l *lexer) npos() (line, col int) {
 	pos := l.file.Position(l.Last.Pos())
 	return pos.Line, pos.Column
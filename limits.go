@@ -0,0 +1,193 @@
+// Copyright 2026 The ql Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ql
+
+import (
+	"errors"
+	"math/big"
+	"time"
+)
+
+// Errors returned by statements exceeding their Limits.
+var (
+	ErrMaxResultRows    = errors.New("statement exceeds the maximum number of result rows")
+	ErrMaxRowsExamined  = errors.New("statement exceeds the maximum number of rows examined")
+	ErrMaxStatementTime = errors.New("statement exceeds the maximum execution time")
+	ErrMaxTempBytes     = errors.New("statement exceeds the maximum size of temporary storage")
+)
+
+// Limits bound the resources used by a statement. A zero field means no
+// limit.
+//
+// The limits apply separately to the execution of every statement and to
+// every call of the Do method of a Recordset, which is where most of the work
+// of a SELECT statement is performed. A statement exceeding a limit fails
+// with the respective ErrMax* error and, if executed in a transaction, the
+// transaction is rolled back like after any other failed statement.
+type Limits struct {
+	// MaxResultRows is the maximum number of rows a Recordset passes to
+	// the function of its Do method.
+	MaxResultRows int64
+
+	// MaxRowsExamined is the maximum number of table rows read. Rows
+	// read by subqueries and by UPDATE and DELETE statements are included.
+	MaxRowsExamined int64
+
+	// MaxStatementTime is the maximum wall time of executing a statement
+	// or of a Do call, including the time spent in the function passed to
+	// Do and waiting for other transactions to finish.
+	MaxStatementTime time.Duration
+
+	// MaxTempBytes is the maximum size of the rows written to the
+	// temporary storage used for DISTINCT, GROUP BY and ORDER BY. The size
+	// is an estimate of the size of the encoded rows. Temporary storage
+	// of the memory backend is held in memory.
+	MaxTempBytes int64
+}
+
+// SetLimits sets the limits of the statements executed using a nil TCtx or
+// a TCtx whose Limits field is nil. The zero value, which is the default,
+// sets no limits.
+//
+// SetLimits is safe for concurrent use by multiple goroutines. It affects
+// statements executed after it returns.
+func (db *DB) SetLimits(l Limits) { db.limits.Store(l) }
+
+// Limits returns the value set by SetLimits.
+func (db *DB) Limits() Limits {
+	l, _ := db.limits.Load().(Limits)
+	return l
+}
+
+// limitsFor returns the limits of statements executed using tctx.
+func (db *DB) limitsFor(tctx *TCtx) Limits {
+	if tctx != nil && tctx.Limits != nil {
+		return *tctx.Limits
+	}
+
+	return db.Limits()
+}
+
+// withLimits makes x enforce l. The timer of l.MaxStatementTime starts
+// running, x.stop must be called when the execution is done.
+func (x *execCtx) withLimits(l Limits) *execCtx {
+	x.limits = l
+	if d := l.MaxStatementTime; d > 0 {
+		timeout := make(chan struct{})
+		x.timeout = timeout
+		x.timer = time.AfterFunc(d, func() { close(timeout) })
+	}
+	return x
+}
+
+// stop stops the timer of the MaxStatementTime limit of x.
+func (x *execCtx) stop() {
+	if x.timer != nil {
+		x.timer.Stop()
+	}
+}
+
+// examine counts a table row read by x.
+func (x *execCtx) examine() error {
+	if x.examined++; x.limits.MaxRowsExamined > 0 && x.examined > x.limits.MaxRowsExamined {
+		return ErrMaxRowsExamined
+	}
+
+	return nil
+}
+
+// result counts a row produced by x.
+func (x *execCtx) result() error {
+	if x.results++; x.limits.MaxResultRows > 0 && x.results > x.limits.MaxResultRows {
+		return ErrMaxResultRows
+	}
+
+	return nil
+}
+
+// createTemp returns a new temporary B-tree of the DB storage. Its size
+// counts toward the MaxTempBytes limit of x.
+func (x *execCtx) createTemp(asc bool) (temp, error) {
+	t, err := x.db.store.CreateTemp(asc)
+	if err != nil || x.limits.MaxTempBytes == 0 {
+		return t, err
+	}
+
+	return &limitedTemp{t, x}, nil
+}
+
+// limitedTemp is a temp enforcing the MaxTempBytes limit of ctx.
+type limitedTemp struct {
+	temp
+	ctx *execCtx
+}
+
+func (t *limitedTemp) use(data []interface{}) error {
+	x := t.ctx
+	if x.tempBytes += recordSize(data); x.tempBytes > x.limits.MaxTempBytes {
+		return ErrMaxTempBytes
+	}
+
+	return nil
+}
+
+func (t *limitedTemp) Create(data ...interface{}) (int64, error) {
+	if err := t.use(data); err != nil {
+		return 0, err
+	}
+
+	return t.temp.Create(data...)
+}
+
+func (t *limitedTemp) Set(k, v []interface{}) error {
+	if err := t.use(k); err != nil {
+		return err
+	}
+
+	if err := t.use(v); err != nil {
+		return err
+	}
+
+	return t.temp.Set(k, v)
+}
+
+// recordSize returns the approximate size of the encoded data.
+func recordSize(data []interface{}) (n int64) {
+	for _, v := range data {
+		switch x := v.(type) {
+		case nil, bool, int8, uint8:
+			n++
+		case int16, uint16:
+			n += 2
+		case int32, uint32, float32:
+			n += 4
+		case complex128, time.Time:
+			n += 16
+		case string:
+			n += int64(len(x))
+		case []byte:
+			n += int64(len(x))
+		case chunk:
+			n += int64(len(x.b))
+		case *big.Int:
+			n += int64(len(x.Bits())) * 8
+		case *big.Rat:
+			n += int64(len(x.Num().Bits())+len(x.Denom().Bits())) * 8
+		case Dec:
+			n += int64(len(x.unscaled().Bits()))*8 + 1
+		case UUID:
+			n += int64(len(x))
+		default:
+			if arrayElem(x) != 0 {
+				n += recordSize(arrayValues(x))
+				break
+			}
+
+			n += 8
+		}
+		n++ // Type tag.
+	}
+	return n
+}
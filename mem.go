@@ -8,6 +8,7 @@ package ql
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"io"
 	"math/big"
@@ -361,11 +362,25 @@ func (s *mem) ResetID() (err error) {
 	return
 }
 
+func (s *mem) SetID(id int64) error {
+	s.id = id
+	return nil
+}
+
 func (s *mem) ID() (id int64, err error) {
 	s.id++
 	return s.id, nil
 }
 
+func (s *mem) UseID(id int64) (bool, error) {
+	if id <= s.id {
+		return false, nil
+	}
+
+	s.id = id
+	return true, nil
+}
+
 func (s *mem) clone(data ...interface{}) []interface{} {
 	r := make([]interface{}, len(data))
 	for i, v := range data {
@@ -425,10 +440,18 @@ func (s *mem) clone(data ...interface{}) []interface{} {
 			r[i] = t
 		case time.Duration:
 			r[i] = x
+		case json.RawMessage:
+			r[i] = append(json.RawMessage(nil), x...)
+		case Dec, Enum, UUID:
+			r[i] = x
 		case map[string]interface{}: // map of ids of a cross join
 			r[i] = x
 		default:
-			panic("internal error 050")
+			if arrayElem(x) == 0 {
+				panic("internal error 050")
+			}
+
+			r[i] = cloneArray(x)
 		}
 	}
 	return r
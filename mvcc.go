@@ -0,0 +1,529 @@
+// Copyright 2026 The ql Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ql
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"sync"
+)
+
+var (
+	_ btreeIndex    = (*mvccIndex)(nil)
+	_ btreeIndex    = (*snapshotIndex)(nil)
+	_ indexIterator = (*mvccIterator)(nil)
+	_ storage       = (*mvcc)(nil)
+)
+
+// errIndexChanged is returned by the iterators of an index modified after the
+// snapshot reading it was taken.
+var errIndexChanged = errors.New("index modified after the snapshot was taken")
+
+// mvcc adds multi-version concurrency control to a storage. Before the open
+// transaction modifies a record for the first time, the committed content of
+// the record is saved. The saved records are kept after the commit until no
+// snapshot which can read them remains, so snapshots see the committed state
+// of the DB while the single writer goes on.
+//
+// The storages are not safe for concurrent use, all calls are serialized by
+// mu.
+type mvcc struct {
+	storage
+	created   map[int64]bool       // Records created by the open transaction.
+	indices   map[*mvccIndex]int64 // Indices modified by the open transaction: their previous versions.
+	mu        sync.Mutex
+	pending   map[int64][]interface{} // Saved records of the open transaction.
+	snapshots map[int64]int           // Version: number of snapshots.
+	tnl       int                     // Transaction nesting level.
+	ver       int64                   // Last committed version.
+	versions  []version               // Ordered by ver.
+}
+
+// version holds the records as they were before ver was committed.
+type version struct {
+	ver  int64
+	recs map[int64][]interface{}
+}
+
+func newMVCC(s storage) *mvcc {
+	r := &mvcc{storage: s, snapshots: map[int64]int{}}
+	r.reset()
+	if f, ok := s.(*file); ok {
+		f.latch = &r.mu
+	}
+	return r
+}
+
+func (s *mvcc) reset() {
+	s.created = map[int64]bool{}
+	s.indices = map[*mvccIndex]int64{}
+	s.pending = map[int64][]interface{}{}
+}
+
+func (s *mvcc) BeginTransaction() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.storage.BeginTransaction(); err != nil {
+		return err
+	}
+
+	s.tnl++
+	return nil
+}
+
+func (s *mvcc) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.storage.Close()
+}
+
+func (s *mvcc) Commit() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	err := s.storage.Commit()
+	if s.tnl--; s.tnl != 0 {
+		return err
+	}
+
+	s.ver++
+	for x := range s.indices {
+		x.ver = s.ver
+	}
+	if len(s.snapshots) != 0 && len(s.pending) != 0 {
+		s.versions = append(s.versions, version{s.ver, s.pending})
+	}
+	s.reset()
+	return err
+}
+
+func (s *mvcc) Create(data ...interface{}) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	defer compactEnums(data)()
+	h, err := s.storage.Create(data...)
+	if err != nil {
+		return h, err
+	}
+
+	if _, ok := s.pending[h]; !ok {
+		s.created[h] = true
+	}
+	return h, nil
+}
+
+func (s *mvcc) CreateIndex(unique bool) (int64, btreeIndex, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	h, x, err := s.storage.CreateIndex(unique)
+	if err != nil {
+		return h, nil, err
+	}
+
+	r := &mvccIndex{btreeIndex: x, s: s}
+	r.modify()
+	return h, r, nil
+}
+
+func (s *mvcc) CreateTemp(asc bool) (temp, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.storage.CreateTemp(asc)
+}
+
+func (s *mvcc) Delete(h int64, blobCols ...*col) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.save(h); err != nil {
+		return err
+	}
+
+	return s.storage.Delete(h, blobCols...)
+}
+
+func (s *mvcc) ID() (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.storage.ID()
+}
+
+func (s *mvcc) OpenIndex(unique bool, handle int64) (btreeIndex, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	x, err := s.storage.OpenIndex(unique, handle)
+	if err != nil {
+		return nil, err
+	}
+
+	return &mvccIndex{btreeIndex: x, s: s}, nil
+}
+
+// Read reads the current content of the record h, including the changes made
+// by the open transaction.
+func (s *mvcc) Read(dst []interface{}, h int64, cols ...*col) ([]interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rec, err := s.storage.Read(dst, h, cols...)
+	if err != nil {
+		return nil, err
+	}
+
+	return rec, expandEnums(rec, cols)
+}
+
+func (s *mvcc) ResetID() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.storage.ResetID()
+}
+
+func (s *mvcc) SetID(id int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.storage.SetID(id)
+}
+
+func (s *mvcc) UseID(id int64) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.storage.UseID(id)
+}
+
+func (s *mvcc) Rollback() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	err := s.storage.Rollback()
+	if s.tnl--; s.tnl != 0 {
+		return err
+	}
+
+	for x, ver := range s.indices {
+		x.ver = ver
+	}
+	s.reset()
+	return err
+}
+
+func (s *mvcc) Update(h int64, data ...interface{}) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.save(h); err != nil {
+		return err
+	}
+
+	defer compactEnums(data)()
+	return s.storage.Update(h, data...)
+}
+
+func (s *mvcc) UpdateRow(h int64, blobCols []*col, data ...interface{}) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.save(h); err != nil {
+		return err
+	}
+
+	defer compactEnums(data)()
+	return s.storage.UpdateRow(h, blobCols, data...)
+}
+
+func (s *mvcc) Verify() (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.storage.Verify()
+}
+
+// save saves the committed content of the record h unless the open
+// transaction already saved or created it.
+func (s *mvcc) save(h int64) error {
+	if _, ok := s.pending[h]; ok || s.created[h] || s.tnl == 0 {
+		return nil
+	}
+
+	rec, err := s.load(h)
+	if err != nil {
+		return err
+	}
+
+	s.pending[h] = rec
+	return nil
+}
+
+// load reads the record h. Blobs are read as well, the record may outlive the
+// chunks the file back end stores them in.
+func (s *mvcc) load(h int64) ([]interface{}, error) {
+	rec, err := s.storage.Read(nil, h)
+	if err != nil {
+		return nil, err
+	}
+
+	var cols []*col
+	for i := 2; i < len(rec); i++ {
+		if _, ok := rec[i].([]byte); ok {
+			cols = append(cols, &col{index: i - 2, typ: qBlob})
+		}
+	}
+	if cols == nil {
+		return rec, nil
+	}
+
+	if rec, err = s.storage.Read(nil, h, cols...); err != nil {
+		return nil, err
+	}
+
+	return rec, expand0(rec)
+}
+
+// readAt reads the record h as it was in version ver.
+func (s *mvcc) readAt(ver int64, dst []interface{}, h int64, cols ...*col) ([]interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rec, ok := s.saved(ver, h)
+	if !ok {
+		rec, err := s.storage.Read(dst, h, cols...)
+		if err != nil {
+			return nil, err
+		}
+
+		if err = expand0(rec); err != nil {
+			return nil, err
+		}
+
+		return rec, expandEnums(rec, cols)
+	}
+
+	rec = copyArgs(rec)
+	for _, c := range cols {
+		i := c.index + 2
+		if i >= len(rec) || rec[i] == nil {
+			continue
+		}
+
+		switch c.typ {
+		case 0, qBlob, qBigInt, qBigRat, qTime, qDuration, qJSON, qDecimal, qUUID, qEnum, qArray:
+			// nop, see load
+		default:
+			var err error
+			if rec[i], err = convert(rec[i], c.typ); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if cols != nil {
+		for n := len(cols) + 2; len(rec) < n; {
+			rec = append(rec, nil)
+		}
+	}
+	return rec, expandEnums(rec, cols)
+}
+
+// saved returns the record h saved by the first commit after ver, or by the
+// open transaction.
+func (s *mvcc) saved(ver, h int64) ([]interface{}, bool) {
+	for i := sort.Search(len(s.versions), func(i int) bool { return s.versions[i].ver > ver }); i < len(s.versions); i++ {
+		if rec, ok := s.versions[i].recs[h]; ok {
+			return rec, true
+		}
+	}
+
+	rec, ok := s.pending[h]
+	return rec, ok
+}
+
+// acquire returns the last committed version. Its records are kept until
+// release is called.
+func (s *mvcc) acquire() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.snapshots[s.ver]++
+	return s.ver
+}
+
+// retain keeps the records of the acquired version ver until release is
+// called once more.
+func (s *mvcc) retain(ver int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.snapshots[ver]++
+}
+
+func (s *mvcc) release(ver int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.snapshots[ver]--; s.snapshots[ver] == 0 {
+		delete(s.snapshots, ver)
+	}
+
+	min := int64(math.MaxInt64)
+	for v := range s.snapshots {
+		if v < min {
+			min = v
+		}
+	}
+	i := sort.Search(len(s.versions), func(i int) bool { return s.versions[i].ver > min })
+	n := copy(s.versions, s.versions[i:])
+	for j := n; j < len(s.versions); j++ {
+		s.versions[j] = version{}
+	}
+	s.versions = s.versions[:n]
+}
+
+// mvccIndex is an index of a mvcc storage. Snapshots cannot use an index
+// modified after they were taken.
+type mvccIndex struct {
+	btreeIndex
+	s   *mvcc
+	ver int64 // Version of the last modification, math.MaxInt64 while the open transaction modifies the index.
+}
+
+// modify is called before the open transaction modifies x.
+func (x *mvccIndex) modify() {
+	if x.ver != math.MaxInt64 && x.s.tnl != 0 {
+		x.s.indices[x] = x.ver
+		x.ver = math.MaxInt64
+	}
+}
+
+func (x *mvccIndex) Clear() error {
+	x.s.mu.Lock()
+	defer x.s.mu.Unlock()
+	x.modify()
+	return x.btreeIndex.Clear()
+}
+
+func (x *mvccIndex) Create(indexedValues []interface{}, h int64) error {
+	x.s.mu.Lock()
+	defer x.s.mu.Unlock()
+	x.modify()
+	return x.btreeIndex.Create(indexedValues, h)
+}
+
+func (x *mvccIndex) Delete(indexedValues []interface{}, h int64) error {
+	x.s.mu.Lock()
+	defer x.s.mu.Unlock()
+	x.modify()
+	return x.btreeIndex.Delete(indexedValues, h)
+}
+
+func (x *mvccIndex) Drop() error {
+	x.s.mu.Lock()
+	defer x.s.mu.Unlock()
+	x.modify()
+	return x.btreeIndex.Drop()
+}
+
+func (x *mvccIndex) Seek(indexedValues []interface{}) (indexIterator, bool, error) {
+	return x.seek(math.MaxInt64, indexedValues)
+}
+
+func (x *mvccIndex) SeekFirst() (indexIterator, error) {
+	return x.seekEnd(math.MaxInt64, x.btreeIndex.SeekFirst)
+}
+
+func (x *mvccIndex) SeekLast() (indexIterator, error) {
+	return x.seekEnd(math.MaxInt64, x.btreeIndex.SeekLast)
+}
+
+func (x *mvccIndex) seek(ver int64, indexedValues []interface{}) (indexIterator, bool, error) {
+	x.s.mu.Lock()
+	defer x.s.mu.Unlock()
+	if x.ver > ver {
+		return nil, false, errIndexChanged
+	}
+
+	it, hit, err := x.btreeIndex.Seek(indexedValues)
+	if err != nil {
+		return nil, hit, err
+	}
+
+	return &mvccIterator{it, x, ver}, hit, nil
+}
+
+func (x *mvccIndex) seekEnd(ver int64, f func() (indexIterator, error)) (indexIterator, error) {
+	x.s.mu.Lock()
+	defer x.s.mu.Unlock()
+	if x.ver > ver {
+		return nil, errIndexChanged
+	}
+
+	it, err := f()
+	if err != nil {
+		return nil, err
+	}
+
+	return &mvccIterator{it, x, ver}, nil
+}
+
+// snapshotIndex is an index read by a snapshot of version ver.
+type snapshotIndex struct {
+	*mvccIndex
+	ver int64
+}
+
+func (x *snapshotIndex) Seek(indexedValues []interface{}) (indexIterator, bool, error) {
+	return x.seek(x.ver, indexedValues)
+}
+
+func (x *snapshotIndex) SeekFirst() (indexIterator, error) {
+	return x.seekEnd(x.ver, x.btreeIndex.SeekFirst)
+}
+
+func (x *snapshotIndex) SeekLast() (indexIterator, error) {
+	return x.seekEnd(x.ver, x.btreeIndex.SeekLast)
+}
+
+type mvccIterator struct {
+	indexIterator
+	x   *mvccIndex
+	ver int64
+}
+
+func (it *mvccIterator) Next() ([]interface{}, int64, error) {
+	return it.step(it.indexIterator.Next)
+}
+
+func (it *mvccIterator) Prev() ([]interface{}, int64, error) {
+	return it.step(it.indexIterator.Prev)
+}
+
+func (it *mvccIterator) step(f func() ([]interface{}, int64, error)) ([]interface{}, int64, error) {
+	it.x.s.mu.Lock()
+	defer it.x.s.mu.Unlock()
+	if it.x.ver > it.ver {
+		return nil, -1, errIndexChanged
+	}
+
+	return f()
+}
+
+// snapshot is a read only view of the DB as it was when a version was
+// committed.
+type snapshot struct {
+	s    *mvcc
+	ver  int64
+	root *root
+}
+
+func (s *snapshot) read(dst []interface{}, h int64, cols ...*col) ([]interface{}, error) {
+	return s.s.readAt(s.ver, dst, h, cols...)
+}
+
+func (s *snapshot) index(x btreeIndex) btreeIndex {
+	switch y := x.(type) {
+	case *mvccIndex:
+		return &snapshotIndex{y, s.ver}
+	case *collatedIndex:
+		return &collatedIndex{s.index(y.btreeIndex), y.name}
+	}
+
+	return x
+}
+
+func (s *snapshot) release() { s.s.release(s.ver) }
+
+// retain returns s after making it outlive one more call of release.
+func (s *snapshot) retain() *snapshot {
+	s.s.retain(s.ver)
+	return s
+}
@@ -0,0 +1,442 @@
+// Copyright 2026 The ql Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ql
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// parallelBatch is the number of rows handed to a worker goroutine at once.
+const parallelBatch = 1024
+
+// SetParallelism sets the number of goroutines used to evaluate WHERE
+// clauses which cannot be answered using an index and to compute the
+// aggregate functions of GROUP BY queries. A value less than 2, which is the
+// default, selects serial execution.
+//
+// Query results do not depend on the degree of parallelism. Rows are
+// produced in the same order and any error reported is the one serial
+// execution would report. Expressions calling functions whose results are
+// not determined by their arguments, like now, random or a function
+// registered by RegisterFunc without FuncOptions.Deterministic, are always
+// evaluated serially.
+//
+// SetParallelism is safe for concurrent use by multiple goroutines. It
+// affects statements executed after it returns.
+func (db *DB) SetParallelism(n int) {
+	if n < 1 {
+		n = 1
+	}
+	atomic.StoreInt32(&db.parallelism, int32(n))
+}
+
+// Parallelism returns the value set by SetParallelism.
+func (db *DB) Parallelism() int {
+	if n := int(atomic.LoadInt32(&db.parallelism)); n > 1 {
+		return n
+	}
+
+	return 1
+}
+
+// parallel calls f(w, i) for every i in [0, count) using at most n
+// goroutines. The goroutine executing f is identified by w in [0, n).
+// Parallel returns after all calls of f have returned.
+func parallel(n, count int, f func(w, i int)) {
+	if n > count {
+		n = count
+	}
+	var wg sync.WaitGroup
+	next := int64(-1)
+	wg.Add(n)
+	for w := 0; w < n; w++ {
+		go func(w int) {
+			defer wg.Done()
+			for {
+				i := int(atomic.AddInt64(&next, 1))
+				if i >= count {
+					return
+				}
+
+				f(w, i)
+			}
+		}(w)
+	}
+	wg.Wait()
+}
+
+// cloneID returns a copy of the row ID rid. Joins reuse the map holding the
+// IDs of the joined rows.
+func cloneID(rid interface{}) interface{} {
+	x, ok := rid.(map[string]interface{})
+	if !ok {
+		return rid
+	}
+
+	y := make(map[string]interface{}, len(x))
+	for k, v := range x {
+		y[k] = v
+	}
+	return y
+}
+
+// canParallelize reports whether e can be evaluated by more goroutines at
+// the same time. Subqueries read the DB storage, which is not safe for
+// concurrent use. Functions which are not static, except id and the
+// aggregate functions, may return different results when called in a
+// different order, like random, or from more goroutines, like registered
+// functions.
+func canParallelize(e expression) (r bool) {
+	r = true
+	visitExpression(e, func(e expression) {
+		switch x := e.(type) {
+		case *pIn:
+			if x.sel != nil {
+				r = false
+			}
+		case *call:
+			if f, ok := findFunc(x.f); !ok || !f.isStatic && !f.isAggregate && x.f != "id" {
+				r = false
+			}
+		}
+	})
+	return r
+}
+
+func (r *filterDefaultPlan) doParallel(ctx *execCtx, n int, f func(id interface{}, data []interface{}) (bool, error)) error {
+	type row struct {
+		id   interface{}
+		data []interface{}
+		ok   bool
+		err  error
+	}
+
+	types := planTypes(r.plan)
+	expr := collateExpression(r.expr, planCollations(r.plan))
+	exprs := make([]compiledExpr, n)
+	for i := range exprs {
+		e, err := expr.clone(ctx.arg)
+		if err != nil {
+			return err
+		}
+
+		exprs[i] = compileEval(e, types, ctx.arg)
+	}
+	ms := make([]map[interface{}]interface{}, n)
+	for i := range ms {
+		ms[i] = map[interface{}]interface{}{}
+	}
+	fields := r.plan.fieldNames()
+	eval := func(w int, row *row) {
+		row.ok, row.err = r.eval(ctx, exprs[w], ms[w], fields, row.id, row.data)
+	}
+
+	batch := make([]row, 0, n*parallelBatch)
+	flush := func() (bool, error) {
+		defer func() { batch = batch[:0] }()
+
+		parallel(n, (len(batch)+parallelBatch-1)/parallelBatch, func(w, i int) {
+			lo := i * parallelBatch
+			hi := lo + parallelBatch
+			if hi > len(batch) {
+				hi = len(batch)
+			}
+			for j := lo; j < hi; j++ {
+				eval(w, &batch[j])
+			}
+		})
+		for i := range batch {
+			row := &batch[i]
+			if row.err != nil {
+				return false, row.err
+			}
+
+			if !row.ok {
+				continue
+			}
+
+			if more, err := f(row.id, row.data); !more || err != nil {
+				return more, err
+			}
+		}
+		return true, nil
+	}
+
+	stop := false
+	if err := r.plan.do(ctx, func(rid interface{}, data []interface{}) (bool, error) {
+		data = append([]interface{}(nil), data...)
+		// Blobs are read here, storage is not safe for concurrent use.
+		if err := expand(data); err != nil {
+			return false, err
+		}
+
+		batch = append(batch, row{id: cloneID(rid), data: data})
+		if len(batch) < cap(batch) {
+			return true, nil
+		}
+
+		more, err := flush()
+		stop = !more
+		return more, err
+	}); err != nil || stop {
+		return err
+	}
+
+	_, err := flush()
+	return err
+}
+
+// groupPart is a sequence of rows of a group evaluated by a single
+// goroutine.
+type groupPart struct {
+	rows [][]interface{}
+	m    map[interface{}]interface{} // Evaluation context of the part.
+	w    int                         // The goroutine which evaluated the part.
+	err  error
+}
+
+type parallelGroup struct {
+	rid   interface{}
+	h     int64 // Head of the row chain.
+	parts []*groupPart
+}
+
+// parallelGroupPlan evaluates the fields of a selectFieldsGroupPlan using
+// more goroutines. Every group is evaluated by a single goroutine unless all
+// the aggregate functions of the fields can merge partial results, in which
+// case large groups are split into parts evaluated concurrently.
+type parallelGroupPlan struct {
+	ctx     *execCtx
+	r       *selectFieldsGroupPlan
+	t       temp
+	cols    []*col
+	n       int
+	flds    [][]*fld  // Clone of r.flds for every goroutine.
+	aggs    [][]*call // Aggregate calls of flds, nil if parts cannot be merged.
+	groups  []*parallelGroup
+	pending int // Number of rows in groups.
+	out     []interface{}
+	stop    bool
+}
+
+func newParallelGroupPlan(ctx *execCtx, r *selectFieldsGroupPlan, t temp, cols []*col, n int, out []interface{}) (*parallelGroupPlan, error) {
+	p := &parallelGroupPlan{
+		ctx:  ctx,
+		r:    r,
+		t:    t,
+		cols: cols,
+		n:    n,
+		flds: make([][]*fld, n),
+		aggs: make([][]*call, n),
+		out:  out,
+	}
+	for w := range p.flds {
+		for _, v := range r.flds {
+			e, err := v.expr.clone(ctx.arg)
+			if err != nil {
+				return nil, err
+			}
+
+			p.flds[w] = append(p.flds[w], &fld{expr: e, name: v.name})
+			visitExpression(e, func(e expression) {
+				if x, ok := e.(*call); ok && builtin[x.f].isAggregate {
+					p.aggs[w] = append(p.aggs[w], x)
+				}
+			})
+		}
+	}
+	for _, v := range p.aggs[0] {
+		switch v.f {
+		case "count", "max", "min", "sum":
+			// ok
+		default:
+			p.aggs = nil
+			return p, nil
+		}
+	}
+	return p, nil
+}
+
+// add reads the rows of a group. The groups read so far are evaluated and
+// passed to f once there are enough rows to keep all goroutines busy.
+func (p *parallelGroupPlan) add(rid interface{}, h int64, f func(id interface{}, data []interface{}) (bool, error)) (bool, error) {
+	g := &parallelGroup{rid: rid, h: h}
+	part := &groupPart{}
+	g.parts = append(g.parts, part)
+	for h != 0 {
+		if err := p.ctx.canceled(); err != nil {
+			return false, err
+		}
+
+		in, err := p.t.Read(nil, h, p.cols...)
+		if err != nil {
+			return false, err
+		}
+
+		rec := in[2:]
+		if err = expand(rec); err != nil {
+			return false, err
+		}
+
+		if p.aggs != nil && len(part.rows) == parallelBatch {
+			part = &groupPart{}
+			g.parts = append(g.parts, part)
+		}
+		part.rows = append(part.rows, rec)
+		p.pending++
+		h = in[0].(int64)
+	}
+	p.groups = append(p.groups, g)
+	if p.pending < p.n*parallelBatch {
+		return true, nil
+	}
+
+	return p.flush(f)
+}
+
+// flush evaluates the groups read so far and passes the results to f in the
+// order of the groups.
+func (p *parallelGroupPlan) flush(f func(id interface{}, data []interface{}) (bool, error)) (bool, error) {
+	if p.stop {
+		return false, nil
+	}
+
+	defer func() {
+		p.groups = p.groups[:0]
+		p.pending = 0
+	}()
+
+	type job struct {
+		g    *parallelGroup
+		part *groupPart
+	}
+	var jobs []job
+	for _, g := range p.groups {
+		for _, part := range g.parts {
+			jobs = append(jobs, job{g, part})
+		}
+	}
+	parallel(p.n, len(jobs), func(w, i int) {
+		j := jobs[i]
+		j.part.w = w
+		j.part.m, j.part.err = p.eval(w, j.g.rid, j.part.rows)
+		j.part.rows = nil
+	})
+	for _, g := range p.groups {
+		if err := p.aggregate(g); err != nil {
+			return false, err
+		}
+
+		if more, err := f(nil, p.out); !more || err != nil {
+			p.stop = !more
+			return more, err
+		}
+	}
+	return true, nil
+}
+
+func (p *parallelGroupPlan) eval(w int, rid interface{}, rows [][]interface{}) (map[interface{}]interface{}, error) {
+	m := map[interface{}]interface{}{}
+	for _, rec := range rows {
+		for i, c := range p.cols {
+			if nm := c.name; nm != "" {
+				m[nm] = rec[i]
+			}
+		}
+		m["$id"] = rid
+		for _, fld := range p.flds[w] {
+			if _, err := fld.expr.eval(p.ctx, m); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return m, nil
+}
+
+// aggregate computes the fields of g into p.out.
+func (p *parallelGroupPlan) aggregate(g *parallelGroup) (err error) {
+	part := g.parts[len(g.parts)-1]
+	switch {
+	case len(g.parts) == 1:
+		if part.err != nil {
+			return part.err
+		}
+	case !p.merge(g):
+		// The serial path reports the same error or computes the same
+		// value.
+		return p.r.aggregate(p.ctx, p.t, p.cols, g.rid, g.h, p.out)
+	}
+
+	part.m["$agg"] = true
+	for i, fld := range p.flds[part.w] {
+		if p.out[i], err = fld.expr.eval(p.ctx, part.m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// merge combines the partial aggregates of all parts of g into the last
+// part. It reports false if any of the parts failed or if the combined value
+// could differ from the serially computed one.
+func (p *parallelGroupPlan) merge(g *parallelGroup) bool {
+	for _, part := range g.parts {
+		if part.err != nil {
+			return false
+		}
+	}
+
+	last := g.parts[len(g.parts)-1]
+	for i, c := range p.aggs[last.w] {
+		var v interface{}
+		for _, part := range g.parts {
+			var ok bool
+			if v, ok = mergeAggregate(c.f, v, part.m[p.aggs[part.w][i]]); !ok {
+				return false
+			}
+		}
+		if v != nil {
+			last.m[c] = v
+		}
+	}
+	return true
+}
+
+// mergeAggregate combines the partial results a and b of the aggregate
+// function fn. It reports false if the result depends on the order of the
+// values aggregated by the parts, like in summing floating point values.
+func mergeAggregate(fn string, a, b interface{}) (interface{}, bool) {
+	switch {
+	case b == nil:
+		return a, true
+	case a == nil:
+		return b, true
+	}
+
+	switch fn {
+	case "count":
+		return a.(int64) + b.(int64), true
+	case "max", "min":
+		switch a.(type) {
+		case float32, float64: // NaN does not compare.
+			return nil, false
+		}
+	case "sum":
+		switch a.(type) {
+		case complex64, complex128, float32, float64:
+			return nil, false
+		}
+	default:
+		return nil, false
+	}
+
+	m := map[interface{}]interface{}{"$fn": fn, fn: a}
+	if _, err := builtin[fn].f([]interface{}{b}, m); err != nil {
+		return nil, false
+	}
+
+	return m[fn], true
+}
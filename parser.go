@@ -17,6 +17,7 @@ import __yyfmt__ "fmt"
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/cznic/mathutil"
 )
@@ -34,327 +35,363 @@ type yyXError struct {
 }
 
 const (
-	yyDefault       = 57437
+	yyDefault       = 57446
 	yyEOFCode       = 57344
-	add             = 57352
-	alter           = 57353
-	and             = 57354
-	andand          = 57355
-	andnot          = 57356
-	as              = 57357
-	asc             = 57358
-	begin           = 57359
-	between         = 57360
-	bigIntType      = 57361
-	bigRatType      = 57362
-	blobType        = 57363
-	boolType        = 57364
-	by              = 57365
-	byteType        = 57366
-	column          = 57367
-	commit          = 57368
-	complex128Type  = 57369
-	complex64Type   = 57370
-	create          = 57371
-	defaultKwd      = 57372
-	deleteKwd       = 57373
-	desc            = 57374
-	distinct        = 57375
-	drop            = 57376
-	durationType    = 57377
-	eq              = 57378
+	add             = 57353
+	alter           = 57354
+	and             = 57355
+	andand          = 57356
+	andnot          = 57357
+	as              = 57358
+	asc             = 57359
+	begin           = 57360
+	between         = 57361
+	bigIntType      = 57362
+	bigRatType      = 57363
+	blobType        = 57364
+	boolType        = 57365
+	by              = 57366
+	byteType        = 57367
+	collateKwd      = 57368
+	column          = 57369
+	commit          = 57370
+	complex128Type  = 57371
+	complex64Type   = 57372
+	create          = 57373
+	decimalType     = 57375
+	defaultKwd      = 57374
+	deleteKwd       = 57376
+	desc            = 57377
+	distinct        = 57378
+	drop            = 57379
+	durationType    = 57380
+	eq              = 57381
 	yyErrCode       = 57345
-	exists          = 57379
-	explain         = 57380
-	falseKwd        = 57381
-	float32Type     = 57383
-	float64Type     = 57384
+	escape          = 57382
+	exists          = 57383
+	explain         = 57384
+	falseKwd        = 57385
+	float32Type     = 57387
+	float64Type     = 57388
 	floatLit        = 57346
-	floatType       = 57382
-	from            = 57385
-	full            = 57386
-	ge              = 57387
-	group           = 57388
+	floatType       = 57386
+	from            = 57389
+	full            = 57390
+	ge              = 57391
+	group           = 57392
 	identifier      = 57347
-	ifKwd           = 57389
+	ifKwd           = 57393
+	ilike           = 57394
 	imaginaryLit    = 57348
-	in              = 57390
-	index           = 57391
-	insert          = 57392
-	int16Type       = 57394
-	int32Type       = 57395
-	int64Type       = 57396
-	int8Type        = 57397
+	in              = 57395
+	index           = 57396
+	insert          = 57397
+	int16Type       = 57399
+	int32Type       = 57400
+	int64Type       = 57401
+	int8Type        = 57402
 	intLit          = 57349
-	intType         = 57393
-	into            = 57398
-	is              = 57399
-	join            = 57400
-	le              = 57401
-	left            = 57402
-	like            = 57403
-	limit           = 57404
-	lsh             = 57405
-	neq             = 57406
-	not             = 57407
-	null            = 57408
-	offset          = 57409
-	on              = 57410
-	or              = 57411
-	order           = 57412
-	oror            = 57413
-	outer           = 57414
-	parseExpression = 57436
-	qlParam         = 57350
-	right           = 57415
-	rollback        = 57416
-	rsh             = 57417
-	runeType        = 57418
-	selectKwd       = 57419
-	set             = 57420
-	stringLit       = 57351
-	stringType      = 57421
-	tableKwd        = 57422
-	timeType        = 57423
-	transaction     = 57424
-	trueKwd         = 57425
-	truncate        = 57426
-	uint16Type      = 57428
-	uint32Type      = 57429
-	uint64Type      = 57430
-	uint8Type       = 57431
-	uintType        = 57427
-	unique          = 57432
-	update          = 57433
-	values          = 57434
-	where           = 57435
+	intType         = 57398
+	intervalLit     = 57350
+	into            = 57403
+	is              = 57404
+	join            = 57405
+	jsonType        = 57406
+	le              = 57407
+	left            = 57408
+	like            = 57409
+	limit           = 57410
+	lsh             = 57411
+	matches         = 57412
+	neq             = 57413
+	not             = 57414
+	null            = 57415
+	offset          = 57416
+	on              = 57417
+	or              = 57418
+	order           = 57419
+	oror            = 57420
+	outer           = 57421
+	parseExpression = 57445
+	qlParam         = 57351
+	regexpKwd       = 57422
+	right           = 57423
+	rollback        = 57424
+	rsh             = 57425
+	runeType        = 57426
+	selectKwd       = 57427
+	set             = 57428
+	stringLit       = 57352
+	stringType      = 57429
+	tableKwd        = 57430
+	timeType        = 57431
+	transaction     = 57432
+	trueKwd         = 57433
+	truncate        = 57434
+	uint16Type      = 57436
+	uint32Type      = 57437
+	uint64Type      = 57438
+	uint8Type       = 57439
+	uintType        = 57435
+	unique          = 57440
+	update          = 57441
+	uuidType        = 57442
+	values          = 57443
+	where           = 57444
 
 	yyMaxDepth = 200
-	yyTabOfs   = -222
+	yyTabOfs   = -265
 )
 
 var (
 	yyPrec = map[int]int{}
 
 	yyXLAT = map[int]int{
-		59:    0,   // ';' (204x)
-		57344: 1,   // $end (203x)
-		41:    2,   // ')' (178x)
-		43:    3,   // '+' (135x)
-		45:    4,   // '-' (135x)
-		94:    5,   // '^' (135x)
-		44:    6,   // ',' (130x)
-		40:    7,   // '(' (129x)
-		57347: 8,   // identifier (118x)
-		57409: 9,   // offset (115x)
-		57404: 10,  // limit (113x)
-		57412: 11,  // order (102x)
-		57435: 12,  // where (97x)
-		57372: 13,  // defaultKwd (94x)
-		57388: 14,  // group (93x)
-		57408: 15,  // null (86x)
-		57361: 16,  // bigIntType (85x)
-		57362: 17,  // bigRatType (85x)
-		57363: 18,  // blobType (85x)
-		57364: 19,  // boolType (85x)
-		57366: 20,  // byteType (85x)
-		57369: 21,  // complex128Type (85x)
-		57370: 22,  // complex64Type (85x)
-		57377: 23,  // durationType (85x)
-		57383: 24,  // float32Type (85x)
-		57384: 25,  // float64Type (85x)
-		57382: 26,  // floatType (85x)
-		57386: 27,  // full (85x)
-		57394: 28,  // int16Type (85x)
-		57395: 29,  // int32Type (85x)
-		57396: 30,  // int64Type (85x)
-		57397: 31,  // int8Type (85x)
-		57393: 32,  // intType (85x)
-		57402: 33,  // left (85x)
-		57415: 34,  // right (85x)
-		57418: 35,  // runeType (85x)
-		57421: 36,  // stringType (85x)
-		57423: 37,  // timeType (85x)
-		57428: 38,  // uint16Type (85x)
-		57429: 39,  // uint32Type (85x)
-		57430: 40,  // uint64Type (85x)
-		57431: 41,  // uint8Type (85x)
-		57427: 42,  // uintType (85x)
-		57381: 43,  // falseKwd (83x)
-		57346: 44,  // floatLit (83x)
-		57348: 45,  // imaginaryLit (83x)
-		57349: 46,  // intLit (83x)
-		57350: 47,  // qlParam (83x)
-		57351: 48,  // stringLit (83x)
-		57425: 49,  // trueKwd (83x)
-		57407: 50,  // not (82x)
-		57411: 51,  // or (81x)
-		57413: 52,  // oror (81x)
-		33:    53,  // '!' (79x)
-		57385: 54,  // from (75x)
-		57358: 55,  // asc (71x)
-		57374: 56,  // desc (71x)
-		93:    57,  // ']' (70x)
-		57357: 58,  // as (69x)
-		58:    59,  // ':' (67x)
-		57354: 60,  // and (67x)
-		57355: 61,  // andand (65x)
-		124:   62,  // '|' (56x)
-		61:    63,  // '=' (55x)
-		57360: 64,  // between (54x)
-		57390: 65,  // in (54x)
-		60:    66,  // '<' (53x)
-		62:    67,  // '>' (53x)
-		57378: 68,  // eq (53x)
-		57387: 69,  // ge (53x)
-		57399: 70,  // is (53x)
-		57401: 71,  // le (53x)
-		57403: 72,  // like (53x)
-		57406: 73,  // neq (53x)
-		57515: 74,  // Type (52x)
-		57453: 75,  // Conversion (51x)
-		57484: 76,  // Literal (51x)
-		57485: 77,  // Operand (51x)
-		57489: 78,  // PrimaryExpression (51x)
-		57492: 79,  // QualifiedIdent (51x)
-		42:    80,  // '*' (48x)
-		57516: 81,  // UnaryExpr (47x)
-		37:    82,  // '%' (44x)
-		38:    83,  // '&' (44x)
-		47:    84,  // '/' (44x)
-		57356: 85,  // andnot (44x)
-		57405: 86,  // lsh (44x)
-		57417: 87,  // rsh (44x)
-		57491: 88,  // PrimaryTerm (40x)
-		57490: 89,  // PrimaryFactor (36x)
-		91:    90,  // '[' (31x)
-		57471: 91,  // Factor (25x)
-		57472: 92,  // Factor1 (25x)
-		57513: 93,  // Term (24x)
-		57468: 94,  // Expression (23x)
-		57521: 95,  // logOr (16x)
-		57419: 96,  // selectKwd (12x)
-		57446: 97,  // ColumnName (10x)
-		57498: 98,  // SelectStmt (9x)
-		57512: 99,  // TableName (9x)
-		57449: 100, // CommaOpt (7x)
-		57469: 101, // ExpressionList (7x)
-		57410: 102, // on (7x)
-		57379: 103, // exists (6x)
-		57400: 104, // join (6x)
-		57443: 105, // Call (5x)
-		57376: 106, // drop (5x)
-		57477: 107, // Index (5x)
-		57508: 108, // Slice (5x)
-		57445: 109, // ColumnDef (4x)
-		57389: 110, // ifKwd (4x)
-		57391: 111, // index (4x)
-		57414: 112, // outer (4x)
-		57422: 113, // tableKwd (4x)
-		57434: 114, // values (4x)
-		57353: 115, // alter (3x)
-		57438: 116, // AlterTableStmt (3x)
-		57359: 117, // begin (3x)
-		57442: 118, // BeginTransactionStmt (3x)
-		57368: 119, // commit (3x)
-		57450: 120, // CommitStmt (3x)
-		57371: 121, // create (3x)
-		57455: 122, // CreateIndexStmt (3x)
-		57457: 123, // CreateTableStmt (3x)
-		57461: 124, // DeleteFromStmt (3x)
-		57373: 125, // deleteKwd (3x)
-		57463: 126, // DropIndexStmt (3x)
-		57464: 127, // DropTableStmt (3x)
-		57465: 128, // EmptyStmt (3x)
-		57380: 129, // explain (3x)
-		57467: 130, // ExplainStmt (3x)
-		57392: 131, // insert (3x)
-		57478: 132, // InsertIntoStmt (3x)
-		57493: 133, // RecordSet (3x)
-		57494: 134, // RecordSet1 (3x)
-		57416: 135, // rollback (3x)
-		57497: 136, // RollbackStmt (3x)
-		57522: 137, // semiOpt (3x)
-		57510: 138, // Statement (3x)
-		57426: 139, // truncate (3x)
-		57514: 140, // TruncateTableStmt (3x)
-		57433: 141, // update (3x)
-		57517: 142, // UpdateStmt (3x)
-		57519: 143, // WhereClause (3x)
-		57352: 144, // add (2x)
-		57439: 145, // Assignment (2x)
-		57365: 146, // by (2x)
-		57447: 147, // ColumnNameList (2x)
-		57458: 148, // CreateTableStmt1 (2x)
-		57473: 149, // Field (2x)
-		57520: 150, // logAnd (2x)
-		57420: 151, // set (2x)
-		46:    152, // '.' (1x)
-		57440: 153, // AssignmentList (1x)
-		57441: 154, // AssignmentList1 (1x)
-		57444: 155, // Call1 (1x)
-		57367: 156, // column (1x)
-		57448: 157, // ColumnNameList1 (1x)
-		57451: 158, // Constraint (1x)
-		57452: 159, // ConstraintOpt (1x)
-		57454: 160, // CreateIndexIfNotExists (1x)
-		57456: 161, // CreateIndexStmtUnique (1x)
-		57459: 162, // Default (1x)
-		57460: 163, // DefaultOpt (1x)
-		57375: 164, // distinct (1x)
-		57462: 165, // DropIndexIfExists (1x)
-		57466: 166, // Eq (1x)
-		57470: 167, // ExpressionList1 (1x)
-		57474: 168, // Field1 (1x)
-		57475: 169, // FieldList (1x)
-		57476: 170, // GroupByClause (1x)
-		57479: 171, // InsertIntoStmt1 (1x)
-		57480: 172, // InsertIntoStmt2 (1x)
-		57398: 173, // into (1x)
-		57481: 174, // JoinClause (1x)
-		57482: 175, // JoinClauseOpt (1x)
-		57483: 176, // JoinType (1x)
-		57486: 177, // OrderBy (1x)
-		57487: 178, // OrderBy1 (1x)
-		57488: 179, // OuterOpt (1x)
-		57436: 180, // parseExpression (1x)
-		57495: 181, // RecordSet2 (1x)
-		57496: 182, // RecordSetList (1x)
-		57499: 183, // SelectStmtDistinct (1x)
-		57500: 184, // SelectStmtFieldList (1x)
-		57501: 185, // SelectStmtFrom (1x)
-		57502: 186, // SelectStmtGroup (1x)
-		57503: 187, // SelectStmtLimit (1x)
-		57504: 188, // SelectStmtOffset (1x)
-		57505: 189, // SelectStmtOrder (1x)
-		57506: 190, // SelectStmtWhere (1x)
-		57507: 191, // SetOpt (1x)
-		57509: 192, // Start (1x)
-		57511: 193, // StatementList (1x)
-		57424: 194, // transaction (1x)
-		57432: 195, // unique (1x)
-		57518: 196, // UpdateStmt1 (1x)
-		57437: 197, // $default (0x)
-		57345: 198, // error (0x)
+		59:    0,   // ';' (242x)
+		57344: 1,   // $end (241x)
+		41:    2,   // ')' (228x)
+		44:    3,   // ',' (172x)
+		57347: 4,   // identifier (162x)
+		43:    5,   // '+' (156x)
+		45:    6,   // '-' (156x)
+		94:    7,   // '^' (156x)
+		40:    8,   // '(' (155x)
+		91:    9,   // '[' (135x)
+		57416: 10,  // offset (133x)
+		57410: 11,  // limit (131x)
+		57419: 12,  // order (118x)
+		57374: 13,  // defaultKwd (112x)
+		57444: 14,  // where (112x)
+		57392: 15,  // group (108x)
+		57362: 16,  // bigIntType (102x)
+		57363: 17,  // bigRatType (102x)
+		57364: 18,  // blobType (102x)
+		57365: 19,  // boolType (102x)
+		57367: 20,  // byteType (102x)
+		57371: 21,  // complex128Type (102x)
+		57372: 22,  // complex64Type (102x)
+		57380: 23,  // durationType (102x)
+		57387: 24,  // float32Type (102x)
+		57388: 25,  // float64Type (102x)
+		57386: 26,  // floatType (102x)
+		57399: 27,  // int16Type (102x)
+		57400: 28,  // int32Type (102x)
+		57401: 29,  // int64Type (102x)
+		57402: 30,  // int8Type (102x)
+		57398: 31,  // intType (102x)
+		57406: 32,  // jsonType (102x)
+		57426: 33,  // runeType (102x)
+		57429: 34,  // stringType (102x)
+		57431: 35,  // timeType (102x)
+		57436: 36,  // uint16Type (102x)
+		57437: 37,  // uint32Type (102x)
+		57438: 38,  // uint64Type (102x)
+		57439: 39,  // uint8Type (102x)
+		57435: 40,  // uintType (102x)
+		57442: 41,  // uuidType (102x)
+		57368: 42,  // collateKwd (101x)
+		57415: 43,  // null (101x)
+		57352: 44,  // stringLit (101x)
+		57375: 45,  // decimalType (100x)
+		57390: 46,  // full (100x)
+		57349: 47,  // intLit (100x)
+		57408: 48,  // left (100x)
+		57423: 49,  // right (100x)
+		57385: 50,  // falseKwd (98x)
+		57346: 51,  // floatLit (98x)
+		57348: 52,  // imaginaryLit (98x)
+		57350: 53,  // intervalLit (98x)
+		57351: 54,  // qlParam (98x)
+		57433: 55,  // trueKwd (98x)
+		57414: 56,  // not (97x)
+		33:    57,  // '!' (94x)
+		57418: 58,  // or (91x)
+		57420: 59,  // oror (91x)
+		57389: 60,  // from (83x)
+		57359: 61,  // asc (82x)
+		57377: 62,  // desc (82x)
+		125:   63,  // '}' (81x)
+		93:    64,  // ']' (80x)
+		57358: 65,  // as (79x)
+		58:    66,  // ':' (75x)
+		57355: 67,  // and (75x)
+		57356: 68,  // andand (73x)
+		61:    69,  // '=' (63x)
+		57540: 70,  // Type (63x)
+		124:   71,  // '|' (62x)
+		57361: 72,  // between (62x)
+		57395: 73,  // in (62x)
+		60:    74,  // '<' (61x)
+		62:    75,  // '>' (61x)
+		57381: 76,  // eq (61x)
+		57391: 77,  // ge (61x)
+		57394: 78,  // ilike (61x)
+		57404: 79,  // is (61x)
+		57407: 80,  // le (61x)
+		57409: 81,  // like (61x)
+		57412: 82,  // matches (61x)
+		57413: 83,  // neq (61x)
+		57422: 84,  // regexpKwd (61x)
+		57448: 85,  // ArrayLit (60x)
+		57467: 86,  // Conversion (60x)
+		57506: 87,  // Literal (60x)
+		57507: 88,  // Operand (60x)
+		57511: 89,  // PrimaryExpression (60x)
+		57514: 90,  // QualifiedIdent (60x)
+		57541: 91,  // UnaryExpr (56x)
+		42:    92,  // '*' (52x)
+		57382: 93,  // escape (49x)
+		57513: 94,  // PrimaryTerm (49x)
+		37:    95,  // '%' (48x)
+		38:    96,  // '&' (48x)
+		47:    97,  // '/' (48x)
+		57357: 98,  // andnot (48x)
+		57411: 99,  // lsh (48x)
+		57425: 100, // rsh (48x)
+		57512: 101, // PrimaryFactor (45x)
+		57491: 102, // Factor (32x)
+		57492: 103, // Factor1 (32x)
+		57538: 104, // Term (31x)
+		57487: 105, // Expression (30x)
+		123:   106, // '{' (27x)
+		57546: 107, // logOr (18x)
+		57417: 108, // on (15x)
+		57427: 109, // selectKwd (13x)
+		57460: 110, // ColumnName (12x)
+		57463: 111, // CommaOpt (9x)
+		57523: 112, // SelectStmt (9x)
+		57537: 113, // TableName (9x)
+		57383: 114, // exists (7x)
+		57405: 115, // join (7x)
+		57488: 116, // ExpressionList (6x)
+		57393: 117, // ifKwd (6x)
+		57396: 118, // index (6x)
+		57453: 119, // Call (5x)
+		57379: 120, // drop (5x)
+		57497: 121, // Index (5x)
+		57533: 122, // Slice (5x)
+		57443: 123, // values (5x)
+		57456: 124, // CollatedExpression (4x)
+		57459: 125, // ColumnDef (4x)
+		57461: 126, // ColumnNameList (4x)
+		57465: 127, // Constraint (4x)
+		57466: 128, // ConstraintOpt (4x)
+		57475: 129, // Default (4x)
+		57476: 130, // DefaultOpt (4x)
+		57421: 131, // outer (4x)
+		57430: 132, // tableKwd (4x)
+		57354: 133, // alter (3x)
+		57447: 134, // AlterTableStmt (3x)
+		57360: 135, // begin (3x)
+		57452: 136, // BeginTransactionStmt (3x)
+		57370: 137, // commit (3x)
+		57464: 138, // CommitStmt (3x)
+		57373: 139, // create (3x)
+		57469: 140, // CreateIndexStmt (3x)
+		57472: 141, // CreateTableStmt (3x)
+		57474: 142, // CreateTypeStmt (3x)
+		57477: 143, // DeleteFromStmt (3x)
+		57376: 144, // deleteKwd (3x)
+		57479: 145, // DropIndexStmt (3x)
+		57480: 146, // DropTableStmt (3x)
+		57481: 147, // DropTypeStmt (3x)
+		57482: 148, // EmptyStmt (3x)
+		57384: 149, // explain (3x)
+		57486: 150, // ExplainStmt (3x)
+		57397: 151, // insert (3x)
+		57499: 152, // InsertIntoStmt (3x)
+		57515: 153, // RecordSet (3x)
+		57516: 154, // RecordSet1 (3x)
+		57424: 155, // rollback (3x)
+		57522: 156, // RollbackStmt (3x)
+		57547: 157, // semiOpt (3x)
+		57535: 158, // Statement (3x)
+		57434: 159, // truncate (3x)
+		57539: 160, // TruncateTableStmt (3x)
+		57441: 161, // update (3x)
+		57542: 162, // UpdateStmt (3x)
+		57544: 163, // WhereClause (3x)
+		57353: 164, // add (2x)
+		57449: 165, // Assignment (2x)
+		57366: 166, // by (2x)
+		57457: 167, // CollatedExpressionList (2x)
+		57455: 168, // CollateOpt (2x)
+		57468: 169, // CreateIndexIfNotExists (2x)
+		57473: 170, // CreateTableStmt1 (2x)
+		57493: 171, // Field (2x)
+		57545: 172, // logAnd (2x)
+		57518: 173, // RecordSetHint (2x)
+		57428: 174, // set (2x)
+		46:    175, // '.' (1x)
+		57450: 176, // AssignmentList (1x)
+		57451: 177, // AssignmentList1 (1x)
+		57454: 178, // Call1 (1x)
+		57458: 179, // CollatedExpressionList1 (1x)
+		57369: 180, // column (1x)
+		57462: 181, // ColumnNameList1 (1x)
+		57470: 182, // CreateIndexStmtUnique (1x)
+		57471: 183, // CreateIndexUsingOpt (1x)
+		57378: 184, // distinct (1x)
+		57478: 185, // DropIndexIfExists (1x)
+		57483: 186, // EnumLabelList (1x)
+		57484: 187, // Eq (1x)
+		57485: 188, // EscapeOpt (1x)
+		57489: 189, // ExpressionList1 (1x)
+		57490: 190, // ExpressionListOpt (1x)
+		57494: 191, // Field1 (1x)
+		57495: 192, // FieldList (1x)
+		57496: 193, // GroupByClause (1x)
+		57498: 194, // IndexNameList (1x)
+		57500: 195, // InsertIntoStmt1 (1x)
+		57501: 196, // InsertIntoStmt2 (1x)
+		57403: 197, // into (1x)
+		57502: 198, // JoinClause (1x)
+		57503: 199, // JoinClauseOpt (1x)
+		57504: 200, // JoinType (1x)
+		57505: 201, // LikeOp (1x)
+		57508: 202, // OrderBy (1x)
+		57509: 203, // OrderBy1 (1x)
+		57510: 204, // OuterOpt (1x)
+		57445: 205, // parseExpression (1x)
+		57517: 206, // RecordSet2 (1x)
+		57519: 207, // RecordSetHintList (1x)
+		57520: 208, // RecordSetHintOpt (1x)
+		57521: 209, // RecordSetList (1x)
+		57524: 210, // SelectStmtDistinct (1x)
+		57525: 211, // SelectStmtFieldList (1x)
+		57526: 212, // SelectStmtFrom (1x)
+		57527: 213, // SelectStmtGroup (1x)
+		57528: 214, // SelectStmtLimit (1x)
+		57529: 215, // SelectStmtOffset (1x)
+		57530: 216, // SelectStmtOrder (1x)
+		57531: 217, // SelectStmtWhere (1x)
+		57532: 218, // SetOpt (1x)
+		57534: 219, // Start (1x)
+		57536: 220, // StatementList (1x)
+		57432: 221, // transaction (1x)
+		57440: 222, // unique (1x)
+		57543: 223, // UpdateStmt1 (1x)
+		57446: 224, // $default (0x)
+		57345: 225, // error (0x)
 	}
 
 	yySymNames = []string{
 		"';'",
 		"$end",
 		"')'",
+		"','",
+		"identifier",
 		"'+'",
 		"'-'",
 		"'^'",
-		"','",
 		"'('",
-		"identifier",
+		"'['",
 		"offset",
 		"limit",
 		"order",
-		"where",
 		"defaultKwd",
+		"where",
 		"group",
-		"null",
 		"bigIntType",
 		"bigRatType",
 		"blobType",
@@ -366,14 +403,12 @@ var (
 		"float32Type",
 		"float64Type",
 		"floatType",
-		"full",
 		"int16Type",
 		"int32Type",
 		"int64Type",
 		"int8Type",
 		"intType",
-		"left",
-		"right",
+		"jsonType",
 		"runeType",
 		"stringType",
 		"timeType",
@@ -382,78 +417,98 @@ var (
 		"uint64Type",
 		"uint8Type",
 		"uintType",
+		"uuidType",
+		"collateKwd",
+		"null",
+		"stringLit",
+		"decimalType",
+		"full",
+		"intLit",
+		"left",
+		"right",
 		"falseKwd",
 		"floatLit",
 		"imaginaryLit",
-		"intLit",
+		"intervalLit",
 		"qlParam",
-		"stringLit",
 		"trueKwd",
 		"not",
+		"'!'",
 		"or",
 		"oror",
-		"'!'",
 		"from",
 		"asc",
 		"desc",
+		"'}'",
 		"']'",
 		"as",
 		"':'",
 		"and",
 		"andand",
-		"'|'",
 		"'='",
+		"Type",
+		"'|'",
 		"between",
 		"in",
 		"'<'",
 		"'>'",
 		"eq",
 		"ge",
+		"ilike",
 		"is",
 		"le",
 		"like",
+		"matches",
 		"neq",
-		"Type",
+		"regexpKwd",
+		"ArrayLit",
 		"Conversion",
 		"Literal",
 		"Operand",
 		"PrimaryExpression",
 		"QualifiedIdent",
-		"'*'",
 		"UnaryExpr",
+		"'*'",
+		"escape",
+		"PrimaryTerm",
 		"'%'",
 		"'&'",
 		"'/'",
 		"andnot",
 		"lsh",
 		"rsh",
-		"PrimaryTerm",
 		"PrimaryFactor",
-		"'['",
 		"Factor",
 		"Factor1",
 		"Term",
 		"Expression",
+		"'{'",
 		"logOr",
+		"on",
 		"selectKwd",
 		"ColumnName",
+		"CommaOpt",
 		"SelectStmt",
 		"TableName",
-		"CommaOpt",
-		"ExpressionList",
-		"on",
 		"exists",
 		"join",
+		"ExpressionList",
+		"ifKwd",
+		"index",
 		"Call",
 		"drop",
 		"Index",
 		"Slice",
+		"values",
+		"CollatedExpression",
 		"ColumnDef",
-		"ifKwd",
-		"index",
+		"ColumnNameList",
+		"Constraint",
+		"ConstraintOpt",
+		"Default",
+		"DefaultOpt",
 		"outer",
 		"tableKwd",
-		"values",
 		"alter",
 		"AlterTableStmt",
 		"begin",
@@ -463,10 +518,12 @@ var (
 		"create",
 		"CreateIndexStmt",
 		"CreateTableStmt",
+		"CreateTypeStmt",
 		"DeleteFromStmt",
 		"deleteKwd",
 		"DropIndexStmt",
 		"DropTableStmt",
+		"DropTypeStmt",
 		"EmptyStmt",
 		"explain",
 		"ExplainStmt",
@@ -486,41 +543,48 @@ var (
 		"add",
 		"Assignment",
 		"by",
-		"ColumnNameList",
+		"CollatedExpressionList",
+		"CollateOpt",
+		"CreateIndexIfNotExists",
 		"CreateTableStmt1",
 		"Field",
 		"logAnd",
+		"RecordSetHint",
 		"set",
 		"'.'",
 		"AssignmentList",
 		"AssignmentList1",
 		"Call1",
+		"CollatedExpressionList1",
 		"column",
 		"ColumnNameList1",
-		"Constraint",
-		"ConstraintOpt",
-		"CreateIndexIfNotExists",
 		"CreateIndexStmtUnique",
-		"Default",
-		"DefaultOpt",
+		"CreateIndexUsingOpt",
 		"distinct",
 		"DropIndexIfExists",
+		"EnumLabelList",
 		"Eq",
+		"EscapeOpt",
 		"ExpressionList1",
+		"ExpressionListOpt",
 		"Field1",
 		"FieldList",
 		"GroupByClause",
+		"IndexNameList",
 		"InsertIntoStmt1",
 		"InsertIntoStmt2",
 		"into",
 		"JoinClause",
 		"JoinClauseOpt",
 		"JoinType",
+		"LikeOp",
 		"OrderBy",
 		"OrderBy1",
 		"OuterOpt",
 		"parseExpression",
 		"RecordSet2",
+		"RecordSetHintList",
+		"RecordSetHintOpt",
 		"RecordSetList",
 		"SelectStmtDistinct",
 		"SelectStmtFieldList",
@@ -542,586 +606,709 @@ var (
 
 	yyTokenLiteralStrings = map[int]string{
 		57347: "identifier",
-		57409: "OFFSET",
-		57404: "LIMIT",
-		57412: "ORDER",
-		57435: "WHERE",
-		57372: "DEFAULT",
-		57388: "GROUP",
-		57408: "NULL",
-		57361: "bigint",
-		57362: "bigrat",
-		57363: "blob",
-		57364: "bool",
-		57366: "byte",
-		57369: "complex128",
-		57370: "complex64",
-		57377: "duration",
-		57383: "float32",
-		57384: "float64",
-		57382: "float",
-		57386: "FULL",
-		57394: "int16",
-		57395: "int32",
-		57396: "int64",
-		57397: "int8",
-		57393: "int",
-		57402: "LEFT",
-		57415: "RIGHT",
-		57418: "rune",
-		57421: "string",
-		57423: "time",
-		57428: "uint16",
-		57429: "uint32",
-		57430: "uint64",
-		57431: "uint8",
-		57427: "uint",
-		57381: "false",
+		57416: "OFFSET",
+		57410: "LIMIT",
+		57419: "ORDER",
+		57374: "DEFAULT",
+		57444: "WHERE",
+		57392: "GROUP",
+		57362: "bigint",
+		57363: "bigrat",
+		57364: "blob",
+		57365: "bool",
+		57367: "byte",
+		57371: "complex128",
+		57372: "complex64",
+		57380: "duration",
+		57387: "float32",
+		57388: "float64",
+		57386: "float",
+		57399: "int16",
+		57400: "int32",
+		57401: "int64",
+		57402: "int8",
+		57398: "int",
+		57406: "json",
+		57426: "rune",
+		57429: "string",
+		57431: "time",
+		57436: "uint16",
+		57437: "uint32",
+		57438: "uint64",
+		57439: "uint8",
+		57435: "uint",
+		57442: "uuid",
+		57368: "COLLATE",
+		57415: "NULL",
+		57352: "string literal",
+		57375: "decimal",
+		57390: "FULL",
+		57349: "integer literal",
+		57408: "LEFT",
+		57423: "RIGHT",
+		57385: "false",
 		57346: "floating-point literal",
 		57348: "imaginary literal",
-		57349: "integer literal",
-		57350: "QL parameter",
-		57351: "string literal",
-		57425: "true",
-		57407: "NOT",
-		57411: "OR",
-		57413: "||",
-		57385: "FROM",
-		57358: "ASC",
-		57374: "DESC",
-		57357: "AS",
-		57354: "AND",
-		57355: "&&",
-		57360: "BETWEEN",
-		57390: "IN",
-		57378: "==",
-		57387: ">=",
-		57399: "IS",
-		57401: "<=",
-		57403: "LIKE",
-		57406: "!=",
-		57356: "&^",
-		57405: "<<",
-		57417: ">>",
-		57419: "SELECT",
-		57410: "ON",
-		57379: "EXISTS",
-		57400: "JOIN",
-		57376: "DROP",
-		57389: "IF",
-		57391: "INDEX",
-		57414: "OUTER",
-		57422: "TABLE",
-		57434: "VALUES",
-		57353: "ALTER",
-		57359: "BEGIN",
-		57368: "COMMIT",
-		57371: "CREATE",
-		57373: "DELETE",
-		57380: "EXPLAIN",
-		57392: "INSERT",
-		57416: "ROLLBACK",
-		57426: "TRUNCATE",
-		57433: "UPDATE",
-		57352: "ADD",
-		57365: "BY",
-		57420: "SET",
-		57367: "COLUMN",
-		57375: "DISTINCT",
-		57398: "INTO",
-		57436: "parse expression prefix",
-		57424: "TRANSACTION",
-		57432: "UNIQUE",
+		57350: "interval literal",
+		57351: "QL parameter",
+		57433: "true",
+		57414: "NOT",
+		57418: "OR",
+		57420: "||",
+		57389: "FROM",
+		57359: "ASC",
+		57377: "DESC",
+		57358: "AS",
+		57355: "AND",
+		57356: "&&",
+		57361: "BETWEEN",
+		57395: "IN",
+		57381: "==",
+		57391: ">=",
+		57394: "ILIKE",
+		57404: "IS",
+		57407: "<=",
+		57409: "LIKE",
+		57412: "MATCHES",
+		57413: "!=",
+		57422: "REGEXP",
+		57382: "ESCAPE",
+		57357: "&^",
+		57411: "<<",
+		57425: ">>",
+		57417: "ON",
+		57427: "SELECT",
+		57383: "EXISTS",
+		57405: "JOIN",
+		57393: "IF",
+		57396: "INDEX",
+		57379: "DROP",
+		57443: "VALUES",
+		57421: "OUTER",
+		57430: "TABLE",
+		57354: "ALTER",
+		57360: "BEGIN",
+		57370: "COMMIT",
+		57373: "CREATE",
+		57376: "DELETE",
+		57384: "EXPLAIN",
+		57397: "INSERT",
+		57424: "ROLLBACK",
+		57434: "TRUNCATE",
+		57441: "UPDATE",
+		57353: "ADD",
+		57366: "BY",
+		57428: "SET",
+		57369: "COLUMN",
+		57378: "DISTINCT",
+		57403: "INTO",
+		57445: "parse expression prefix",
+		57432: "TRANSACTION",
+		57440: "UNIQUE",
 	}
 
 	yyReductions = map[int]struct{ xsym, components int }{
 		0:   {0, 1},
-		1:   {192, 1},
-		2:   {192, 2},
-		3:   {116, 5},
-		4:   {116, 6},
-		5:   {145, 3},
-		6:   {153, 3},
-		7:   {154, 0},
-		8:   {154, 3},
-		9:   {118, 2},
-		10:  {105, 3},
-		11:  {105, 3},
-		12:  {155, 0},
-		13:  {155, 1},
-		14:  {109, 4},
-		15:  {97, 1},
-		16:  {147, 3},
-		17:  {157, 0},
-		18:  {157, 3},
-		19:  {120, 1},
-		20:  {158, 2},
-		21:  {158, 1},
-		22:  {159, 0},
-		23:  {159, 1},
-		24:  {75, 4},
-		25:  {122, 10},
-		26:  {160, 0},
-		27:  {160, 3},
-		28:  {161, 0},
-		29:  {161, 1},
-		30:  {123, 8},
-		31:  {123, 11},
-		32:  {148, 0},
-		33:  {148, 3},
-		34:  {162, 2},
-		35:  {163, 0},
-		36:  {163, 1},
-		37:  {124, 3},
-		38:  {124, 4},
-		39:  {126, 4},
-		40:  {165, 0},
-		41:  {165, 2},
-		42:  {127, 3},
-		43:  {127, 5},
-		44:  {128, 0},
-		45:  {130, 2},
-		46:  {94, 1},
-		47:  {94, 3},
-		48:  {95, 1},
-		49:  {95, 1},
-		50:  {166, 1},
-		51:  {166, 1},
-		52:  {101, 3},
-		53:  {167, 0},
-		54:  {167, 3},
-		55:  {91, 1},
-		56:  {91, 5},
-		57:  {91, 6},
-		58:  {91, 6},
-		59:  {91, 7},
-		60:  {91, 5},
-		61:  {91, 6},
-		62:  {91, 3},
-		63:  {91, 4},
-		64:  {92, 1},
-		65:  {92, 3},
-		66:  {92, 3},
-		67:  {92, 3},
-		68:  {92, 3},
-		69:  {92, 3},
-		70:  {92, 3},
-		71:  {92, 3},
-		72:  {149, 2},
-		73:  {168, 0},
-		74:  {168, 2},
-		75:  {169, 1},
-		76:  {169, 3},
-		77:  {170, 3},
-		78:  {107, 3},
-		79:  {132, 10},
-		80:  {132, 5},
-		81:  {171, 0},
-		82:  {171, 3},
-		83:  {172, 0},
-		84:  {172, 5},
-		85:  {76, 1},
-		86:  {76, 1},
-		87:  {76, 1},
-		88:  {76, 1},
-		89:  {76, 1},
-		90:  {76, 1},
-		91:  {76, 1},
-		92:  {77, 1},
-		93:  {77, 1},
-		94:  {77, 1},
-		95:  {77, 3},
-		96:  {177, 4},
-		97:  {178, 0},
-		98:  {178, 1},
-		99:  {178, 1},
-		100: {78, 1},
-		101: {78, 1},
-		102: {78, 2},
-		103: {78, 2},
-		104: {78, 2},
-		105: {89, 1},
-		106: {89, 3},
-		107: {89, 3},
-		108: {89, 3},
-		109: {89, 3},
-		110: {88, 1},
-		111: {88, 3},
-		112: {88, 3},
-		113: {88, 3},
-		114: {88, 3},
-		115: {88, 3},
-		116: {88, 3},
-		117: {88, 3},
-		118: {79, 1},
-		119: {79, 3},
-		120: {133, 2},
-		121: {134, 1},
-		122: {134, 4},
-		123: {137, 0},
-		124: {137, 1},
-		125: {181, 0},
-		126: {181, 2},
-		127: {182, 1},
-		128: {182, 3},
-		129: {136, 1},
-		130: {176, 1},
-		131: {176, 1},
-		132: {176, 1},
-		133: {179, 0},
-		134: {179, 1},
-		135: {174, 6},
-		136: {175, 0},
-		137: {175, 1},
-		138: {98, 10},
-		139: {185, 0},
-		140: {185, 3},
-		141: {187, 0},
-		142: {187, 2},
-		143: {188, 0},
-		144: {188, 2},
-		145: {183, 0},
-		146: {183, 1},
-		147: {184, 1},
-		148: {184, 1},
-		149: {184, 2},
-		150: {190, 0},
-		151: {190, 1},
-		152: {186, 0},
-		153: {186, 1},
-		154: {189, 0},
-		155: {189, 1},
-		156: {108, 3},
-		157: {108, 4},
-		158: {108, 4},
-		159: {108, 5},
-		160: {138, 1},
-		161: {138, 1},
-		162: {138, 1},
-		163: {138, 1},
-		164: {138, 1},
-		165: {138, 1},
-		166: {138, 1},
-		167: {138, 1},
-		168: {138, 1},
-		169: {138, 1},
-		170: {138, 1},
-		171: {138, 1},
-		172: {138, 1},
-		173: {138, 1},
-		174: {138, 1},
-		175: {193, 1},
-		176: {193, 3},
-		177: {99, 1},
-		178: {93, 1},
-		179: {93, 3},
-		180: {150, 1},
-		181: {150, 1},
-		182: {140, 3},
-		183: {74, 1},
-		184: {74, 1},
-		185: {74, 1},
-		186: {74, 1},
-		187: {74, 1},
-		188: {74, 1},
-		189: {74, 1},
-		190: {74, 1},
-		191: {74, 1},
-		192: {74, 1},
-		193: {74, 1},
-		194: {74, 1},
-		195: {74, 1},
-		196: {74, 1},
-		197: {74, 1},
-		198: {74, 1},
-		199: {74, 1},
-		200: {74, 1},
-		201: {74, 1},
-		202: {74, 1},
-		203: {74, 1},
-		204: {74, 1},
-		205: {74, 1},
-		206: {74, 1},
-		207: {142, 5},
-		208: {196, 0},
-		209: {196, 1},
-		210: {81, 1},
-		211: {81, 2},
-		212: {81, 2},
-		213: {81, 2},
-		214: {81, 2},
-		215: {143, 2},
-		216: {143, 5},
-		217: {143, 6},
-		218: {191, 0},
-		219: {191, 1},
-		220: {100, 0},
-		221: {100, 1},
+		1:   {219, 1},
+		2:   {219, 2},
+		3:   {134, 5},
+		4:   {134, 6},
+		5:   {85, 6},
+		6:   {165, 3},
+		7:   {176, 3},
+		8:   {177, 0},
+		9:   {177, 3},
+		10:  {136, 2},
+		11:  {119, 3},
+		12:  {119, 3},
+		13:  {178, 0},
+		14:  {178, 1},
+		15:  {168, 0},
+		16:  {168, 2},
+		17:  {124, 2},
+		18:  {167, 3},
+		19:  {179, 0},
+		20:  {179, 3},
+		21:  {125, 5},
+		22:  {125, 9},
+		23:  {125, 6},
+		24:  {125, 4},
+		25:  {110, 1},
+		26:  {126, 3},
+		27:  {181, 0},
+		28:  {181, 3},
+		29:  {138, 1},
+		30:  {127, 2},
+		31:  {127, 1},
+		32:  {128, 0},
+		33:  {128, 1},
+		34:  {86, 4},
+		35:  {86, 4},
+		36:  {140, 10},
+		37:  {140, 11},
+		38:  {169, 0},
+		39:  {169, 3},
+		40:  {183, 0},
+		41:  {183, 2},
+		42:  {182, 0},
+		43:  {182, 1},
+		44:  {141, 8},
+		45:  {141, 11},
+		46:  {170, 0},
+		47:  {170, 3},
+		48:  {142, 9},
+		49:  {129, 2},
+		50:  {130, 0},
+		51:  {130, 1},
+		52:  {143, 3},
+		53:  {143, 4},
+		54:  {145, 4},
+		55:  {185, 0},
+		56:  {185, 2},
+		57:  {146, 3},
+		58:  {146, 5},
+		59:  {147, 3},
+		60:  {147, 5},
+		61:  {148, 0},
+		62:  {186, 1},
+		63:  {186, 3},
+		64:  {188, 0},
+		65:  {188, 2},
+		66:  {150, 2},
+		67:  {105, 1},
+		68:  {105, 3},
+		69:  {107, 1},
+		70:  {107, 1},
+		71:  {187, 1},
+		72:  {187, 1},
+		73:  {116, 3},
+		74:  {189, 0},
+		75:  {189, 3},
+		76:  {190, 0},
+		77:  {190, 1},
+		78:  {102, 1},
+		79:  {102, 5},
+		80:  {102, 6},
+		81:  {102, 6},
+		82:  {102, 7},
+		83:  {102, 5},
+		84:  {102, 6},
+		85:  {102, 3},
+		86:  {102, 4},
+		87:  {103, 1},
+		88:  {103, 3},
+		89:  {103, 3},
+		90:  {103, 3},
+		91:  {103, 3},
+		92:  {103, 3},
+		93:  {103, 3},
+		94:  {103, 4},
+		95:  {103, 3},
+		96:  {103, 3},
+		97:  {171, 2},
+		98:  {191, 0},
+		99:  {191, 2},
+		100: {192, 1},
+		101: {192, 3},
+		102: {193, 3},
+		103: {121, 3},
+		104: {194, 1},
+		105: {194, 3},
+		106: {152, 10},
+		107: {152, 5},
+		108: {195, 0},
+		109: {195, 3},
+		110: {195, 7},
+		111: {196, 0},
+		112: {196, 5},
+		113: {201, 1},
+		114: {201, 1},
+		115: {87, 1},
+		116: {87, 1},
+		117: {87, 1},
+		118: {87, 1},
+		119: {87, 1},
+		120: {87, 1},
+		121: {87, 1},
+		122: {87, 1},
+		123: {88, 1},
+		124: {88, 1},
+		125: {88, 1},
+		126: {88, 3},
+		127: {88, 1},
+		128: {202, 4},
+		129: {203, 0},
+		130: {203, 1},
+		131: {203, 1},
+		132: {89, 1},
+		133: {89, 1},
+		134: {89, 2},
+		135: {89, 2},
+		136: {89, 2},
+		137: {101, 1},
+		138: {101, 3},
+		139: {101, 3},
+		140: {101, 3},
+		141: {101, 3},
+		142: {94, 1},
+		143: {94, 3},
+		144: {94, 3},
+		145: {94, 3},
+		146: {94, 3},
+		147: {94, 3},
+		148: {94, 3},
+		149: {94, 3},
+		150: {90, 1},
+		151: {90, 3},
+		152: {153, 3},
+		153: {154, 1},
+		154: {154, 4},
+		155: {154, 4},
+		156: {157, 0},
+		157: {157, 1},
+		158: {206, 0},
+		159: {206, 2},
+		160: {173, 5},
+		161: {173, 3},
+		162: {207, 1},
+		163: {207, 2},
+		164: {208, 0},
+		165: {208, 1},
+		166: {209, 1},
+		167: {209, 3},
+		168: {156, 1},
+		169: {200, 1},
+		170: {200, 1},
+		171: {200, 1},
+		172: {204, 0},
+		173: {204, 1},
+		174: {198, 6},
+		175: {199, 0},
+		176: {199, 1},
+		177: {112, 10},
+		178: {212, 0},
+		179: {212, 3},
+		180: {214, 0},
+		181: {214, 2},
+		182: {215, 0},
+		183: {215, 2},
+		184: {210, 0},
+		185: {210, 1},
+		186: {211, 1},
+		187: {211, 1},
+		188: {211, 2},
+		189: {217, 0},
+		190: {217, 1},
+		191: {213, 0},
+		192: {213, 1},
+		193: {216, 0},
+		194: {216, 1},
+		195: {122, 3},
+		196: {122, 4},
+		197: {122, 4},
+		198: {122, 5},
+		199: {158, 1},
+		200: {158, 1},
+		201: {158, 1},
+		202: {158, 1},
+		203: {158, 1},
+		204: {158, 1},
+		205: {158, 1},
+		206: {158, 1},
+		207: {158, 1},
+		208: {158, 1},
+		209: {158, 1},
+		210: {158, 1},
+		211: {158, 1},
+		212: {158, 1},
+		213: {158, 1},
+		214: {158, 1},
+		215: {158, 1},
+		216: {220, 1},
+		217: {220, 3},
+		218: {113, 1},
+		219: {104, 1},
+		220: {104, 3},
+		221: {172, 1},
+		222: {172, 1},
+		223: {160, 3},
+		224: {70, 1},
+		225: {70, 1},
+		226: {70, 1},
+		227: {70, 1},
+		228: {70, 1},
+		229: {70, 1},
+		230: {70, 1},
+		231: {70, 1},
+		232: {70, 1},
+		233: {70, 1},
+		234: {70, 1},
+		235: {70, 1},
+		236: {70, 1},
+		237: {70, 1},
+		238: {70, 1},
+		239: {70, 1},
+		240: {70, 1},
+		241: {70, 1},
+		242: {70, 1},
+		243: {70, 1},
+		244: {70, 1},
+		245: {70, 1},
+		246: {70, 1},
+		247: {70, 1},
+		248: {70, 1},
+		249: {70, 1},
+		250: {162, 5},
+		251: {223, 0},
+		252: {223, 1},
+		253: {91, 1},
+		254: {91, 2},
+		255: {91, 2},
+		256: {91, 2},
+		257: {91, 2},
+		258: {163, 2},
+		259: {163, 5},
+		260: {163, 6},
+		261: {218, 0},
+		262: {218, 1},
+		263: {111, 0},
+		264: {111, 1},
 	}
 
 	yyXErrors = map[yyXError]string{
 		{1, -1}:   "expected $end",
-		{43, -1}:  "expected '('",
-		{94, -1}:  "expected '('",
-		{96, -1}:  "expected '('",
-		{168, -1}: "expected '('",
-		{192, -1}: "expected '('",
-		{293, -1}: "expected '('",
-		{321, -1}: "expected '('",
-		{325, -1}: "expected '('",
-		{356, -1}: "expected '('",
-		{98, -1}:  "expected ')'",
-		{101, -1}: "expected ')'",
-		{127, -1}: "expected ')'",
-		{128, -1}: "expected ')'",
-		{129, -1}: "expected ')'",
-		{198, -1}: "expected ')'",
-		{200, -1}: "expected ')'",
-		{201, -1}: "expected ')'",
-		{205, -1}: "expected ')'",
-		{207, -1}: "expected ')'",
-		{239, -1}: "expected ')'",
-		{291, -1}: "expected ')'",
-		{296, -1}: "expected ')'",
-		{302, -1}: "expected ')'",
-		{330, -1}: "expected ')'",
-		{347, -1}: "expected ')'",
-		{358, -1}: "expected ')'",
-		{36, -1}:  "expected '='",
-		{252, -1}: "expected BY",
-		{255, -1}: "expected BY",
-		{364, -1}: "expected COLUMN",
-		{7, -1}:   "expected CREATE INDEX optional UNIQUE clause or one of [INDEX, TABLE, UNIQUE]",
-		{349, -1}: "expected CREATE INDEX statement optional IF NOT EXISTS cluse or one of [IF, identifier]",
-		{328, -1}: "expected CREATE TABLE statement colum definition list or optional comma or one of [')', ',']",
-		{345, -1}: "expected CREATE TABLE statement colum definition list or optional comma or one of [')', ',']",
-		{305, -1}: "expected DROP INDEX statement optional IF EXISTS clause or one of [IF, identifier]",
-		{95, -1}:  "expected EXISTS",
-		{308, -1}: "expected EXISTS",
-		{312, -1}: "expected EXISTS",
-		{323, -1}: "expected EXISTS",
-		{352, -1}: "expected EXISTS",
-		{46, -1}:  "expected Eq or one of [!=, $end, &&, ')', ',', ':', ';', '<', '=', '>', ']', <=, ==, >=, AND, AS, ASC, BETWEEN, DEFAULT, DESC, FROM, FULL, GROUP, IN, IS, LEFT, LIKE, LIMIT, NOT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{8, -1}:   "expected FROM",
-		{318, -1}: "expected INDEX",
-		{319, -1}: "expected INDEX",
-		{288, -1}: "expected INSERT INTO statement optional column list clause or SELECT statement or one of ['(', SELECT, VALUES]",
-		{297, -1}: "expected INSERT INTO statement optional values list or optional comma or one of [$end, ',', ';']",
-		{11, -1}:  "expected INTO",
-		{276, -1}: "expected JOIN",
-		{277, -1}: "expected JOIN",
-		{322, -1}: "expected NOT",
-		{351, -1}: "expected NOT",
-		{187, -1}: "expected NULL",
-		{336, -1}: "expected NULL",
-		{279, -1}: "expected ON",
-		{354, -1}: "expected ON",
-		{265, -1}: "expected ORDER BY clause optional collation specification or one of [$end, ')', ';', ASC, DESC, LIMIT, OFFSET]",
-		{229, -1}: "expected RecordSetList or one of ['(', identifier]",
-		{13, -1}:  "expected SELECT statement field list or SELECT statement optional DISTINCT clause or one of ['!', '(', '*', '+', '-', '^', DISTINCT, NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{221, -1}: "expected SELECT statement field list or one of ['!', '(', '*', '+', '-', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{226, -1}: "expected SELECT statement optional FROM clause or SELECT statement optional GROUP BY clause or SELECT statement optional JOIN clause or SELECT statement optional LIMIT clause or SELECT statement optional OFFSET clause or SELECT statement optional ORDER BY clause or SELECT statement optional WHERE clause or one of [$end, ')', ';', FROM, FULL, GROUP, LEFT, LIMIT, OFFSET, ORDER, RIGHT, WHERE]",
-		{228, -1}: "expected SELECT statement optional GROUP BY clause or SELECT statement optional JOIN clause or SELECT statement optional LIMIT clause or SELECT statement optional OFFSET clause or SELECT statement optional ORDER BY clause or SELECT statement optional WHERE clause or one of [$end, ')', ';', FULL, GROUP, LEFT, LIMIT, OFFSET, ORDER, RIGHT, WHERE]",
-		{249, -1}: "expected SELECT statement optional GROUP BY clause or SELECT statement optional LIMIT clause or SELECT statement optional OFFSET clause or SELECT statement optional ORDER BY clause or SELECT statement optional WHERE clause or one of [$end, ')', ';', GROUP, LIMIT, OFFSET, ORDER, WHERE]",
-		{250, -1}: "expected SELECT statement optional GROUP BY clause or SELECT statement optional LIMIT clause or SELECT statement optional OFFSET clause or SELECT statement optional ORDER BY clause or one of [$end, ')', ';', GROUP, LIMIT, OFFSET, ORDER]",
-		{253, -1}: "expected SELECT statement optional LIMIT clause or SELECT statement optional OFFSET clause or SELECT statement optional ORDER BY clause or one of [$end, ')', ';', LIMIT, OFFSET, ORDER]",
-		{256, -1}: "expected SELECT statement optional LIMIT clause or SELECT statement optional OFFSET clause or one of [$end, ')', ';', LIMIT, OFFSET]",
-		{258, -1}: "expected SELECT statement optional OFFSET clause or one of [$end, ')', ';', OFFSET]",
-		{97, -1}:  "expected SELECT statement or SELECT",
-		{100, -1}: "expected SELECT statement or SELECT",
-		{232, -1}: "expected SELECT statement or SELECT",
-		{197, -1}: "expected SELECT statement or expression list or one of ['!', '(', '+', '-', '^', NULL, QL parameter, SELECT, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{204, -1}: "expected SELECT statement or expression list or one of ['!', '(', '+', '-', '^', NULL, QL parameter, SELECT, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{289, -1}: "expected SELECT statement or one of [SELECT, VALUES]",
-		{33, -1}:  "expected SetOpt or assignment list or one of [SET, identifier]",
+		{35, -1}:  "expected $end",
+		{55, -1}:  "expected '('",
+		{56, -1}:  "expected '('",
+		{119, -1}: "expected '('",
+		{165, -1}: "expected '('",
+		{187, -1}: "expected '('",
+		{272, -1}: "expected '('",
+		{276, -1}: "expected '('",
+		{280, -1}: "expected '('",
+		{318, -1}: "expected '('",
+		{327, -1}: "expected '('",
+		{329, -1}: "expected '('",
+		{361, -1}: "expected '('",
+		{362, -1}: "expected '('",
+		{413, -1}: "expected '('",
+		{214, -1}: "expected ')'",
+		{215, -1}: "expected ')'",
+		{216, -1}: "expected ')'",
+		{248, -1}: "expected ')'",
+		{282, -1}: "expected ')'",
+		{303, -1}: "expected ')'",
+		{304, -1}: "expected ')'",
+		{305, -1}: "expected ')'",
+		{332, -1}: "expected ')'",
+		{349, -1}: "expected ')'",
+		{365, -1}: "expected ')'",
+		{368, -1}: "expected ')'",
+		{370, -1}: "expected ')'",
+		{385, -1}: "expected ')'",
+		{397, -1}: "expected ')'",
+		{403, -1}: "expected ')'",
+		{421, -1}: "expected ')'",
+		{423, -1}: "expected ')'",
+		{429, -1}: "expected ')'",
+		{437, -1}: "expected ')'",
+		{442, -1}: "expected ')'",
+		{469, -1}: "expected ')'",
+		{371, -1}: "expected ','",
+		{389, -1}: "expected ','",
+		{246, -1}: "expected '='",
+		{66, -1}:  "expected ']'",
+		{319, -1}: "expected ']'",
+		{222, -1}: "expected '{'",
+		{315, -1}: "expected '}'",
+		{316, -1}: "expected '}'",
+		{164, -1}: "expected AS",
+		{376, -1}: "expected BY",
+		{409, -1}: "expected BY",
+		{224, -1}: "expected COLUMN",
+		{441, -1}: "expected CREATE FULLTEXT INDEX optional USING clause or one of [$end, ';', identifier]",
+		{25, -1}:  "expected CREATE INDEX optional UNIQUE clause or one of [INDEX, TABLE, UNIQUE, identifier]",
+		{162, -1}: "expected CREATE INDEX statement optional IF NOT EXISTS cluse or one of [IF, identifier]",
+		{163, -1}: "expected CREATE INDEX statement optional IF NOT EXISTS cluse or one of [IF, identifier]",
+		{273, -1}: "expected CREATE TABLE statement colum definition list or one of [')', ',']",
+		{401, -1}: "expected CREATE TABLE statement colum definition list or one of [')', ',']",
+		{100, -1}: "expected DROP INDEX statement optional IF EXISTS clause or one of [IF, identifier]",
+		{169, -1}: "expected EXISTS",
+		{171, -1}: "expected EXISTS",
+		{173, -1}: "expected EXISTS",
+		{230, -1}: "expected EXISTS",
+		{270, -1}: "expected EXISTS",
+		{277, -1}: "expected EXISTS",
+		{39, -1}:  "expected Eq or LIKE or ILIKE or one of [!=, $end, &&, ')', ',', ':', ';', '<', '=', '>', ']', '}', <=, ==, >=, AND, AS, ASC, BETWEEN, COLLATE, DEFAULT, DESC, FROM, FULL, GROUP, ILIKE, IN, IS, LEFT, LIKE, LIMIT, MATCHES, NOT, OFFSET, OR, ORDER, REGEXP, RIGHT, WHERE, ||]",
+		{26, -1}:  "expected FROM",
+		{95, -1}:  "expected INDEX",
+		{98, -1}:  "expected INDEX",
+		{174, -1}: "expected INSERT INTO statement optional column list clause or one of ['(', SELECT, VALUES]",
+		{404, -1}: "expected INSERT INTO statement optional values list or one of [$end, ',', ';']",
+		{29, -1}:  "expected INTO",
+		{336, -1}: "expected JOIN",
+		{337, -1}: "expected JOIN",
+		{166, -1}: "expected NOT",
+		{226, -1}: "expected NOT",
+		{191, -1}: "expected NULL",
+		{359, -1}: "expected NULL",
+		{269, -1}: "expected ON",
+		{271, -1}: "expected ON",
+		{411, -1}: "expected ON",
+		{414, -1}: "expected ORDER",
+		{449, -1}: "expected ORDER BY clause optional collation specification or one of [$end, ')', ';', ASC, DESC, LIMIT, OFFSET]",
+		{240, -1}: "expected RecordSetList or one of ['(', identifier]",
+		{105, -1}: "expected SELECT statement field list or one of ['!', '(', '*', '+', '-', '[', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{31, -1}:  "expected SELECT statement optional DISTINCT clause or one of ['!', '(', '*', '+', '-', '[', '^', DISTINCT, NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{175, -1}: "expected SELECT statement optional FROM clause or one of [$end, ')', ';', FROM, FULL, GROUP, LEFT, LIMIT, OFFSET, ORDER, RIGHT, WHERE]",
+		{334, -1}: "expected SELECT statement optional GROUP BY clause or one of [$end, ')', ';', GROUP, LIMIT, OFFSET, ORDER]",
+		{239, -1}: "expected SELECT statement optional JOIN clause or one of [$end, ')', ';', FULL, GROUP, LEFT, LIMIT, OFFSET, ORDER, RIGHT, WHERE]",
+		{407, -1}: "expected SELECT statement optional LIMIT clause or one of [$end, ')', ';', LIMIT, OFFSET]",
+		{430, -1}: "expected SELECT statement optional OFFSET clause or one of [$end, ')', ';', OFFSET]",
+		{374, -1}: "expected SELECT statement optional ORDER BY clause or one of [$end, ')', ';', LIMIT, OFFSET, ORDER]",
+		{285, -1}: "expected SELECT statement optional WHERE clause or one of [$end, ')', ';', GROUP, LIMIT, OFFSET, ORDER, WHERE]",
+		{295, -1}: "expected SELECT statement or SELECT",
+		{328, -1}: "expected SELECT statement or SELECT",
+		{369, -1}: "expected SELECT statement or SELECT",
+		{186, -1}: "expected SELECT statement or expression list or one of ['!', '(', '+', '-', '[', '^', NULL, QL parameter, SELECT, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{250, -1}: "expected SELECT statement or expression list or one of ['!', '(', '+', '-', '[', '^', NULL, QL parameter, SELECT, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{237, -1}: "expected SELECT statement or one of [SELECT, VALUES]",
+		{108, -1}: "expected SetOpt or one of [SET, identifier]",
 		{0, -1}:   "expected Start or one of [$end, ';', ALTER, BEGIN, COMMIT, CREATE, DELETE, DROP, EXPLAIN, INSERT, ROLLBACK, SELECT, TRUNCATE, UPDATE, parse expression prefix]",
-		{4, -1}:   "expected TABLE",
-		{30, -1}:  "expected TABLE",
-		{5, -1}:   "expected TRANSACTION",
-		{39, -1}:  "expected UPDATE statement optional WHERE clause or one of [$end, ';', WHERE]",
-		{316, -1}: "expected WHERE clause or one of [$end, ';', WHERE]",
-		{37, -1}:  "expected assignment list optional trailing comma or optional comma or one of [$end, ',', ';', WHERE]",
-		{34, -1}:  "expected assignment list or identifier",
-		{215, -1}: "expected assignment or one of [$end, ';', WHERE, identifier]",
-		{269, -1}: "expected column name list or identifier",
-		{290, -1}: "expected column name list or identifier",
-		{270, -1}: "expected column name list with optional trailing comma or optional comma or one of [$end, ')', ',', ';', LIMIT, OFFSET, ORDER]",
-		{365, -1}: "expected column name or identifier",
-		{274, -1}: "expected column name or one of [$end, ')', ';', LIMIT, OFFSET, ORDER, identifier]",
-		{118, -1}: "expected expression factor or one of ['!', '(', '+', '-', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{130, -1}: "expected expression list expression or logical or operator or optional comma or one of [$end, ')', ',', ';', ASC, DESC, LIMIT, OFFSET, OR, ||]",
-		{264, -1}: "expected expression list or one of ['!', '(', '+', '-', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{295, -1}: "expected expression list or one of ['!', '(', '+', '-', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{301, -1}: "expected expression list or one of ['!', '(', '+', '-', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{357, -1}: "expected expression list or one of ['!', '(', '+', '-', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{133, -1}: "expected expression or one of [$end, '!', '(', ')', '+', '-', ';', '^', ASC, DESC, LIMIT, NULL, OFFSET, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{105, -1}: "expected expression or one of ['!', '(', '+', '-', ':', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{110, -1}: "expected expression or one of ['!', '(', '+', '-', ']', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{123, -1}: "expected expression or one of ['!', '(', '+', '-', ']', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{42, -1}:  "expected expression or one of ['!', '(', '+', '-', '^', EXISTS, NOT, NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{3, -1}:   "expected expression or one of ['!', '(', '+', '-', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{58, -1}:  "expected expression or one of ['!', '(', '+', '-', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{210, -1}: "expected expression or one of ['!', '(', '+', '-', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{217, -1}: "expected expression or one of ['!', '(', '+', '-', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{259, -1}: "expected expression or one of ['!', '(', '+', '-', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{262, -1}: "expected expression or one of ['!', '(', '+', '-', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{280, -1}: "expected expression or one of ['!', '(', '+', '-', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{341, -1}: "expected expression or one of ['!', '(', '+', '-', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{113, -1}: "expected expression term or one of ['!', '(', '+', '-', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{223, -1}: "expected field expression optional AS clause or logical or operator or one of [$end, ')', ',', ';', AS, FROM, FULL, GROUP, LEFT, LIMIT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{282, -1}: "expected field expression or one of [$end, '!', '(', ')', '+', '-', ';', '^', FROM, FULL, GROUP, LEFT, LIMIT, NULL, OFFSET, ORDER, QL parameter, RIGHT, WHERE, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{104, -1}: "expected function call optional argument list or one of ['!', '(', ')', '*', '+', '-', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{61, -1}:  "expected function call or string index or string slice or one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, DEFAULT, DESC, FROM, FULL, GROUP, IN, IS, LEFT, LIKE, LIMIT, NOT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{103, -1}: "expected function call or string index or string slice or one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, DEFAULT, DESC, FROM, FULL, GROUP, IN, IS, LEFT, LIKE, LIMIT, NOT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{137, -1}: "expected function call or string index or string slice or one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, DEFAULT, DESC, FROM, FULL, GROUP, IN, IS, LEFT, LIKE, LIMIT, NOT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{138, -1}: "expected function call or string index or string slice or one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, DEFAULT, DESC, FROM, FULL, GROUP, IN, IS, LEFT, LIKE, LIMIT, NOT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{139, -1}: "expected function call or string index or string slice or one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, DEFAULT, DESC, FROM, FULL, GROUP, IN, IS, LEFT, LIKE, LIMIT, NOT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{35, -1}:  "expected identifier",
-		{140, -1}: "expected identifier",
-		{242, -1}: "expected identifier",
-		{285, -1}: "expected identifier",
-		{311, -1}: "expected identifier",
-		{313, -1}: "expected identifier",
-		{350, -1}: "expected identifier",
-		{353, -1}: "expected identifier",
-		{355, -1}: "expected identifier",
-		{44, -1}:  "expected logical and operator or one of [$end, &&, ')', ',', ':', ';', ']', AND, AS, ASC, DEFAULT, DESC, FROM, FULL, GROUP, LEFT, LIMIT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{117, -1}: "expected logical and operator or one of [$end, &&, ')', ',', ':', ';', ']', AND, AS, ASC, DEFAULT, DESC, FROM, FULL, GROUP, LEFT, LIMIT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{134, -1}: "expected logical or operator or one of [$end, ')', ',', ';', ASC, DESC, LIMIT, OFFSET, OR, ||]",
-		{337, -1}: "expected logical or operator or one of [$end, ')', ',', ';', DEFAULT, OR, ||]",
-		{343, -1}: "expected logical or operator or one of [$end, ')', ',', ';', OR, ||]",
-		{281, -1}: "expected logical or operator or one of [$end, ')', ';', GROUP, LIMIT, OFFSET, OR, ORDER, WHERE, ||]",
-		{45, -1}:  "expected logical or operator or one of [$end, ')', ';', GROUP, LIMIT, OFFSET, OR, ORDER, ||]",
-		{260, -1}: "expected logical or operator or one of [$end, ')', ';', OFFSET, OR, ||]",
-		{263, -1}: "expected logical or operator or one of [$end, ')', ';', OR, ||]",
-		{218, -1}: "expected logical or operator or one of [$end, ',', ';', OR, WHERE, ||]",
-		{368, -1}: "expected logical or operator or one of [$end, OR, ||]",
+		{22, -1}:  "expected TABLE",
+		{32, -1}:  "expected TABLE",
+		{23, -1}:  "expected TRANSACTION",
+		{244, -1}: "expected UPDATE statement optional WHERE clause or one of [$end, ';', WHERE]",
+		{167, -1}: "expected WHERE clause or one of [$end, ';', WHERE]",
+		{245, -1}: "expected assignment list optional trailing comma or one of [$end, ',', ';', WHERE]",
+		{181, -1}: "expected assignment list or identifier",
+		{345, -1}: "expected assignment or one of [$end, ';', WHERE, identifier]",
+		{238, -1}: "expected column name list or identifier",
+		{396, -1}: "expected column name list or identifier",
+		{405, -1}: "expected column name list or identifier",
+		{410, -1}: "expected column name list or identifier",
+		{284, -1}: "expected column name list with optional trailing comma or one of [$end, ')', ',', ';', LIMIT, OFFSET, ORDER]",
+		{268, -1}: "expected column name or identifier",
+		{373, -1}: "expected column name or one of [$end, ')', ';', LIMIT, OFFSET, ORDER, identifier]",
+		{325, -1}: "expected enum label list or string literal",
+		{116, -1}: "expected expression factor or one of ['!', '(', '+', '-', '[', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{217, -1}: "expected expression list expression or logical or operator or one of [')', ',', '}', OR, ||]",
+		{330, -1}: "expected expression list or one of ['!', '(', '+', '-', '[', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{459, -1}: "expected expression list or one of ['!', '(', '+', '-', '[', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{314, -1}: "expected expression or one of ['!', '(', ')', '+', '-', '[', '^', '}', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{150, -1}: "expected expression or one of ['!', '(', '+', '-', ':', '[', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{213, -1}: "expected expression or one of ['!', '(', '+', '-', '[', ']', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{257, -1}: "expected expression or one of ['!', '(', '+', '-', '[', ']', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{232, -1}: "expected expression or one of ['!', '(', '+', '-', '[', '^', EXISTS, NOT, NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{53, -1}:  "expected expression or one of ['!', '(', '+', '-', '[', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{157, -1}: "expected expression or one of ['!', '(', '+', '-', '[', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{158, -1}: "expected expression or one of ['!', '(', '+', '-', '[', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{301, -1}: "expected expression or one of ['!', '(', '+', '-', '[', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{342, -1}: "expected expression or one of ['!', '(', '+', '-', '[', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{393, -1}: "expected expression or one of ['!', '(', '+', '-', '[', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{431, -1}: "expected expression or one of ['!', '(', '+', '-', '[', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{434, -1}: "expected expression or one of ['!', '(', '+', '-', '[', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{447, -1}: "expected expression or one of ['!', '(', '+', '-', '[', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{112, -1}: "expected expression term or one of ['!', '(', '+', '-', '[', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{455, -1}: "expected expression with optional COLLATE clause or one of [$end, '!', '(', ')', '+', '-', ';', '[', '^', ASC, DESC, LIMIT, NULL, OFFSET, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{3, -1}:   "expected expression with optional COLLATE clause or one of ['!', '(', '+', '-', '[', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{179, -1}: "expected field expression optional AS clause or logical or operator or one of [$end, ')', ',', ';', AS, FROM, FULL, GROUP, LEFT, LIMIT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
+		{241, -1}: "expected field expression or one of [$end, '!', '(', ')', '+', '-', ';', '[', '^', FROM, FULL, GROUP, LEFT, LIMIT, NULL, OFFSET, ORDER, QL parameter, RIGHT, WHERE, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{151, -1}: "expected function call optional argument list or one of ['!', '(', ')', '*', '+', '-', '[', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{43, -1}:  "expected function call or string index or string slice or one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', '}', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, COLLATE, DEFAULT, DESC, ESCAPE, FROM, FULL, GROUP, ILIKE, IN, IS, LEFT, LIKE, LIMIT, MATCHES, NOT, OFFSET, OR, ORDER, REGEXP, RIGHT, WHERE, ||]",
+		{152, -1}: "expected function call or string index or string slice or one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', '}', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, COLLATE, DEFAULT, DESC, ESCAPE, FROM, FULL, GROUP, ILIKE, IN, IS, LEFT, LIKE, LIMIT, MATCHES, NOT, OFFSET, OR, ORDER, REGEXP, RIGHT, WHERE, ||]",
+		{153, -1}: "expected function call or string index or string slice or one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', '}', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, COLLATE, DEFAULT, DESC, ESCAPE, FROM, FULL, GROUP, ILIKE, IN, IS, LEFT, LIKE, LIMIT, MATCHES, NOT, OFFSET, OR, ORDER, REGEXP, RIGHT, WHERE, ||]",
+		{154, -1}: "expected function call or string index or string slice or one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', '}', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, COLLATE, DEFAULT, DESC, ESCAPE, FROM, FULL, GROUP, ILIKE, IN, IS, LEFT, LIKE, LIMIT, MATCHES, NOT, OFFSET, OR, ORDER, REGEXP, RIGHT, WHERE, ||]",
+		{155, -1}: "expected function call or string index or string slice or one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', '}', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, COLLATE, DEFAULT, DESC, ESCAPE, FROM, FULL, GROUP, ILIKE, IN, IS, LEFT, LIKE, LIMIT, MATCHES, NOT, OFFSET, OR, ORDER, REGEXP, RIGHT, WHERE, ||]",
+		{113, -1}: "expected identifier",
+		{159, -1}: "expected identifier",
+		{168, -1}: "expected identifier",
+		{182, -1}: "expected identifier",
+		{225, -1}: "expected identifier",
+		{227, -1}: "expected identifier",
+		{228, -1}: "expected identifier",
+		{234, -1}: "expected identifier",
+		{236, -1}: "expected identifier",
+		{243, -1}: "expected identifier",
+		{322, -1}: "expected identifier",
+		{323, -1}: "expected identifier",
+		{324, -1}: "expected identifier",
+		{341, -1}: "expected identifier",
+		{457, -1}: "expected identifier",
+		{465, -1}: "expected identifier",
+		{435, -1}: "expected index name list or identifier",
+		{355, -1}: "expected integer literal",
+		{418, -1}: "expected integer literal",
+		{395, -1}: "expected list of expressions with optional COLLATE clauses or one of ['!', '(', '+', '-', '[', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{432, -1}: "expected list of expressions with optional COLLATE clauses or one of ['!', '(', '+', '-', '[', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{422, -1}: "expected list of expressions with optional COLLATE clauses with optional trailing comma or one of [$end, ')', ',', ';', ASC, DESC, LIMIT, OFFSET]",
+		{37, -1}:  "expected logical and operator or one of [$end, &&, ')', ',', ':', ';', ']', '}', AND, AS, ASC, COLLATE, DEFAULT, DESC, FROM, FULL, GROUP, LEFT, LIMIT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
+		{183, -1}: "expected logical and operator or one of [$end, &&, ')', ',', ':', ';', ']', '}', AND, AS, ASC, COLLATE, DEFAULT, DESC, FROM, FULL, GROUP, LEFT, LIMIT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
+		{360, -1}: "expected logical or operator or one of [$end, ')', ',', ';', DEFAULT, OR, ||]",
+		{420, -1}: "expected logical or operator or one of [$end, ')', ',', ';', OR, ||]",
+		{450, -1}: "expected logical or operator or one of [$end, ')', ';', GROUP, LIMIT, OFFSET, OR, ORDER, WHERE, ||]",
+		{275, -1}: "expected logical or operator or one of [$end, ')', ';', GROUP, LIMIT, OFFSET, OR, ORDER, ||]",
+		{448, -1}: "expected logical or operator or one of [$end, ')', ';', OFFSET, OR, ||]",
+		{460, -1}: "expected logical or operator or one of [$end, ')', ';', OR, ||]",
+		{346, -1}: "expected logical or operator or one of [$end, ',', ';', OR, WHERE, ||]",
+		{352, -1}: "expected logical or operator or one of [')', ',', '}', OR, ||]",
 		{156, -1}: "expected logical or operator or one of [')', OR, ||]",
-		{211, -1}: "expected logical or operator or one of [')', OR, ||]",
-		{109, -1}: "expected logical or operator or one of [':', ']', OR, ||]",
-		{111, -1}: "expected logical or operator or one of [']', OR, ||]",
-		{124, -1}: "expected logical or operator or one of [']', OR, ||]",
-		{64, -1}:  "expected one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '.', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, DEFAULT, DESC, FROM, FULL, GROUP, IN, IS, LEFT, LIKE, LIMIT, NOT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{48, -1}:  "expected one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, DEFAULT, DESC, FROM, FULL, GROUP, IN, IS, LEFT, LIKE, LIMIT, NOT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{49, -1}:  "expected one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, DEFAULT, DESC, FROM, FULL, GROUP, IN, IS, LEFT, LIKE, LIMIT, NOT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{50, -1}:  "expected one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, DEFAULT, DESC, FROM, FULL, GROUP, IN, IS, LEFT, LIKE, LIMIT, NOT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{51, -1}:  "expected one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, DEFAULT, DESC, FROM, FULL, GROUP, IN, IS, LEFT, LIKE, LIMIT, NOT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{52, -1}:  "expected one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, DEFAULT, DESC, FROM, FULL, GROUP, IN, IS, LEFT, LIKE, LIMIT, NOT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{53, -1}:  "expected one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, DEFAULT, DESC, FROM, FULL, GROUP, IN, IS, LEFT, LIKE, LIMIT, NOT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{54, -1}:  "expected one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, DEFAULT, DESC, FROM, FULL, GROUP, IN, IS, LEFT, LIKE, LIMIT, NOT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{55, -1}:  "expected one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, DEFAULT, DESC, FROM, FULL, GROUP, IN, IS, LEFT, LIKE, LIMIT, NOT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{56, -1}:  "expected one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, DEFAULT, DESC, FROM, FULL, GROUP, IN, IS, LEFT, LIKE, LIMIT, NOT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{57, -1}:  "expected one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, DEFAULT, DESC, FROM, FULL, GROUP, IN, IS, LEFT, LIKE, LIMIT, NOT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{59, -1}:  "expected one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, DEFAULT, DESC, FROM, FULL, GROUP, IN, IS, LEFT, LIKE, LIMIT, NOT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{60, -1}:  "expected one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, DEFAULT, DESC, FROM, FULL, GROUP, IN, IS, LEFT, LIKE, LIMIT, NOT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{106, -1}: "expected one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, DEFAULT, DESC, FROM, FULL, GROUP, IN, IS, LEFT, LIKE, LIMIT, NOT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{107, -1}: "expected one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, DEFAULT, DESC, FROM, FULL, GROUP, IN, IS, LEFT, LIKE, LIMIT, NOT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{108, -1}: "expected one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, DEFAULT, DESC, FROM, FULL, GROUP, IN, IS, LEFT, LIKE, LIMIT, NOT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{112, -1}: "expected one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, DEFAULT, DESC, FROM, FULL, GROUP, IN, IS, LEFT, LIKE, LIMIT, NOT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{116, -1}: "expected one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, DEFAULT, DESC, FROM, FULL, GROUP, IN, IS, LEFT, LIKE, LIMIT, NOT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{122, -1}: "expected one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, DEFAULT, DESC, FROM, FULL, GROUP, IN, IS, LEFT, LIKE, LIMIT, NOT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{125, -1}: "expected one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, DEFAULT, DESC, FROM, FULL, GROUP, IN, IS, LEFT, LIKE, LIMIT, NOT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{126, -1}: "expected one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, DEFAULT, DESC, FROM, FULL, GROUP, IN, IS, LEFT, LIKE, LIMIT, NOT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{135, -1}: "expected one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, DEFAULT, DESC, FROM, FULL, GROUP, IN, IS, LEFT, LIKE, LIMIT, NOT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{136, -1}: "expected one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, DEFAULT, DESC, FROM, FULL, GROUP, IN, IS, LEFT, LIKE, LIMIT, NOT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{141, -1}: "expected one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, DEFAULT, DESC, FROM, FULL, GROUP, IN, IS, LEFT, LIKE, LIMIT, NOT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{157, -1}: "expected one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, DEFAULT, DESC, FROM, FULL, GROUP, IN, IS, LEFT, LIKE, LIMIT, NOT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{212, -1}: "expected one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, DEFAULT, DESC, FROM, FULL, GROUP, IN, IS, LEFT, LIKE, LIMIT, NOT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{62, -1}:  "expected one of [!=, $end, &&, &^, '%', '&', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', ']', '^', '|', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, DEFAULT, DESC, FROM, FULL, GROUP, IN, IS, LEFT, LIKE, LIMIT, NOT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{63, -1}:  "expected one of [!=, $end, &&, &^, '%', '&', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', ']', '^', '|', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, DEFAULT, DESC, FROM, FULL, GROUP, IN, IS, LEFT, LIKE, LIMIT, NOT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{149, -1}: "expected one of [!=, $end, &&, &^, '%', '&', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', ']', '^', '|', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, DEFAULT, DESC, FROM, FULL, GROUP, IN, IS, LEFT, LIKE, LIMIT, NOT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{150, -1}: "expected one of [!=, $end, &&, &^, '%', '&', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', ']', '^', '|', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, DEFAULT, DESC, FROM, FULL, GROUP, IN, IS, LEFT, LIKE, LIMIT, NOT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{151, -1}: "expected one of [!=, $end, &&, &^, '%', '&', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', ']', '^', '|', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, DEFAULT, DESC, FROM, FULL, GROUP, IN, IS, LEFT, LIKE, LIMIT, NOT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{152, -1}: "expected one of [!=, $end, &&, &^, '%', '&', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', ']', '^', '|', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, DEFAULT, DESC, FROM, FULL, GROUP, IN, IS, LEFT, LIKE, LIMIT, NOT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{153, -1}: "expected one of [!=, $end, &&, &^, '%', '&', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', ']', '^', '|', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, DEFAULT, DESC, FROM, FULL, GROUP, IN, IS, LEFT, LIKE, LIMIT, NOT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{154, -1}: "expected one of [!=, $end, &&, &^, '%', '&', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', ']', '^', '|', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, DEFAULT, DESC, FROM, FULL, GROUP, IN, IS, LEFT, LIKE, LIMIT, NOT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{155, -1}: "expected one of [!=, $end, &&, &^, '%', '&', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', ']', '^', '|', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, DEFAULT, DESC, FROM, FULL, GROUP, IN, IS, LEFT, LIKE, LIMIT, NOT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{162, -1}: "expected one of [!=, $end, &&, &^, '%', '&', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', ']', '^', '|', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, DEFAULT, DESC, FROM, FULL, GROUP, IN, IS, LEFT, LIKE, LIMIT, NOT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{163, -1}: "expected one of [!=, $end, &&, &^, '%', '&', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', ']', '^', '|', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, DEFAULT, DESC, FROM, FULL, GROUP, IN, IS, LEFT, LIKE, LIMIT, NOT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{164, -1}: "expected one of [!=, $end, &&, &^, '%', '&', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', ']', '^', '|', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, DEFAULT, DESC, FROM, FULL, GROUP, IN, IS, LEFT, LIKE, LIMIT, NOT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{165, -1}: "expected one of [!=, $end, &&, &^, '%', '&', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', ']', '^', '|', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, DEFAULT, DESC, FROM, FULL, GROUP, IN, IS, LEFT, LIKE, LIMIT, NOT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{47, -1}:  "expected one of [!=, $end, &&, ')', '+', ',', '-', ':', ';', '<', '=', '>', ']', '^', '|', <=, ==, >=, AND, AS, ASC, BETWEEN, DEFAULT, DESC, FROM, FULL, GROUP, IN, IS, LEFT, LIKE, LIMIT, NOT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{179, -1}: "expected one of [!=, $end, &&, ')', '+', ',', '-', ':', ';', '<', '=', '>', ']', '^', '|', <=, ==, >=, AND, AS, ASC, BETWEEN, DEFAULT, DESC, FROM, FULL, GROUP, IN, IS, LEFT, LIKE, LIMIT, NOT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{180, -1}: "expected one of [!=, $end, &&, ')', '+', ',', '-', ':', ';', '<', '=', '>', ']', '^', '|', <=, ==, >=, AND, AS, ASC, BETWEEN, DEFAULT, DESC, FROM, FULL, GROUP, IN, IS, LEFT, LIKE, LIMIT, NOT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{181, -1}: "expected one of [!=, $end, &&, ')', '+', ',', '-', ':', ';', '<', '=', '>', ']', '^', '|', <=, ==, >=, AND, AS, ASC, BETWEEN, DEFAULT, DESC, FROM, FULL, GROUP, IN, IS, LEFT, LIKE, LIMIT, NOT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{182, -1}: "expected one of [!=, $end, &&, ')', '+', ',', '-', ':', ';', '<', '=', '>', ']', '^', '|', <=, ==, >=, AND, AS, ASC, BETWEEN, DEFAULT, DESC, FROM, FULL, GROUP, IN, IS, LEFT, LIKE, LIMIT, NOT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{183, -1}: "expected one of [!=, $end, &&, ')', '+', ',', '-', ':', ';', '<', '=', '>', ']', '^', '|', <=, ==, >=, AND, AS, ASC, BETWEEN, DEFAULT, DESC, FROM, FULL, GROUP, IN, IS, LEFT, LIKE, LIMIT, NOT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{184, -1}: "expected one of [!=, $end, &&, ')', '+', ',', '-', ':', ';', '<', '=', '>', ']', '^', '|', <=, ==, >=, AND, AS, ASC, BETWEEN, DEFAULT, DESC, FROM, FULL, GROUP, IN, IS, LEFT, LIKE, LIMIT, NOT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{185, -1}: "expected one of [!=, $end, &&, ')', '+', ',', '-', ':', ';', '<', '=', '>', ']', '^', '|', <=, ==, >=, AND, AS, ASC, BETWEEN, DEFAULT, DESC, FROM, FULL, GROUP, IN, IS, LEFT, LIKE, LIMIT, NOT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{191, -1}: "expected one of [$end, &&, ')', '+', ',', '-', ':', ';', ']', '^', '|', AND, AS, ASC, DEFAULT, DESC, FROM, FULL, GROUP, LEFT, LIMIT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{196, -1}: "expected one of [$end, &&, ')', '+', ',', '-', ':', ';', ']', '^', '|', AND, AS, ASC, DEFAULT, DESC, FROM, FULL, GROUP, LEFT, LIMIT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{65, -1}:  "expected one of [$end, &&, ')', ',', ':', ';', ']', AND, AS, ASC, DEFAULT, DESC, FROM, FULL, GROUP, LEFT, LIMIT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{121, -1}: "expected one of [$end, &&, ')', ',', ':', ';', ']', AND, AS, ASC, DEFAULT, DESC, FROM, FULL, GROUP, LEFT, LIMIT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{186, -1}: "expected one of [$end, &&, ')', ',', ':', ';', ']', AND, AS, ASC, DEFAULT, DESC, FROM, FULL, GROUP, LEFT, LIMIT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{188, -1}: "expected one of [$end, &&, ')', ',', ':', ';', ']', AND, AS, ASC, DEFAULT, DESC, FROM, FULL, GROUP, LEFT, LIMIT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{202, -1}: "expected one of [$end, &&, ')', ',', ':', ';', ']', AND, AS, ASC, DEFAULT, DESC, FROM, FULL, GROUP, LEFT, LIMIT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{203, -1}: "expected one of [$end, &&, ')', ',', ':', ';', ']', AND, AS, ASC, DEFAULT, DESC, FROM, FULL, GROUP, LEFT, LIMIT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{208, -1}: "expected one of [$end, &&, ')', ',', ':', ';', ']', AND, AS, ASC, DEFAULT, DESC, FROM, FULL, GROUP, LEFT, LIMIT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{209, -1}: "expected one of [$end, &&, ')', ',', ':', ';', ']', AND, AS, ASC, DEFAULT, DESC, FROM, FULL, GROUP, LEFT, LIMIT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
-		{66, -1}:  "expected one of [$end, '!', '(', ')', '+', ',', '-', ';', '^', DEFAULT, NOT, NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{67, -1}:  "expected one of [$end, '!', '(', ')', '+', ',', '-', ';', '^', DEFAULT, NOT, NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{68, -1}:  "expected one of [$end, '!', '(', ')', '+', ',', '-', ';', '^', DEFAULT, NOT, NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{69, -1}:  "expected one of [$end, '!', '(', ')', '+', ',', '-', ';', '^', DEFAULT, NOT, NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{70, -1}:  "expected one of [$end, '!', '(', ')', '+', ',', '-', ';', '^', DEFAULT, NOT, NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{71, -1}:  "expected one of [$end, '!', '(', ')', '+', ',', '-', ';', '^', DEFAULT, NOT, NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{72, -1}:  "expected one of [$end, '!', '(', ')', '+', ',', '-', ';', '^', DEFAULT, NOT, NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{73, -1}:  "expected one of [$end, '!', '(', ')', '+', ',', '-', ';', '^', DEFAULT, NOT, NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{74, -1}:  "expected one of [$end, '!', '(', ')', '+', ',', '-', ';', '^', DEFAULT, NOT, NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{75, -1}:  "expected one of [$end, '!', '(', ')', '+', ',', '-', ';', '^', DEFAULT, NOT, NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{76, -1}:  "expected one of [$end, '!', '(', ')', '+', ',', '-', ';', '^', DEFAULT, NOT, NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{77, -1}:  "expected one of [$end, '!', '(', ')', '+', ',', '-', ';', '^', DEFAULT, NOT, NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{78, -1}:  "expected one of [$end, '!', '(', ')', '+', ',', '-', ';', '^', DEFAULT, NOT, NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{79, -1}:  "expected one of [$end, '!', '(', ')', '+', ',', '-', ';', '^', DEFAULT, NOT, NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{80, -1}:  "expected one of [$end, '!', '(', ')', '+', ',', '-', ';', '^', DEFAULT, NOT, NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{81, -1}:  "expected one of [$end, '!', '(', ')', '+', ',', '-', ';', '^', DEFAULT, NOT, NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{82, -1}:  "expected one of [$end, '!', '(', ')', '+', ',', '-', ';', '^', DEFAULT, NOT, NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{83, -1}:  "expected one of [$end, '!', '(', ')', '+', ',', '-', ';', '^', DEFAULT, NOT, NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{84, -1}:  "expected one of [$end, '!', '(', ')', '+', ',', '-', ';', '^', DEFAULT, NOT, NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{85, -1}:  "expected one of [$end, '!', '(', ')', '+', ',', '-', ';', '^', DEFAULT, NOT, NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{86, -1}:  "expected one of [$end, '!', '(', ')', '+', ',', '-', ';', '^', DEFAULT, NOT, NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{87, -1}:  "expected one of [$end, '!', '(', ')', '+', ',', '-', ';', '^', DEFAULT, NOT, NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{88, -1}:  "expected one of [$end, '!', '(', ')', '+', ',', '-', ';', '^', DEFAULT, NOT, NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{89, -1}:  "expected one of [$end, '!', '(', ')', '+', ',', '-', ';', '^', DEFAULT, NOT, NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{32, -1}:  "expected one of [$end, '(', ';', ADD, DROP, SELECT, SET, VALUES, WHERE, identifier]",
-		{300, -1}: "expected one of [$end, '(', ';']",
-		{38, -1}:  "expected one of [$end, ')', ',', ';', '=', LIMIT, OFFSET, ORDER, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, float, float32, float64, int, int16, int32, int64, int8, rune, string, time, uint, uint16, uint32, uint64, uint8]",
-		{231, -1}: "expected one of [$end, ')', ',', ';', AS, FULL, GROUP, LEFT, LIMIT, OFFSET, ON, ORDER, RIGHT, WHERE]",
-		{240, -1}: "expected one of [$end, ')', ',', ';', AS, FULL, GROUP, LEFT, LIMIT, OFFSET, ON, ORDER, RIGHT, WHERE]",
-		{338, -1}: "expected one of [$end, ')', ',', ';', DEFAULT]",
-		{339, -1}: "expected one of [$end, ')', ',', ';', DEFAULT]",
-		{224, -1}: "expected one of [$end, ')', ',', ';', FROM, FULL, GROUP, LEFT, LIMIT, OFFSET, ORDER, RIGHT, WHERE]",
-		{225, -1}: "expected one of [$end, ')', ',', ';', FROM, FULL, GROUP, LEFT, LIMIT, OFFSET, ORDER, RIGHT, WHERE]",
-		{283, -1}: "expected one of [$end, ')', ',', ';', FROM, FULL, GROUP, LEFT, LIMIT, OFFSET, ORDER, RIGHT, WHERE]",
-		{284, -1}: "expected one of [$end, ')', ',', ';', FROM, FULL, GROUP, LEFT, LIMIT, OFFSET, ORDER, RIGHT, WHERE]",
-		{286, -1}: "expected one of [$end, ')', ',', ';', FROM, FULL, GROUP, LEFT, LIMIT, OFFSET, ORDER, RIGHT, WHERE]",
-		{241, -1}: "expected one of [$end, ')', ',', ';', FULL, GROUP, LEFT, LIMIT, OFFSET, ON, ORDER, RIGHT, WHERE]",
-		{243, -1}: "expected one of [$end, ')', ',', ';', FULL, GROUP, LEFT, LIMIT, OFFSET, ON, ORDER, RIGHT, WHERE]",
-		{233, -1}: "expected one of [$end, ')', ',', ';', FULL, GROUP, LEFT, LIMIT, OFFSET, ORDER, RIGHT, WHERE]",
-		{237, -1}: "expected one of [$end, ')', ',', ';', FULL, GROUP, LEFT, LIMIT, OFFSET, ORDER, RIGHT, WHERE]",
-		{275, -1}: "expected one of [$end, ')', ',', ';', LIMIT, OFFSET, ORDER]",
-		{340, -1}: "expected one of [$end, ')', ',', ';']",
-		{342, -1}: "expected one of [$end, ')', ',', ';']",
-		{132, -1}: "expected one of [$end, ')', ';', ASC, DESC, LIMIT, OFFSET]",
-		{227, -1}: "expected one of [$end, ')', ';', FROM, FULL, GROUP, LEFT, LIMIT, OFFSET, ORDER, RIGHT, WHERE]",
-		{236, -1}: "expected one of [$end, ')', ';', FULL, GROUP, LEFT, LIMIT, OFFSET, ORDER, RIGHT, WHERE]",
-		{248, -1}: "expected one of [$end, ')', ';', GROUP, LIMIT, OFFSET, ORDER, WHERE]",
-		{99, -1}:  "expected one of [$end, ')', ';', GROUP, LIMIT, OFFSET, ORDER]",
-		{102, -1}: "expected one of [$end, ')', ';', GROUP, LIMIT, OFFSET, ORDER]",
-		{251, -1}: "expected one of [$end, ')', ';', GROUP, LIMIT, OFFSET, ORDER]",
-		{254, -1}: "expected one of [$end, ')', ';', LIMIT, OFFSET, ORDER]",
-		{271, -1}: "expected one of [$end, ')', ';', LIMIT, OFFSET, ORDER]",
-		{273, -1}: "expected one of [$end, ')', ';', LIMIT, OFFSET, ORDER]",
-		{257, -1}: "expected one of [$end, ')', ';', LIMIT, OFFSET]",
-		{266, -1}: "expected one of [$end, ')', ';', LIMIT, OFFSET]",
-		{267, -1}: "expected one of [$end, ')', ';', LIMIT, OFFSET]",
-		{268, -1}: "expected one of [$end, ')', ';', LIMIT, OFFSET]",
-		{261, -1}: "expected one of [$end, ')', ';']",
-		{216, -1}: "expected one of [$end, ',', ';', WHERE]",
-		{303, -1}: "expected one of [$end, ',', ';']",
-		{214, -1}: "expected one of [$end, ';', WHERE]",
+		{219, -1}: "expected logical or operator or one of [')', OR, ||]",
+		{220, -1}: "expected logical or operator or one of [')', OR, ||]",
+		{384, -1}: "expected logical or operator or one of [')', OR, ||]",
+		{212, -1}: "expected logical or operator or one of [':', ']', OR, ||]",
+		{259, -1}: "expected logical or operator or one of [']', OR, ||]",
+		{311, -1}: "expected logical or operator or one of [']', OR, ||]",
+		{36, -1}:  "expected logical or operator or optional COLLATE clause or one of [$end, ')', ',', ';', ASC, COLLATE, DESC, LIMIT, OFFSET, OR, ||]",
+		{65, -1}:  "expected one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '.', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', '}', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, COLLATE, DEFAULT, DESC, ESCAPE, FROM, FULL, GROUP, ILIKE, IN, IS, LEFT, LIKE, LIMIT, MATCHES, NOT, OFFSET, OR, ORDER, REGEXP, RIGHT, WHERE, ||]",
+		{48, -1}:  "expected one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', '}', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, COLLATE, DEFAULT, DESC, ESCAPE, FROM, FULL, GROUP, ILIKE, IN, IS, LEFT, LIKE, LIMIT, MATCHES, NOT, OFFSET, OR, ORDER, REGEXP, RIGHT, WHERE, ||]",
+		{49, -1}:  "expected one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', '}', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, COLLATE, DEFAULT, DESC, ESCAPE, FROM, FULL, GROUP, ILIKE, IN, IS, LEFT, LIKE, LIMIT, MATCHES, NOT, OFFSET, OR, ORDER, REGEXP, RIGHT, WHERE, ||]",
+		{50, -1}:  "expected one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', '}', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, COLLATE, DEFAULT, DESC, ESCAPE, FROM, FULL, GROUP, ILIKE, IN, IS, LEFT, LIKE, LIMIT, MATCHES, NOT, OFFSET, OR, ORDER, REGEXP, RIGHT, WHERE, ||]",
+		{51, -1}:  "expected one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', '}', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, COLLATE, DEFAULT, DESC, ESCAPE, FROM, FULL, GROUP, ILIKE, IN, IS, LEFT, LIKE, LIMIT, MATCHES, NOT, OFFSET, OR, ORDER, REGEXP, RIGHT, WHERE, ||]",
+		{52, -1}:  "expected one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', '}', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, COLLATE, DEFAULT, DESC, ESCAPE, FROM, FULL, GROUP, ILIKE, IN, IS, LEFT, LIKE, LIMIT, MATCHES, NOT, OFFSET, OR, ORDER, REGEXP, RIGHT, WHERE, ||]",
+		{54, -1}:  "expected one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', '}', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, COLLATE, DEFAULT, DESC, ESCAPE, FROM, FULL, GROUP, ILIKE, IN, IS, LEFT, LIKE, LIMIT, MATCHES, NOT, OFFSET, OR, ORDER, REGEXP, RIGHT, WHERE, ||]",
+		{57, -1}:  "expected one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', '}', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, COLLATE, DEFAULT, DESC, ESCAPE, FROM, FULL, GROUP, ILIKE, IN, IS, LEFT, LIKE, LIMIT, MATCHES, NOT, OFFSET, OR, ORDER, REGEXP, RIGHT, WHERE, ||]",
+		{58, -1}:  "expected one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', '}', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, COLLATE, DEFAULT, DESC, ESCAPE, FROM, FULL, GROUP, ILIKE, IN, IS, LEFT, LIKE, LIMIT, MATCHES, NOT, OFFSET, OR, ORDER, REGEXP, RIGHT, WHERE, ||]",
+		{59, -1}:  "expected one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', '}', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, COLLATE, DEFAULT, DESC, ESCAPE, FROM, FULL, GROUP, ILIKE, IN, IS, LEFT, LIKE, LIMIT, MATCHES, NOT, OFFSET, OR, ORDER, REGEXP, RIGHT, WHERE, ||]",
+		{60, -1}:  "expected one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', '}', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, COLLATE, DEFAULT, DESC, ESCAPE, FROM, FULL, GROUP, ILIKE, IN, IS, LEFT, LIKE, LIMIT, MATCHES, NOT, OFFSET, OR, ORDER, REGEXP, RIGHT, WHERE, ||]",
+		{61, -1}:  "expected one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', '}', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, COLLATE, DEFAULT, DESC, ESCAPE, FROM, FULL, GROUP, ILIKE, IN, IS, LEFT, LIKE, LIMIT, MATCHES, NOT, OFFSET, OR, ORDER, REGEXP, RIGHT, WHERE, ||]",
+		{62, -1}:  "expected one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', '}', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, COLLATE, DEFAULT, DESC, ESCAPE, FROM, FULL, GROUP, ILIKE, IN, IS, LEFT, LIKE, LIMIT, MATCHES, NOT, OFFSET, OR, ORDER, REGEXP, RIGHT, WHERE, ||]",
+		{63, -1}:  "expected one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', '}', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, COLLATE, DEFAULT, DESC, ESCAPE, FROM, FULL, GROUP, ILIKE, IN, IS, LEFT, LIKE, LIMIT, MATCHES, NOT, OFFSET, OR, ORDER, REGEXP, RIGHT, WHERE, ||]",
+		{64, -1}:  "expected one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', '}', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, COLLATE, DEFAULT, DESC, ESCAPE, FROM, FULL, GROUP, ILIKE, IN, IS, LEFT, LIKE, LIMIT, MATCHES, NOT, OFFSET, OR, ORDER, REGEXP, RIGHT, WHERE, ||]",
+		{147, -1}: "expected one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', '}', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, COLLATE, DEFAULT, DESC, ESCAPE, FROM, FULL, GROUP, ILIKE, IN, IS, LEFT, LIKE, LIMIT, MATCHES, NOT, OFFSET, OR, ORDER, REGEXP, RIGHT, WHERE, ||]",
+		{148, -1}: "expected one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', '}', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, COLLATE, DEFAULT, DESC, ESCAPE, FROM, FULL, GROUP, ILIKE, IN, IS, LEFT, LIKE, LIMIT, MATCHES, NOT, OFFSET, OR, ORDER, REGEXP, RIGHT, WHERE, ||]",
+		{149, -1}: "expected one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', '}', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, COLLATE, DEFAULT, DESC, ESCAPE, FROM, FULL, GROUP, ILIKE, IN, IS, LEFT, LIKE, LIMIT, MATCHES, NOT, OFFSET, OR, ORDER, REGEXP, RIGHT, WHERE, ||]",
+		{218, -1}: "expected one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', '}', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, COLLATE, DEFAULT, DESC, ESCAPE, FROM, FULL, GROUP, ILIKE, IN, IS, LEFT, LIKE, LIMIT, MATCHES, NOT, OFFSET, OR, ORDER, REGEXP, RIGHT, WHERE, ||]",
+		{221, -1}: "expected one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', '}', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, COLLATE, DEFAULT, DESC, ESCAPE, FROM, FULL, GROUP, ILIKE, IN, IS, LEFT, LIKE, LIMIT, MATCHES, NOT, OFFSET, OR, ORDER, REGEXP, RIGHT, WHERE, ||]",
+		{256, -1}: "expected one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', '}', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, COLLATE, DEFAULT, DESC, ESCAPE, FROM, FULL, GROUP, ILIKE, IN, IS, LEFT, LIKE, LIMIT, MATCHES, NOT, OFFSET, OR, ORDER, REGEXP, RIGHT, WHERE, ||]",
+		{258, -1}: "expected one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', '}', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, COLLATE, DEFAULT, DESC, ESCAPE, FROM, FULL, GROUP, ILIKE, IN, IS, LEFT, LIKE, LIMIT, MATCHES, NOT, OFFSET, OR, ORDER, REGEXP, RIGHT, WHERE, ||]",
+		{260, -1}: "expected one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', '}', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, COLLATE, DEFAULT, DESC, ESCAPE, FROM, FULL, GROUP, ILIKE, IN, IS, LEFT, LIKE, LIMIT, MATCHES, NOT, OFFSET, OR, ORDER, REGEXP, RIGHT, WHERE, ||]",
+		{261, -1}: "expected one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', '}', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, COLLATE, DEFAULT, DESC, ESCAPE, FROM, FULL, GROUP, ILIKE, IN, IS, LEFT, LIKE, LIMIT, MATCHES, NOT, OFFSET, OR, ORDER, REGEXP, RIGHT, WHERE, ||]",
+		{263, -1}: "expected one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', '}', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, COLLATE, DEFAULT, DESC, ESCAPE, FROM, FULL, GROUP, ILIKE, IN, IS, LEFT, LIKE, LIMIT, MATCHES, NOT, OFFSET, OR, ORDER, REGEXP, RIGHT, WHERE, ||]",
+		{264, -1}: "expected one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', '}', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, COLLATE, DEFAULT, DESC, ESCAPE, FROM, FULL, GROUP, ILIKE, IN, IS, LEFT, LIKE, LIMIT, MATCHES, NOT, OFFSET, OR, ORDER, REGEXP, RIGHT, WHERE, ||]",
+		{310, -1}: "expected one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', '}', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, COLLATE, DEFAULT, DESC, ESCAPE, FROM, FULL, GROUP, ILIKE, IN, IS, LEFT, LIKE, LIMIT, MATCHES, NOT, OFFSET, OR, ORDER, REGEXP, RIGHT, WHERE, ||]",
+		{312, -1}: "expected one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', '}', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, COLLATE, DEFAULT, DESC, ESCAPE, FROM, FULL, GROUP, ILIKE, IN, IS, LEFT, LIKE, LIMIT, MATCHES, NOT, OFFSET, OR, ORDER, REGEXP, RIGHT, WHERE, ||]",
+		{351, -1}: "expected one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', '}', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, COLLATE, DEFAULT, DESC, ESCAPE, FROM, FULL, GROUP, ILIKE, IN, IS, LEFT, LIKE, LIMIT, MATCHES, NOT, OFFSET, OR, ORDER, REGEXP, RIGHT, WHERE, ||]",
+		{353, -1}: "expected one of [!=, $end, &&, &^, '%', '&', '(', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', '[', ']', '^', '|', '}', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, COLLATE, DEFAULT, DESC, ESCAPE, FROM, FULL, GROUP, ILIKE, IN, IS, LEFT, LIKE, LIMIT, MATCHES, NOT, OFFSET, OR, ORDER, REGEXP, RIGHT, WHERE, ||]",
+		{41, -1}:  "expected one of [!=, $end, &&, &^, '%', '&', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', ']', '^', '|', '}', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, COLLATE, DEFAULT, DESC, ESCAPE, FROM, FULL, GROUP, ILIKE, IN, IS, LEFT, LIKE, LIMIT, MATCHES, NOT, OFFSET, OR, ORDER, REGEXP, RIGHT, WHERE, ||]",
+		{42, -1}:  "expected one of [!=, $end, &&, &^, '%', '&', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', ']', '^', '|', '}', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, COLLATE, DEFAULT, DESC, ESCAPE, FROM, FULL, GROUP, ILIKE, IN, IS, LEFT, LIKE, LIMIT, MATCHES, NOT, OFFSET, OR, ORDER, REGEXP, RIGHT, WHERE, ||]",
+		{201, -1}: "expected one of [!=, $end, &&, &^, '%', '&', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', ']', '^', '|', '}', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, COLLATE, DEFAULT, DESC, ESCAPE, FROM, FULL, GROUP, ILIKE, IN, IS, LEFT, LIKE, LIMIT, MATCHES, NOT, OFFSET, OR, ORDER, REGEXP, RIGHT, WHERE, ||]",
+		{202, -1}: "expected one of [!=, $end, &&, &^, '%', '&', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', ']', '^', '|', '}', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, COLLATE, DEFAULT, DESC, ESCAPE, FROM, FULL, GROUP, ILIKE, IN, IS, LEFT, LIKE, LIMIT, MATCHES, NOT, OFFSET, OR, ORDER, REGEXP, RIGHT, WHERE, ||]",
+		{203, -1}: "expected one of [!=, $end, &&, &^, '%', '&', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', ']', '^', '|', '}', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, COLLATE, DEFAULT, DESC, ESCAPE, FROM, FULL, GROUP, ILIKE, IN, IS, LEFT, LIKE, LIMIT, MATCHES, NOT, OFFSET, OR, ORDER, REGEXP, RIGHT, WHERE, ||]",
+		{204, -1}: "expected one of [!=, $end, &&, &^, '%', '&', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', ']', '^', '|', '}', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, COLLATE, DEFAULT, DESC, ESCAPE, FROM, FULL, GROUP, ILIKE, IN, IS, LEFT, LIKE, LIMIT, MATCHES, NOT, OFFSET, OR, ORDER, REGEXP, RIGHT, WHERE, ||]",
+		{205, -1}: "expected one of [!=, $end, &&, &^, '%', '&', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', ']', '^', '|', '}', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, COLLATE, DEFAULT, DESC, ESCAPE, FROM, FULL, GROUP, ILIKE, IN, IS, LEFT, LIKE, LIMIT, MATCHES, NOT, OFFSET, OR, ORDER, REGEXP, RIGHT, WHERE, ||]",
+		{206, -1}: "expected one of [!=, $end, &&, &^, '%', '&', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', ']', '^', '|', '}', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, COLLATE, DEFAULT, DESC, ESCAPE, FROM, FULL, GROUP, ILIKE, IN, IS, LEFT, LIKE, LIMIT, MATCHES, NOT, OFFSET, OR, ORDER, REGEXP, RIGHT, WHERE, ||]",
+		{207, -1}: "expected one of [!=, $end, &&, &^, '%', '&', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', ']', '^', '|', '}', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, COLLATE, DEFAULT, DESC, ESCAPE, FROM, FULL, GROUP, ILIKE, IN, IS, LEFT, LIKE, LIMIT, MATCHES, NOT, OFFSET, OR, ORDER, REGEXP, RIGHT, WHERE, ||]",
+		{208, -1}: "expected one of [!=, $end, &&, &^, '%', '&', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', ']', '^', '|', '}', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, COLLATE, DEFAULT, DESC, ESCAPE, FROM, FULL, GROUP, ILIKE, IN, IS, LEFT, LIKE, LIMIT, MATCHES, NOT, OFFSET, OR, ORDER, REGEXP, RIGHT, WHERE, ||]",
+		{209, -1}: "expected one of [!=, $end, &&, &^, '%', '&', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', ']', '^', '|', '}', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, COLLATE, DEFAULT, DESC, ESCAPE, FROM, FULL, GROUP, ILIKE, IN, IS, LEFT, LIKE, LIMIT, MATCHES, NOT, OFFSET, OR, ORDER, REGEXP, RIGHT, WHERE, ||]",
+		{210, -1}: "expected one of [!=, $end, &&, &^, '%', '&', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', ']', '^', '|', '}', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, COLLATE, DEFAULT, DESC, ESCAPE, FROM, FULL, GROUP, ILIKE, IN, IS, LEFT, LIKE, LIMIT, MATCHES, NOT, OFFSET, OR, ORDER, REGEXP, RIGHT, WHERE, ||]",
+		{211, -1}: "expected one of [!=, $end, &&, &^, '%', '&', ')', '*', '+', ',', '-', '/', ':', ';', '<', '=', '>', ']', '^', '|', '}', <<, <=, ==, >=, >>, AND, AS, ASC, BETWEEN, COLLATE, DEFAULT, DESC, ESCAPE, FROM, FULL, GROUP, ILIKE, IN, IS, LEFT, LIKE, LIMIT, MATCHES, NOT, OFFSET, OR, ORDER, REGEXP, RIGHT, WHERE, ||]",
+		{40, -1}:  "expected one of [!=, $end, &&, ')', '+', ',', '-', ':', ';', '<', '=', '>', ']', '^', '|', '}', <=, ==, >=, AND, AS, ASC, BETWEEN, COLLATE, DEFAULT, DESC, FROM, FULL, GROUP, ILIKE, IN, IS, LEFT, LIKE, LIMIT, MATCHES, NOT, OFFSET, OR, ORDER, REGEXP, RIGHT, WHERE, ||]",
+		{192, -1}: "expected one of [!=, $end, &&, ')', '+', ',', '-', ':', ';', '<', '=', '>', ']', '^', '|', '}', <=, ==, >=, AND, AS, ASC, BETWEEN, COLLATE, DEFAULT, DESC, FROM, FULL, GROUP, ILIKE, IN, IS, LEFT, LIKE, LIMIT, MATCHES, NOT, OFFSET, OR, ORDER, REGEXP, RIGHT, WHERE, ||]",
+		{193, -1}: "expected one of [!=, $end, &&, ')', '+', ',', '-', ':', ';', '<', '=', '>', ']', '^', '|', '}', <=, ==, >=, AND, AS, ASC, BETWEEN, COLLATE, DEFAULT, DESC, FROM, FULL, GROUP, ILIKE, IN, IS, LEFT, LIKE, LIMIT, MATCHES, NOT, OFFSET, OR, ORDER, REGEXP, RIGHT, WHERE, ||]",
+		{194, -1}: "expected one of [!=, $end, &&, ')', '+', ',', '-', ':', ';', '<', '=', '>', ']', '^', '|', '}', <=, ==, >=, AND, AS, ASC, BETWEEN, COLLATE, DEFAULT, DESC, FROM, FULL, GROUP, ILIKE, IN, IS, LEFT, LIKE, LIMIT, MATCHES, NOT, OFFSET, OR, ORDER, REGEXP, RIGHT, WHERE, ||]",
+		{195, -1}: "expected one of [!=, $end, &&, ')', '+', ',', '-', ':', ';', '<', '=', '>', ']', '^', '|', '}', <=, ==, >=, AND, AS, ASC, BETWEEN, COLLATE, DEFAULT, DESC, FROM, FULL, GROUP, ILIKE, IN, IS, LEFT, LIKE, LIMIT, MATCHES, NOT, OFFSET, OR, ORDER, REGEXP, RIGHT, WHERE, ||]",
+		{196, -1}: "expected one of [!=, $end, &&, ')', '+', ',', '-', ':', ';', '<', '=', '>', ']', '^', '|', '}', <=, ==, >=, AND, AS, ASC, BETWEEN, COLLATE, DEFAULT, DESC, FROM, FULL, GROUP, ILIKE, IN, IS, LEFT, LIKE, LIMIT, MATCHES, NOT, OFFSET, OR, ORDER, REGEXP, RIGHT, WHERE, ||]",
+		{197, -1}: "expected one of [!=, $end, &&, ')', '+', ',', '-', ':', ';', '<', '=', '>', ']', '^', '|', '}', <=, ==, >=, AND, AS, ASC, BETWEEN, COLLATE, DEFAULT, DESC, FROM, FULL, GROUP, ILIKE, IN, IS, LEFT, LIKE, LIMIT, MATCHES, NOT, OFFSET, OR, ORDER, REGEXP, RIGHT, WHERE, ||]",
+		{199, -1}: "expected one of [!=, $end, &&, ')', '+', ',', '-', ':', ';', '<', '=', '>', ']', '^', '|', '}', <=, ==, >=, AND, AS, ASC, BETWEEN, COLLATE, DEFAULT, DESC, FROM, FULL, GROUP, ILIKE, IN, IS, LEFT, LIKE, LIMIT, MATCHES, NOT, OFFSET, OR, ORDER, REGEXP, RIGHT, WHERE, ||]",
+		{200, -1}: "expected one of [!=, $end, &&, ')', '+', ',', '-', ':', ';', '<', '=', '>', ']', '^', '|', '}', <=, ==, >=, AND, AS, ASC, BETWEEN, COLLATE, DEFAULT, DESC, FROM, FULL, GROUP, ILIKE, IN, IS, LEFT, LIKE, LIMIT, MATCHES, NOT, OFFSET, OR, ORDER, REGEXP, RIGHT, WHERE, ||]",
+		{254, -1}: "expected one of [!=, $end, &&, ')', ',', ':', ';', '<', '=', '>', ']', '}', <=, ==, >=, AND, AS, ASC, BETWEEN, COLLATE, DEFAULT, DESC, FROM, FULL, GROUP, ILIKE, IN, IS, LEFT, LIKE, LIMIT, MATCHES, NOT, OFFSET, OR, ORDER, REGEXP, RIGHT, WHERE, ||]",
+		{309, -1}: "expected one of [!=, $end, &&, ')', ',', ':', ';', '<', '=', '>', ']', '}', <=, ==, >=, AND, AS, ASC, BETWEEN, COLLATE, DEFAULT, DESC, FROM, FULL, GROUP, ILIKE, IN, IS, LEFT, LIKE, LIMIT, MATCHES, NOT, OFFSET, OR, ORDER, REGEXP, RIGHT, WHERE, ||]",
+		{308, -1}: "expected one of [$end, &&, ')', '+', ',', '-', ':', ';', ']', '^', '|', '}', AND, AS, ASC, COLLATE, DEFAULT, DESC, FROM, FULL, GROUP, LEFT, LIMIT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
+		{350, -1}: "expected one of [$end, &&, ')', '+', ',', '-', ':', ';', ']', '^', '|', '}', AND, AS, ASC, COLLATE, DEFAULT, DESC, FROM, FULL, GROUP, LEFT, LIMIT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
+		{38, -1}:  "expected one of [$end, &&, ')', ',', ':', ';', ']', '}', AND, AS, ASC, COLLATE, DEFAULT, DESC, FROM, FULL, GROUP, LEFT, LIMIT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
+		{185, -1}: "expected one of [$end, &&, ')', ',', ':', ';', ']', '}', AND, AS, ASC, COLLATE, DEFAULT, DESC, FROM, FULL, GROUP, LEFT, LIMIT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
+		{190, -1}: "expected one of [$end, &&, ')', ',', ':', ';', ']', '}', AND, AS, ASC, COLLATE, DEFAULT, DESC, FROM, FULL, GROUP, LEFT, LIMIT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
+		{253, -1}: "expected one of [$end, &&, ')', ',', ':', ';', ']', '}', AND, AS, ASC, COLLATE, DEFAULT, DESC, FROM, FULL, GROUP, LEFT, LIMIT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
+		{302, -1}: "expected one of [$end, &&, ')', ',', ':', ';', ']', '}', AND, AS, ASC, COLLATE, DEFAULT, DESC, FROM, FULL, GROUP, LEFT, LIMIT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
+		{347, -1}: "expected one of [$end, &&, ')', ',', ':', ';', ']', '}', AND, AS, ASC, COLLATE, DEFAULT, DESC, FROM, FULL, GROUP, LEFT, LIMIT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
+		{348, -1}: "expected one of [$end, &&, ')', ',', ':', ';', ']', '}', AND, AS, ASC, COLLATE, DEFAULT, DESC, FROM, FULL, GROUP, LEFT, LIMIT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
+		{387, -1}: "expected one of [$end, &&, ')', ',', ':', ';', ']', '}', AND, AS, ASC, COLLATE, DEFAULT, DESC, FROM, FULL, GROUP, LEFT, LIMIT, OFFSET, OR, ORDER, RIGHT, WHERE, ||]",
+		{67, -1}:  "expected one of [$end, '!', '(', ')', '+', ',', '-', ';', '[', '^', '{', COLLATE, DEFAULT, NOT, NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{68, -1}:  "expected one of [$end, '!', '(', ')', '+', ',', '-', ';', '[', '^', '{', COLLATE, DEFAULT, NOT, NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{69, -1}:  "expected one of [$end, '!', '(', ')', '+', ',', '-', ';', '[', '^', '{', COLLATE, DEFAULT, NOT, NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{70, -1}:  "expected one of [$end, '!', '(', ')', '+', ',', '-', ';', '[', '^', '{', COLLATE, DEFAULT, NOT, NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{71, -1}:  "expected one of [$end, '!', '(', ')', '+', ',', '-', ';', '[', '^', '{', COLLATE, DEFAULT, NOT, NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{72, -1}:  "expected one of [$end, '!', '(', ')', '+', ',', '-', ';', '[', '^', '{', COLLATE, DEFAULT, NOT, NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{73, -1}:  "expected one of [$end, '!', '(', ')', '+', ',', '-', ';', '[', '^', '{', COLLATE, DEFAULT, NOT, NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{74, -1}:  "expected one of [$end, '!', '(', ')', '+', ',', '-', ';', '[', '^', '{', COLLATE, DEFAULT, NOT, NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{75, -1}:  "expected one of [$end, '!', '(', ')', '+', ',', '-', ';', '[', '^', '{', COLLATE, DEFAULT, NOT, NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{76, -1}:  "expected one of [$end, '!', '(', ')', '+', ',', '-', ';', '[', '^', '{', COLLATE, DEFAULT, NOT, NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{77, -1}:  "expected one of [$end, '!', '(', ')', '+', ',', '-', ';', '[', '^', '{', COLLATE, DEFAULT, NOT, NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{78, -1}:  "expected one of [$end, '!', '(', ')', '+', ',', '-', ';', '[', '^', '{', COLLATE, DEFAULT, NOT, NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{79, -1}:  "expected one of [$end, '!', '(', ')', '+', ',', '-', ';', '[', '^', '{', COLLATE, DEFAULT, NOT, NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{80, -1}:  "expected one of [$end, '!', '(', ')', '+', ',', '-', ';', '[', '^', '{', COLLATE, DEFAULT, NOT, NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{81, -1}:  "expected one of [$end, '!', '(', ')', '+', ',', '-', ';', '[', '^', '{', COLLATE, DEFAULT, NOT, NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{82, -1}:  "expected one of [$end, '!', '(', ')', '+', ',', '-', ';', '[', '^', '{', COLLATE, DEFAULT, NOT, NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{83, -1}:  "expected one of [$end, '!', '(', ')', '+', ',', '-', ';', '[', '^', '{', COLLATE, DEFAULT, NOT, NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{84, -1}:  "expected one of [$end, '!', '(', ')', '+', ',', '-', ';', '[', '^', '{', COLLATE, DEFAULT, NOT, NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{85, -1}:  "expected one of [$end, '!', '(', ')', '+', ',', '-', ';', '[', '^', '{', COLLATE, DEFAULT, NOT, NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{86, -1}:  "expected one of [$end, '!', '(', ')', '+', ',', '-', ';', '[', '^', '{', COLLATE, DEFAULT, NOT, NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{87, -1}:  "expected one of [$end, '!', '(', ')', '+', ',', '-', ';', '[', '^', '{', COLLATE, DEFAULT, NOT, NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{88, -1}:  "expected one of [$end, '!', '(', ')', '+', ',', '-', ';', '[', '^', '{', COLLATE, DEFAULT, NOT, NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{89, -1}:  "expected one of [$end, '!', '(', ')', '+', ',', '-', ';', '[', '^', '{', COLLATE, DEFAULT, NOT, NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{90, -1}:  "expected one of [$end, '!', '(', ')', '+', ',', '-', ';', '[', '^', '{', COLLATE, DEFAULT, NOT, NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{91, -1}:  "expected one of [$end, '!', '(', ')', '+', ',', '-', ';', '[', '^', '{', COLLATE, DEFAULT, NOT, NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{92, -1}:  "expected one of [$end, '!', '(', ')', '+', ',', '-', ';', '[', '^', '{', COLLATE, DEFAULT, NOT, NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{184, -1}: "expected one of [$end, '!', '(', ')', '+', ',', '-', ';', '[', '^', ASC, DEFAULT, DESC, LIMIT, NOT, NULL, OFFSET, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{294, -1}: "expected one of [$end, '(', ')', ',', ';', AS, FULL, GROUP, LEFT, LIMIT, OFFSET, ON, ORDER, RIGHT, WHERE, identifier]",
+		{109, -1}: "expected one of [$end, '(', ';', ADD, DROP, SELECT, SET, VALUES, WHERE, identifier]",
+		{444, -1}: "expected one of [$end, '(', ';']",
+		{247, -1}: "expected one of [$end, ')', ',', ';', '=', '[', LIMIT, OFFSET, ORDER, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, float, float32, float64, identifier, int, int16, int32, int64, int8, json, rune, string, time, uint, uint16, uint32, uint64, uint8, uuid]",
+		{415, -1}: "expected one of [$end, ')', ',', ';', AS, FULL, GROUP, LEFT, LIMIT, OFFSET, ON, ORDER, RIGHT, WHERE, identifier]",
+		{416, -1}: "expected one of [$end, ')', ',', ';', AS, FULL, GROUP, LEFT, LIMIT, OFFSET, ON, ORDER, RIGHT, WHERE, identifier]",
+		{111, -1}: "expected one of [$end, ')', ',', ';', ASC, DESC, LIMIT, OFFSET]",
+		{467, -1}: "expected one of [$end, ')', ',', ';', ASC, DESC, LIMIT, OFFSET]",
+		{358, -1}: "expected one of [$end, ')', ',', ';', DEFAULT]",
+		{394, -1}: "expected one of [$end, ')', ',', ';', DEFAULT]",
+		{177, -1}: "expected one of [$end, ')', ',', ';', FROM, FULL, GROUP, LEFT, LIMIT, OFFSET, ORDER, RIGHT, WHERE]",
+		{178, -1}: "expected one of [$end, ')', ',', ';', FROM, FULL, GROUP, LEFT, LIMIT, OFFSET, ORDER, RIGHT, WHERE]",
+		{242, -1}: "expected one of [$end, ')', ',', ';', FROM, FULL, GROUP, LEFT, LIMIT, OFFSET, ORDER, RIGHT, WHERE]",
+		{296, -1}: "expected one of [$end, ')', ',', ';', FROM, FULL, GROUP, LEFT, LIMIT, OFFSET, ORDER, RIGHT, WHERE]",
+		{297, -1}: "expected one of [$end, ')', ',', ';', FROM, FULL, GROUP, LEFT, LIMIT, OFFSET, ORDER, RIGHT, WHERE]",
+		{381, -1}: "expected one of [$end, ')', ',', ';', FULL, GROUP, LEFT, LIMIT, OFFSET, ON, ORDER, RIGHT, WHERE, identifier]",
+		{383, -1}: "expected one of [$end, ')', ',', ';', FULL, GROUP, LEFT, LIMIT, OFFSET, ON, ORDER, RIGHT, WHERE, identifier]",
+		{412, -1}: "expected one of [$end, ')', ',', ';', FULL, GROUP, LEFT, LIMIT, OFFSET, ON, ORDER, RIGHT, WHERE, identifier]",
+		{436, -1}: "expected one of [$end, ')', ',', ';', FULL, GROUP, LEFT, LIMIT, OFFSET, ON, ORDER, RIGHT, WHERE, identifier]",
+		{464, -1}: "expected one of [$end, ')', ',', ';', FULL, GROUP, LEFT, LIMIT, OFFSET, ON, ORDER, RIGHT, WHERE, identifier]",
+		{379, -1}: "expected one of [$end, ')', ',', ';', FULL, GROUP, LEFT, LIMIT, OFFSET, ON, ORDER, RIGHT, WHERE]",
+		{292, -1}: "expected one of [$end, ')', ',', ';', FULL, GROUP, LEFT, LIMIT, OFFSET, ORDER, RIGHT, WHERE]",
+		{378, -1}: "expected one of [$end, ')', ',', ';', FULL, GROUP, LEFT, LIMIT, OFFSET, ORDER, RIGHT, WHERE]",
+		{406, -1}: "expected one of [$end, ')', ',', ';', LIMIT, OFFSET, ORDER]",
+		{391, -1}: "expected one of [$end, ')', ',', ';']",
+		{392, -1}: "expected one of [$end, ')', ',', ';']",
+		{417, -1}: "expected one of [$end, ')', ',', ';']",
+		{438, -1}: "expected one of [$end, ')', ',', ';']",
+		{471, -1}: "expected one of [$end, ')', ',', ';']",
+		{454, -1}: "expected one of [$end, ')', ';', ASC, DESC, LIMIT, OFFSET]",
+		{176, -1}: "expected one of [$end, ')', ';', FROM, FULL, GROUP, LEFT, LIMIT, OFFSET, ORDER, RIGHT, WHERE]",
+		{338, -1}: "expected one of [$end, ')', ';', FULL, GROUP, LEFT, LIMIT, OFFSET, ORDER, RIGHT, WHERE]",
+		{286, -1}: "expected one of [$end, ')', ';', GROUP, LIMIT, OFFSET, ORDER, WHERE]",
+		{335, -1}: "expected one of [$end, ')', ';', GROUP, LIMIT, OFFSET, ORDER]",
+		{402, -1}: "expected one of [$end, ')', ';', GROUP, LIMIT, OFFSET, ORDER]",
+		{427, -1}: "expected one of [$end, ')', ';', GROUP, LIMIT, OFFSET, ORDER]",
+		{372, -1}: "expected one of [$end, ')', ';', LIMIT, OFFSET, ORDER]",
+		{375, -1}: "expected one of [$end, ')', ';', LIMIT, OFFSET, ORDER]",
+		{433, -1}: "expected one of [$end, ')', ';', LIMIT, OFFSET, ORDER]",
+		{408, -1}: "expected one of [$end, ')', ';', LIMIT, OFFSET]",
+		{461, -1}: "expected one of [$end, ')', ';', LIMIT, OFFSET]",
+		{462, -1}: "expected one of [$end, ')', ';', LIMIT, OFFSET]",
+		{463, -1}: "expected one of [$end, ')', ';', LIMIT, OFFSET]",
+		{446, -1}: "expected one of [$end, ')', ';']",
+		{386, -1}: "expected one of [$end, ',', ';', WHERE]",
+		{472, -1}: "expected one of [$end, ',', ';']",
+		{344, -1}: "expected one of [$end, ';', WHERE]",
 		{2, -1}:   "expected one of [$end, ';']",
+		{4, -1}:   "expected one of [$end, ';']",
+		{5, -1}:   "expected one of [$end, ';']",
 		{6, -1}:   "expected one of [$end, ';']",
+		{7, -1}:   "expected one of [$end, ';']",
+		{8, -1}:   "expected one of [$end, ';']",
+		{9, -1}:   "expected one of [$end, ';']",
+		{10, -1}:  "expected one of [$end, ';']",
+		{11, -1}:  "expected one of [$end, ';']",
 		{12, -1}:  "expected one of [$end, ';']",
+		{13, -1}:  "expected one of [$end, ';']",
 		{14, -1}:  "expected one of [$end, ';']",
 		{15, -1}:  "expected one of [$end, ';']",
 		{16, -1}:  "expected one of [$end, ';']",
@@ -1130,556 +1317,709 @@ var (
 		{19, -1}:  "expected one of [$end, ';']",
 		{20, -1}:  "expected one of [$end, ';']",
 		{21, -1}:  "expected one of [$end, ';']",
-		{22, -1}:  "expected one of [$end, ';']",
-		{23, -1}:  "expected one of [$end, ';']",
 		{24, -1}:  "expected one of [$end, ';']",
-		{25, -1}:  "expected one of [$end, ';']",
-		{26, -1}:  "expected one of [$end, ';']",
-		{27, -1}:  "expected one of [$end, ';']",
-		{28, -1}:  "expected one of [$end, ';']",
-		{29, -1}:  "expected one of [$end, ';']",
-		{40, -1}:  "expected one of [$end, ';']",
-		{41, -1}:  "expected one of [$end, ';']",
-		{220, -1}: "expected one of [$end, ';']",
-		{294, -1}: "expected one of [$end, ';']",
+		{30, -1}:  "expected one of [$end, ';']",
+		{94, -1}:  "expected one of [$end, ';']",
+		{103, -1}: "expected one of [$end, ';']",
+		{110, -1}: "expected one of [$end, ';']",
+		{170, -1}: "expected one of [$end, ';']",
+		{172, -1}: "expected one of [$end, ';']",
+		{180, -1}: "expected one of [$end, ';']",
+		{231, -1}: "expected one of [$end, ';']",
+		{233, -1}: "expected one of [$end, ';']",
+		{266, -1}: "expected one of [$end, ';']",
+		{278, -1}: "expected one of [$end, ';']",
+		{279, -1}: "expected one of [$end, ';']",
+		{281, -1}: "expected one of [$end, ';']",
+		{298, -1}: "expected one of [$end, ';']",
 		{299, -1}: "expected one of [$end, ';']",
-		{304, -1}: "expected one of [$end, ';']",
-		{307, -1}: "expected one of [$end, ';']",
-		{310, -1}: "expected one of [$end, ';']",
-		{314, -1}: "expected one of [$end, ';']",
-		{317, -1}: "expected one of [$end, ';']",
-		{333, -1}: "expected one of [$end, ';']",
-		{348, -1}: "expected one of [$end, ';']",
-		{359, -1}: "expected one of [$end, ';']",
-		{360, -1}: "expected one of [$end, ';']",
-		{366, -1}: "expected one of [$end, ';']",
-		{367, -1}: "expected one of [$end, ';']",
-		{370, -1}: "expected one of [$end, ';']",
-		{222, -1}: "expected one of ['!', '(', '*', '+', '-', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{114, -1}: "expected one of ['!', '(', '+', '-', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{115, -1}: "expected one of ['!', '(', '+', '-', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{119, -1}: "expected one of ['!', '(', '+', '-', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{120, -1}: "expected one of ['!', '(', '+', '-', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{166, -1}: "expected one of ['!', '(', '+', '-', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{167, -1}: "expected one of ['!', '(', '+', '-', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{332, -1}: "expected one of [')', ',']",
+		{321, -1}: "expected one of [$end, ';']",
+		{399, -1}: "expected one of [$end, ';']",
+		{424, -1}: "expected one of [$end, ';']",
+		{439, -1}: "expected one of [$end, ';']",
+		{443, -1}: "expected one of [$end, ';']",
+		{456, -1}: "expected one of [$end, ';']",
+		{458, -1}: "expected one of [$end, ';']",
+		{468, -1}: "expected one of [$end, ';']",
+		{106, -1}: "expected one of ['!', '(', '*', '+', '-', '[', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{114, -1}: "expected one of ['!', '(', '+', '-', '[', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{115, -1}: "expected one of ['!', '(', '+', '-', '[', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{117, -1}: "expected one of ['!', '(', '+', '-', '[', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{118, -1}: "expected one of ['!', '(', '+', '-', '[', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{132, -1}: "expected one of ['!', '(', '+', '-', '[', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{133, -1}: "expected one of ['!', '(', '+', '-', '[', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{134, -1}: "expected one of ['!', '(', '+', '-', '[', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{135, -1}: "expected one of ['!', '(', '+', '-', '[', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{283, -1}: "expected one of ['(', ')', ',']",
+		{364, -1}: "expected one of [')', ',']",
+		{400, -1}: "expected one of [')', ',']",
+		{425, -1}: "expected one of [')', ',']",
+		{451, -1}: "expected one of [')', ',']",
+		{452, -1}: "expected one of [')', ',']",
+		{470, -1}: "expected one of [')', ',']",
+		{313, -1}: "expected one of [')', '}']",
+		{398, -1}: "expected one of [')', string literal]",
 		{189, -1}: "expected one of ['+', '-', '^', '|', AND]",
-		{194, -1}: "expected one of ['+', '-', '^', '|', AND]",
-		{362, -1}: "expected one of [ADD, DROP]",
-		{169, -1}: "expected one of [BETWEEN, IN]",
-		{9, -1}:   "expected one of [INDEX, TABLE]",
-		{244, -1}: "expected one of [JOIN, OUTER]",
-		{245, -1}: "expected one of [JOIN, OUTER]",
-		{246, -1}: "expected one of [JOIN, OUTER]",
-		{171, -1}: "expected one of [NOT, NULL]",
-		{292, -1}: "expected one of [SELECT, VALUES]",
-		{335, -1}: "expected optional DEFAULT clause or one of [$end, ')', ',', ';', DEFAULT]",
-		{334, -1}: "expected optional DEFAULT clause or optional column value constraint or one of [$end, '!', '(', ')', '+', ',', '-', ';', '^', DEFAULT, NOT, NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{247, -1}: "expected optional OUTER clause or one of [JOIN, OUTER]",
-		{131, -1}: "expected optional comma or one of [$end, ')', ',', ';', ASC, DESC, LIMIT, OFFSET]",
-		{234, -1}: "expected optional comma or one of [$end, ')', ',', ';', FULL, GROUP, LEFT, LIMIT, OFFSET, ORDER, RIGHT, WHERE]",
-		{272, -1}: "expected optional comma or one of [$end, ')', ',', ';', LIMIT, OFFSET, ORDER]",
-		{213, -1}: "expected optional comma or one of [$end, ',', ';', WHERE]",
-		{298, -1}: "expected optional comma or one of [$end, ',', ';']",
-		{329, -1}: "expected optional comma or one of [')', ',']",
-		{346, -1}: "expected optional comma or one of [')', ',']",
-		{170, -1}: "expected primary expression factor or one of ['!', '(', '+', '-', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{172, -1}: "expected primary expression factor or one of ['!', '(', '+', '-', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{173, -1}: "expected primary expression factor or one of ['!', '(', '+', '-', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{174, -1}: "expected primary expression factor or one of ['!', '(', '+', '-', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{175, -1}: "expected primary expression factor or one of ['!', '(', '+', '-', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{176, -1}: "expected primary expression factor or one of ['!', '(', '+', '-', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{177, -1}: "expected primary expression factor or one of ['!', '(', '+', '-', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{178, -1}: "expected primary expression factor or one of ['!', '(', '+', '-', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{190, -1}: "expected primary expression factor or one of ['!', '(', '+', '-', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{193, -1}: "expected primary expression factor or one of ['!', '(', '+', '-', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{195, -1}: "expected primary expression factor or one of ['!', '(', '+', '-', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{90, -1}:  "expected primary expression or one of ['(', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{91, -1}:  "expected primary expression or one of ['(', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{92, -1}:  "expected primary expression or one of ['(', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{93, -1}:  "expected primary expression or one of ['(', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{158, -1}: "expected primary expression term or one of ['!', '(', '+', '-', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{159, -1}: "expected primary expression term or one of ['!', '(', '+', '-', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{160, -1}: "expected primary expression term or one of ['!', '(', '+', '-', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{161, -1}: "expected primary expression term or one of ['!', '(', '+', '-', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{230, -1}: "expected record set optional AS clause or one of [$end, ')', ',', ';', AS, FULL, GROUP, LEFT, LIMIT, OFFSET, ON, ORDER, RIGHT, WHERE]",
-		{235, -1}: "expected record set or one of [$end, '(', ')', ';', FULL, GROUP, LEFT, LIMIT, OFFSET, ORDER, RIGHT, WHERE, identifier]",
-		{278, -1}: "expected record set or one of ['(', identifier]",
-		{199, -1}: "expected semiOpt or one of [')', ';']",
-		{206, -1}: "expected semiOpt or one of [')', ';']",
-		{238, -1}: "expected semiOpt or one of [')', ';']",
-		{10, -1}:  "expected statement or one of [$end, ';', ALTER, BEGIN, COMMIT, CREATE, DELETE, DROP, EXPLAIN, INSERT, ROLLBACK, SELECT, TRUNCATE, UPDATE]",
-		{369, -1}: "expected statement or one of [$end, ';', ALTER, BEGIN, COMMIT, CREATE, DELETE, DROP, EXPLAIN, INSERT, ROLLBACK, SELECT, TRUNCATE, UPDATE]",
-		{326, -1}: "expected table column definition or identifier",
-		{344, -1}: "expected table column definition or identifier",
-		{363, -1}: "expected table column definition or identifier",
-		{331, -1}: "expected table column definition or one of [')', identifier]",
-		{31, -1}:  "expected table name or identifier",
-		{219, -1}: "expected table name or identifier",
-		{287, -1}: "expected table name or identifier",
-		{309, -1}: "expected table name or identifier",
-		{315, -1}: "expected table name or identifier",
-		{324, -1}: "expected table name or identifier",
-		{361, -1}: "expected table name or identifier",
-		{306, -1}: "expected table name or one of [IF, identifier]",
-		{320, -1}: "expected table name or one of [IF, identifier]",
-		{327, -1}: "expected type or one of [bigint, bigrat, blob, bool, byte, complex128, complex64, duration, float, float32, float64, int, int16, int32, int64, int8, rune, string, time, uint, uint16, uint32, uint64, uint8]",
-		{142, -1}: "expected unary expression or one of ['!', '(', '+', '-', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{143, -1}: "expected unary expression or one of ['!', '(', '+', '-', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{144, -1}: "expected unary expression or one of ['!', '(', '+', '-', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{145, -1}: "expected unary expression or one of ['!', '(', '+', '-', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{146, -1}: "expected unary expression or one of ['!', '(', '+', '-', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{147, -1}: "expected unary expression or one of ['!', '(', '+', '-', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
-		{148, -1}: "expected unary expression or one of ['!', '(', '+', '-', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8]",
+		{251, -1}: "expected one of ['+', '-', '^', '|', AND]",
+		{161, -1}: "expected one of [ADD, DROP]",
+		{120, -1}: "expected one of [BETWEEN, IN]",
+		{102, -1}: "expected one of [IF, identifier]",
+		{382, -1}: "expected one of [INDEX, JOIN]",
+		{27, -1}:  "expected one of [INDEX, TABLE, identifier]",
+		{96, -1}:  "expected one of [INDEX, identifier]",
+		{288, -1}: "expected one of [JOIN, OUTER]",
+		{289, -1}: "expected one of [JOIN, OUTER]",
+		{290, -1}: "expected one of [JOIN, OUTER]",
+		{122, -1}: "expected one of [NOT, NULL]",
+		{331, -1}: "expected one of [SELECT, VALUES]",
+		{445, -1}: "expected one of [SELECT, VALUES]",
+		{317, -1}: "expected optional COLLATE clause or one of [$end, '!', '(', ')', '+', ',', '-', ';', '[', '^', COLLATE, DEFAULT, NOT, NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{357, -1}: "expected optional DEFAULT clause or one of [$end, ')', ',', ';', DEFAULT]",
+		{388, -1}: "expected optional DEFAULT clause or one of [$end, ')', ',', ';', DEFAULT]",
+		{419, -1}: "expected optional DEFAULT clause or one of [$end, ')', ',', ';', DEFAULT]",
+		{466, -1}: "expected optional DEFAULT clause or one of [$end, ')', ',', ';', DEFAULT]",
+		{198, -1}: "expected optional ESCAPE clause or one of [!=, $end, &&, ')', '+', ',', '-', ':', ';', '<', '=', '>', ']', '^', '|', '}', <=, ==, >=, AND, AS, ASC, BETWEEN, COLLATE, DEFAULT, DESC, ESCAPE, FROM, FULL, GROUP, ILIKE, IN, IS, LEFT, LIKE, LIMIT, MATCHES, NOT, OFFSET, OR, ORDER, REGEXP, RIGHT, WHERE, ||]",
+		{287, -1}: "expected optional OUTER clause or one of [JOIN, OUTER]",
+		{320, -1}: "expected optional column value constraint or one of [$end, '!', '(', ')', '+', ',', '-', ';', '[', '^', DEFAULT, NOT, NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{354, -1}: "expected optional column value constraint or one of [$end, '!', '(', ')', '+', ',', '-', ';', '[', '^', DEFAULT, NOT, NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{390, -1}: "expected optional column value constraint or one of [$end, '!', '(', ')', '+', ',', '-', ';', '[', '^', DEFAULT, NOT, NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{453, -1}: "expected optional column value constraint or one of [$end, '!', '(', ')', '+', ',', '-', ';', '[', '^', DEFAULT, NOT, NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{440, -1}: "expected optional comma or one of [$end, ')', ',', ';', ASC, DESC, LIMIT, OFFSET]",
+		{291, -1}: "expected optional comma or one of [$end, ')', ',', ';', FULL, GROUP, LEFT, LIMIT, OFFSET, ORDER, RIGHT, WHERE]",
+		{333, -1}: "expected optional comma or one of [$end, ')', ',', ';', LIMIT, OFFSET, ORDER]",
+		{300, -1}: "expected optional comma or one of [$end, ',', ';', WHERE]",
+		{428, -1}: "expected optional comma or one of [$end, ',', ';']",
+		{262, -1}: "expected optional comma or one of [')', ',', '}']",
+		{326, -1}: "expected optional comma or one of [')', ',']",
+		{363, -1}: "expected optional comma or one of [')', ',']",
+		{426, -1}: "expected optional comma or one of [')', ',']",
+		{265, -1}: "expected optional expression list or one of ['!', '(', '+', '-', '[', '^', '}', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{121, -1}: "expected primary expression factor or one of ['!', '(', '+', '-', '[', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{123, -1}: "expected primary expression factor or one of ['!', '(', '+', '-', '[', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{124, -1}: "expected primary expression factor or one of ['!', '(', '+', '-', '[', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{125, -1}: "expected primary expression factor or one of ['!', '(', '+', '-', '[', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{126, -1}: "expected primary expression factor or one of ['!', '(', '+', '-', '[', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{127, -1}: "expected primary expression factor or one of ['!', '(', '+', '-', '[', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{128, -1}: "expected primary expression factor or one of ['!', '(', '+', '-', '[', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{129, -1}: "expected primary expression factor or one of ['!', '(', '+', '-', '[', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{130, -1}: "expected primary expression factor or one of ['!', '(', '+', '-', '[', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{131, -1}: "expected primary expression factor or one of ['!', '(', '+', '-', '[', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{188, -1}: "expected primary expression factor or one of ['!', '(', '+', '-', '[', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{252, -1}: "expected primary expression factor or one of ['!', '(', '+', '-', '[', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{307, -1}: "expected primary expression factor or one of ['!', '(', '+', '-', '[', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{44, -1}:  "expected primary expression or one of ['(', '[', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{45, -1}:  "expected primary expression or one of ['(', '[', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{46, -1}:  "expected primary expression or one of ['(', '[', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{47, -1}:  "expected primary expression or one of ['(', '[', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{136, -1}: "expected primary expression term or one of ['!', '(', '+', '-', '[', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{137, -1}: "expected primary expression term or one of ['!', '(', '+', '-', '[', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{138, -1}: "expected primary expression term or one of ['!', '(', '+', '-', '[', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{139, -1}: "expected primary expression term or one of ['!', '(', '+', '-', '[', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{380, -1}: "expected record set hint or one of [$end, ')', ',', ';', FULL, GROUP, LEFT, LIMIT, OFFSET, ON, ORDER, RIGHT, WHERE, identifier]",
+		{293, -1}: "expected record set optional AS clause or one of [$end, ')', ',', ';', AS, FULL, GROUP, LEFT, LIMIT, OFFSET, ON, ORDER, RIGHT, WHERE, identifier]",
+		{340, -1}: "expected record set optional hint list or one of [$end, ')', ',', ';', FULL, GROUP, LEFT, LIMIT, OFFSET, ON, ORDER, RIGHT, WHERE, identifier]",
+		{339, -1}: "expected record set or one of [$end, '(', ')', ';', FULL, GROUP, LEFT, LIMIT, OFFSET, ORDER, RIGHT, WHERE, identifier]",
+		{377, -1}: "expected record set or one of ['(', identifier]",
+		{249, -1}: "expected semiOpt or one of [')', ';']",
+		{306, -1}: "expected semiOpt or one of [')', ';']",
+		{343, -1}: "expected semiOpt or one of [')', ';']",
+		{28, -1}:  "expected statement or one of [$end, ';', ALTER, BEGIN, COMMIT, CREATE, DELETE, DROP, EXPLAIN, INSERT, ROLLBACK, SELECT, TRUNCATE, UPDATE]",
+		{34, -1}:  "expected statement or one of [$end, ';', ALTER, BEGIN, COMMIT, CREATE, DELETE, DROP, EXPLAIN, INSERT, ROLLBACK, SELECT, TRUNCATE, UPDATE]",
+		{255, -1}: "expected string literal",
+		{223, -1}: "expected table column definition or identifier",
+		{229, -1}: "expected table column definition or identifier",
+		{367, -1}: "expected table column definition or identifier",
+		{366, -1}: "expected table column definition or one of [')', identifier]",
+		{33, -1}:  "expected table name or identifier",
+		{93, -1}:  "expected table name or identifier",
+		{99, -1}:  "expected table name or identifier",
+		{104, -1}: "expected table name or identifier",
+		{107, -1}: "expected table name or identifier",
+		{235, -1}: "expected table name or identifier",
+		{274, -1}: "expected table name or identifier",
+		{97, -1}:  "expected table name or one of [IF, identifier]",
+		{101, -1}: "expected table name or one of [IF, identifier]",
+		{267, -1}: "expected type or one of ['[', bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, float, float32, float64, identifier, int, int16, int32, int64, int8, json, rune, string, time, uint, uint16, uint32, uint64, uint8, uuid]",
+		{160, -1}: "expected type or one of [bigint, bigrat, blob, bool, byte, complex128, complex64, duration, float, float32, float64, int, int16, int32, int64, int8, json, rune, string, time, uint, uint16, uint32, uint64, uint8, uuid]",
+		{356, -1}: "expected type or one of [bigint, bigrat, blob, bool, byte, complex128, complex64, duration, float, float32, float64, int, int16, int32, int64, int8, json, rune, string, time, uint, uint16, uint32, uint64, uint8, uuid]",
+		{140, -1}: "expected unary expression or one of ['!', '(', '+', '-', '[', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{141, -1}: "expected unary expression or one of ['!', '(', '+', '-', '[', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{142, -1}: "expected unary expression or one of ['!', '(', '+', '-', '[', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{143, -1}: "expected unary expression or one of ['!', '(', '+', '-', '[', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{144, -1}: "expected unary expression or one of ['!', '(', '+', '-', '[', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{145, -1}: "expected unary expression or one of ['!', '(', '+', '-', '[', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
+		{146, -1}: "expected unary expression or one of ['!', '(', '+', '-', '[', '^', NULL, QL parameter, bigint, bigrat, blob, bool, byte, complex128, complex64, decimal, duration, false, float, float32, float64, floating-point literal, identifier, imaginary literal, int, int16, int32, int64, int8, integer literal, interval literal, json, rune, string, string literal, time, true, uint, uint16, uint32, uint64, uint8, uuid]",
 	}
 
-	yyParseTab = [371][]uint16{
+	yyParseTab = [473][]uint16{
 		// 0
-		{178, 178, 96: 235, 98: 248, 106: 231, 115: 226, 237, 227, 238, 228, 239, 229, 240, 241, 242, 230, 243, 244, 236, 232, 245, 233, 246, 135: 234, 247, 138: 251, 252, 249, 253, 250, 180: 225, 192: 223, 224},
-		{1: 222},
-		{591, 221},
-		{3: 315, 314, 312, 7: 280, 286, 15: 271, 288, 289, 290, 291, 292, 293, 294, 295, 297, 298, 296, 28: 300, 301, 302, 303, 299, 35: 304, 305, 306, 308, 309, 310, 311, 307, 270, 273, 274, 275, 278, 276, 272, 53: 313, 74: 265, 282, 277, 281, 283, 279, 81: 285, 88: 284, 269, 91: 287, 268, 266, 590},
-		{113: 583},
+		{204, 204, 109: 296, 112: 284, 120: 292, 133: 287, 271, 288, 272, 289, 273, 290, 274, 275, 276, 277, 291, 278, 279, 280, 270, 293, 281, 294, 282, 155: 295, 283, 158: 269, 297, 285, 298, 286, 205: 268, 219: 266, 267},
+		{1: 265},
+		{299, 264},
+		{4: 330, 312, 311, 309, 318, 331, 16: 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 43: 323, 329, 321, 47: 327, 50: 322, 325, 326, 328, 316, 324, 57: 310, 70: 320, 85: 319, 314, 315, 313, 308, 317, 307, 94: 306, 101: 305, 303, 304, 302, 301, 124: 300},
+		{49, 49},
 		// 5
-		{194: 582},
-		{203, 203},
-		{111: 194, 113: 542, 161: 540, 195: 541},
-		{54: 537},
-		{111: 527, 113: 528},
-		// 10
-		{178, 178, 96: 235, 98: 248, 106: 231, 115: 226, 237, 227, 238, 228, 239, 229, 240, 241, 242, 230, 243, 244, 236, 232, 245, 233, 246, 135: 234, 247, 138: 526, 252, 249, 253, 250},
-		{173: 509},
-		{93, 93},
-		{3: 77, 77, 77, 7: 77, 77, 15: 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 28: 77, 77, 77, 77, 77, 35: 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 53: 77, 80: 77, 164: 444, 183: 443},
+		{66, 66},
+		{65, 65},
+		{64, 64},
+		{63, 63},
 		{62, 62},
-		// 15
+		// 10
 		{61, 61},
 		{60, 60},
 		{59, 59},
 		{58, 58},
 		{57, 57},
-		// 20
+		// 15
 		{56, 56},
 		{55, 55},
 		{54, 54},
 		{53, 53},
 		{52, 52},
-		// 25
+		// 20
 		{51, 51},
 		{50, 50},
-		{49, 49},
-		{48, 48},
-		{47, 47},
+		{132: 358},
+		{221: 359},
+		{236, 236},
+		// 25
+		{4: 361, 118: 223, 132: 362, 182: 360, 222: 363},
+		{60: 364},
+		{4: 367, 118: 365, 132: 366},
+		{204, 204, 109: 296, 112: 284, 120: 292, 133: 287, 271, 288, 272, 289, 273, 290, 274, 275, 276, 277, 291, 278, 279, 280, 270, 293, 281, 294, 282, 155: 295, 283, 158: 368, 297, 285, 298, 286},
+		{197: 369},
 		// 30
-		{113: 441},
-		{8: 254, 99: 255},
-		{45, 45, 7: 45, 45, 12: 45, 96: 45, 106: 45, 114: 45, 144: 45, 151: 45},
-		{8: 4, 151: 257, 191: 256},
-		{8: 260, 97: 258, 145: 259, 153: 261},
+		{97, 97},
+		{4: 81, 81, 81, 81, 81, 81, 16: 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 43: 81, 81, 81, 47: 81, 50: 81, 81, 81, 81, 81, 81, 57: 81, 92: 81, 184: 371, 210: 370},
+		{132: 372},
+		{4: 374, 113: 373},
+		{204, 204, 109: 296, 112: 284, 120: 292, 133: 287, 271, 288, 272, 289, 273, 290, 274, 275, 276, 277, 291, 278, 279, 280, 270, 293, 281, 294, 282, 155: 295, 283, 158: 375, 297, 285, 298, 286},
 		// 35
-		{8: 3},
-		{63: 439},
-		{215, 215, 6: 215, 12: 215, 154: 435},
-		{207, 207, 207, 6: 207, 9: 207, 207, 207, 16: 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 28: 207, 207, 207, 207, 207, 35: 207, 207, 207, 207, 207, 207, 207, 207, 63: 207},
-		{14, 14, 12: 264, 143: 263, 196: 262},
+		{1: 263},
+		{250, 250, 250, 250, 10: 250, 250, 42: 378, 58: 380, 379, 61: 250, 250, 107: 377, 168: 376},
+		{198, 198, 198, 198, 10: 198, 198, 198, 198, 198, 198, 42: 198, 46: 198, 48: 198, 198, 58: 198, 198, 198, 198, 198, 198, 198, 198, 198, 383, 382, 172: 381},
+		{46, 46, 46, 46, 10: 46, 46, 46, 46, 46, 46, 42: 46, 46: 46, 48: 46, 46, 58: 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46},
+		{187, 187, 187, 187, 10: 187, 187, 187, 187, 187, 187, 42: 187, 46: 187, 48: 187, 187, 56: 385, 58: 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 398, 72: 386, 384, 391, 389, 397, 388, 400, 387, 390, 399, 395, 392, 396, 187: 393, 201: 394},
 		// 40
-		{15, 15},
-		{13, 13},
-		{3: 315, 314, 312, 7: 280, 286, 15: 271, 288, 289, 290, 291, 292, 293, 294, 295, 297, 298, 296, 28: 300, 301, 302, 303, 299, 35: 304, 305, 306, 308, 309, 310, 311, 307, 270, 273, 274, 275, 278, 276, 272, 317, 53: 313, 74: 265, 282, 277, 281, 283, 279, 81: 285, 88: 284, 269, 91: 287, 268, 266, 267, 103: 316},
-		{7: 432},
-		{176, 176, 176, 6: 176, 9: 176, 176, 176, 176, 176, 176, 27: 176, 33: 176, 176, 51: 176, 176, 54: 176, 176, 176, 176, 176, 176, 342, 341, 150: 340},
+		{178, 178, 178, 178, 5: 404, 403, 401, 10: 178, 178, 178, 178, 178, 178, 42: 178, 46: 178, 48: 178, 178, 56: 178, 58: 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 71: 402, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178},
+		{128, 128, 128, 128, 5: 128, 128, 128, 10: 128, 128, 128, 128, 128, 128, 42: 128, 46: 128, 48: 128, 128, 56: 128, 58: 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 71: 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 92: 411, 128, 95: 409, 406, 410, 405, 407, 408},
+		{123, 123, 123, 123, 5: 123, 123, 123, 10: 123, 123, 123, 123, 123, 123, 42: 123, 46: 123, 48: 123, 123, 56: 123, 58: 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 71: 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 92: 123, 123, 95: 123, 123, 123, 123, 123, 123},
+		{12, 12, 12, 12, 5: 12, 12, 12, 416, 415, 12, 12, 12, 12, 12, 12, 42: 12, 46: 12, 48: 12, 12, 56: 12, 58: 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 71: 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 92: 12, 12, 95: 12, 12, 12, 12, 12, 12, 119: 414, 121: 412, 413},
+		{4: 330, 8: 318, 331, 16: 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 43: 323, 329, 321, 47: 327, 50: 322, 325, 326, 328, 316, 324, 70: 320, 85: 319, 314, 315, 313, 417, 317},
 		// 45
-		{7, 7, 7, 9: 7, 7, 7, 14: 7, 51: 337, 336, 95: 335},
-		{167, 167, 167, 6: 167, 9: 167, 167, 167, 167, 167, 167, 27: 167, 33: 167, 167, 50: 391, 167, 167, 54: 167, 167, 167, 167, 167, 167, 167, 167, 63: 389, 392, 390, 397, 395, 388, 394, 393, 396, 400, 398, 166: 399},
-		{158, 158, 158, 383, 382, 380, 158, 9: 158, 158, 158, 158, 158, 158, 27: 158, 33: 158, 158, 50: 158, 158, 158, 54: 158, 158, 158, 158, 158, 158, 158, 158, 381, 158, 158, 158, 158, 158, 158, 158, 158, 158, 158, 158},
-		{137, 137, 137, 137, 137, 137, 137, 137, 9: 137, 137, 137, 137, 137, 137, 27: 137, 33: 137, 137, 50: 137, 137, 137, 54: 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 80: 137, 82: 137, 137, 137, 137, 137, 137, 90: 137},
-		{136, 136, 136, 136, 136, 136, 136, 136, 9: 136, 136, 136, 136, 136, 136, 27: 136, 33: 136, 136, 50: 136, 136, 136, 54: 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 80: 136, 82: 136, 136, 136, 136, 136, 136, 90: 136},
+		{4: 330, 8: 318, 331, 16: 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 43: 323, 329, 321, 47: 327, 50: 322, 325, 326, 328, 316, 324, 70: 320, 85: 319, 314, 315, 313, 418, 317},
+		{4: 330, 8: 318, 331, 16: 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 43: 323, 329, 321, 47: 327, 50: 322, 325, 326, 328, 316, 324, 70: 320, 85: 319, 314, 315, 313, 419, 317},
+		{4: 330, 8: 318, 331, 16: 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 43: 323, 329, 321, 47: 327, 50: 322, 325, 326, 328, 316, 324, 70: 320, 85: 319, 314, 315, 313, 420, 317},
+		{133, 133, 133, 133, 5: 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 42: 133, 46: 133, 48: 133, 133, 56: 133, 58: 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 71: 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 92: 133, 133, 95: 133, 133, 133, 133, 133, 133},
+		{132, 132, 132, 132, 5: 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 42: 132, 46: 132, 48: 132, 132, 56: 132, 58: 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 71: 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 92: 132, 132, 95: 132, 132, 132, 132, 132, 132},
 		// 50
-		{135, 135, 135, 135, 135, 135, 135, 135, 9: 135, 135, 135, 135, 135, 135, 27: 135, 33: 135, 135, 50: 135, 135, 135, 54: 135, 135, 135, 135, 135, 135, 135, 135, 135, 135, 135, 135, 135, 135, 135, 135, 135, 135, 135, 135, 80: 135, 82: 135, 135, 135, 135, 135, 135, 90: 135},
-		{134, 134, 134, 134, 134, 134, 134, 134, 9: 134, 134, 134, 134, 134, 134, 27: 134, 33: 134, 134, 50: 134, 134, 134, 54: 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 80: 134, 82: 134, 134, 134, 134, 134, 134, 90: 134},
-		{133, 133, 133, 133, 133, 133, 133, 133, 9: 133, 133, 133, 133, 133, 133, 27: 133, 33: 133, 133, 50: 133, 133, 133, 54: 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 80: 133, 82: 133, 133, 133, 133, 133, 133, 90: 133},
-		{132, 132, 132, 132, 132, 132, 132, 132, 9: 132, 132, 132, 132, 132, 132, 27: 132, 33: 132, 132, 50: 132, 132, 132, 54: 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 80: 132, 82: 132, 132, 132, 132, 132, 132, 90: 132},
-		{131, 131, 131, 131, 131, 131, 131, 131, 9: 131, 131, 131, 131, 131, 131, 27: 131, 33: 131, 131, 50: 131, 131, 131, 54: 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 80: 131, 82: 131, 131, 131, 131, 131, 131, 90: 131},
+		{142, 142, 142, 142, 5: 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 42: 142, 46: 142, 48: 142, 142, 56: 142, 58: 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 71: 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 92: 142, 142, 95: 142, 142, 142, 142, 142, 142},
+		{141, 141, 141, 141, 5: 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 42: 141, 46: 141, 48: 141, 141, 56: 141, 58: 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 71: 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 92: 141, 141, 95: 141, 141, 141, 141, 141, 141},
+		{140, 140, 140, 140, 5: 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 42: 140, 46: 140, 48: 140, 140, 56: 140, 58: 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 71: 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 92: 140, 140, 95: 140, 140, 140, 140, 140, 140},
+		{4: 330, 312, 311, 309, 318, 331, 16: 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 43: 323, 329, 321, 47: 327, 50: 322, 325, 326, 328, 316, 324, 57: 310, 70: 320, 85: 319, 314, 315, 313, 308, 317, 307, 94: 306, 101: 305, 303, 304, 302, 421},
+		{138, 138, 138, 138, 5: 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 42: 138, 46: 138, 48: 138, 138, 56: 138, 58: 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 71: 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 92: 138, 138, 95: 138, 138, 138, 138, 138, 138},
 		// 55
-		{130, 130, 130, 130, 130, 130, 130, 130, 9: 130, 130, 130, 130, 130, 130, 27: 130, 33: 130, 130, 50: 130, 130, 130, 54: 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 80: 130, 82: 130, 130, 130, 130, 130, 130, 90: 130},
-		{129, 129, 129, 129, 129, 129, 129, 129, 9: 129, 129, 129, 129, 129, 129, 27: 129, 33: 129, 129, 50: 129, 129, 129, 54: 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 80: 129, 82: 129, 129, 129, 129, 129, 129, 90: 129},
-		{128, 128, 128, 128, 128, 128, 128, 128, 9: 128, 128, 128, 128, 128, 128, 27: 128, 33: 128, 128, 50: 128, 128, 128, 54: 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 80: 128, 82: 128, 128, 128, 128, 128, 128, 90: 128},
-		{3: 315, 314, 312, 7: 280, 286, 15: 271, 288, 289, 290, 291, 292, 293, 294, 295, 297, 298, 296, 28: 300, 301, 302, 303, 299, 35: 304, 305, 306, 308, 309, 310, 311, 307, 270, 273, 274, 275, 278, 276, 272, 53: 313, 74: 265, 282, 277, 281, 283, 279, 81: 285, 88: 284, 269, 91: 287, 268, 266, 378},
-		{122, 122, 122, 122, 122, 122, 122, 122, 9: 122, 122, 122, 122, 122, 122, 27: 122, 33: 122, 122, 50: 122, 122, 122, 54: 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 80: 122, 82: 122, 122, 122, 122, 122, 122, 90: 122},
+		{8: 422},
+		{8: 423},
+		{150, 150, 150, 150, 5: 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 42: 150, 46: 150, 48: 150, 150, 56: 150, 58: 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 71: 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 92: 150, 150, 95: 150, 150, 150, 150, 150, 150},
+		{149, 149, 149, 149, 5: 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 42: 149, 46: 149, 48: 149, 149, 56: 149, 58: 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 71: 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 92: 149, 149, 95: 149, 149, 149, 149, 149, 149},
+		{148, 148, 148, 148, 5: 148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 42: 148, 46: 148, 48: 148, 148, 56: 148, 58: 148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 71: 148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 92: 148, 148, 95: 148, 148, 148, 148, 148, 148},
 		// 60
-		{121, 121, 121, 121, 121, 121, 121, 121, 9: 121, 121, 121, 121, 121, 121, 27: 121, 33: 121, 121, 50: 121, 121, 121, 54: 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 80: 121, 82: 121, 121, 121, 121, 121, 121, 90: 121},
-		{12, 12, 12, 12, 12, 12, 12, 326, 9: 12, 12, 12, 12, 12, 12, 27: 12, 33: 12, 12, 50: 12, 12, 12, 54: 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 80: 12, 82: 12, 12, 12, 12, 12, 12, 90: 327, 105: 330, 107: 328, 329},
-		{117, 117, 117, 117, 117, 117, 117, 9: 117, 117, 117, 117, 117, 117, 27: 117, 33: 117, 117, 50: 117, 117, 117, 54: 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 80: 370, 82: 368, 365, 369, 364, 366, 367},
-		{112, 112, 112, 112, 112, 112, 112, 9: 112, 112, 112, 112, 112, 112, 27: 112, 33: 112, 112, 50: 112, 112, 112, 54: 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 80: 112, 82: 112, 112, 112, 112, 112, 112},
-		{104, 104, 104, 104, 104, 104, 104, 104, 9: 104, 104, 104, 104, 104, 104, 27: 104, 33: 104, 104, 50: 104, 104, 104, 54: 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 80: 104, 82: 104, 104, 104, 104, 104, 104, 90: 104, 152: 362},
+		{147, 147, 147, 147, 5: 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 42: 147, 46: 147, 48: 147, 147, 56: 147, 58: 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 71: 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 92: 147, 147, 95: 147, 147, 147, 147, 147, 147},
+		{146, 146, 146, 146, 5: 146, 146, 146, 146, 146, 146, 146, 146, 146, 146, 146, 42: 146, 46: 146, 48: 146, 146, 56: 146, 58: 146, 146, 146, 146, 146, 146, 146, 146, 146, 146, 146, 146, 71: 146, 146, 146, 146, 146, 146, 146, 146, 146, 146, 146, 146, 146, 146, 92: 146, 146, 95: 146, 146, 146, 146, 146, 146},
+		{145, 145, 145, 145, 5: 145, 145, 145, 145, 145, 145, 145, 145, 145, 145, 145, 42: 145, 46: 145, 48: 145, 145, 56: 145, 58: 145, 145, 145, 145, 145, 145, 145, 145, 145, 145, 145, 145, 71: 145, 145, 145, 145, 145, 145, 145, 145, 145, 145, 145, 145, 145, 145, 92: 145, 145, 95: 145, 145, 145, 145, 145, 145},
+		{144, 144, 144, 144, 5: 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 42: 144, 46: 144, 48: 144, 144, 56: 144, 58: 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 71: 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 92: 144, 144, 95: 144, 144, 144, 144, 144, 144},
+		{143, 143, 143, 143, 5: 143, 143, 143, 143, 143, 143, 143, 143, 143, 143, 143, 42: 143, 46: 143, 48: 143, 143, 56: 143, 58: 143, 143, 143, 143, 143, 143, 143, 143, 143, 143, 143, 143, 71: 143, 143, 143, 143, 143, 143, 143, 143, 143, 143, 143, 143, 143, 143, 92: 143, 143, 95: 143, 143, 143, 143, 143, 143},
 		// 65
-		{44, 44, 44, 6: 44, 9: 44, 44, 44, 44, 44, 44, 27: 44, 33: 44, 44, 51: 44, 44, 54: 44, 44, 44, 44, 44, 44, 44, 44},
-		{39, 39, 39, 39, 39, 39, 39, 39, 39, 13: 39, 15: 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 28: 39, 39, 39, 39, 39, 35: 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 53: 39},
-		{38, 38, 38, 38, 38, 38, 38, 38, 38, 13: 38, 15: 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 28: 38, 38, 38, 38, 38, 35: 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 53: 38},
-		{37, 37, 37, 37, 37, 37, 37, 37, 37, 13: 37, 15: 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 28: 37, 37, 37, 37, 37, 35: 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 53: 37},
-		{36, 36, 36, 36, 36, 36, 36, 36, 36, 13: 36, 15: 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 28: 36, 36, 36, 36, 36, 35: 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 53: 36},
+		{115, 115, 115, 115, 5: 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 42: 115, 46: 115, 48: 115, 115, 56: 115, 58: 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 71: 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 92: 115, 115, 95: 115, 115, 115, 115, 115, 115, 175: 424},
+		{64: 425},
+		{41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 13: 41, 16: 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 47: 41, 50: 41, 41, 41, 41, 41, 41, 41, 41, 106: 41},
+		{40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 13: 40, 16: 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 47: 40, 50: 40, 40, 40, 40, 40, 40, 40, 40, 106: 40},
+		{39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 13: 39, 16: 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 47: 39, 50: 39, 39, 39, 39, 39, 39, 39, 39, 106: 39},
 		// 70
-		{35, 35, 35, 35, 35, 35, 35, 35, 35, 13: 35, 15: 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 28: 35, 35, 35, 35, 35, 35: 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 53: 35},
-		{34, 34, 34, 34, 34, 34, 34, 34, 34, 13: 34, 15: 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 28: 34, 34, 34, 34, 34, 35: 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 53: 34},
-		{33, 33, 33, 33, 33, 33, 33, 33, 33, 13: 33, 15: 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 28: 33, 33, 33, 33, 33, 35: 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 53: 33},
-		{32, 32, 32, 32, 32, 32, 32, 32, 32, 13: 32, 15: 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 28: 32, 32, 32, 32, 32, 35: 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 53: 32},
-		{31, 31, 31, 31, 31, 31, 31, 31, 31, 13: 31, 15: 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 28: 31, 31, 31, 31, 31, 35: 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 53: 31},
+		{38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 13: 38, 16: 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 47: 38, 50: 38, 38, 38, 38, 38, 38, 38, 38, 106: 38},
+		{37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 13: 37, 16: 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 47: 37, 50: 37, 37, 37, 37, 37, 37, 37, 37, 106: 37},
+		{36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 13: 36, 16: 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 47: 36, 50: 36, 36, 36, 36, 36, 36, 36, 36, 106: 36},
+		{35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 13: 35, 16: 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 47: 35, 50: 35, 35, 35, 35, 35, 35, 35, 35, 106: 35},
+		{34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 13: 34, 16: 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 47: 34, 50: 34, 34, 34, 34, 34, 34, 34, 34, 106: 34},
 		// 75
-		{30, 30, 30, 30, 30, 30, 30, 30, 30, 13: 30, 15: 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 28: 30, 30, 30, 30, 30, 35: 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 53: 30},
-		{29, 29, 29, 29, 29, 29, 29, 29, 29, 13: 29, 15: 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 28: 29, 29, 29, 29, 29, 35: 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 53: 29},
-		{28, 28, 28, 28, 28, 28, 28, 28, 28, 13: 28, 15: 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28: 28, 28, 28, 28, 28, 35: 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 53: 28},
-		{27, 27, 27, 27, 27, 27, 27, 27, 27, 13: 27, 15: 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 28: 27, 27, 27, 27, 27, 35: 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 53: 27},
-		{26, 26, 26, 26, 26, 26, 26, 26, 26, 13: 26, 15: 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 28: 26, 26, 26, 26, 26, 35: 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 53: 26},
+		{33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 13: 33, 16: 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 47: 33, 50: 33, 33, 33, 33, 33, 33, 33, 33, 106: 33},
+		{32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 13: 32, 16: 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 47: 32, 50: 32, 32, 32, 32, 32, 32, 32, 32, 106: 32},
+		{31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 13: 31, 16: 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 47: 31, 50: 31, 31, 31, 31, 31, 31, 31, 31, 106: 31},
+		{30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 13: 30, 16: 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 47: 30, 50: 30, 30, 30, 30, 30, 30, 30, 30, 106: 30},
+		{29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 13: 29, 16: 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 47: 29, 50: 29, 29, 29, 29, 29, 29, 29, 29, 106: 29},
 		// 80
-		{25, 25, 25, 25, 25, 25, 25, 25, 25, 13: 25, 15: 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 28: 25, 25, 25, 25, 25, 35: 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 53: 25},
-		{24, 24, 24, 24, 24, 24, 24, 24, 24, 13: 24, 15: 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 28: 24, 24, 24, 24, 24, 35: 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 53: 24},
-		{23, 23, 23, 23, 23, 23, 23, 23, 23, 13: 23, 15: 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 28: 23, 23, 23, 23, 23, 35: 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 53: 23},
-		{22, 22, 22, 22, 22, 22, 22, 22, 22, 13: 22, 15: 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 28: 22, 22, 22, 22, 22, 35: 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 53: 22},
-		{21, 21, 21, 21, 21, 21, 21, 21, 21, 13: 21, 15: 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 28: 21, 21, 21, 21, 21, 35: 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 53: 21},
+		{28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 13: 28, 16: 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 47: 28, 50: 28, 28, 28, 28, 28, 28, 28, 28, 106: 28},
+		{27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 13: 27, 16: 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 47: 27, 50: 27, 27, 27, 27, 27, 27, 27, 27, 106: 27},
+		{26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 13: 26, 16: 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 47: 26, 50: 26, 26, 26, 26, 26, 26, 26, 26, 106: 26},
+		{25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 13: 25, 16: 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 47: 25, 50: 25, 25, 25, 25, 25, 25, 25, 25, 106: 25},
+		{24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 13: 24, 16: 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 47: 24, 50: 24, 24, 24, 24, 24, 24, 24, 24, 106: 24},
 		// 85
-		{20, 20, 20, 20, 20, 20, 20, 20, 20, 13: 20, 15: 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 28: 20, 20, 20, 20, 20, 35: 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 53: 20},
-		{19, 19, 19, 19, 19, 19, 19, 19, 19, 13: 19, 15: 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 28: 19, 19, 19, 19, 19, 35: 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 53: 19},
-		{18, 18, 18, 18, 18, 18, 18, 18, 18, 13: 18, 15: 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 28: 18, 18, 18, 18, 18, 35: 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 53: 18},
-		{17, 17, 17, 17, 17, 17, 17, 17, 17, 13: 17, 15: 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 28: 17, 17, 17, 17, 17, 35: 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 53: 17},
-		{16, 16, 16, 16, 16, 16, 16, 16, 16, 13: 16, 15: 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 28: 16, 16, 16, 16, 16, 35: 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 53: 16},
+		{23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 13: 23, 16: 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 47: 23, 50: 23, 23, 23, 23, 23, 23, 23, 23, 106: 23},
+		{22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 13: 22, 16: 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 47: 22, 50: 22, 22, 22, 22, 22, 22, 22, 22, 106: 22},
+		{21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 13: 21, 16: 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 47: 21, 50: 21, 21, 21, 21, 21, 21, 21, 21, 106: 21},
+		{20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 13: 20, 16: 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 47: 20, 50: 20, 20, 20, 20, 20, 20, 20, 20, 106: 20},
+		{19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 13: 19, 16: 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 47: 19, 50: 19, 19, 19, 19, 19, 19, 19, 19, 106: 19},
 		// 90
-		{7: 280, 286, 15: 271, 288, 289, 290, 291, 292, 293, 294, 295, 297, 298, 296, 28: 300, 301, 302, 303, 299, 35: 304, 305, 306, 308, 309, 310, 311, 307, 270, 273, 274, 275, 278, 276, 272, 74: 265, 282, 277, 281, 361, 279},
-		{7: 280, 286, 15: 271, 288, 289, 290, 291, 292, 293, 294, 295, 297, 298, 296, 28: 300, 301, 302, 303, 299, 35: 304, 305, 306, 308, 309, 310, 311, 307, 270, 273, 274, 275, 278, 276, 272, 74: 265, 282, 277, 281, 360, 279},
-		{7: 280, 286, 15: 271, 288, 289, 290, 291, 292, 293, 294, 295, 297, 298, 296, 28: 300, 301, 302, 303, 299, 35: 304, 305, 306, 308, 309, 310, 311, 307, 270, 273, 274, 275, 278, 276, 272, 74: 265, 282, 277, 281, 359, 279},
-		{7: 280, 286, 15: 271, 288, 289, 290, 291, 292, 293, 294, 295, 297, 298, 296, 28: 300, 301, 302, 303, 299, 35: 304, 305, 306, 308, 309, 310, 311, 307, 270, 273, 274, 275, 278, 276, 272, 74: 265, 282, 277, 281, 325, 279},
-		{7: 322},
+		{18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 13: 18, 16: 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 47: 18, 50: 18, 18, 18, 18, 18, 18, 18, 18, 106: 18},
+		{17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 13: 17, 16: 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 47: 17, 50: 17, 17, 17, 17, 17, 17, 17, 17, 106: 17},
+		{16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 13: 16, 16: 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 47: 16, 50: 16, 16, 16, 16, 16, 16, 16, 16, 106: 16},
+		{4: 374, 113: 426},
+		{255, 255},
 		// 95
-		{103: 318},
-		{7: 319},
-		{96: 235, 98: 320},
-		{2: 321},
-		{5, 5, 5, 9: 5, 5, 5, 14: 5},
+		{118: 427},
+		{4: 429, 118: 428},
+		{4: 374, 113: 430, 117: 431},
+		{118: 222},
+		{4: 374, 113: 432},
 		// 100
-		{96: 235, 98: 323},
-		{2: 324},
-		{6, 6, 6, 9: 6, 6, 6, 14: 6},
-		{8, 8, 8, 8, 8, 8, 8, 326, 9: 8, 8, 8, 8, 8, 8, 27: 8, 33: 8, 8, 50: 8, 8, 8, 54: 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 80: 8, 82: 8, 8, 8, 8, 8, 8, 90: 327, 105: 330, 107: 328, 329},
-		{2: 210, 315, 314, 312, 7: 280, 286, 15: 271, 288, 289, 290, 291, 292, 293, 294, 295, 297, 298, 296, 28: 300, 301, 302, 303, 299, 35: 304, 305, 306, 308, 309, 310, 311, 307, 270, 273, 274, 275, 278, 276, 272, 53: 313, 74: 265, 282, 277, 281, 283, 279, 350, 285, 88: 284, 269, 91: 287, 268, 266, 352, 101: 351, 155: 349},
+		{4: 210, 117: 434, 185: 433},
+		{4: 374, 113: 435, 117: 436},
+		{4: 437, 117: 438},
+		{199, 199},
+		{4: 374, 113: 439},
 		// 105
-		{3: 315, 314, 312, 7: 280, 286, 15: 271, 288, 289, 290, 291, 292, 293, 294, 295, 297, 298, 296, 28: 300, 301, 302, 303, 299, 35: 304, 305, 306, 308, 309, 310, 311, 307, 270, 273, 274, 275, 278, 276, 272, 53: 313, 59: 332, 74: 265, 282, 277, 281, 283, 279, 81: 285, 88: 284, 269, 91: 287, 268, 266, 331},
-		{120, 120, 120, 120, 120, 120, 120, 120, 9: 120, 120, 120, 120, 120, 120, 27: 120, 33: 120, 120, 50: 120, 120, 120, 54: 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 80: 120, 82: 120, 120, 120, 120, 120, 120, 90: 120},
-		{119, 119, 119, 119, 119, 119, 119, 119, 9: 119, 119, 119, 119, 119, 119, 27: 119, 33: 119, 119, 50: 119, 119, 119, 54: 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 80: 119, 82: 119, 119, 119, 119, 119, 119, 90: 119},
-		{118, 118, 118, 118, 118, 118, 118, 118, 9: 118, 118, 118, 118, 118, 118, 27: 118, 33: 118, 118, 50: 118, 118, 118, 54: 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 80: 118, 82: 118, 118, 118, 118, 118, 118, 90: 118},
-		{51: 337, 336, 57: 344, 59: 345, 95: 335},
+		{4: 330, 312, 311, 309, 318, 331, 16: 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 43: 323, 329, 321, 47: 327, 50: 322, 325, 326, 328, 316, 324, 57: 310, 70: 320, 85: 319, 314, 315, 313, 308, 317, 307, 441, 94: 306, 101: 305, 303, 304, 302, 444, 171: 443, 192: 442, 211: 440},
+		{4: 80, 80, 80, 80, 80, 80, 16: 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 43: 80, 80, 80, 47: 80, 50: 80, 80, 80, 80, 80, 80, 57: 80, 92: 80},
+		{4: 374, 113: 445},
+		{4: 4, 174: 447, 218: 446},
+		{47, 47, 4: 47, 8: 47, 14: 47, 109: 47, 120: 47, 123: 47, 164: 47, 174: 47},
 		// 110
-		{3: 315, 314, 312, 7: 280, 286, 15: 271, 288, 289, 290, 291, 292, 293, 294, 295, 297, 298, 296, 28: 300, 301, 302, 303, 299, 35: 304, 305, 306, 308, 309, 310, 311, 307, 270, 273, 274, 275, 278, 276, 272, 53: 313, 57: 334, 74: 265, 282, 277, 281, 283, 279, 81: 285, 88: 284, 269, 91: 287, 268, 266, 333},
-		{51: 337, 336, 57: 338, 95: 335},
-		{66, 66, 66, 66, 66, 66, 66, 66, 9: 66, 66, 66, 66, 66, 66, 27: 66, 33: 66, 66, 50: 66, 66, 66, 54: 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 80: 66, 82: 66, 66, 66, 66, 66, 66, 90: 66},
-		{3: 315, 314, 312, 7: 280, 286, 15: 271, 288, 289, 290, 291, 292, 293, 294, 295, 297, 298, 296, 28: 300, 301, 302, 303, 299, 35: 304, 305, 306, 308, 309, 310, 311, 307, 270, 273, 274, 275, 278, 276, 272, 53: 313, 74: 265, 282, 277, 281, 283, 279, 81: 285, 88: 284, 269, 91: 287, 268, 339},
-		{3: 174, 174, 174, 7: 174, 174, 15: 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 28: 174, 174, 174, 174, 174, 35: 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 53: 174},
+		{48, 48},
+		{248, 248, 248, 248, 10: 248, 248, 61: 248, 248},
+		{4: 330, 312, 311, 309, 318, 331, 16: 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 43: 323, 329, 321, 47: 327, 50: 322, 325, 326, 328, 316, 324, 57: 310, 70: 320, 85: 319, 314, 315, 313, 308, 317, 307, 94: 306, 101: 305, 303, 304, 448},
+		{4: 449},
+		{4: 196, 196, 196, 196, 196, 196, 16: 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 43: 196, 196, 196, 47: 196, 50: 196, 196, 196, 196, 196, 196, 57: 196},
 		// 115
-		{3: 173, 173, 173, 7: 173, 173, 15: 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 28: 173, 173, 173, 173, 173, 35: 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 53: 173},
-		{65, 65, 65, 65, 65, 65, 65, 65, 9: 65, 65, 65, 65, 65, 65, 27: 65, 33: 65, 65, 50: 65, 65, 65, 54: 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 80: 65, 82: 65, 65, 65, 65, 65, 65, 90: 65},
-		{175, 175, 175, 6: 175, 9: 175, 175, 175, 175, 175, 175, 27: 175, 33: 175, 175, 51: 175, 175, 54: 175, 175, 175, 175, 175, 175, 342, 341, 150: 340},
-		{3: 315, 314, 312, 7: 280, 286, 15: 271, 288, 289, 290, 291, 292, 293, 294, 295, 297, 298, 296, 28: 300, 301, 302, 303, 299, 35: 304, 305, 306, 308, 309, 310, 311, 307, 270, 273, 274, 275, 278, 276, 272, 53: 313, 74: 265, 282, 277, 281, 283, 279, 81: 285, 88: 284, 269, 91: 343, 268},
-		{3: 42, 42, 42, 7: 42, 42, 15: 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 28: 42, 42, 42, 42, 42, 35: 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 53: 42},
+		{4: 195, 195, 195, 195, 195, 195, 16: 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 43: 195, 195, 195, 47: 195, 50: 195, 195, 195, 195, 195, 195, 57: 195},
+		{4: 330, 312, 311, 309, 318, 331, 16: 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 43: 323, 329, 321, 47: 327, 50: 322, 325, 326, 328, 316, 324, 57: 310, 70: 320, 85: 319, 314, 315, 313, 308, 317, 307, 94: 306, 101: 305, 450, 304},
+		{4: 44, 44, 44, 44, 44, 44, 16: 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 43: 44, 44, 44, 47: 44, 50: 44, 44, 44, 44, 44, 44, 57: 44},
+		{4: 43, 43, 43, 43, 43, 43, 16: 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43: 43, 43, 43, 47: 43, 50: 43, 43, 43, 43, 43, 43, 57: 43},
+		{8: 451},
 		// 120
-		{3: 41, 41, 41, 7: 41, 41, 15: 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 28: 41, 41, 41, 41, 41, 35: 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 53: 41},
-		{43, 43, 43, 6: 43, 9: 43, 43, 43, 43, 43, 43, 27: 43, 33: 43, 43, 51: 43, 43, 54: 43, 43, 43, 43, 43, 43, 43, 43},
-		{144, 144, 144, 144, 144, 144, 144, 144, 9: 144, 144, 144, 144, 144, 144, 27: 144, 33: 144, 144, 50: 144, 144, 144, 54: 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 80: 144, 82: 144, 144, 144, 144, 144, 144, 90: 144},
-		{3: 315, 314, 312, 7: 280, 286, 15: 271, 288, 289, 290, 291, 292, 293, 294, 295, 297, 298, 296, 28: 300, 301, 302, 303, 299, 35: 304, 305, 306, 308, 309, 310, 311, 307, 270, 273, 274, 275, 278, 276, 272, 53: 313, 57: 347, 74: 265, 282, 277, 281, 283, 279, 81: 285, 88: 284, 269, 91: 287, 268, 266, 346},
-		{51: 337, 336, 57: 348, 95: 335},
+		{72: 453, 452},
+		{4: 330, 312, 311, 309, 318, 331, 16: 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 43: 323, 329, 321, 47: 327, 50: 322, 325, 326, 328, 316, 324, 57: 310, 70: 320, 85: 319, 314, 315, 313, 308, 317, 307, 94: 306, 101: 454},
+		{43: 455, 56: 456},
+		{4: 330, 312, 311, 309, 318, 331, 16: 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 43: 323, 329, 321, 47: 327, 50: 322, 325, 326, 328, 316, 324, 57: 310, 70: 320, 85: 319, 314, 315, 313, 308, 317, 307, 94: 306, 101: 457},
+		{4: 330, 312, 311, 309, 318, 331, 16: 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 43: 323, 329, 321, 47: 327, 50: 322, 325, 326, 328, 316, 324, 57: 310, 70: 320, 85: 319, 314, 315, 313, 308, 317, 307, 94: 306, 101: 458},
 		// 125
-		{64, 64, 64, 64, 64, 64, 64, 64, 9: 64, 64, 64, 64, 64, 64, 27: 64, 33: 64, 64, 50: 64, 64, 64, 54: 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 80: 64, 82: 64, 64, 64, 64, 64, 64, 90: 64},
-		{63, 63, 63, 63, 63, 63, 63, 63, 9: 63, 63, 63, 63, 63, 63, 27: 63, 33: 63, 63, 50: 63, 63, 63, 54: 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 80: 63, 82: 63, 63, 63, 63, 63, 63, 90: 63},
-		{2: 358},
-		{2: 357},
-		{2: 209},
+		{4: 330, 312, 311, 309, 318, 331, 16: 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 43: 323, 329, 321, 47: 327, 50: 322, 325, 326, 328, 316, 324, 57: 310, 70: 320, 85: 319, 314, 315, 313, 308, 317, 307, 94: 306, 101: 459},
+		{4: 330, 312, 311, 309, 318, 331, 16: 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 43: 323, 329, 321, 47: 327, 50: 322, 325, 326, 328, 316, 324, 57: 310, 70: 320, 85: 319, 314, 315, 313, 308, 317, 307, 94: 306, 101: 460},
+		{4: 330, 312, 311, 309, 318, 331, 16: 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 43: 323, 329, 321, 47: 327, 50: 322, 325, 326, 328, 316, 324, 57: 310, 70: 320, 85: 319, 314, 315, 313, 308, 317, 307, 94: 306, 101: 461},
+		{4: 330, 312, 311, 309, 318, 331, 16: 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 43: 323, 329, 321, 47: 327, 50: 322, 325, 326, 328, 316, 324, 57: 310, 70: 320, 85: 319, 314, 315, 313, 308, 317, 307, 94: 306, 101: 462},
+		{4: 330, 312, 311, 309, 318, 331, 16: 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 43: 323, 329, 321, 47: 327, 50: 322, 325, 326, 328, 316, 324, 57: 310, 70: 320, 85: 319, 314, 315, 313, 308, 317, 307, 94: 306, 101: 463},
 		// 130
-		{169, 169, 169, 6: 169, 9: 169, 169, 51: 337, 336, 55: 169, 169, 95: 335, 167: 353},
-		{2, 2, 2, 6: 355, 9: 2, 2, 55: 2, 2, 100: 354},
-		{170, 170, 170, 9: 170, 170, 55: 170, 170},
-		{1, 1, 1, 315, 314, 312, 7: 280, 286, 1, 1, 15: 271, 288, 289, 290, 291, 292, 293, 294, 295, 297, 298, 296, 28: 300, 301, 302, 303, 299, 35: 304, 305, 306, 308, 309, 310, 311, 307, 270, 273, 274, 275, 278, 276, 272, 53: 313, 55: 1, 1, 74: 265, 282, 277, 281, 283, 279, 81: 285, 88: 284, 269, 91: 287, 268, 266, 356},
-		{168, 168, 168, 6: 168, 9: 168, 168, 51: 337, 336, 55: 168, 168, 95: 335},
+		{4: 330, 312, 311, 309, 318, 331, 16: 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 43: 323, 329, 321, 47: 327, 50: 322, 325, 326, 328, 316, 324, 57: 310, 70: 320, 85: 319, 314, 315, 313, 308, 317, 307, 94: 306, 101: 464},
+		{4: 330, 312, 311, 309, 318, 331, 16: 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 43: 323, 329, 321, 47: 327, 50: 322, 325, 326, 328, 316, 324, 57: 310, 70: 320, 85: 319, 314, 315, 313, 308, 317, 307, 94: 306, 101: 465},
+		{4: 194, 194, 194, 194, 194, 194, 16: 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 43: 194, 194, 194, 47: 194, 50: 194, 194, 194, 194, 194, 194, 57: 194},
+		{4: 193, 193, 193, 193, 193, 193, 16: 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 43: 193, 193, 193, 47: 193, 50: 193, 193, 193, 193, 193, 193, 57: 193},
+		{4: 152, 152, 152, 152, 152, 152, 16: 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 43: 152, 152, 152, 47: 152, 50: 152, 152, 152, 152, 152, 152, 57: 152},
 		// 135
-		{211, 211, 211, 211, 211, 211, 211, 211, 9: 211, 211, 211, 211, 211, 211, 27: 211, 33: 211, 211, 50: 211, 211, 211, 54: 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 80: 211, 82: 211, 211, 211, 211, 211, 211, 90: 211},
-		{212, 212, 212, 212, 212, 212, 212, 212, 9: 212, 212, 212, 212, 212, 212, 27: 212, 33: 212, 212, 50: 212, 212, 212, 54: 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 80: 212, 82: 212, 212, 212, 212, 212, 212, 90: 212},
-		{9, 9, 9, 9, 9, 9, 9, 326, 9: 9, 9, 9, 9, 9, 9, 27: 9, 33: 9, 9, 50: 9, 9, 9, 54: 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 80: 9, 82: 9, 9, 9, 9, 9, 9, 90: 327, 105: 330, 107: 328, 329},
-		{10, 10, 10, 10, 10, 10, 10, 326, 9: 10, 10, 10, 10, 10, 10, 27: 10, 33: 10, 10, 50: 10, 10, 10, 54: 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 80: 10, 82: 10, 10, 10, 10, 10, 10, 90: 327, 105: 330, 107: 328, 329},
-		{11, 11, 11, 11, 11, 11, 11, 326, 9: 11, 11, 11, 11, 11, 11, 27: 11, 33: 11, 11, 50: 11, 11, 11, 54: 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 80: 11, 82: 11, 11, 11, 11, 11, 11, 90: 327, 105: 330, 107: 328, 329},
+		{4: 151, 151, 151, 151, 151, 151, 16: 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 43: 151, 151, 151, 47: 151, 50: 151, 151, 151, 151, 151, 151, 57: 151},
+		{4: 330, 312, 311, 309, 318, 331, 16: 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 43: 323, 329, 321, 47: 327, 50: 322, 325, 326, 328, 316, 324, 57: 310, 70: 320, 85: 319, 314, 315, 313, 308, 317, 307, 94: 466},
+		{4: 330, 312, 311, 309, 318, 331, 16: 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 43: 323, 329, 321, 47: 327, 50: 322, 325, 326, 328, 316, 324, 57: 310, 70: 320, 85: 319, 314, 315, 313, 308, 317, 307, 94: 467},
+		{4: 330, 312, 311, 309, 318, 331, 16: 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 43: 323, 329, 321, 47: 327, 50: 322, 325, 326, 328, 316, 324, 57: 310, 70: 320, 85: 319, 314, 315, 313, 308, 317, 307, 94: 468},
+		{4: 330, 312, 311, 309, 318, 331, 16: 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 43: 323, 329, 321, 47: 327, 50: 322, 325, 326, 328, 316, 324, 57: 310, 70: 320, 85: 319, 314, 315, 313, 308, 317, 307, 94: 469},
 		// 140
-		{8: 363},
-		{103, 103, 103, 103, 103, 103, 103, 103, 9: 103, 103, 103, 103, 103, 103, 27: 103, 33: 103, 103, 50: 103, 103, 103, 54: 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 80: 103, 82: 103, 103, 103, 103, 103, 103, 90: 103},
-		{3: 315, 314, 312, 7: 280, 286, 15: 271, 288, 289, 290, 291, 292, 293, 294, 295, 297, 298, 296, 28: 300, 301, 302, 303, 299, 35: 304, 305, 306, 308, 309, 310, 311, 307, 270, 273, 274, 275, 278, 276, 272, 53: 313, 74: 265, 282, 277, 281, 283, 279, 81: 377},
-		{3: 315, 314, 312, 7: 280, 286, 15: 271, 288, 289, 290, 291, 292, 293, 294, 295, 297, 298, 296, 28: 300, 301, 302, 303, 299, 35: 304, 305, 306, 308, 309, 310, 311, 307, 270, 273, 274, 275, 278, 276, 272, 53: 313, 74: 265, 282, 277, 281, 283, 279, 81: 376},
-		{3: 315, 314, 312, 7: 280, 286, 15: 271, 288, 289, 290, 291, 292, 293, 294, 295, 297, 298, 296, 28: 300, 301, 302, 303, 299, 35: 304, 305, 306, 308, 309, 310, 311, 307, 270, 273, 274, 275, 278, 276, 272, 53: 313, 74: 265, 282, 277, 281, 283, 279, 81: 375},
+		{4: 330, 312, 311, 309, 318, 331, 16: 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 43: 323, 329, 321, 47: 327, 50: 322, 325, 326, 328, 316, 324, 57: 310, 70: 320, 85: 319, 314, 315, 313, 308, 317, 470},
+		{4: 330, 312, 311, 309, 318, 331, 16: 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 43: 323, 329, 321, 47: 327, 50: 322, 325, 326, 328, 316, 324, 57: 310, 70: 320, 85: 319, 314, 315, 313, 308, 317, 471},
+		{4: 330, 312, 311, 309, 318, 331, 16: 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 43: 323, 329, 321, 47: 327, 50: 322, 325, 326, 328, 316, 324, 57: 310, 70: 320, 85: 319, 314, 315, 313, 308, 317, 472},
+		{4: 330, 312, 311, 309, 318, 331, 16: 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 43: 323, 329, 321, 47: 327, 50: 322, 325, 326, 328, 316, 324, 57: 310, 70: 320, 85: 319, 314, 315, 313, 308, 317, 473},
+		{4: 330, 312, 311, 309, 318, 331, 16: 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 43: 323, 329, 321, 47: 327, 50: 322, 325, 326, 328, 316, 324, 57: 310, 70: 320, 85: 319, 314, 315, 313, 308, 317, 474},
 		// 145
-		{3: 315, 314, 312, 7: 280, 286, 15: 271, 288, 289, 290, 291, 292, 293, 294, 295, 297, 298, 296, 28: 300, 301, 302, 303, 299, 35: 304, 305, 306, 308, 309, 310, 311, 307, 270, 273, 274, 275, 278, 276, 272, 53: 313, 74: 265, 282, 277, 281, 283, 279, 81: 374},
-		{3: 315, 314, 312, 7: 280, 286, 15: 271, 288, 289, 290, 291, 292, 293, 294, 295, 297, 298, 296, 28: 300, 301, 302, 303, 299, 35: 304, 305, 306, 308, 309, 310, 311, 307, 270, 273, 274, 275, 278, 276, 272, 53: 313, 74: 265, 282, 277, 281, 283, 279, 81: 373},
-		{3: 315, 314, 312, 7: 280, 286, 15: 271, 288, 289, 290, 291, 292, 293, 294, 295, 297, 298, 296, 28: 300, 301, 302, 303, 299, 35: 304, 305, 306, 308, 309, 310, 311, 307, 270, 273, 274, 275, 278, 276, 272, 53: 313, 74: 265, 282, 277, 281, 283, 279, 81: 372},
-		{3: 315, 314, 312, 7: 280, 286, 15: 271, 288, 289, 290, 291, 292, 293, 294, 295, 297, 298, 296, 28: 300, 301, 302, 303, 299, 35: 304, 305, 306, 308, 309, 310, 311, 307, 270, 273, 274, 275, 278, 276, 272, 53: 313, 74: 265, 282, 277, 281, 283, 279, 81: 371},
-		{105, 105, 105, 105, 105, 105, 105, 9: 105, 105, 105, 105, 105, 105, 27: 105, 33: 105, 105, 50: 105, 105, 105, 54: 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 80: 105, 82: 105, 105, 105, 105, 105, 105},
+		{4: 330, 312, 311, 309, 318, 331, 16: 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 43: 323, 329, 321, 47: 327, 50: 322, 325, 326, 328, 316, 324, 57: 310, 70: 320, 85: 319, 314, 315, 313, 308, 317, 475},
+		{4: 330, 312, 311, 309, 318, 331, 16: 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 43: 323, 329, 321, 47: 327, 50: 322, 325, 326, 328, 316, 324, 57: 310, 70: 320, 85: 319, 314, 315, 313, 308, 317, 476},
+		{131, 131, 131, 131, 5: 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 42: 131, 46: 131, 48: 131, 131, 56: 131, 58: 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 71: 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 92: 131, 131, 95: 131, 131, 131, 131, 131, 131},
+		{130, 130, 130, 130, 5: 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 42: 130, 46: 130, 48: 130, 130, 56: 130, 58: 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 71: 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 92: 130, 130, 95: 130, 130, 130, 130, 130, 130},
+		{129, 129, 129, 129, 5: 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 42: 129, 46: 129, 48: 129, 129, 56: 129, 58: 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 71: 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 92: 129, 129, 95: 129, 129, 129, 129, 129, 129},
 		// 150
-		{106, 106, 106, 106, 106, 106, 106, 9: 106, 106, 106, 106, 106, 106, 27: 106, 33: 106, 106, 50: 106, 106, 106, 54: 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 80: 106, 82: 106, 106, 106, 106, 106, 106},
-		{107, 107, 107, 107, 107, 107, 107, 9: 107, 107, 107, 107, 107, 107, 27: 107, 33: 107, 107, 50: 107, 107, 107, 54: 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 80: 107, 82: 107, 107, 107, 107, 107, 107},
-		{108, 108, 108, 108, 108, 108, 108, 9: 108, 108, 108, 108, 108, 108, 27: 108, 33: 108, 108, 50: 108, 108, 108, 54: 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 80: 108, 82: 108, 108, 108, 108, 108, 108},
-		{109, 109, 109, 109, 109, 109, 109, 9: 109, 109, 109, 109, 109, 109, 27: 109, 33: 109, 109, 50: 109, 109, 109, 54: 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 80: 109, 82: 109, 109, 109, 109, 109, 109},
-		{110, 110, 110, 110, 110, 110, 110, 9: 110, 110, 110, 110, 110, 110, 27: 110, 33: 110, 110, 50: 110, 110, 110, 54: 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 80: 110, 82: 110, 110, 110, 110, 110, 110},
+		{4: 330, 312, 311, 309, 318, 331, 16: 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 43: 323, 329, 321, 47: 327, 50: 322, 325, 326, 328, 316, 324, 57: 310, 66: 478, 70: 320, 85: 319, 314, 315, 313, 308, 317, 307, 94: 306, 101: 305, 303, 304, 302, 477},
+		{2: 252, 4: 330, 312, 311, 309, 318, 331, 16: 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 43: 323, 329, 321, 47: 327, 50: 322, 325, 326, 328, 316, 324, 57: 310, 70: 320, 85: 319, 314, 315, 313, 308, 317, 307, 480, 94: 306, 101: 305, 303, 304, 302, 482, 116: 481, 178: 479},
+		{11, 11, 11, 11, 5: 11, 11, 11, 416, 415, 11, 11, 11, 11, 11, 11, 42: 11, 46: 11, 48: 11, 11, 56: 11, 58: 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 71: 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 92: 11, 11, 95: 11, 11, 11, 11, 11, 11, 119: 414, 121: 412, 413},
+		{10, 10, 10, 10, 5: 10, 10, 10, 416, 415, 10, 10, 10, 10, 10, 10, 42: 10, 46: 10, 48: 10, 10, 56: 10, 58: 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 71: 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 92: 10, 10, 95: 10, 10, 10, 10, 10, 10, 119: 414, 121: 412, 413},
+		{9, 9, 9, 9, 5: 9, 9, 9, 416, 415, 9, 9, 9, 9, 9, 9, 42: 9, 46: 9, 48: 9, 9, 56: 9, 58: 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 71: 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 92: 9, 9, 95: 9, 9, 9, 9, 9, 9, 119: 414, 121: 412, 413},
 		// 155
-		{111, 111, 111, 111, 111, 111, 111, 9: 111, 111, 111, 111, 111, 111, 27: 111, 33: 111, 111, 50: 111, 111, 111, 54: 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 80: 111, 82: 111, 111, 111, 111, 111, 111},
-		{2: 379, 51: 337, 336, 95: 335},
-		{127, 127, 127, 127, 127, 127, 127, 127, 9: 127, 127, 127, 127, 127, 127, 27: 127, 33: 127, 127, 50: 127, 127, 127, 54: 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 80: 127, 82: 127, 127, 127, 127, 127, 127, 90: 127},
-		{3: 315, 314, 312, 7: 280, 286, 15: 271, 288, 289, 290, 291, 292, 293, 294, 295, 297, 298, 296, 28: 300, 301, 302, 303, 299, 35: 304, 305, 306, 308, 309, 310, 311, 307, 270, 273, 274, 275, 278, 276, 272, 53: 313, 74: 265, 282, 277, 281, 283, 279, 81: 285, 88: 387},
-		{3: 315, 314, 312, 7: 280, 286, 15: 271, 288, 289, 290, 291, 292, 293, 294, 295, 297, 298, 296, 28: 300, 301, 302, 303, 299, 35: 304, 305, 306, 308, 309, 310, 311, 307, 270, 273, 274, 275, 278, 276, 272, 53: 313, 74: 265, 282, 277, 281, 283, 279, 81: 285, 88: 386},
+		{8, 8, 8, 8, 5: 8, 8, 8, 416, 415, 8, 8, 8, 8, 8, 8, 42: 8, 46: 8, 48: 8, 8, 56: 8, 58: 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 71: 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 92: 8, 8, 95: 8, 8, 8, 8, 8, 8, 119: 414, 121: 412, 413},
+		{2: 483, 58: 380, 379, 107: 377},
+		{4: 330, 312, 311, 309, 318, 331, 16: 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 43: 323, 329, 321, 47: 327, 50: 322, 325, 326, 328, 316, 324, 57: 310, 70: 320, 85: 319, 314, 315, 313, 308, 317, 307, 94: 306, 101: 305, 303, 304, 302, 484},
+		{4: 330, 312, 311, 309, 318, 331, 16: 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 43: 323, 329, 321, 47: 327, 50: 322, 325, 326, 328, 316, 324, 57: 310, 70: 320, 85: 319, 314, 315, 313, 308, 317, 307, 94: 306, 101: 305, 303, 304, 302, 485},
+		{4: 486},
 		// 160
-		{3: 315, 314, 312, 7: 280, 286, 15: 271, 288, 289, 290, 291, 292, 293, 294, 295, 297, 298, 296, 28: 300, 301, 302, 303, 299, 35: 304, 305, 306, 308, 309, 310, 311, 307, 270, 273, 274, 275, 278, 276, 272, 53: 313, 74: 265, 282, 277, 281, 283, 279, 81: 285, 88: 385},
-		{3: 315, 314, 312, 7: 280, 286, 15: 271, 288, 289, 290, 291, 292, 293, 294, 295, 297, 298, 296, 28: 300, 301, 302, 303, 299, 35: 304, 305, 306, 308, 309, 310, 311, 307, 270, 273, 274, 275, 278, 276, 272, 53: 313, 74: 265, 282, 277, 281, 283, 279, 81: 285, 88: 384},
-		{113, 113, 113, 113, 113, 113, 113, 9: 113, 113, 113, 113, 113, 113, 27: 113, 33: 113, 113, 50: 113, 113, 113, 54: 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 80: 370, 82: 368, 365, 369, 364, 366, 367},
-		{114, 114, 114, 114, 114, 114, 114, 9: 114, 114, 114, 114, 114, 114, 27: 114, 33: 114, 114, 50: 114, 114, 114, 54: 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 80: 370, 82: 368, 365, 369, 364, 366, 367},
-		{115, 115, 115, 115, 115, 115, 115, 9: 115, 115, 115, 115, 115, 115, 27: 115, 33: 115, 115, 50: 115, 115, 115, 54: 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 80: 370, 82: 368, 365, 369, 364, 366, 367},
+		{16: 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 70: 487},
+		{120: 489, 164: 488},
+		{4: 227, 117: 491, 169: 490},
+		{4: 227, 117: 491, 169: 492},
+		{65: 493},
 		// 165
-		{116, 116, 116, 116, 116, 116, 116, 9: 116, 116, 116, 116, 116, 116, 27: 116, 33: 116, 116, 50: 116, 116, 116, 54: 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 80: 370, 82: 368, 365, 369, 364, 366, 367},
-		{3: 172, 172, 172, 7: 172, 172, 15: 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 28: 172, 172, 172, 172, 172, 35: 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 53: 172},
-		{3: 171, 171, 171, 7: 171, 171, 15: 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 28: 171, 171, 171, 171, 171, 35: 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 53: 171},
-		{7: 426},
-		{64: 415, 414},
+		{8: 494},
+		{56: 495},
+		{213, 213, 14: 497, 163: 496},
+		{4: 498},
+		{114: 499},
 		// 170
-		{3: 315, 314, 312, 7: 280, 286, 15: 271, 288, 289, 290, 291, 292, 293, 294, 295, 297, 298, 296, 28: 300, 301, 302, 303, 299, 35: 304, 305, 306, 308, 309, 310, 311, 307, 270, 273, 274, 275, 278, 276, 272, 53: 313, 74: 265, 282, 277, 281, 283, 279, 81: 285, 88: 284, 411},
-		{15: 408, 50: 409},
-		{3: 315, 314, 312, 7: 280, 286, 15: 271, 288, 289, 290, 291, 292, 293, 294, 295, 297, 298, 296, 28: 300, 301, 302, 303, 299, 35: 304, 305, 306, 308, 309, 310, 311, 307, 270, 273, 274, 275, 278, 276, 272, 53: 313, 74: 265, 282, 277, 281, 283, 279, 81: 285, 88: 284, 407},
-		{3: 315, 314, 312, 7: 280, 286, 15: 271, 288, 289, 290, 291, 292, 293, 294, 295, 297, 298, 296, 28: 300, 301, 302, 303, 299, 35: 304, 305, 306, 308, 309, 310, 311, 307, 270, 273, 274, 275, 278, 276, 272, 53: 313, 74: 265, 282, 277, 281, 283, 279, 81: 285, 88: 284, 406},
-		{3: 315, 314, 312, 7: 280, 286, 15: 271, 288, 289, 290, 291, 292, 293, 294, 295, 297, 298, 296, 28: 300, 301, 302, 303, 299, 35: 304, 305, 306, 308, 309, 310, 311, 307, 270, 273, 274, 275, 278, 276, 272, 53: 313, 74: 265, 282, 277, 281, 283, 279, 81: 285, 88: 284, 405},
+		{208, 208},
+		{114: 500},
+		{206, 206},
+		{114: 501},
+		{8: 503, 109: 157, 123: 157, 195: 502},
 		// 175
-		{3: 315, 314, 312, 7: 280, 286, 15: 271, 288, 289, 290, 291, 292, 293, 294, 295, 297, 298, 296, 28: 300, 301, 302, 303, 299, 35: 304, 305, 306, 308, 309, 310, 311, 307, 270, 273, 274, 275, 278, 276, 272, 53: 313, 74: 265, 282, 277, 281, 283, 279, 81: 285, 88: 284, 404},
-		{3: 315, 314, 312, 7: 280, 286, 15: 271, 288, 289, 290, 291, 292, 293, 294, 295, 297, 298, 296, 28: 300, 301, 302, 303, 299, 35: 304, 305, 306, 308, 309, 310, 311, 307, 270, 273, 274, 275, 278, 276, 272, 53: 313, 74: 265, 282, 277, 281, 283, 279, 81: 285, 88: 284, 403},
-		{3: 315, 314, 312, 7: 280, 286, 15: 271, 288, 289, 290, 291, 292, 293, 294, 295, 297, 298, 296, 28: 300, 301, 302, 303, 299, 35: 304, 305, 306, 308, 309, 310, 311, 307, 270, 273, 274, 275, 278, 276, 272, 53: 313, 74: 265, 282, 277, 281, 283, 279, 81: 285, 88: 284, 402},
-		{3: 315, 314, 312, 7: 280, 286, 15: 271, 288, 289, 290, 291, 292, 293, 294, 295, 297, 298, 296, 28: 300, 301, 302, 303, 299, 35: 304, 305, 306, 308, 309, 310, 311, 307, 270, 273, 274, 275, 278, 276, 272, 53: 313, 74: 265, 282, 277, 281, 283, 279, 81: 285, 88: 284, 401},
-		{151, 151, 151, 383, 382, 380, 151, 9: 151, 151, 151, 151, 151, 151, 27: 151, 33: 151, 151, 50: 151, 151, 151, 54: 151, 151, 151, 151, 151, 151, 151, 151, 381, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151},
+		{87, 87, 87, 10: 87, 87, 87, 14: 87, 87, 46: 87, 48: 87, 87, 60: 505, 212: 504},
+		{79, 79, 79, 10: 79, 79, 79, 14: 79, 79, 46: 79, 48: 79, 79, 60: 79},
+		{78, 78, 78, 506, 10: 78, 78, 78, 14: 78, 78, 46: 78, 48: 78, 78, 60: 78},
+		{165, 165, 165, 165, 10: 165, 165, 165, 14: 165, 165, 46: 165, 48: 165, 165, 60: 165},
+		{167, 167, 167, 167, 10: 167, 167, 167, 14: 167, 167, 46: 167, 48: 167, 167, 58: 380, 379, 167, 65: 508, 107: 377, 191: 507},
 		// 180
-		{152, 152, 152, 383, 382, 380, 152, 9: 152, 152, 152, 152, 152, 152, 27: 152, 33: 152, 152, 50: 152, 152, 152, 54: 152, 152, 152, 152, 152, 152, 152, 152, 381, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152},
-		{153, 153, 153, 383, 382, 380, 153, 9: 153, 153, 153, 153, 153, 153, 27: 153, 33: 153, 153, 50: 153, 153, 153, 54: 153, 153, 153, 153, 153, 153, 153, 153, 381, 153, 153, 153, 153, 153, 153, 153, 153, 153, 153, 153},
-		{154, 154, 154, 383, 382, 380, 154, 9: 154, 154, 154, 154, 154, 154, 27: 154, 33: 154, 154, 50: 154, 154, 154, 54: 154, 154, 154, 154, 154, 154, 154, 154, 381, 154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 154},
-		{155, 155, 155, 383, 382, 380, 155, 9: 155, 155, 155, 155, 155, 155, 27: 155, 33: 155, 155, 50: 155, 155, 155, 54: 155, 155, 155, 155, 155, 155, 155, 155, 381, 155, 155, 155, 155, 155, 155, 155, 155, 155, 155, 155},
-		{156, 156, 156, 383, 382, 380, 156, 9: 156, 156, 156, 156, 156, 156, 27: 156, 33: 156, 156, 50: 156, 156, 156, 54: 156, 156, 156, 156, 156, 156, 156, 156, 381, 156, 156, 156, 156, 156, 156, 156, 156, 156, 156, 156},
+		{42, 42},
+		{4: 512, 110: 511, 165: 510, 176: 509},
+		{4: 3},
+		{197, 197, 197, 197, 10: 197, 197, 197, 197, 197, 197, 42: 197, 46: 197, 48: 197, 197, 58: 197, 197, 197, 197, 197, 197, 197, 197, 197, 383, 382, 172: 381},
+		{249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 13: 249, 16: 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 43: 249, 249, 249, 47: 249, 50: 249, 249, 249, 249, 249, 249, 249, 249, 61: 249, 249},
 		// 185
-		{157, 157, 157, 383, 382, 380, 157, 9: 157, 157, 157, 157, 157, 157, 27: 157, 33: 157, 157, 50: 157, 157, 157, 54: 157, 157, 157, 157, 157, 157, 157, 157, 381, 157, 157, 157, 157, 157, 157, 157, 157, 157, 157, 157},
-		{160, 160, 160, 6: 160, 9: 160, 160, 160, 160, 160, 160, 27: 160, 33: 160, 160, 51: 160, 160, 54: 160, 160, 160, 160, 160, 160, 160, 160},
-		{15: 410},
-		{159, 159, 159, 6: 159, 9: 159, 159, 159, 159, 159, 159, 27: 159, 33: 159, 159, 51: 159, 159, 54: 159, 159, 159, 159, 159, 159, 159, 159},
-		{3: 383, 382, 380, 60: 412, 62: 381},
+		{45, 45, 45, 45, 10: 45, 45, 45, 45, 45, 45, 42: 45, 46: 45, 48: 45, 45, 58: 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45},
+		{4: 330, 312, 311, 309, 318, 331, 16: 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 43: 323, 329, 321, 47: 327, 50: 322, 325, 326, 328, 316, 324, 57: 310, 70: 320, 85: 319, 314, 315, 313, 308, 317, 307, 94: 306, 101: 305, 303, 304, 302, 482, 109: 296, 112: 514, 116: 513},
+		{8: 515},
+		{4: 330, 312, 311, 309, 318, 331, 16: 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 43: 323, 329, 321, 47: 327, 50: 322, 325, 326, 328, 316, 324, 57: 310, 70: 320, 85: 319, 314, 315, 313, 308, 317, 307, 94: 306, 101: 516},
+		{5: 404, 403, 401, 67: 517, 71: 402},
 		// 190
-		{3: 315, 314, 312, 7: 280, 286, 15: 271, 288, 289, 290, 291, 292, 293, 294, 295, 297, 298, 296, 28: 300, 301, 302, 303, 299, 35: 304, 305, 306, 308, 309, 310, 311, 307, 270, 273, 274, 275, 278, 276, 272, 53: 313, 74: 265, 282, 277, 281, 283, 279, 81: 285, 88: 284, 413},
-		{162, 162, 162, 383, 382, 380, 162, 9: 162, 162, 162, 162, 162, 162, 27: 162, 33: 162, 162, 51: 162, 162, 54: 162, 162, 162, 162, 162, 162, 162, 162, 381},
-		{7: 419},
-		{3: 315, 314, 312, 7: 280, 286, 15: 271, 288, 289, 290, 291, 292, 293, 294, 295, 297, 298, 296, 28: 300, 301, 302, 303, 299, 35: 304, 305, 306, 308, 309, 310, 311, 307, 270, 273, 274, 275, 278, 276, 272, 53: 313, 74: 265, 282, 277, 281, 283, 279, 81: 285, 88: 284, 416},
-		{3: 383, 382, 380, 60: 417, 62: 381},
+		{180, 180, 180, 180, 10: 180, 180, 180, 180, 180, 180, 42: 180, 46: 180, 48: 180, 180, 58: 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180},
+		{43: 518},
+		{177, 177, 177, 177, 5: 404, 403, 401, 10: 177, 177, 177, 177, 177, 177, 42: 177, 46: 177, 48: 177, 177, 56: 177, 58: 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 71: 402, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177},
+		{176, 176, 176, 176, 5: 404, 403, 401, 10: 176, 176, 176, 176, 176, 176, 42: 176, 46: 176, 48: 176, 176, 56: 176, 58: 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 71: 402, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176},
+		{175, 175, 175, 175, 5: 404, 403, 401, 10: 175, 175, 175, 175, 175, 175, 42: 175, 46: 175, 48: 175, 175, 56: 175, 58: 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 71: 402, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175},
 		// 195
-		{3: 315, 314, 312, 7: 280, 286, 15: 271, 288, 289, 290, 291, 292, 293, 294, 295, 297, 298, 296, 28: 300, 301, 302, 303, 299, 35: 304, 305, 306, 308, 309, 310, 311, 307, 270, 273, 274, 275, 278, 276, 272, 53: 313, 74: 265, 282, 277, 281, 283, 279, 81: 285, 88: 284, 418},
-		{161, 161, 161, 383, 382, 380, 161, 9: 161, 161, 161, 161, 161, 161, 27: 161, 33: 161, 161, 51: 161, 161, 54: 161, 161, 161, 161, 161, 161, 161, 161, 381},
-		{3: 315, 314, 312, 7: 280, 286, 15: 271, 288, 289, 290, 291, 292, 293, 294, 295, 297, 298, 296, 28: 300, 301, 302, 303, 299, 35: 304, 305, 306, 308, 309, 310, 311, 307, 270, 273, 274, 275, 278, 276, 272, 53: 313, 74: 265, 282, 277, 281, 283, 279, 81: 285, 88: 284, 269, 91: 287, 268, 266, 352, 96: 235, 98: 421, 101: 420},
-		{2: 425},
-		{423, 2: 99, 137: 422},
+		{174, 174, 174, 174, 5: 404, 403, 401, 10: 174, 174, 174, 174, 174, 174, 42: 174, 46: 174, 48: 174, 174, 56: 174, 58: 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 71: 402, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174},
+		{173, 173, 173, 173, 5: 404, 403, 401, 10: 173, 173, 173, 173, 173, 173, 42: 173, 46: 173, 48: 173, 173, 56: 173, 58: 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 71: 402, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173},
+		{172, 172, 172, 172, 5: 404, 403, 401, 10: 172, 172, 172, 172, 172, 172, 42: 172, 46: 172, 48: 172, 172, 56: 172, 58: 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 71: 402, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172},
+		{201, 201, 201, 201, 5: 404, 403, 401, 10: 201, 201, 201, 201, 201, 201, 42: 201, 46: 201, 48: 201, 201, 56: 201, 58: 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 71: 402, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 93: 520, 188: 519},
+		{170, 170, 170, 170, 5: 404, 403, 401, 10: 170, 170, 170, 170, 170, 170, 42: 170, 46: 170, 48: 170, 170, 56: 170, 58: 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 71: 402, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170},
 		// 200
-		{2: 424},
-		{2: 98},
-		{163, 163, 163, 6: 163, 9: 163, 163, 163, 163, 163, 163, 27: 163, 33: 163, 163, 51: 163, 163, 54: 163, 163, 163, 163, 163, 163, 163, 163},
-		{165, 165, 165, 6: 165, 9: 165, 165, 165, 165, 165, 165, 27: 165, 33: 165, 165, 51: 165, 165, 54: 165, 165, 165, 165, 165, 165, 165, 165},
-		{3: 315, 314, 312, 7: 280, 286, 15: 271, 288, 289, 290, 291, 292, 293, 294, 295, 297, 298, 296, 28: 300, 301, 302, 303, 299, 35: 304, 305, 306, 308, 309, 310, 311, 307, 270, 273, 274, 275, 278, 276, 272, 53: 313, 74: 265, 282, 277, 281, 283, 279, 81: 285, 88: 284, 269, 91: 287, 268, 266, 352, 96: 235, 98: 428, 101: 427},
+		{169, 169, 169, 169, 5: 404, 403, 401, 10: 169, 169, 169, 169, 169, 169, 42: 169, 46: 169, 48: 169, 169, 56: 169, 58: 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 71: 402, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169},
+		{127, 127, 127, 127, 5: 127, 127, 127, 10: 127, 127, 127, 127, 127, 127, 42: 127, 46: 127, 48: 127, 127, 56: 127, 58: 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 71: 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 92: 411, 127, 95: 409, 406, 410, 405, 407, 408},
+		{126, 126, 126, 126, 5: 126, 126, 126, 10: 126, 126, 126, 126, 126, 126, 42: 126, 46: 126, 48: 126, 126, 56: 126, 58: 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 71: 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 92: 411, 126, 95: 409, 406, 410, 405, 407, 408},
+		{125, 125, 125, 125, 5: 125, 125, 125, 10: 125, 125, 125, 125, 125, 125, 42: 125, 46: 125, 48: 125, 125, 56: 125, 58: 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 71: 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 92: 411, 125, 95: 409, 406, 410, 405, 407, 408},
+		{124, 124, 124, 124, 5: 124, 124, 124, 10: 124, 124, 124, 124, 124, 124, 42: 124, 46: 124, 48: 124, 124, 56: 124, 58: 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 71: 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 92: 411, 124, 95: 409, 406, 410, 405, 407, 408},
 		// 205
-		{2: 431},
-		{423, 2: 99, 137: 429},
-		{2: 430},
-		{164, 164, 164, 6: 164, 9: 164, 164, 164, 164, 164, 164, 27: 164, 33: 164, 164, 51: 164, 164, 54: 164, 164, 164, 164, 164, 164, 164, 164},
-		{166, 166, 166, 6: 166, 9: 166, 166, 166, 166, 166, 166, 27: 166, 33: 166, 166, 51: 166, 166, 54: 166, 166, 166, 166, 166, 166, 166, 166},
+		{122, 122, 122, 122, 5: 122, 122, 122, 10: 122, 122, 122, 122, 122, 122, 42: 122, 46: 122, 48: 122, 122, 56: 122, 58: 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 71: 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 92: 122, 122, 95: 122, 122, 122, 122, 122, 122},
+		{121, 121, 121, 121, 5: 121, 121, 121, 10: 121, 121, 121, 121, 121, 121, 42: 121, 46: 121, 48: 121, 121, 56: 121, 58: 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 71: 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 92: 121, 121, 95: 121, 121, 121, 121, 121, 121},
+		{120, 120, 120, 120, 5: 120, 120, 120, 10: 120, 120, 120, 120, 120, 120, 42: 120, 46: 120, 48: 120, 120, 56: 120, 58: 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 71: 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 92: 120, 120, 95: 120, 120, 120, 120, 120, 120},
+		{119, 119, 119, 119, 5: 119, 119, 119, 10: 119, 119, 119, 119, 119, 119, 42: 119, 46: 119, 48: 119, 119, 56: 119, 58: 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 71: 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 92: 119, 119, 95: 119, 119, 119, 119, 119, 119},
+		{118, 118, 118, 118, 5: 118, 118, 118, 10: 118, 118, 118, 118, 118, 118, 42: 118, 46: 118, 48: 118, 118, 56: 118, 58: 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 71: 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 92: 118, 118, 95: 118, 118, 118, 118, 118, 118},
 		// 210
-		{3: 315, 314, 312, 7: 280, 286, 15: 271, 288, 289, 290, 291, 292, 293, 294, 295, 297, 298, 296, 28: 300, 301, 302, 303, 299, 35: 304, 305, 306, 308, 309, 310, 311, 307, 270, 273, 274, 275, 278, 276, 272, 53: 313, 74: 265, 282, 277, 281, 283, 279, 81: 285, 88: 284, 269, 91: 287, 268, 266, 433},
-		{2: 434, 51: 337, 336, 95: 335},
-		{198, 198, 198, 198, 198, 198, 198, 198, 9: 198, 198, 198, 198, 198, 198, 27: 198, 33: 198, 198, 50: 198, 198, 198, 54: 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 80: 198, 82: 198, 198, 198, 198, 198, 198, 90: 198},
-		{2, 2, 6: 437, 12: 2, 100: 436},
-		{216, 216, 12: 216},
+		{117, 117, 117, 117, 5: 117, 117, 117, 10: 117, 117, 117, 117, 117, 117, 42: 117, 46: 117, 48: 117, 117, 56: 117, 58: 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 71: 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 92: 117, 117, 95: 117, 117, 117, 117, 117, 117},
+		{116, 116, 116, 116, 5: 116, 116, 116, 10: 116, 116, 116, 116, 116, 116, 42: 116, 46: 116, 48: 116, 116, 56: 116, 58: 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 71: 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 92: 116, 116, 95: 116, 116, 116, 116, 116, 116},
+		{58: 380, 379, 64: 521, 66: 522, 107: 377},
+		{4: 330, 312, 311, 309, 318, 331, 16: 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 43: 323, 329, 321, 47: 327, 50: 322, 325, 326, 328, 316, 324, 57: 310, 64: 523, 70: 320, 85: 319, 314, 315, 313, 308, 317, 307, 94: 306, 101: 305, 303, 304, 302, 524},
+		{2: 525},
 		// 215
-		{1, 1, 8: 260, 12: 1, 97: 258, 145: 438},
-		{214, 214, 6: 214, 12: 214},
-		{3: 315, 314, 312, 7: 280, 286, 15: 271, 288, 289, 290, 291, 292, 293, 294, 295, 297, 298, 296, 28: 300, 301, 302, 303, 299, 35: 304, 305, 306, 308, 309, 310, 311, 307, 270, 273, 274, 275, 278, 276, 272, 53: 313, 74: 265, 282, 277, 281, 283, 279, 81: 285, 88: 284, 269, 91: 287, 268, 266, 440},
-		{217, 217, 6: 217, 12: 217, 51: 337, 336, 95: 335},
-		{8: 254, 99: 442},
+		{2: 526},
+		{2: 251},
+		{2: 191, 191, 58: 380, 379, 63: 191, 107: 377, 189: 527},
+		{139, 139, 139, 139, 5: 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 42: 139, 46: 139, 48: 139, 139, 56: 139, 58: 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 71: 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 92: 139, 139, 95: 139, 139, 139, 139, 139, 139},
+		{2: 528, 58: 380, 379, 107: 377},
 		// 220
-		{40, 40},
-		{3: 315, 314, 312, 7: 280, 286, 15: 271, 288, 289, 290, 291, 292, 293, 294, 295, 297, 298, 296, 28: 300, 301, 302, 303, 299, 35: 304, 305, 306, 308, 309, 310, 311, 307, 270, 273, 274, 275, 278, 276, 272, 53: 313, 74: 265, 282, 277, 281, 283, 279, 449, 285, 88: 284, 269, 91: 287, 268, 266, 445, 149: 446, 169: 447, 184: 448},
-		{3: 76, 76, 76, 7: 76, 76, 15: 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 28: 76, 76, 76, 76, 76, 35: 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 53: 76, 80: 76},
-		{149, 149, 149, 6: 149, 9: 149, 149, 149, 149, 14: 149, 27: 149, 33: 149, 149, 51: 337, 336, 54: 149, 58: 507, 95: 335, 168: 506},
-		{147, 147, 147, 6: 147, 9: 147, 147, 147, 147, 14: 147, 27: 147, 33: 147, 147, 54: 147},
+		{2: 529, 58: 380, 379, 107: 377},
+		{114, 114, 114, 114, 5: 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 42: 114, 46: 114, 48: 114, 114, 56: 114, 58: 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 71: 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 92: 114, 114, 95: 114, 114, 114, 114, 114, 114},
+		{106: 530},
+		{4: 512, 110: 532, 125: 531},
+		{180: 533},
 		// 225
-		{74, 74, 74, 6: 504, 9: 74, 74, 74, 74, 14: 74, 27: 74, 33: 74, 74, 54: 74},
-		{83, 83, 83, 9: 83, 83, 83, 83, 14: 83, 27: 83, 33: 83, 83, 54: 451, 185: 450},
-		{75, 75, 75, 9: 75, 75, 75, 75, 14: 75, 27: 75, 33: 75, 75, 54: 75},
-		{86, 86, 86, 9: 86, 86, 86, 86, 14: 86, 27: 468, 33: 466, 467, 174: 470, 471, 469},
-		{7: 454, 453, 133: 455, 452, 182: 456},
+		{4: 534},
+		{56: 535},
+		{4: 536},
+		{4: 537},
+		{4: 512, 110: 532, 125: 538},
 		// 230
-		{97, 97, 97, 6: 97, 9: 97, 97, 97, 97, 14: 97, 27: 97, 33: 97, 97, 58: 464, 102: 97, 181: 463},
-		{101, 101, 101, 6: 101, 9: 101, 101, 101, 101, 14: 101, 27: 101, 33: 101, 101, 58: 101, 102: 101},
-		{96: 235, 98: 460},
-		{95, 95, 95, 6: 95, 9: 95, 95, 95, 95, 14: 95, 27: 95, 33: 95, 95},
-		{2, 2, 2, 6: 457, 9: 2, 2, 2, 2, 14: 2, 27: 2, 33: 2, 2, 100: 458},
+		{114: 539},
+		{212, 212},
+		{4: 330, 312, 311, 309, 318, 331, 16: 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 43: 323, 329, 321, 47: 327, 50: 322, 325, 326, 328, 316, 324, 542, 310, 70: 320, 85: 319, 314, 315, 313, 308, 317, 307, 94: 306, 101: 305, 303, 304, 302, 540, 114: 541},
+		{211, 211},
+		{4: 209},
 		// 235
-		{1, 1, 1, 7: 454, 453, 1, 1, 1, 1, 14: 1, 27: 1, 33: 1, 1, 133: 459, 452},
-		{82, 82, 82, 9: 82, 82, 82, 82, 14: 82, 27: 82, 33: 82, 82},
-		{94, 94, 94, 6: 94, 9: 94, 94, 94, 94, 14: 94, 27: 94, 33: 94, 94},
-		{423, 2: 99, 137: 461},
-		{2: 462},
+		{4: 374, 113: 543},
+		{4: 544},
+		{109: 296, 112: 546, 123: 545},
+		{4: 548, 110: 549, 126: 547},
+		{90, 90, 90, 10: 90, 90, 90, 14: 90, 90, 46: 555, 48: 553, 554, 198: 551, 550, 552},
 		// 240
-		{100, 100, 100, 6: 100, 9: 100, 100, 100, 100, 14: 100, 27: 100, 33: 100, 100, 58: 100, 102: 100},
-		{102, 102, 102, 6: 102, 9: 102, 102, 102, 102, 14: 102, 27: 102, 33: 102, 102, 102: 102},
-		{8: 465},
-		{96, 96, 96, 6: 96, 9: 96, 96, 96, 96, 14: 96, 27: 96, 33: 96, 96, 102: 96},
-		{104: 92, 112: 92},
+		{4: 559, 8: 560, 153: 557, 558, 209: 556},
+		{77, 77, 77, 4: 330, 312, 311, 309, 318, 331, 77, 77, 77, 14: 77, 77, 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 43: 323, 329, 321, 77, 327, 77, 77, 322, 325, 326, 328, 316, 324, 57: 310, 60: 77, 70: 320, 85: 319, 314, 315, 313, 308, 317, 307, 94: 306, 101: 305, 303, 304, 302, 444, 171: 561},
+		{168, 168, 168, 168, 10: 168, 168, 168, 14: 168, 168, 46: 168, 48: 168, 168, 60: 168},
+		{4: 562},
+		{14, 14, 14: 497, 163: 564, 223: 563},
 		// 245
-		{104: 91, 112: 91},
-		{104: 90, 112: 90},
-		{104: 89, 112: 498, 179: 499},
-		{85, 85, 85, 9: 85, 85, 85, 85, 14: 85},
-		{72, 72, 72, 9: 72, 72, 72, 264, 14: 72, 143: 473, 190: 472},
+		{257, 257, 3: 257, 14: 257, 177: 565},
+		{69: 566},
+		{240, 240, 240, 240, 240, 9: 240, 240, 240, 240, 16: 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 45: 240, 69: 240},
+		{2: 567},
+		{569, 2: 109, 157: 568},
 		// 250
-		{70, 70, 70, 9: 70, 70, 70, 14: 474, 170: 476, 186: 475},
-		{71, 71, 71, 9: 71, 71, 71, 14: 71},
-		{146: 491},
-		{68, 68, 68, 9: 68, 68, 477, 177: 479, 189: 478},
-		{69, 69, 69, 9: 69, 69, 69},
+		{4: 330, 312, 311, 309, 318, 331, 16: 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 43: 323, 329, 321, 47: 327, 50: 322, 325, 326, 328, 316, 324, 57: 310, 70: 320, 85: 319, 314, 315, 313, 308, 317, 307, 94: 306, 101: 305, 303, 304, 302, 482, 109: 296, 112: 571, 116: 570},
+		{5: 404, 403, 401, 67: 572, 71: 402},
+		{4: 330, 312, 311, 309, 318, 331, 16: 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 43: 323, 329, 321, 47: 327, 50: 322, 325, 326, 328, 316, 324, 57: 310, 70: 320, 85: 319, 314, 315, 313, 308, 317, 307, 94: 306, 101: 573},
+		{179, 179, 179, 179, 10: 179, 179, 179, 179, 179, 179, 42: 179, 46: 179, 48: 179, 179, 58: 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179},
+		{171, 171, 171, 171, 10: 171, 171, 171, 171, 171, 171, 42: 171, 46: 171, 48: 171, 171, 56: 171, 58: 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 72: 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171},
 		// 255
-		{146: 486},
-		{81, 81, 81, 9: 81, 481, 187: 480},
-		{67, 67, 67, 9: 67, 67},
-		{79, 79, 79, 9: 484, 188: 483},
-		{3: 315, 314, 312, 7: 280, 286, 15: 271, 288, 289, 290, 291, 292, 293, 294, 295, 297, 298, 296, 28: 300, 301, 302, 303, 299, 35: 304, 305, 306, 308, 309, 310, 311, 307, 270, 273, 274, 275, 278, 276, 272, 53: 313, 74: 265, 282, 277, 281, 283, 279, 81: 285, 88: 284, 269, 91: 287, 268, 266, 482},
+		{44: 574},
+		{162, 162, 162, 162, 5: 162, 162, 162, 162, 162, 162, 162, 162, 162, 162, 162, 42: 162, 46: 162, 48: 162, 162, 56: 162, 58: 162, 162, 162, 162, 162, 162, 162, 162, 162, 162, 162, 162, 71: 162, 162, 162, 162, 162, 162, 162, 162, 162, 162, 162, 162, 162, 162, 92: 162, 162, 95: 162, 162, 162, 162, 162, 162},
+		{4: 330, 312, 311, 309, 318, 331, 16: 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 43: 323, 329, 321, 47: 327, 50: 322, 325, 326, 328, 316, 324, 57: 310, 64: 575, 70: 320, 85: 319, 314, 315, 313, 308, 317, 307, 94: 306, 101: 305, 303, 304, 302, 576},
+		{70, 70, 70, 70, 5: 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 42: 70, 46: 70, 48: 70, 70, 56: 70, 58: 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 71: 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 92: 70, 70, 95: 70, 70, 70, 70, 70, 70},
+		{58: 380, 379, 64: 577, 107: 377},
 		// 260
-		{80, 80, 80, 9: 80, 51: 337, 336, 95: 335},
-		{84, 84, 84},
-		{3: 315, 314, 312, 7: 280, 286, 15: 271, 288, 289, 290, 291, 292, 293, 294, 295, 297, 298, 296, 28: 300, 301, 302, 303, 299, 35: 304, 305, 306, 308, 309, 310, 311, 307, 270, 273, 274, 275, 278, 276, 272, 53: 313, 74: 265, 282, 277, 281, 283, 279, 81: 285, 88: 284, 269, 91: 287, 268, 266, 485},
-		{78, 78, 78, 51: 337, 336, 95: 335},
-		{3: 315, 314, 312, 7: 280, 286, 15: 271, 288, 289, 290, 291, 292, 293, 294, 295, 297, 298, 296, 28: 300, 301, 302, 303, 299, 35: 304, 305, 306, 308, 309, 310, 311, 307, 270, 273, 274, 275, 278, 276, 272, 53: 313, 74: 265, 282, 277, 281, 283, 279, 81: 285, 88: 284, 269, 91: 287, 268, 266, 352, 101: 487},
+		{254, 254, 254, 254, 5: 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 42: 254, 46: 254, 48: 254, 254, 56: 254, 58: 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 71: 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 92: 254, 254, 95: 254, 254, 254, 254, 254, 254},
+		{253, 253, 253, 253, 5: 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 42: 253, 46: 253, 48: 253, 253, 56: 253, 58: 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 71: 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 92: 253, 253, 95: 253, 253, 253, 253, 253, 253},
+		{2: 2, 579, 63: 2, 111: 578},
+		{231, 231, 231, 231, 5: 231, 231, 231, 231, 231, 231, 231, 231, 231, 231, 231, 42: 231, 46: 231, 48: 231, 231, 56: 231, 58: 231, 231, 231, 231, 231, 231, 231, 231, 231, 231, 231, 231, 71: 231, 231, 231, 231, 231, 231, 231, 231, 231, 231, 231, 231, 231, 231, 92: 231, 231, 95: 231, 231, 231, 231, 231, 231},
+		{230, 230, 230, 230, 5: 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 42: 230, 46: 230, 48: 230, 230, 56: 230, 58: 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 71: 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 92: 230, 230, 95: 230, 230, 230, 230, 230, 230},
 		// 265
-		{125, 125, 125, 9: 125, 125, 55: 489, 490, 178: 488},
-		{126, 126, 126, 9: 126, 126},
-		{124, 124, 124, 9: 124, 124},
-		{123, 123, 123, 9: 123, 123},
-		{8: 260, 97: 492, 147: 493},
+		{4: 330, 312, 311, 309, 318, 331, 16: 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 43: 323, 329, 321, 47: 327, 50: 322, 325, 326, 328, 316, 324, 57: 310, 63: 189, 70: 320, 85: 319, 314, 315, 313, 308, 317, 307, 94: 306, 101: 305, 303, 304, 302, 482, 116: 581, 190: 580},
+		{262, 262},
+		{4: 585, 9: 584, 16: 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 45: 583, 70: 582},
+		{4: 512, 110: 586},
+		{108: 587},
 		// 270
-		{205, 205, 205, 6: 205, 9: 205, 205, 205, 157: 494},
-		{145, 145, 145, 9: 145, 145, 145},
-		{2, 2, 2, 6: 496, 9: 2, 2, 2, 100: 495},
-		{206, 206, 206, 9: 206, 206, 206},
-		{1, 1, 1, 8: 260, 1, 1, 1, 97: 497},
+		{114: 588},
+		{108: 589},
+		{8: 590},
+		{2: 219, 219, 170: 591},
+		{4: 374, 113: 592},
 		// 275
-		{204, 204, 204, 6: 204, 9: 204, 204, 204},
-		{104: 88},
-		{104: 500},
-		{7: 454, 453, 133: 501, 452},
-		{102: 502},
+		{7, 7, 7, 10: 7, 7, 7, 15: 7, 58: 380, 379, 107: 377},
+		{8: 593},
+		{114: 594},
+		{207, 207},
+		{205, 205},
 		// 280
-		{3: 315, 314, 312, 7: 280, 286, 15: 271, 288, 289, 290, 291, 292, 293, 294, 295, 297, 298, 296, 28: 300, 301, 302, 303, 299, 35: 304, 305, 306, 308, 309, 310, 311, 307, 270, 273, 274, 275, 278, 276, 272, 53: 313, 74: 265, 282, 277, 281, 283, 279, 81: 285, 88: 284, 269, 91: 287, 268, 266, 503},
-		{87, 87, 87, 9: 87, 87, 87, 87, 14: 87, 51: 337, 336, 95: 335},
-		{73, 73, 73, 315, 314, 312, 7: 280, 286, 73, 73, 73, 73, 14: 73, 271, 288, 289, 290, 291, 292, 293, 294, 295, 297, 298, 296, 73, 300, 301, 302, 303, 299, 73, 73, 304, 305, 306, 308, 309, 310, 311, 307, 270, 273, 274, 275, 278, 276, 272, 53: 313, 73, 74: 265, 282, 277, 281, 283, 279, 81: 285, 88: 284, 269, 91: 287, 268, 266, 445, 149: 505},
-		{146, 146, 146, 6: 146, 9: 146, 146, 146, 146, 14: 146, 27: 146, 33: 146, 146, 54: 146},
-		{150, 150, 150, 6: 150, 9: 150, 150, 150, 150, 14: 150, 27: 150, 33: 150, 150, 54: 150},
+		{8: 595},
+		{158, 158},
+		{2: 596},
+		{2: 240, 240, 8: 597},
+		{238, 238, 238, 238, 10: 238, 238, 238, 181: 598},
 		// 285
-		{8: 508},
-		{148, 148, 148, 6: 148, 9: 148, 148, 148, 148, 14: 148, 27: 148, 33: 148, 148, 54: 148},
-		{8: 254, 99: 510},
-		{7: 512, 96: 141, 114: 141, 171: 511},
-		{96: 235, 98: 516, 114: 515},
+		{76, 76, 76, 10: 76, 76, 76, 14: 497, 76, 163: 600, 217: 599},
+		{89, 89, 89, 10: 89, 89, 89, 14: 89, 89},
+		{115: 93, 131: 602, 204: 601},
+		{115: 96, 131: 96},
+		{115: 95, 131: 95},
 		// 290
-		{8: 260, 97: 492, 147: 513},
-		{2: 514},
-		{96: 140, 114: 140},
-		{7: 517},
-		{142, 142},
+		{115: 94, 131: 94},
+		{2, 2, 2, 604, 10: 2, 2, 2, 14: 2, 2, 46: 2, 48: 2, 2, 111: 603},
+		{99, 99, 99, 99, 10: 99, 99, 99, 14: 99, 99, 46: 99, 48: 99, 99},
+		{107, 107, 107, 107, 107, 10: 107, 107, 107, 14: 107, 107, 46: 107, 48: 107, 107, 65: 606, 108: 107, 206: 605},
+		{112, 112, 112, 112, 112, 8: 607, 10: 112, 112, 112, 14: 112, 112, 46: 112, 48: 112, 112, 65: 112, 108: 112},
 		// 295
-		{3: 315, 314, 312, 7: 280, 286, 15: 271, 288, 289, 290, 291, 292, 293, 294, 295, 297, 298, 296, 28: 300, 301, 302, 303, 299, 35: 304, 305, 306, 308, 309, 310, 311, 307, 270, 273, 274, 275, 278, 276, 272, 53: 313, 74: 265, 282, 277, 281, 283, 279, 81: 285, 88: 284, 269, 91: 287, 268, 266, 352, 101: 518},
-		{2: 519},
-		{139, 139, 6: 139, 172: 520},
-		{2, 2, 6: 522, 100: 521},
-		{143, 143},
+		{109: 296, 112: 608},
+		{164, 164, 164, 164, 10: 164, 164, 164, 14: 164, 164, 46: 164, 48: 164, 164, 60: 164},
+		{166, 166, 166, 166, 10: 166, 166, 166, 14: 166, 166, 46: 166, 48: 166, 166, 60: 166},
+		{15, 15},
+		{13, 13},
 		// 300
-		{1, 1, 7: 523},
-		{3: 315, 314, 312, 7: 280, 286, 15: 271, 288, 289, 290, 291, 292, 293, 294, 295, 297, 298, 296, 28: 300, 301, 302, 303, 299, 35: 304, 305, 306, 308, 309, 310, 311, 307, 270, 273, 274, 275, 278, 276, 272, 53: 313, 74: 265, 282, 277, 281, 283, 279, 81: 285, 88: 284, 269, 91: 287, 268, 266, 352, 101: 524},
-		{2: 525},
-		{138, 138, 6: 138},
-		{177, 177},
+		{2, 2, 3: 610, 14: 2, 111: 609},
+		{4: 330, 312, 311, 309, 318, 331, 16: 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 43: 323, 329, 321, 47: 327, 50: 322, 325, 326, 328, 316, 324, 57: 310, 70: 320, 85: 319, 314, 315, 313, 308, 317, 307, 94: 306, 101: 305, 303, 304, 302, 611},
+		{186, 186, 186, 186, 10: 186, 186, 186, 186, 186, 186, 42: 186, 46: 186, 48: 186, 186, 58: 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186},
+		{2: 612},
+		{2: 108},
 		// 305
-		{8: 182, 110: 534, 165: 533},
-		{8: 254, 99: 529, 110: 530},
-		{180, 180},
-		{103: 531},
-		{8: 254, 99: 532},
+		{2: 613},
+		{569, 2: 109, 157: 614},
+		{4: 330, 312, 311, 309, 318, 331, 16: 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 43: 323, 329, 321, 47: 327, 50: 322, 325, 326, 328, 316, 324, 57: 310, 70: 320, 85: 319, 314, 315, 313, 308, 317, 307, 94: 306, 101: 615},
+		{182, 182, 182, 182, 5: 404, 403, 401, 10: 182, 182, 182, 182, 182, 182, 42: 182, 46: 182, 48: 182, 182, 58: 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 71: 402},
+		{200, 200, 200, 200, 10: 200, 200, 200, 200, 200, 200, 42: 200, 46: 200, 48: 200, 200, 56: 200, 58: 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 72: 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200},
 		// 310
-		{179, 179},
-		{8: 536},
-		{103: 535},
-		{8: 181},
-		{183, 183},
+		{68, 68, 68, 68, 5: 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 42: 68, 46: 68, 48: 68, 68, 56: 68, 58: 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 71: 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 92: 68, 68, 95: 68, 68, 68, 68, 68, 68},
+		{58: 380, 379, 64: 616, 107: 377},
+		{69, 69, 69, 69, 5: 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 42: 69, 46: 69, 48: 69, 69, 56: 69, 58: 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 71: 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 92: 69, 69, 95: 69, 69, 69, 69, 69, 69},
+		{2: 192, 63: 192},
+		{2: 1, 4: 330, 312, 311, 309, 318, 331, 16: 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 43: 323, 329, 321, 47: 327, 50: 322, 325, 326, 328, 316, 324, 57: 310, 63: 1, 70: 320, 85: 319, 314, 315, 313, 308, 317, 307, 94: 306, 101: 305, 303, 304, 302, 617},
 		// 315
-		{8: 254, 99: 538},
-		{185, 185, 12: 264, 143: 539},
-		{184, 184},
-		{111: 571},
-		{111: 193},
+		{63: 618},
+		{63: 188},
+		{250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 13: 250, 16: 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 378, 250, 250, 250, 47: 250, 50: 250, 250, 250, 250, 250, 250, 250, 250, 168: 619},
+		{8: 620},
+		{64: 621},
 		// 320
-		{8: 254, 99: 543, 110: 544},
-		{7: 566},
-		{50: 545},
-		{103: 546},
-		{8: 254, 99: 547},
+		{233, 233, 233, 233, 330, 312, 311, 309, 318, 331, 13: 233, 16: 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 43: 323, 329, 321, 47: 327, 50: 322, 325, 326, 328, 316, 324, 624, 310, 70: 320, 85: 319, 314, 315, 313, 308, 317, 307, 94: 306, 101: 305, 303, 304, 302, 625, 127: 623, 622},
+		{261, 261},
+		{4: 626},
+		{4: 226},
+		{4: 627},
 		// 325
-		{7: 548},
-		{8: 260, 97: 549, 109: 550},
-		{16: 288, 289, 290, 291, 292, 293, 294, 295, 297, 298, 296, 28: 300, 301, 302, 303, 299, 35: 304, 305, 306, 308, 309, 310, 311, 307, 74: 556},
-		{2: 190, 6: 190, 148: 551},
-		{2: 2, 6: 553, 100: 552},
+		{44: 629, 186: 628},
+		{2: 2, 631, 111: 630},
+		{8: 632},
+		{109: 296, 112: 633},
+		{8: 634},
 		// 330
-		{2: 555},
-		{2: 1, 8: 260, 97: 549, 109: 554},
-		{2: 189, 6: 189},
-		{191, 191},
-		{200, 200, 200, 315, 314, 312, 200, 280, 286, 13: 200, 15: 271, 288, 289, 290, 291, 292, 293, 294, 295, 297, 298, 296, 28: 300, 301, 302, 303, 299, 35: 304, 305, 306, 308, 309, 310, 311, 307, 270, 273, 274, 275, 278, 276, 272, 558, 53: 313, 74: 265, 282, 277, 281, 283, 279, 81: 285, 88: 284, 269, 91: 287, 268, 266, 559, 158: 560, 557},
+		{4: 330, 312, 311, 309, 318, 331, 16: 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 43: 323, 329, 321, 47: 327, 50: 322, 325, 326, 328, 316, 324, 57: 310, 70: 320, 85: 319, 314, 315, 313, 308, 317, 307, 94: 306, 101: 305, 303, 304, 302, 482, 116: 635},
+		{109: 156, 123: 156},
+		{2: 636},
+		{2, 2, 2, 638, 10: 2, 2, 2, 111: 637},
+		{74, 74, 74, 10: 74, 74, 74, 15: 641, 193: 640, 213: 639},
 		// 335
-		{187, 187, 187, 6: 187, 13: 563, 162: 564, 562},
-		{15: 561},
-		{201, 201, 201, 6: 201, 13: 201, 51: 337, 336, 95: 335},
-		{199, 199, 199, 6: 199, 13: 199},
-		{202, 202, 202, 6: 202, 13: 202},
+		{75, 75, 75, 10: 75, 75, 75, 15: 75},
+		{115: 642},
+		{115: 92},
+		{86, 86, 86, 10: 86, 86, 86, 14: 86, 86, 46: 86, 48: 86, 86},
+		{1, 1, 1, 4: 559, 8: 560, 10: 1, 1, 1, 14: 1, 1, 46: 1, 48: 1, 1, 153: 643, 558},
 		// 340
-		{208, 208, 208, 6: 208},
-		{3: 315, 314, 312, 7: 280, 286, 15: 271, 288, 289, 290, 291, 292, 293, 294, 295, 297, 298, 296, 28: 300, 301, 302, 303, 299, 35: 304, 305, 306, 308, 309, 310, 311, 307, 270, 273, 274, 275, 278, 276, 272, 53: 313, 74: 265, 282, 277, 281, 283, 279, 81: 285, 88: 284, 269, 91: 287, 268, 266, 565},
-		{186, 186, 186, 6: 186},
-		{188, 188, 188, 6: 188, 51: 337, 336, 95: 335},
-		{8: 260, 97: 549, 109: 567},
+		{101, 101, 101, 101, 647, 10: 101, 101, 101, 14: 101, 101, 46: 101, 48: 101, 101, 108: 101, 173: 646, 207: 645, 644},
+		{4: 648},
+		{4: 330, 312, 311, 309, 318, 331, 16: 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 43: 323, 329, 321, 47: 327, 50: 322, 325, 326, 328, 316, 324, 57: 310, 70: 320, 85: 319, 314, 315, 313, 308, 317, 307, 94: 306, 101: 305, 303, 304, 302, 649},
+		{569, 2: 109, 157: 650},
+		{258, 258, 14: 258},
 		// 345
-		{2: 190, 6: 190, 148: 568},
-		{2: 2, 6: 553, 100: 569},
-		{2: 570},
-		{192, 192},
-		{8: 196, 110: 573, 160: 572},
+		{1, 1, 4: 512, 14: 1, 110: 511, 165: 651},
+		{259, 259, 3: 259, 14: 259, 58: 380, 379, 107: 377},
+		{184, 184, 184, 184, 10: 184, 184, 184, 184, 184, 184, 42: 184, 46: 184, 48: 184, 184, 58: 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184},
+		{185, 185, 185, 185, 10: 185, 185, 185, 185, 185, 185, 42: 185, 46: 185, 48: 185, 185, 58: 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185},
+		{2: 652},
 		// 350
-		{8: 576},
-		{50: 574},
-		{103: 575},
-		{8: 195},
-		{102: 577},
+		{181, 181, 181, 181, 5: 404, 403, 401, 10: 181, 181, 181, 181, 181, 181, 42: 181, 46: 181, 48: 181, 181, 58: 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 71: 402},
+		{67, 67, 67, 67, 5: 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 42: 67, 46: 67, 48: 67, 67, 56: 67, 58: 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 71: 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 92: 67, 67, 95: 67, 67, 67, 67, 67, 67},
+		{2: 190, 190, 58: 380, 379, 63: 190, 107: 377},
+		{260, 260, 260, 260, 5: 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 42: 260, 46: 260, 48: 260, 260, 56: 260, 58: 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 71: 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 92: 260, 260, 95: 260, 260, 260, 260, 260, 260},
+		{233, 233, 233, 233, 330, 312, 311, 309, 318, 331, 13: 233, 16: 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 43: 323, 329, 321, 47: 327, 50: 322, 325, 326, 328, 316, 324, 624, 310, 70: 320, 85: 319, 314, 315, 313, 308, 317, 307, 94: 306, 101: 305, 303, 304, 302, 625, 127: 623, 653},
 		// 355
-		{8: 578},
-		{7: 579},
-		{3: 315, 314, 312, 7: 280, 286, 15: 271, 288, 289, 290, 291, 292, 293, 294, 295, 297, 298, 296, 28: 300, 301, 302, 303, 299, 35: 304, 305, 306, 308, 309, 310, 311, 307, 270, 273, 274, 275, 278, 276, 272, 53: 313, 74: 265, 282, 277, 281, 283, 279, 81: 285, 88: 284, 269, 91: 287, 268, 266, 352, 101: 580},
-		{2: 581},
-		{197, 197},
+		{47: 654},
+		{16: 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 70: 655},
+		{215, 215, 215, 215, 13: 658, 129: 657, 656},
+		{232, 232, 232, 232, 13: 232},
+		{43: 659},
 		// 360
-		{213, 213},
-		{8: 254, 99: 584},
-		{106: 586, 144: 585},
-		{8: 260, 97: 549, 109: 589},
-		{156: 587},
+		{234, 234, 234, 234, 13: 234, 58: 380, 379, 107: 377},
+		{8: 660},
+		{8: 661},
+		{2: 2, 663, 111: 662},
+		{2: 203, 203},
 		// 365
-		{8: 260, 97: 588},
-		{218, 218},
-		{219, 219},
-		{1: 220, 51: 337, 336, 95: 335},
-		{178, 178, 96: 235, 98: 248, 106: 231, 115: 226, 237, 227, 238, 228, 239, 229, 240, 241, 242, 230, 243, 244, 236, 232, 245, 233, 246, 135: 234, 247, 138: 592, 252, 249, 253, 250},
+		{2: 664},
+		{2: 1, 4: 512, 110: 532, 125: 665},
+		{4: 512, 110: 532, 125: 666},
+		{2: 667},
+		{109: 296, 112: 668},
 		// 370
-		{46, 46},
+		{2: 669},
+		{3: 670},
+		{239, 239, 239, 10: 239, 239, 239},
+		{1, 1, 1, 4: 512, 10: 1, 1, 1, 110: 671},
+		{72, 72, 72, 10: 72, 72, 674, 202: 673, 216: 672},
+		// 375
+		{73, 73, 73, 10: 73, 73, 73},
+		{166: 675},
+		{4: 559, 8: 560, 153: 676, 558},
+		{98, 98, 98, 98, 10: 98, 98, 98, 14: 98, 98, 46: 98, 48: 98, 98},
+		{113, 113, 113, 113, 10: 113, 113, 113, 14: 113, 113, 46: 113, 48: 113, 113, 108: 113},
+		// 380
+		{100, 100, 100, 100, 647, 10: 100, 100, 100, 14: 100, 100, 46: 100, 48: 100, 100, 108: 100, 173: 677},
+		{103, 103, 103, 103, 103, 10: 103, 103, 103, 14: 103, 103, 46: 103, 48: 103, 103, 108: 103},
+		{115: 679, 118: 678},
+		{106, 106, 106, 106, 106, 10: 106, 106, 106, 14: 106, 106, 46: 106, 48: 106, 106, 108: 106},
+		{2: 680, 58: 380, 379, 107: 377},
+		// 385
+		{2: 681},
+		{256, 256, 3: 256, 14: 256},
+		{183, 183, 183, 183, 10: 183, 183, 183, 183, 183, 183, 42: 183, 46: 183, 48: 183, 183, 58: 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183},
+		{215, 215, 215, 215, 13: 658, 129: 657, 682},
+		{3: 683},
+		// 390
+		{233, 233, 233, 233, 330, 312, 311, 309, 318, 331, 13: 233, 16: 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 43: 323, 329, 321, 47: 327, 50: 322, 325, 326, 328, 316, 324, 624, 310, 70: 320, 85: 319, 314, 315, 313, 308, 317, 307, 94: 306, 101: 305, 303, 304, 302, 625, 127: 623, 684},
+		{241, 241, 241, 241},
+		{214, 214, 214, 214},
+		{4: 330, 312, 311, 309, 318, 331, 16: 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 43: 323, 329, 321, 47: 327, 50: 322, 325, 326, 328, 316, 324, 57: 310, 70: 320, 85: 319, 314, 315, 313, 308, 317, 307, 94: 306, 101: 305, 303, 304, 302, 685},
+		{235, 235, 235, 235, 13: 235},
+		// 395
+		{4: 330, 312, 311, 309, 318, 331, 16: 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 43: 323, 329, 321, 47: 327, 50: 322, 325, 326, 328, 316, 324, 57: 310, 70: 320, 85: 319, 314, 315, 313, 308, 317, 307, 94: 306, 101: 305, 303, 304, 302, 301, 124: 687, 167: 686},
+		{4: 512, 110: 549, 126: 688},
+		{2: 689},
+		{2: 1, 44: 690},
+		{221, 221},
+		// 400
+		{2: 218, 218},
+		{2: 219, 219, 170: 691},
+		{6, 6, 6, 10: 6, 6, 6, 15: 6},
+		{2: 692},
+		{154, 154, 3: 154, 196: 693},
+		// 405
+		{4: 512, 110: 549, 126: 694},
+		{237, 237, 237, 237, 10: 237, 237, 237},
+		{85, 85, 85, 10: 85, 696, 214: 695},
+		{71, 71, 71, 10: 71, 71},
+		{166: 697},
+		// 410
+		{4: 512, 110: 549, 126: 698},
+		{108: 699},
+		{102, 102, 102, 102, 102, 10: 102, 102, 102, 14: 102, 102, 46: 102, 48: 102, 102, 108: 102},
+		{8: 700},
+		{12: 701},
+		// 415
+		{110, 110, 110, 110, 110, 10: 110, 110, 110, 14: 110, 110, 46: 110, 48: 110, 110, 65: 110, 108: 110},
+		{111, 111, 111, 111, 111, 10: 111, 111, 111, 14: 111, 111, 46: 111, 48: 111, 111, 65: 111, 108: 111},
+		{244, 244, 244, 244},
+		{47: 702},
+		{215, 215, 215, 215, 13: 658, 129: 657, 703},
+		// 420
+		{216, 216, 216, 216, 58: 380, 379, 107: 377},
+		{2: 704},
+		{246, 246, 246, 246, 10: 246, 246, 61: 246, 246, 179: 705},
+		{2: 706},
+		{217, 217},
+		// 425
+		{2: 202, 202},
+		{2: 2, 631, 111: 707},
+		{5, 5, 5, 10: 5, 5, 5, 15: 5},
+		{2, 2, 3: 709, 111: 708},
+		{2: 710},
+		// 430
+		{83, 83, 83, 10: 712, 215: 711},
+		{4: 330, 312, 311, 309, 318, 331, 16: 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 43: 323, 329, 321, 47: 327, 50: 322, 325, 326, 328, 316, 324, 57: 310, 70: 320, 85: 319, 314, 315, 313, 308, 317, 307, 94: 306, 101: 305, 303, 304, 302, 713},
+		{4: 330, 312, 311, 309, 318, 331, 16: 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 43: 323, 329, 321, 47: 327, 50: 322, 325, 326, 328, 316, 324, 57: 310, 70: 320, 85: 319, 314, 315, 313, 308, 317, 307, 94: 306, 101: 305, 303, 304, 302, 301, 124: 687, 167: 714},
+		{163, 163, 163, 10: 163, 163, 163},
+		{4: 330, 312, 311, 309, 318, 331, 16: 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 43: 323, 329, 321, 47: 327, 50: 322, 325, 326, 328, 316, 324, 57: 310, 70: 320, 85: 319, 314, 315, 313, 308, 317, 307, 94: 306, 101: 305, 303, 304, 302, 715},
+		// 435
+		{4: 717, 194: 716},
+		{104, 104, 104, 104, 104, 10: 104, 104, 104, 14: 104, 104, 46: 104, 48: 104, 104, 108: 104},
+		{2: 718},
+		{242, 242, 242, 242},
+		{229, 229},
+		// 440
+		{2, 2, 2, 720, 10: 2, 2, 61: 2, 2, 111: 719},
+		{225, 225, 4: 722, 183: 721},
+		{2: 723},
+		{159, 159},
+		{1, 1, 8: 724},
+		// 445
+		{109: 155, 123: 155},
+		{88, 88, 88},
+		{4: 330, 312, 311, 309, 318, 331, 16: 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 43: 323, 329, 321, 47: 327, 50: 322, 325, 326, 328, 316, 324, 57: 310, 70: 320, 85: 319, 314, 315, 313, 308, 317, 307, 94: 306, 101: 305, 303, 304, 302, 725},
+		{84, 84, 84, 10: 84, 58: 380, 379, 107: 377},
+		{136, 136, 136, 10: 136, 136, 61: 727, 728, 203: 726},
+		// 450
+		{91, 91, 91, 10: 91, 91, 91, 14: 91, 91, 58: 380, 379, 107: 377},
+		{2: 729, 730},
+		{2: 161, 161},
+		{233, 233, 233, 233, 330, 312, 311, 309, 318, 331, 13: 233, 16: 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 43: 323, 329, 321, 47: 327, 50: 322, 325, 326, 328, 316, 324, 624, 310, 70: 320, 85: 319, 314, 315, 313, 308, 317, 307, 94: 306, 101: 305, 303, 304, 302, 625, 127: 623, 731},
+		{247, 247, 247, 10: 247, 247, 61: 247, 247},
+		// 455
+		{1, 1, 1, 4: 330, 312, 311, 309, 318, 331, 1, 1, 16: 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 43: 323, 329, 321, 47: 327, 50: 322, 325, 326, 328, 316, 324, 57: 310, 61: 1, 1, 70: 320, 85: 319, 314, 315, 313, 308, 317, 307, 94: 306, 101: 305, 303, 304, 302, 301, 124: 732},
+		{228, 228},
+		{4: 733},
+		{220, 220},
+		{4: 330, 312, 311, 309, 318, 331, 16: 332, 333, 334, 335, 336, 337, 338, 339, 341, 342, 340, 344, 345, 346, 347, 343, 348, 349, 350, 351, 353, 354, 355, 356, 352, 357, 43: 323, 329, 321, 47: 327, 50: 322, 325, 326, 328, 316, 324, 57: 310, 70: 320, 85: 319, 314, 315, 313, 308, 317, 307, 94: 306, 101: 305, 303, 304, 302, 482, 116: 734},
+		// 460
+		{82, 82, 82, 58: 380, 379, 107: 377},
+		{137, 137, 137, 10: 137, 137},
+		{135, 135, 135, 10: 135, 135},
+		{134, 134, 134, 10: 134, 134},
+		{105, 105, 105, 105, 105, 10: 105, 105, 105, 14: 105, 105, 46: 105, 48: 105, 105, 108: 105},
+		// 465
+		{4: 735},
+		{215, 215, 215, 215, 13: 658, 129: 657, 736},
+		{245, 245, 245, 245, 10: 245, 245, 61: 245, 245},
+		{224, 224},
+		{2: 737},
+		// 470
+		{2: 160, 160},
+		{243, 243, 243, 243},
+		{153, 153, 3: 153},
 	}
 )
 
@@ -1720,7 +2060,7 @@ func yylex1(yylex yyLexer, lval *yySymType) (n int) {
 }
 
 func yyParse(yylex yyLexer) int {
-	const yyError = 198
+	const yyError = 225
 
 	yyEx, _ := yylex.(yyLexerEx)
 	var yyn int
@@ -1910,90 +2250,236 @@ yynewstate:
 	switch r {
 	case 2:
 		{
+
 			yylex.(*lexer).expr = expr(yyS[yypt-0].item)
+
 		}
 	case 3:
 		{
+
 			yyVAL.item = &alterTableAddStmt{tableName: yyS[yypt-2].item.(string), c: yyS[yypt-0].item.(*col)}
+
 		}
 	case 4:
 		{
+
 			yyVAL.item = &alterTableDropColumnStmt{tableName: yyS[yypt-3].item.(string), colName: yyS[yypt-0].item.(string)}
+
 		}
 	case 5:
 		{
-			yyVAL.item = assignment{colName: yyS[yypt-2].item.(string), expr: expr(yyS[yypt-0].item)}
+
+			var err error
+			if yyVAL.item, err = newArrayLit(yyS[yypt-3].item.(int), yyS[yypt-1].item.([]expression)); err != nil {
+				yylex.(*lexer).err("%v", err)
+				return 1
+			}
+
 		}
 	case 6:
 		{
-			yyVAL.item = append([]assignment{yyS[yypt-2].item.(assignment)}, yyS[yypt-1].item.([]assignment)...)
+
+			yyVAL.item = assignment{colName: yyS[yypt-2].item.(string), expr: expr(yyS[yypt-0].item)}
+
 		}
 	case 7:
 		{
-			yyVAL.item = []assignment{}
+
+			yyVAL.item = append([]assignment{yyS[yypt-2].item.(assignment)}, yyS[yypt-1].item.([]assignment)...)
+
 		}
 	case 8:
 		{
-			yyVAL.item = append(yyS[yypt-2].item.([]assignment), yyS[yypt-0].item.(assignment))
+
+			yyVAL.item = []assignment{}
+
 		}
 	case 9:
 		{
-			yyVAL.item = beginTransactionStmt{}
+
+			yyVAL.item = append(yyS[yypt-2].item.([]assignment), yyS[yypt-0].item.(assignment))
+
 		}
 	case 10:
 		{
-			yyVAL.item = yyS[yypt-1].item
+
+			yyVAL.item = beginTransactionStmt{}
+
 		}
 	case 11:
 		{
-			yyVAL.item = '*'
+
+			yyVAL.item = yyS[yypt-1].item
+
 		}
 	case 12:
 		{
+
+			yyVAL.item = '*'
+
+		}
+	case 13:
+		{
+
 			yyVAL.item = []expression{}
+
+		}
+	case 15:
+		{
+
+			yyVAL.item = ""
+
+		}
+	case 16:
+		{
+
+			yyVAL.item = strings.ToLower(yyS[yypt-0].item.(string))
+
 		}
-	case 14:
+	case 17:
+		{
+
+			yyVAL.item = expr(yyS[yypt-1].item)
+			if s := yyS[yypt-0].item.(string); s != "" {
+				yyVAL.item = &collateExpr{expr: expr(yyS[yypt-1].item), name: s}
+			}
+
+		}
+	case 18:
+		{
+
+			yyVAL.item = append([]expression{yyS[yypt-2].item.(expression)}, yyS[yypt-1].item.([]expression)...)
+
+		}
+	case 19:
+		{
+
+			yyVAL.item = []expression(nil)
+
+		}
+	case 20:
+		{
+
+			yyVAL.item = append(yyS[yypt-2].item.([]expression), yyS[yypt-0].item.(expression))
+
+		}
+	case 21:
 		{
-			x := &col{name: yyS[yypt-3].item.(string), typ: yyS[yypt-2].item.(int), constraint: yyS[yypt-1].item.(*constraint)}
+
+			x := &col{name: yyS[yypt-4].item.(string), typ: yyS[yypt-3].item.(int), constraint: yyS[yypt-1].item.(*constraint)}
+			switch s := yyS[yypt-2].item.(string); {
+			case s == "" || s == "binary":
+				// nop
+			case x.typ != qString:
+				yylex.(*lexer).err("COLLATE %s: column %s is not a string", s, x.name)
+				return 1
+			default:
+				x.collation = s
+			}
 			if yyS[yypt-0].item != nil {
 				x.dflt = expr(yyS[yypt-0].item)
 			}
 			yyVAL.item = x
+
 		}
-	case 16:
+	case 22:
+		{
+
+			x := &col{name: yyS[yypt-8].item.(string), typ: qDecimal, constraint: yyS[yypt-1].item.(*constraint)}
+			var err error
+			if x.prec, x.scale, err = decimalParams(yyS[yypt-5].item, yyS[yypt-3].item); err != nil {
+				yylex.(*lexer).err("%v", err)
+				return 1
+			}
+
+			if yyS[yypt-0].item != nil {
+				x.dflt = expr(yyS[yypt-0].item)
+			}
+			yyVAL.item = x
+
+		}
+	case 23:
+		{
+
+			x := &col{name: yyS[yypt-5].item.(string), typ: qArray, elem: yyS[yypt-2].item.(int), constraint: yyS[yypt-1].item.(*constraint)}
+			if err := arrayElemType(x.elem); err != nil {
+				yylex.(*lexer).err("%v", err)
+				return 1
+			}
+
+			if yyS[yypt-0].item != nil {
+				x.dflt = expr(yyS[yypt-0].item)
+			}
+			yyVAL.item = x
+
+		}
+	case 24:
+		{
+
+			x := &col{name: yyS[yypt-3].item.(string), typ: qEnum, enum: &enumType{name: yyS[yypt-2].item.(string)}, constraint: yyS[yypt-1].item.(*constraint)}
+			if yyS[yypt-0].item != nil {
+				x.dflt = expr(yyS[yypt-0].item)
+			}
+			yyVAL.item = x
+
+		}
+	case 26:
 		{
+
 			yyVAL.item = append([]string{yyS[yypt-2].item.(string)}, yyS[yypt-1].item.([]string)...)
+
 		}
-	case 17:
+	case 27:
 		{
+
 			yyVAL.item = []string{}
+
 		}
-	case 18:
+	case 28:
 		{
+
 			yyVAL.item = append(yyS[yypt-2].item.([]string), yyS[yypt-0].item.(string))
+
 		}
-	case 19:
+	case 29:
 		{
+
 			yyVAL.item = commitStmt{}
+
 		}
-	case 20:
+	case 30:
 		{
+
 			yyVAL.item = &constraint{}
+
 		}
-	case 21:
+	case 31:
 		{
+
 			yyVAL.item = &constraint{expr(yyS[yypt-0].item)}
+
 		}
-	case 22:
+	case 32:
 		{
+
 			yyVAL.item = (*constraint)(nil)
+
 		}
-	case 24:
+	case 34:
 		{
+
 			yyVAL.item = &conversion{typ: yyS[yypt-3].item.(int), val: expr(yyS[yypt-1].item)}
+
+		}
+	case 35:
+		{
+
+			yyVAL.item = &conversion{typ: qDecimal, val: expr(yyS[yypt-1].item)}
+
 		}
-	case 25:
+	case 36:
 		{
+
 			indexName, tableName, exprList := yyS[yypt-5].item.(string), yyS[yypt-3].item.(string), yyS[yypt-1].item.([]expression)
 			simpleIndex := len(exprList) == 1
 			var columnName string
@@ -2032,39 +2518,117 @@ yynewstate:
 				yylex.(*lexer).err("name is used for system tables: %s", indexName)
 				return 1
 			}
+
 		}
-	case 26:
-		{
-			yyVAL.item = false
-		}
-	case 27:
-		{
-			yyVAL.item = true
-		}
-	case 28:
-		{
-			yyVAL.item = false
-		}
-	case 29:
-		{
-			yyVAL.item = true
-		}
-	case 30:
+	case 37:
 		{
-			nm := yyS[yypt-5].item.(string)
-			yyVAL.item = &createTableStmt{tableName: nm, cols: append([]*col{yyS[yypt-3].item.(*col)}, yyS[yypt-2].item.([]*col)...)}
 
-			if yylex.(*lexer).root {
-				break
+			var spatial bool
+			tokenizer := yyS[yypt-0].item.(string)
+			switch s := yyS[yypt-9].item.(string); {
+			case strings.EqualFold(s, "fulltext"):
+				if tokenizer == "" {
+					tokenizer = "simple"
+				}
+			case strings.EqualFold(s, "spatial"):
+				if tokenizer != "" {
+					yylex.(*lexer).err("syntax error: unexpected USING")
+					return 1
+				}
+
+				spatial = true
+			default:
+				yylex.(*lexer).err("syntax error: unexpected %s, expecting FULLTEXT or SPATIAL", s)
+				return 1
+			}
+
+			indexName, tableName := yyS[yypt-6].item.(string), yyS[yypt-4].item.(string)
+			var exprList []expression
+			for _, v := range yyS[yypt-2].item.([]string) {
+				if v == indexName {
+					yylex.(*lexer).err("index name collision: %s", indexName)
+					return 1
+				}
+
+				exprList = append(exprList, &ident{v})
+			}
+			yyVAL.item = &createIndexStmt{ifNotExists: yyS[yypt-7].item.(bool), indexName: indexName, tableName: tableName, exprList: exprList, tokenizer: tokenizer, spatial: spatial}
+
+			if indexName == tableName {
+				yylex.(*lexer).err("index name collision: %s", indexName)
+				return 1
+			}
+
+			if yylex.(*lexer).root {
+				break
+			}
+
+			if isSystemName[indexName] || isSystemName[tableName] {
+				yylex.(*lexer).err("name is used for system tables: %s", indexName)
+				return 1
+			}
+
+		}
+	case 38:
+		{
+
+			yyVAL.item = false
+
+		}
+	case 39:
+		{
+
+			yyVAL.item = true
+
+		}
+	case 40:
+		{
+
+			yyVAL.item = ""
+
+		}
+	case 41:
+		{
+
+			if !strings.EqualFold(yyS[yypt-1].item.(string), "using") {
+				yylex.(*lexer).err("syntax error: unexpected %s, expecting USING", yyS[yypt-1].item)
+				return 1
+			}
+
+			yyVAL.item = yyS[yypt-0].item
+
+		}
+	case 42:
+		{
+
+			yyVAL.item = false
+
+		}
+	case 43:
+		{
+
+			yyVAL.item = true
+
+		}
+	case 44:
+		{
+
+			nm := yyS[yypt-5].item.(string)
+			yyVAL.item = &createTableStmt{tableName: nm, cols: append([]*col{yyS[yypt-3].item.(*col)}, yyS[yypt-2].item.([]*col)...)}
+
+			if yylex.(*lexer).root {
+				break
 			}
 
 			if isSystemName[nm] {
 				yylex.(*lexer).err("name is used for system tables: %s", nm)
 				return 1
 			}
+
 		}
-	case 31:
+	case 45:
 		{
+
 			nm := yyS[yypt-5].item.(string)
 			yyVAL.item = &createTableStmt{ifNotExists: true, tableName: nm, cols: append([]*col{yyS[yypt-3].item.(*col)}, yyS[yypt-2].item.([]*col)...)}
 
@@ -2076,25 +2640,51 @@ yynewstate:
 				yylex.(*lexer).err("name is used for system tables: %s", nm)
 				return 1
 			}
+
 		}
-	case 32:
+	case 46:
 		{
+
 			yyVAL.item = []*col{}
+
 		}
-	case 33:
+	case 47:
 		{
+
 			yyVAL.item = append(yyS[yypt-2].item.([]*col), yyS[yypt-0].item.(*col))
+
 		}
-	case 34:
+	case 48:
+		{
+
+			if !strings.EqualFold(yyS[yypt-7].item.(string), "type") {
+				yylex.(*lexer).err("syntax error: unexpected %s, expecting TYPE", yyS[yypt-7].item)
+				return 1
+			}
+
+			if !strings.EqualFold(yyS[yypt-4].item.(string), "enum") {
+				yylex.(*lexer).err("syntax error: unexpected %s, expecting ENUM", yyS[yypt-4].item)
+				return 1
+			}
+
+			yyVAL.item = &createTypeStmt{typeName: yyS[yypt-6].item.(string), labels: yyS[yypt-2].item.([]string)}
+
+		}
+	case 49:
 		{
+
 			yyVAL.item = yyS[yypt-0].item
+
 		}
-	case 35:
+	case 50:
 		{
+
 			yyVAL.item = nil
+
 		}
-	case 37:
+	case 52:
 		{
+
 			yyVAL.item = &truncateTableStmt{yyS[yypt-0].item.(string)}
 
 			if yylex.(*lexer).root {
@@ -2105,9 +2695,11 @@ yynewstate:
 				yylex.(*lexer).err("name is used for system tables: %s", yyS[yypt-0].item.(string))
 				return 1
 			}
+
 		}
-	case 38:
+	case 53:
 		{
+
 			yyVAL.item = &deleteStmt{tableName: yyS[yypt-1].item.(string), where: yyS[yypt-0].item.(*whereRset).expr}
 
 			if yylex.(*lexer).root {
@@ -2118,21 +2710,29 @@ yynewstate:
 				yylex.(*lexer).err("name is used for system tables: %s", yyS[yypt-1].item.(string))
 				return 1
 			}
+
 		}
-	case 39:
+	case 54:
 		{
+
 			yyVAL.item = &dropIndexStmt{ifExists: yyS[yypt-1].item.(bool), indexName: yyS[yypt-0].item.(string)}
+
 		}
-	case 40:
+	case 55:
 		{
+
 			yyVAL.item = false
+
 		}
-	case 41:
+	case 56:
 		{
+
 			yyVAL.item = true
+
 		}
-	case 42:
+	case 57:
 		{
+
 			nm := yyS[yypt-0].item.(string)
 			yyVAL.item = &dropTableStmt{tableName: nm}
 
@@ -2144,9 +2744,11 @@ yynewstate:
 				yylex.(*lexer).err("name is used for system tables: %s", nm)
 				return 1
 			}
+
 		}
-	case 43:
+	case 58:
 		{
+
 			nm := yyS[yypt-0].item.(string)
 			yyVAL.item = &dropTableStmt{ifExists: true, tableName: nm}
 
@@ -2158,129 +2760,249 @@ yynewstate:
 				yylex.(*lexer).err("name is used for system tables: %s", nm)
 				return 1
 			}
+
 		}
-	case 44:
+	case 59:
 		{
+
+			if !strings.EqualFold(yyS[yypt-1].item.(string), "type") {
+				yylex.(*lexer).err("syntax error: unexpected %s, expecting TYPE", yyS[yypt-1].item)
+				return 1
+			}
+
+			yyVAL.item = &dropTypeStmt{typeName: yyS[yypt-0].item.(string)}
+
+		}
+	case 60:
+		{
+
+			if !strings.EqualFold(yyS[yypt-3].item.(string), "type") {
+				yylex.(*lexer).err("syntax error: unexpected %s, expecting TYPE", yyS[yypt-3].item)
+				return 1
+			}
+
+			yyVAL.item = &dropTypeStmt{ifExists: true, typeName: yyS[yypt-0].item.(string)}
+
+		}
+	case 61:
+		{
+
 			yyVAL.item = nil
+
 		}
-	case 45:
+	case 62:
+		{
+
+			yyVAL.item = []string{yyS[yypt-0].item.(string)}
+
+		}
+	case 63:
+		{
+
+			yyVAL.item = append(yyS[yypt-2].item.([]string), yyS[yypt-0].item.(string))
+
+		}
+	case 64:
+		{
+
+			yyVAL.item = ""
+
+		}
+	case 65:
+		{
+
+			yyVAL.item = yyS[yypt-0].item
+
+		}
+	case 66:
 		{
+
 			yyVAL.item = &explainStmt{yyS[yypt-0].item.(stmt)}
+
 		}
-	case 47:
+	case 68:
 		{
+
 			var err error
 			if yyVAL.item, err = newBinaryOperation(oror, yyS[yypt-2].item, yyS[yypt-0].item); err != nil {
 				yylex.(*lexer).err("%v", err)
 				return 1
 			}
+
 		}
-	case 52:
+	case 73:
 		{
+
 			yyVAL.item = append([]expression{expr(yyS[yypt-2].item)}, yyS[yypt-1].item.([]expression)...)
+
 		}
-	case 53:
+	case 74:
 		{
+
 			yyVAL.item = []expression(nil)
+
 		}
-	case 54:
+	case 75:
 		{
+
 			yyVAL.item = append(yyS[yypt-2].item.([]expression), expr(yyS[yypt-0].item))
+
 		}
-	case 56:
+	case 76:
+		{
+
+			yyVAL.item = []expression(nil)
+
+		}
+	case 79:
 		{
+
 			yyVAL.item = &pIn{expr: yyS[yypt-4].item.(expression), list: yyS[yypt-1].item.([]expression)}
+
 		}
-	case 57:
+	case 80:
 		{
+
 			yyVAL.item = &pIn{expr: yyS[yypt-5].item.(expression), not: true, list: yyS[yypt-1].item.([]expression)}
+
 		}
-	case 58:
+	case 81:
 		{
+
 			yyVAL.item = &pIn{expr: yyS[yypt-5].item.(expression), sel: yyS[yypt-2].item.(*selectStmt)}
+
 		}
-	case 59:
+	case 82:
 		{
+
 			yyVAL.item = &pIn{expr: yyS[yypt-6].item.(expression), not: true, sel: yyS[yypt-2].item.(*selectStmt)}
+
 		}
-	case 60:
+	case 83:
 		{
+
 			var err error
 			if yyVAL.item, err = newBetween(yyS[yypt-4].item, yyS[yypt-2].item, yyS[yypt-0].item, false); err != nil {
 				yylex.(*lexer).err("%v", err)
 				return 1
 			}
+
 		}
-	case 61:
+	case 84:
 		{
+
 			var err error
 			if yyVAL.item, err = newBetween(yyS[yypt-5].item, yyS[yypt-2].item, yyS[yypt-0].item, true); err != nil {
 				yylex.(*lexer).err("%v", err)
 				return 1
 			}
+
 		}
-	case 62:
+	case 85:
 		{
+
 			yyVAL.item = &isNull{expr: yyS[yypt-2].item.(expression)}
+
 		}
-	case 63:
+	case 86:
 		{
+
 			yyVAL.item = &isNull{expr: yyS[yypt-3].item.(expression), not: true}
+
 		}
-	case 65:
+	case 88:
 		{
+
 			var err error
 			if yyVAL.item, err = newBinaryOperation(ge, yyS[yypt-2].item, yyS[yypt-0].item); err != nil {
 				yylex.(*lexer).err("%v", err)
 				return 1
 			}
+
 		}
-	case 66:
+	case 89:
 		{
+
 			var err error
 			if yyVAL.item, err = newBinaryOperation('>', yyS[yypt-2].item, yyS[yypt-0].item); err != nil {
 				yylex.(*lexer).err("%v", err)
 				return 1
 			}
+
 		}
-	case 67:
+	case 90:
 		{
+
 			var err error
 			if yyVAL.item, err = newBinaryOperation(le, yyS[yypt-2].item, yyS[yypt-0].item); err != nil {
 				yylex.(*lexer).err("%v", err)
 				return 1
 			}
+
 		}
-	case 68:
+	case 91:
 		{
+
 			var err error
 			if yyVAL.item, err = newBinaryOperation('<', yyS[yypt-2].item, yyS[yypt-0].item); err != nil {
 				yylex.(*lexer).err("%v", err)
 				return 1
 			}
+
 		}
-	case 69:
+	case 92:
 		{
+
 			var err error
 			if yyVAL.item, err = newBinaryOperation(neq, yyS[yypt-2].item, yyS[yypt-0].item); err != nil {
 				yylex.(*lexer).err("%v", err)
 				return 1
 			}
+
 		}
-	case 70:
+	case 93:
 		{
+
 			var err error
 			if yyVAL.item, err = newBinaryOperation(eq, yyS[yypt-2].item, yyS[yypt-0].item); err != nil {
 				yylex.(*lexer).err("%v", err)
 				return 1
 			}
+
 		}
-	case 71:
+	case 94:
 		{
-			yyVAL.item = &pLike{expr: yyS[yypt-2].item.(expression), pattern: yyS[yypt-0].item.(expression)}
+
+			var err error
+			if yyVAL.item, err = newLike(yyS[yypt-3].item.(expression), yyS[yypt-1].item.(expression), yyS[yypt-2].item.(string), yyS[yypt-0].item.(string)); err != nil {
+				yylex.(*lexer).err("%v", err)
+				return 1
+			}
+
 		}
-	case 72:
+	case 95:
 		{
+
+			var err error
+			if yyVAL.item, err = newLike(yyS[yypt-2].item.(expression), yyS[yypt-0].item.(expression), "MATCHES", ""); err != nil {
+				yylex.(*lexer).err("%v", err)
+				return 1
+			}
+
+		}
+	case 96:
+		{
+
+			var err error
+			if yyVAL.item, err = newLike(yyS[yypt-2].item.(expression), yyS[yypt-0].item.(expression), "REGEXP", ""); err != nil {
+				yylex.(*lexer).err("%v", err)
+				return 1
+			}
+
+		}
+	case 97:
+		{
+
 			expr, name := expr(yyS[yypt-1].item), yyS[yypt-0].item.(string)
 			if name == "" {
 				s, ok := expr.(*ident)
@@ -2289,21 +3011,29 @@ yynewstate:
 				}
 			}
 			yyVAL.item = &fld{expr: expr, name: name}
+
 		}
-	case 73:
+	case 98:
 		{
+
 			yyVAL.item = ""
+
 		}
-	case 74:
+	case 99:
 		{
+
 			yyVAL.item = yyS[yypt-0].item
+
 		}
-	case 75:
+	case 100:
 		{
+
 			yyVAL.item = []*fld{yyS[yypt-0].item.(*fld)}
+
 		}
-	case 76:
+	case 101:
 		{
+
 			l, f := yyS[yypt-2].item.([]*fld), yyS[yypt-0].item.(*fld)
 			if f.name != "" {
 				if f := findFld(l, f.name); f != nil {
@@ -2313,17 +3043,35 @@ yynewstate:
 			}
 
 			yyVAL.item = append(yyS[yypt-2].item.([]*fld), yyS[yypt-0].item.(*fld))
+
 		}
-	case 77:
+	case 102:
 		{
+
 			yyVAL.item = &groupByRset{colNames: yyS[yypt-0].item.([]string)}
+
 		}
-	case 78:
+	case 103:
 		{
+
 			yyVAL.item = yyS[yypt-1].item
+
 		}
-	case 79:
+	case 104:
+		{
+
+			yyVAL.item = []string{yyS[yypt-0].item.(string)}
+
+		}
+	case 105:
 		{
+
+			yyVAL.item = append(yyS[yypt-2].item.([]string), yyS[yypt-0].item.(string))
+
+		}
+	case 106:
+		{
+
 			yyVAL.item = &insertIntoStmt{tableName: yyS[yypt-7].item.(string), colNames: yyS[yypt-6].item.([]string), lists: append([][]expression{yyS[yypt-3].item.([]expression)}, yyS[yypt-1].item.([][]expression)...)}
 
 			if yylex.(*lexer).root {
@@ -2334,33 +3082,70 @@ yynewstate:
 				yylex.(*lexer).err("name is used for system tables: %s", yyS[yypt-7].item.(string))
 				return 1
 			}
+
 		}
-	case 80:
+	case 107:
 		{
+
 			yyVAL.item = &insertIntoStmt{tableName: yyS[yypt-2].item.(string), colNames: yyS[yypt-1].item.([]string), sel: yyS[yypt-0].item.(*selectStmt)}
+
 		}
-	case 81:
+	case 108:
 		{
+
 			yyVAL.item = []string{}
+
 		}
-	case 82:
+	case 109:
 		{
+
 			yyVAL.item = yyS[yypt-1].item
+
 		}
-	case 83:
+	case 110:
+		{
+
+			if yyS[yypt-5].item.(string) != "id" {
+				yylex.(*lexer).err("expected column name or id(), have %s()", yyS[yypt-5].item.(string))
+				return 1
+			}
+
+			yyVAL.item = append([]string{"id()"}, yyS[yypt-1].item.([]string)...)
+
+		}
+	case 111:
+		{
+
+			yyVAL.item = [][]expression{}
+
+		}
+	case 112:
+		{
+
+			yyVAL.item = append(yyS[yypt-4].item.([][]expression), yyS[yypt-1].item.([]expression))
+
+		}
+	case 113:
 		{
-			yyVAL.item = [][]expression{}
+
+			yyVAL.item = "LIKE"
+
 		}
-	case 84:
+	case 114:
 		{
-			yyVAL.item = append(yyS[yypt-4].item.([][]expression), yyS[yypt-1].item.([]expression))
+
+			yyVAL.item = "ILIKE"
+
 		}
-	case 92:
+	case 123:
 		{
+
 			yyVAL.item = value{yyS[yypt-0].item}
+
 		}
-	case 93:
+	case 124:
 		{
+
 			n := yyS[yypt-0].item.(int)
 			yyVAL.item = parameter{n}
 			l := yylex.(*lexer)
@@ -2369,50 +3154,68 @@ yynewstate:
 				l.err("parameter number must be non zero")
 				return 1
 			}
+
 		}
-	case 94:
+	case 125:
 		{
+
 			yyVAL.item = &ident{yyS[yypt-0].item.(string)}
+
 		}
-	case 95:
+	case 126:
 		{
+
 			yyVAL.item = &pexpr{expr: expr(yyS[yypt-1].item)}
+
 		}
-	case 96:
+	case 128:
 		{
+
 			yyVAL.item = &orderByRset{by: yyS[yypt-1].item.([]expression), asc: yyS[yypt-0].item.(bool)}
+
 		}
-	case 97:
+	case 129:
 		{
+
 			yyVAL.item = true // ASC by default
+
 		}
-	case 98:
+	case 130:
 		{
+
 			yyVAL.item = true
+
 		}
-	case 99:
+	case 131:
 		{
+
 			yyVAL.item = false
+
 		}
-	case 102:
+	case 134:
 		{
+
 			var err error
 			if yyVAL.item, err = newIndex(yyS[yypt-1].item.(expression), expr(yyS[yypt-0].item)); err != nil {
 				yylex.(*lexer).err("%v", err)
 				return 1
 			}
+
 		}
-	case 103:
+	case 135:
 		{
+
 			var err error
 			s := yyS[yypt-0].item.([2]*expression)
 			if yyVAL.item, err = newSlice(yyS[yypt-1].item.(expression), s[0], s[1]); err != nil {
 				yylex.(*lexer).err("%v", err)
 				return 1
 			}
+
 		}
-	case 104:
+	case 136:
 		{
+
 			x := yylex.(*lexer)
 			f, ok := yyS[yypt-1].item.(*ident)
 			if !ok {
@@ -2438,161 +3241,269 @@ yynewstate:
 			if n := len(x.agg); n > 0 {
 				x.agg[n-1] = x.agg[n-1] || agg
 			}
+
 		}
-	case 106:
+	case 138:
 		{
+
 			var err error
 			if yyVAL.item, err = newBinaryOperation('^', yyS[yypt-2].item, yyS[yypt-0].item); err != nil {
 				yylex.(*lexer).err("%v", err)
 				return 1
 			}
+
 		}
-	case 107:
+	case 139:
 		{
+
 			var err error
 			if yyVAL.item, err = newBinaryOperation('|', yyS[yypt-2].item, yyS[yypt-0].item); err != nil {
 				yylex.(*lexer).err("%v", err)
 				return 1
 			}
+
 		}
-	case 108:
+	case 140:
 		{
+
 			var err error
 			if yyVAL.item, err = newBinaryOperation('-', yyS[yypt-2].item, yyS[yypt-0].item); err != nil {
 				yylex.(*lexer).err("%v", err)
 				return 1
 			}
+
 		}
-	case 109:
+	case 141:
 		{
+
 			var err error
 			yyVAL.item, err = newBinaryOperation('+', yyS[yypt-2].item, yyS[yypt-0].item)
 			if err != nil {
 				yylex.(*lexer).err("%v", err)
 				return 1
 			}
+
 		}
-	case 111:
+	case 143:
 		{
+
 			var err error
 			yyVAL.item, err = newBinaryOperation(andnot, yyS[yypt-2].item, yyS[yypt-0].item)
 			if err != nil {
 				yylex.(*lexer).err("%v", err)
 				return 1
 			}
+
 		}
-	case 112:
+	case 144:
 		{
+
 			var err error
 			yyVAL.item, err = newBinaryOperation('&', yyS[yypt-2].item, yyS[yypt-0].item)
 			if err != nil {
 				yylex.(*lexer).err("%v", err)
 				return 1
 			}
+
 		}
-	case 113:
+	case 145:
 		{
+
 			var err error
 			yyVAL.item, err = newBinaryOperation(lsh, yyS[yypt-2].item, yyS[yypt-0].item)
 			if err != nil {
 				yylex.(*lexer).err("%v", err)
 				return 1
 			}
+
 		}
-	case 114:
+	case 146:
 		{
+
 			var err error
 			yyVAL.item, err = newBinaryOperation(rsh, yyS[yypt-2].item, yyS[yypt-0].item)
 			if err != nil {
 				yylex.(*lexer).err("%v", err)
 				return 1
 			}
+
 		}
-	case 115:
+	case 147:
 		{
+
 			var err error
 			yyVAL.item, err = newBinaryOperation('%', yyS[yypt-2].item, yyS[yypt-0].item)
 			if err != nil {
 				yylex.(*lexer).err("%v", err)
 				return 1
 			}
+
 		}
-	case 116:
+	case 148:
 		{
+
 			var err error
 			yyVAL.item, err = newBinaryOperation('/', yyS[yypt-2].item, yyS[yypt-0].item)
 			if err != nil {
 				yylex.(*lexer).err("%v", err)
 				return 1
 			}
+
 		}
-	case 117:
+	case 149:
 		{
+
 			var err error
 			yyVAL.item, err = newBinaryOperation('*', yyS[yypt-2].item, yyS[yypt-0].item)
 			if err != nil {
 				yylex.(*lexer).err("%v", err)
 				return 1
 			}
+
 		}
-	case 119:
+	case 151:
 		{
+
 			yyVAL.item = fmt.Sprintf("%s.%s", yyS[yypt-2].item.(string), yyS[yypt-0].item.(string))
+
 		}
-	case 120:
+	case 152:
 		{
-			yyVAL.item = []interface{}{yyS[yypt-1].item, yyS[yypt-0].item}
+
+			yyVAL.item = []interface{}{yyS[yypt-2].item, yyS[yypt-1].item, yyS[yypt-0].item}
+
 		}
-	case 122:
+	case 154:
 		{
+
 			yyVAL.item = yyS[yypt-2].item
+
 		}
-	case 125:
+	case 155:
+		{
+
+			if s := yyS[yypt-3].item.(string); s != "unnest" {
+				yylex.(*lexer).err("unknown table function %s", s)
+				return 1
+			}
+
+			yyVAL.item = &unnestRset{expr: expr(yyS[yypt-1].item)}
+
+		}
+	case 158:
 		{
+
 			yyVAL.item = ""
+
 		}
-	case 126:
+	case 159:
 		{
+
 			yyVAL.item = yyS[yypt-0].item
+
+		}
+	case 160:
+		{
+
+			var kind int
+			switch s := yyS[yypt-4].item.(string); strings.ToUpper(s) {
+			case "USE":
+				kind = useIndexHint
+			case "IGNORE":
+				kind = ignoreIndexHint
+			default:
+				yylex.(*lexer).err("unexpected %s, expected USE or IGNORE", s)
+				return 1
+			}
+			yyVAL.item = &recordSetHint{kind: kind, indices: yyS[yypt-1].item.([]string)}
+
+		}
+	case 161:
+		{
+
+			if s := yyS[yypt-2].item.(string); !strings.EqualFold(s, "FORCE") {
+				yylex.(*lexer).err("unexpected %s, expected FORCE", s)
+				return 1
+			}
+
+			yyVAL.item = &recordSetHint{kind: forceJoinOrderHint}
+
+		}
+	case 162:
+		{
+
+			yyVAL.item = []*recordSetHint{yyS[yypt-0].item.(*recordSetHint)}
+
+		}
+	case 163:
+		{
+
+			yyVAL.item = append(yyS[yypt-1].item.([]*recordSetHint), yyS[yypt-0].item.(*recordSetHint))
+
+		}
+	case 164:
+		{
+
+			yyVAL.item = []*recordSetHint(nil)
+
 		}
-	case 127:
+	case 166:
 		{
+
 			yyVAL.list = []interface{}{yyS[yypt-0].item}
+
 		}
-	case 128:
+	case 167:
 		{
+
 			yyVAL.list = append(yyS[yypt-2].list, yyS[yypt-0].item)
+
 		}
-	case 129:
+	case 168:
 		{
+
 			yyVAL.item = rollbackStmt{}
+
 		}
-	case 130:
+	case 169:
 		{
+
 			yyVAL.item = leftJoin
+
 		}
-	case 131:
+	case 170:
 		{
+
 			yyVAL.item = rightJoin
+
 		}
-	case 132:
+	case 171:
 		{
+
 			yyVAL.item = fullJoin
+
 		}
-	case 133:
+	case 172:
 		{
+
 			yyVAL.item = nil
+
 		}
-	case 135:
+	case 174:
 		{
+
 			yyVAL.item = []interface{}{yyS[yypt-5].item, yyS[yypt-2].item, yyS[yypt-0].item}
+
 		}
-	case 136:
+	case 175:
 		{
+
 			yyVAL.item = nil
+
 		}
-	case 138:
+	case 177:
 		{
+
 			x := yylex.(*lexer)
 			n := len(x.agg)
 			join := &joinRset{sources: yyS[yypt-6].list}
@@ -2602,7 +3513,7 @@ yynewstate:
 				join.sources = append(join.sources, o[1].([]interface{}))
 				join.on = o[2].(expression)
 			}
-			yyVAL.item = &selectStmt{
+			s := &selectStmt{
 				distinct:      yyS[yypt-8].item.(bool),
 				flds:          yyS[yypt-7].item.([]*fld),
 				from:          join,
@@ -2613,110 +3524,162 @@ yynewstate:
 				limit:         yyS[yypt-1].item.(*limitRset),
 				offset:        yyS[yypt-0].item.(*offsetRset),
 			}
+			if err := s.bindRank(); err != nil {
+				x.err("%v", err)
+				return 1
+			}
+
+			yyVAL.item = s
 			x.agg = x.agg[:n-1]
+
 		}
-	case 139:
+	case 178:
 		{
+
 			yyVAL.list = nil
+
 		}
-	case 140:
+	case 179:
 		{
+
 			yyVAL.list = yyS[yypt-1].list
+
 		}
-	case 141:
+	case 180:
 		{
+
 			yyVAL.item = (*limitRset)(nil)
+
 		}
-	case 142:
+	case 181:
 		{
+
 			yyVAL.item = &limitRset{expr: expr(yyS[yypt-0].item)}
+
 		}
-	case 143:
+	case 182:
 		{
+
 			yyVAL.item = (*offsetRset)(nil)
+
 		}
-	case 144:
+	case 183:
 		{
+
 			yyVAL.item = &offsetRset{expr: expr(yyS[yypt-0].item)}
+
 		}
-	case 145:
+	case 184:
 		{
+
 			yyVAL.item = false
+
 		}
-	case 146:
+	case 185:
 		{
+
 			yyVAL.item = true
+
 		}
-	case 147:
+	case 186:
 		{
+
 			yyVAL.item = []*fld{}
+
 		}
-	case 148:
+	case 187:
 		{
+
 			yyVAL.item = yyS[yypt-0].item
+
 		}
-	case 149:
+	case 188:
 		{
+
 			yyVAL.item = yyS[yypt-1].item
+
 		}
-	case 150:
+	case 189:
 		{
+
 			yyVAL.item = (*whereRset)(nil)
+
 		}
-	case 152:
+	case 191:
 		{
+
 			yyVAL.item = (*groupByRset)(nil)
+
 		}
-	case 154:
+	case 193:
 		{
+
 			yyVAL.item = (*orderByRset)(nil)
+
 		}
-	case 156:
+	case 195:
 		{
+
 			yyVAL.item = [2]*expression{nil, nil}
+
 		}
-	case 157:
+	case 196:
 		{
+
 			hi := expr(yyS[yypt-1].item)
 			yyVAL.item = [2]*expression{nil, &hi}
+
 		}
-	case 158:
+	case 197:
 		{
+
 			lo := expr(yyS[yypt-2].item)
 			yyVAL.item = [2]*expression{&lo, nil}
+
 		}
-	case 159:
+	case 198:
 		{
+
 			lo := expr(yyS[yypt-3].item)
 			hi := expr(yyS[yypt-1].item)
 			yyVAL.item = [2]*expression{&lo, &hi}
+
 		}
-	case 175:
+	case 216:
 		{
+
 			if yyS[yypt-0].item != nil {
 				yylex.(*lexer).list = []stmt{yyS[yypt-0].item.(stmt)}
 			}
+
 		}
-	case 176:
+	case 217:
 		{
+
 			if yyS[yypt-0].item != nil {
 				yylex.(*lexer).list = append(yylex.(*lexer).list, yyS[yypt-0].item.(stmt))
 			}
+
 		}
-	case 179:
+	case 220:
 		{
+
 			var err error
 			if yyVAL.item, err = newBinaryOperation(andand, yyS[yypt-2].item, yyS[yypt-0].item); err != nil {
 				yylex.(*lexer).err("%v", err)
 				return 1
 			}
+
 		}
-	case 182:
+	case 223:
 		{
+
 			yyVAL.item = &truncateTableStmt{tableName: yyS[yypt-0].item.(string)}
+
 		}
-	case 207:
+	case 250:
 		{
+
 			var expr expression
 			if w := yyS[yypt-0].item; w != nil {
 				expr = w.(*whereRset).expr
@@ -2731,58 +3694,75 @@ yynewstate:
 				yylex.(*lexer).err("name is used for system tables: %s", yyS[yypt-3].item.(string))
 				return 1
 			}
+
 		}
-	case 208:
+	case 251:
 		{
+
 			yyVAL.item = nil
+
 		}
-	case 211:
+	case 254:
 		{
+
 			var err error
 			yyVAL.item, err = newUnaryOperation('^', yyS[yypt-0].item)
 			if err != nil {
 				yylex.(*lexer).err("%v", err)
 				return 1
 			}
+
 		}
-	case 212:
+	case 255:
 		{
+
 			var err error
 			yyVAL.item, err = newUnaryOperation('!', yyS[yypt-0].item)
 			if err != nil {
 				yylex.(*lexer).err("%v", err)
 				return 1
 			}
+
 		}
-	case 213:
+	case 256:
 		{
+
 			var err error
 			yyVAL.item, err = newUnaryOperation('-', yyS[yypt-0].item)
 			if err != nil {
 				yylex.(*lexer).err("%v", err)
 				return 1
 			}
+
 		}
-	case 214:
+	case 257:
 		{
+
 			var err error
 			yyVAL.item, err = newUnaryOperation('+', yyS[yypt-0].item)
 			if err != nil {
 				yylex.(*lexer).err("%v", err)
 				return 1
 			}
+
 		}
-	case 215:
+	case 258:
 		{
+
 			yyVAL.item = &whereRset{expr: expr(yyS[yypt-0].item)}
+
 		}
-	case 216:
+	case 259:
 		{
+
 			yyVAL.item = &whereRset{exists: true, sel: (yyS[yypt-1].item.(*selectStmt))}
+
 		}
-	case 217:
+	case 260:
 		{
+
 			yyVAL.item = &whereRset{sel: (yyS[yypt-1].item.(*selectStmt))}
+
 		}
 
 	}
@@ -14,6 +14,7 @@ package ql
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/cznic/mathutil"
 )
@@ -33,6 +34,7 @@ import (
 	/*yy:token "%c"     */	identifier	"identifier"
 	/*yy:token "%di"    */	imaginaryLit	"imaginary literal"
 	/*yy:token "%d"     */	intLit		"integer literal"
+	/*yy:token "INTERVAL '%ds'" */	intervalLit	"interval literal"
 	/*yy:token "$%d"    */	qlParam		"QL parameter"
 	/*yy:token "\"%c\"" */	stringLit	"string literal"
 
@@ -51,18 +53,21 @@ import (
 	boolType	"bool"
 	by		"BY"
 	byteType	"byte"
+	collateKwd	"COLLATE"
 	column		"COLUMN"
 	commit		"COMMIT"
 	complex128Type	"complex128"
 	complex64Type	"complex64"
 	create		"CREATE"
 	defaultKwd	"DEFAULT"
+	decimalType	"decimal"
 	deleteKwd	"DELETE"
 	desc		"DESC"
 	distinct	"DISTINCT"
 	drop		"DROP"
 	durationType	"duration"
 	eq		"=="
+	escape		"ESCAPE"
 	exists		"EXISTS"
 	explain		"EXPLAIN"
 	falseKwd	"false"
@@ -74,6 +79,7 @@ import (
 	ge		">="
 	group		"GROUP"
 	ifKwd		"IF"
+	ilike		"ILIKE"
 	in		"IN"
 	index		"INDEX"
 	insert		"INSERT"
@@ -85,11 +91,13 @@ import (
 	into		"INTO"
 	is		"IS"
 	join		"JOIN"
+	jsonType	"json"
 	le		"<="
 	left		"LEFT"
 	like		"LIKE"
 	limit		"LIMIT"
 	lsh 		"<<"
+	matches		"MATCHES"
 	neq		"!="
 	not		"NOT"
 	null		"NULL"
@@ -99,6 +107,7 @@ import (
 	order		"ORDER"
 	oror		"||"
 	outer		"OUTER"
+	regexpKwd	"REGEXP"
 	right		"RIGHT"
 	rollback	"ROLLBACK"
 	rsh		">>"
@@ -118,6 +127,7 @@ import (
 	uint8Type	"uint8",
 	unique		"UNIQUE"
 	update		"UPDATE"
+	uuidType	"uuid"
 	values		"VALUES"
 	where		"WHERE"
 
@@ -125,12 +135,17 @@ import (
 
 %type	<item>
 	AlterTableStmt		"ALTER TABLE statement"
+	ArrayLit		"array literal"
 	Assignment		"assignment"
 	AssignmentList		"assignment list"
 	AssignmentList1		"assignment list optional trailing comma"
 	BeginTransactionStmt	"BEGIN TRANSACTION statement"
 	Call			"function call"
 	Call1			"function call optional argument list"
+	CollateOpt		"optional COLLATE clause"
+	CollatedExpression	"expression with optional COLLATE clause"
+	CollatedExpressionList	"list of expressions with optional COLLATE clauses"
+	CollatedExpressionList1	"list of expressions with optional COLLATE clauses with optional trailing comma"
 	ColumnDef		"table column definition"
 	ColumnName		"column name"
 	ColumnNameList		"column name list"
@@ -143,19 +158,25 @@ import (
 	CreateIndexStmt		"CREATE INDEX statement"
 	CreateIndexIfNotExists	"CREATE INDEX statement optional IF NOT EXISTS cluse"
 	CreateIndexStmtUnique	"CREATE INDEX optional UNIQUE clause"
+	CreateIndexUsingOpt	"CREATE FULLTEXT INDEX optional USING clause"
 	CreateTableStmt		"CREATE TABLE statement"
 	CreateTableStmt1	"CREATE TABLE statement colum definition list"
+	CreateTypeStmt		"CREATE TYPE statement"
 	Default			"DEFAULT clause"
 	DefaultOpt		"optional DEFAULT clause"
 	DeleteFromStmt		"DELETE FROM statement"
 	DropIndexStmt		"DROP INDEX statement"
 	DropIndexIfExists	"DROP INDEX statement optional IF EXISTS clause"
 	DropTableStmt		"DROP TABLE statement"
+	DropTypeStmt		"DROP TYPE statement"
 	EmptyStmt		"empty statement"
+	EnumLabelList		"enum label list"
+	EscapeOpt		"optional ESCAPE clause"
 	ExplainStmt		"EXPLAIN statement"
 	Expression		"expression"
 	ExpressionList		"expression list"
 	ExpressionList1		"expression list expression"
+	ExpressionListOpt	"optional expression list"
 	Factor			"expression factor"
 	Factor1			"binary expression factor"
 	Field			"field expression"
@@ -163,12 +184,14 @@ import (
 	FieldList		"field expression list"
 	GroupByClause		"GROUP BY clause"
 	Index			"string index"
+	IndexNameList		"index name list"
 	InsertIntoStmt		"INSERT INTO statement"
 	InsertIntoStmt1		"INSERT INTO statement optional column list clause"
 	InsertIntoStmt2		"INSERT INTO statement optional values list"
 	JoinClause		"SELECT statement JOIN clause"
 	JoinClauseOpt		"SELECT statement optional JOIN clause"
 	JoinType		"join type"
+	LikeOp			"LIKE or ILIKE"
 	Literal			"literal value"
 	logAnd			"logical and operator"
 	logOr			"logical or operator"
@@ -181,8 +204,11 @@ import (
 	PrimaryFactor		"primary expression factor"
 	PrimaryTerm		"primary expression term"
 	RecordSet		"record set"
-	RecordSet1		"record set name or parenthesized SELECTECT statement"
+	RecordSet1		"record set name, parenthesized SELECTECT statement or table function"
 	RecordSet2		"record set optional AS clause"
+	RecordSetHint		"record set hint"
+	RecordSetHintList	"record set hint list"
+	RecordSetHintOpt	"record set optional hint list"
 	RollbackStmt		"ROLLBACK statement"
 	SelectStmt		"SELECT statement"
 	SelectStmtDistinct	"SELECT statement optional DISTINCT clause"
@@ -214,7 +240,7 @@ import (
 
 Start:
 	StatementList
-|	parseExpression Expression
+|	parseExpression CollatedExpression
 	{
 		yylex.(*lexer).expr = expr($2)
 	}
@@ -229,6 +255,16 @@ AlterTableStmt:
 		$$ = &alterTableDropColumnStmt{tableName: $3.(string), colName: $6.(string)}
 	}
 
+ArrayLit:
+	'[' ']' Type '{' ExpressionListOpt '}'
+	{
+		var err error
+		if $$, err = newArrayLit($3.(int), $5.([]expression)); err != nil {
+			yylex.(*lexer).err("%v", err)
+			return 1
+		}
+	}
+
 Assignment:
 	ColumnName '=' Expression
 	{
@@ -274,10 +310,88 @@ Call1:
 	}
 |	ExpressionList
 
+CollateOpt:
+	{
+		$$ = ""
+	}
+|	"COLLATE" identifier
+	{
+		$$ = strings.ToLower($2.(string))
+	}
+
+CollatedExpression:
+	Expression CollateOpt
+	{
+		$$ = expr($1)
+		if s := $2.(string); s != "" {
+			$$ = &collateExpr{expr: expr($1), name: s}
+		}
+	}
+
+CollatedExpressionList:
+	CollatedExpression CollatedExpressionList1 CommaOpt
+	{
+		$$ = append([]expression{$1.(expression)}, $2.([]expression)...)
+	}
+
+CollatedExpressionList1:
+	/* EMPTY */
+	{
+		$$ = []expression(nil)
+	}
+|	CollatedExpressionList1 ',' CollatedExpression
+	{
+		$$ = append($1.([]expression), $3.(expression))
+	}
+
 ColumnDef:
-	ColumnName Type ConstraintOpt DefaultOpt
+	ColumnName Type CollateOpt ConstraintOpt DefaultOpt
+	{
+		x := &col{name: $1.(string), typ: $2.(int), constraint: $4.(*constraint)}
+		switch s := $3.(string); {
+		case s == "" || s == "binary":
+			// nop
+		case x.typ != qString:
+			yylex.(*lexer).err("COLLATE %s: column %s is not a string", s, x.name)
+			return 1
+		default:
+			x.collation = s
+		}
+		if $5 != nil {
+			x.dflt = expr($5)
+		}
+		$$ = x
+	}
+|	ColumnName "decimal" '(' intLit ',' intLit ')' ConstraintOpt DefaultOpt
+	{
+		x := &col{name: $1.(string), typ: qDecimal, constraint: $8.(*constraint)}
+		var err error
+		if x.prec, x.scale, err = decimalParams($4, $6); err != nil {
+			yylex.(*lexer).err("%v", err)
+			return 1
+		}
+
+		if $9 != nil {
+			x.dflt = expr($9)
+		}
+		$$ = x
+	}
+|	ColumnName '[' ']' Type ConstraintOpt DefaultOpt
 	{
-		x := &col{name: $1.(string), typ: $2.(int), constraint: $3.(*constraint)}
+		x := &col{name: $1.(string), typ: qArray, elem: $4.(int), constraint: $5.(*constraint)}
+		if err := arrayElemType(x.elem); err != nil {
+			yylex.(*lexer).err("%v", err)
+			return 1
+		}
+
+		if $6 != nil {
+			x.dflt = expr($6)
+		}
+		$$ = x
+	}
+|	ColumnName identifier ConstraintOpt DefaultOpt
+	{
+		x := &col{name: $1.(string), typ: qEnum, enum: &enumType{name: $2.(string)}, constraint: $3.(*constraint)}
 		if $4 != nil {
 			x.dflt = expr($4)
 		}
@@ -330,9 +444,13 @@ Conversion:
 	{
 		$$ = &conversion{typ: $1.(int), val: expr($3)}
 	}
+|	"decimal" '(' Expression ')'
+	{
+		$$ = &conversion{typ: qDecimal, val: expr($3)}
+	}
 
 CreateIndexStmt:
-	"CREATE" CreateIndexStmtUnique "INDEX" CreateIndexIfNotExists identifier "ON" identifier '(' ExpressionList ')'
+	"CREATE" CreateIndexStmtUnique "INDEX" CreateIndexIfNotExists identifier "ON" identifier '(' CollatedExpressionList ')'
 	{
 		indexName, tableName, exprList := $5.(string), $7.(string), $9.([]expression)
 		simpleIndex := len(exprList) == 1
@@ -374,6 +492,54 @@ CreateIndexStmt:
 		}
 	}
 
+|	"CREATE" identifier "INDEX" CreateIndexIfNotExists identifier "ON" identifier '(' ColumnNameList ')' CreateIndexUsingOpt
+	{
+		var spatial bool
+		tokenizer := $11.(string)
+		switch s := $2.(string); {
+		case strings.EqualFold(s, "fulltext"):
+			if tokenizer == "" {
+				tokenizer = "simple"
+			}
+		case strings.EqualFold(s, "spatial"):
+			if tokenizer != "" {
+				yylex.(*lexer).err("syntax error: unexpected USING")
+				return 1
+			}
+
+			spatial = true
+		default:
+			yylex.(*lexer).err("syntax error: unexpected %s, expecting FULLTEXT or SPATIAL", s)
+			return 1
+		}
+
+		indexName, tableName := $5.(string), $7.(string)
+		var exprList []expression
+		for _, v := range $9.([]string) {
+			if v == indexName {
+				yylex.(*lexer).err("index name collision: %s", indexName)
+				return 1
+			}
+
+			exprList = append(exprList, &ident{v})
+		}
+		$$ = &createIndexStmt{ifNotExists: $4.(bool), indexName: indexName, tableName: tableName, exprList: exprList, tokenizer: tokenizer, spatial: spatial}
+
+		if indexName == tableName {
+			yylex.(*lexer).err("index name collision: %s", indexName)
+			return 1
+		}
+
+		if yylex.(*lexer).root {
+			break
+		}
+
+		if isSystemName[indexName] || isSystemName[tableName] {
+			yylex.(*lexer).err("name is used for system tables: %s", indexName)
+			return 1
+		}
+	}
+
 CreateIndexIfNotExists:
 	{
 		$$ = false
@@ -383,6 +549,20 @@ CreateIndexIfNotExists:
 		$$ = true
 	}
 
+CreateIndexUsingOpt:
+	{
+		$$ = ""
+	}
+|	identifier identifier
+	{
+		if !strings.EqualFold($1.(string), "using") {
+			yylex.(*lexer).err("syntax error: unexpected %s, expecting USING", $1)
+			return 1
+		}
+
+		$$ = $2
+	}
+
 CreateIndexStmtUnique:
 	{
 		$$ = false
@@ -432,6 +612,22 @@ CreateTableStmt1:
 		$$ = append($1.([]*col), $3.(*col))
 	}
 
+CreateTypeStmt:
+	"CREATE" identifier identifier "AS" identifier '(' EnumLabelList CommaOpt ')'
+	{
+		if !strings.EqualFold($2.(string), "type") {
+			yylex.(*lexer).err("syntax error: unexpected %s, expecting TYPE", $2)
+			return 1
+		}
+
+		if !strings.EqualFold($5.(string), "enum") {
+			yylex.(*lexer).err("syntax error: unexpected %s, expecting ENUM", $5)
+			return 1
+		}
+
+		$$ = &createTypeStmt{typeName: $3.(string), labels: $7.([]string)}
+	}
+
 Default:
 	"DEFAULT" Expression
 	{
@@ -517,12 +713,52 @@ DropTableStmt:
 		}
 	}
 
+DropTypeStmt:
+	"DROP" identifier identifier
+	{
+		if !strings.EqualFold($2.(string), "type") {
+			yylex.(*lexer).err("syntax error: unexpected %s, expecting TYPE", $2)
+			return 1
+		}
+
+		$$ = &dropTypeStmt{typeName: $3.(string)}
+	}
+|	"DROP" identifier "IF" "EXISTS" identifier
+	{
+		if !strings.EqualFold($2.(string), "type") {
+			yylex.(*lexer).err("syntax error: unexpected %s, expecting TYPE", $2)
+			return 1
+		}
+
+		$$ = &dropTypeStmt{ifExists: true, typeName: $5.(string)}
+	}
+
 EmptyStmt:
 	/* EMPTY */
 	{
 		$$ = nil
 	}
 
+EnumLabelList:
+	stringLit
+	{
+		$$ = []string{$1.(string)}
+	}
+|	EnumLabelList ',' stringLit
+	{
+		$$ = append($1.([]string), $3.(string))
+	}
+
+EscapeOpt:
+	/* EMPTY */
+	{
+		$$ = ""
+	}
+|	"ESCAPE" stringLit
+	{
+		$$ = $2
+	}
+
 ExplainStmt:
 	"EXPLAIN" Statement
 	{
@@ -568,6 +804,13 @@ ExpressionList1:
 		$$ = append($1.([]expression), expr($3))
 	}
 
+ExpressionListOpt:
+	/* EMPTY */
+	{
+		$$ = []expression(nil)
+	}
+|	ExpressionList
+
 Factor:
 	Factor1
 |       Factor1 "IN" '(' ExpressionList ')'
@@ -661,9 +904,29 @@ Factor1:
 			return 1
 		}
         }
-|	Factor1 "LIKE" PrimaryFactor
+|	Factor1 LikeOp PrimaryFactor EscapeOpt
 	{
-		$$ = &pLike{expr: $1.(expression), pattern: $3.(expression)}
+		var err error
+		if $$, err = newLike($1.(expression), $3.(expression), $2.(string), $4.(string)); err != nil {
+			yylex.(*lexer).err("%v", err)
+			return 1
+		}
+	}
+|	Factor1 "MATCHES" PrimaryFactor
+	{
+		var err error
+		if $$, err = newLike($1.(expression), $3.(expression), "MATCHES", ""); err != nil {
+			yylex.(*lexer).err("%v", err)
+			return 1
+		}
+	}
+|	Factor1 "REGEXP" PrimaryFactor
+	{
+		var err error
+		if $$, err = newLike($1.(expression), $3.(expression), "REGEXP", ""); err != nil {
+			yylex.(*lexer).err("%v", err)
+			return 1
+		}
 	}
 
 Field:
@@ -719,6 +982,16 @@ Index:
 		$$ = $2
 	}
 
+IndexNameList:
+	identifier
+	{
+		$$ = []string{$1.(string)}
+	}
+|	IndexNameList ',' identifier
+	{
+		$$ = append($1.([]string), $3.(string))
+	}
+
 InsertIntoStmt:
 	"INSERT" "INTO" TableName InsertIntoStmt1 "VALUES" '(' ExpressionList ')' InsertIntoStmt2 CommaOpt
 	{
@@ -747,6 +1020,15 @@ InsertIntoStmt1:
 	{
 		$$ = $2
 	}
+|	'(' identifier '(' ')' ',' ColumnNameList ')'
+	{
+		if $2.(string) != "id" {
+			yylex.(*lexer).err("expected column name or id(), have %s()", $2.(string))
+			return 1
+		}
+
+		$$ = append([]string{"id()"}, $6.([]string)...)
+	}
 
 InsertIntoStmt2:
 	/* EMPTY */
@@ -758,6 +1040,16 @@ InsertIntoStmt2:
 		$$ = append($1.([][]expression), $4.([]expression))
 	}
 
+LikeOp:
+	"LIKE"
+	{
+		$$ = "LIKE"
+	}
+|	"ILIKE"
+	{
+		$$ = "ILIKE"
+	}
+
 Literal:
 	"false"
 |	"NULL"
@@ -765,6 +1057,7 @@ Literal:
 |	floatLit
 |	imaginaryLit
 |	intLit
+|	intervalLit
 |	stringLit
 
 Operand:
@@ -791,9 +1084,10 @@ Operand:
 	{
 		$$ = &pexpr{expr: expr($2)}
 	}
+|	ArrayLit
 
 OrderBy:
-	"ORDER" "BY" ExpressionList OrderBy1
+	"ORDER" "BY" CollatedExpressionList OrderBy1
 	{
 		$$ = &orderByRset{by: $3.([]expression), asc: $4.(bool)}
 	}
@@ -971,9 +1265,9 @@ QualifiedIdent:
 	}
 
 RecordSet:
-	RecordSet1 RecordSet2
+	RecordSet1 RecordSet2 RecordSetHintOpt
 	{
-		$$ = []interface{}{$1, $2}
+		$$ = []interface{}{$1, $2, $3}
 	}
 
 RecordSet1:
@@ -982,6 +1276,15 @@ RecordSet1:
 	{
 		$$ = $2
 	}
+|	identifier '(' Expression ')'
+	{
+		if s := $1.(string); s != "unnest" {
+			yylex.(*lexer).err("unknown table function %s", s)
+			return 1
+		}
+
+		$$ = &unnestRset{expr: expr($3)}
+	}
 
 semiOpt:
 	/* EMPTY */
@@ -997,6 +1300,48 @@ RecordSet2:
 		$$ = $2
 	}
 
+RecordSetHint:
+	identifier "INDEX" '(' IndexNameList ')'
+	{
+		var kind int
+		switch s := $1.(string); strings.ToUpper(s) {
+		case "USE":
+			kind = useIndexHint
+		case "IGNORE":
+			kind = ignoreIndexHint
+		default:
+			yylex.(*lexer).err("unexpected %s, expected USE or IGNORE", s)
+			return 1
+		}
+		$$ = &recordSetHint{kind: kind, indices: $4.([]string)}
+	}
+|	identifier "JOIN" "ORDER"
+	{
+		if s := $1.(string); !strings.EqualFold(s, "FORCE") {
+			yylex.(*lexer).err("unexpected %s, expected FORCE", s)
+			return 1
+		}
+
+		$$ = &recordSetHint{kind: forceJoinOrderHint}
+	}
+
+RecordSetHintList:
+	RecordSetHint
+	{
+		$$ = []*recordSetHint{$1.(*recordSetHint)}
+	}
+|	RecordSetHintList RecordSetHint
+	{
+		$$ = append($1.([]*recordSetHint), $2.(*recordSetHint))
+	}
+
+RecordSetHintOpt:
+	/* EMPTY */
+	{
+		$$ = []*recordSetHint(nil)
+	}
+|	RecordSetHintList
+
 RecordSetList:
 	RecordSet
 	{
@@ -1059,7 +1404,7 @@ SelectStmt:
 			join.sources = append(join.sources, o[1].([]interface{}))
 			join.on = o[2].(expression)
 		}
-		$$ = &selectStmt{
+		s := &selectStmt{
 			distinct:      $2.(bool),
 			flds:          $3.([]*fld),
 			from:          join,
@@ -1070,6 +1415,12 @@ SelectStmt:
 			limit:         $9.(*limitRset),
 			offset:        $10.(*offsetRset),
 		}
+		if err := s.bindRank(); err != nil {
+			x.err("%v", err)
+			return 1
+		}
+
+		$$ = s
 		x.agg = x.agg[:n-1]
 	}
 
@@ -1174,9 +1525,11 @@ Statement:
 |	CommitStmt
 |	CreateIndexStmt
 |	CreateTableStmt
+|	CreateTypeStmt
 |	DeleteFromStmt
 |	DropIndexStmt
 |	DropTableStmt
+|	DropTypeStmt
 |	ExplainStmt
 |	InsertIntoStmt
 |	RollbackStmt
@@ -1243,6 +1596,7 @@ Type:
 |	"int32"
 |	"int64"
 |	"int8"
+|	"json"
 |	"rune"
 |	"string"
 |	"time"
@@ -1251,6 +1605,7 @@ Type:
 |	"uint32"
 |	"uint64"
 |	"uint8"
+|	"uuid"
 
 UpdateStmt:
 	"UPDATE" TableName SetOpt AssignmentList UpdateStmt1
@@ -49,7 +49,7 @@ func TestParser0(t *testing.T) {
 		{"SELECT * FROM t AS u, v AS w", true},
 		{"SELECT * FROM foo, bar, foo", true},
 		// 30
-		{"CREATE TABLE foo (a bytes)", false},
+		{"CREATE TABLE foo (a bytes)", true}, // Enum type, checked on execution.
 		{"SELECT DISTINCTS * FROM t", false},
 		{"SELECT DISTINCT * FROM t", true},
 		{"INSERT INTO foo (a) VALUES (42)", true},
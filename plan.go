@@ -7,6 +7,8 @@ package ql
 import (
 	"bytes"
 	"fmt"
+	"math"
+	"sort"
 	"strings"
 
 	"github.com/cznic/b"
@@ -39,7 +41,9 @@ var (
 	_ plan = (*filterDefaultPlan)(nil)
 	_ plan = (*fullJoinDefaultPlan)(nil)
 	_ plan = (*groupByDefaultPlan)(nil)
+	_ plan = (*indexIntersectionPlan)(nil)
 	_ plan = (*indexPlan)(nil)
+	_ plan = (*indexUnionPlan)(nil)
 	_ plan = (*leftJoinDefaultPlan)(nil)
 	_ plan = (*limitDefaultPlan)(nil)
 	_ plan = (*nullPlan)(nil)
@@ -55,6 +59,7 @@ var (
 	_ plan = (*sysTableDefaultPlan)(nil)
 	_ plan = (*tableDefaultPlan)(nil)
 	_ plan = (*tableNilPlan)(nil)
+	_ plan = (*unnestDefaultPlan)(nil)
 )
 
 type plan interface {
@@ -96,7 +101,7 @@ func (r *indexPlan) doGe(ctx *execCtx, f func(interface{}, []interface{}) (bool,
 	// nil, nil, ..., L-1, L-1, L, L, L+1, L+1, ...
 	// ---  ---  ---  ---  ---  +  +  +++  +++  +++
 	t := r.src
-	it, _, err := r.x.Seek([]interface{}{r.lval})
+	it, _, err := ctx.index(r.x).Seek([]interface{}{r.lval})
 	if err != nil {
 		return noEOF(err)
 	}
@@ -122,7 +127,7 @@ func (r *indexPlan) doGt(ctx *execCtx, f func(interface{}, []interface{}) (bool,
 	// nil, nil, ..., L-1, L-1, L, L, L+1, L+1, ...
 	// ---  ---  ---  ---  ---  -  -  +++  +++  +++
 	t := r.src
-	it, _, err := r.x.Seek([]interface{}{r.lval})
+	it, _, err := ctx.index(r.x).Seek([]interface{}{r.lval})
 	if err != nil {
 		return noEOF(err)
 	}
@@ -162,7 +167,7 @@ func (r *indexPlan) doInterval00(ctx *execCtx, f func(interface{}, []interface{}
 	// nil, nil, ..., L-1, L-1, L, L, L+1, L+1, ..., H-1, H-1, H, H, H+1, H+1, ...
 	// ---  ---  ---  ---  ---  -  -  +++  +++  +++  +++  +++  -  -  ---  ---  ---
 	t := r.src
-	it, _, err := r.x.Seek([]interface{}{r.lval})
+	it, _, err := ctx.index(r.x).Seek([]interface{}{r.lval})
 	if err != nil {
 		return noEOF(err)
 	}
@@ -211,7 +216,7 @@ func (r *indexPlan) doIntervalOC(ctx *execCtx, f func(interface{}, []interface{}
 	// nil, nil, ..., L-1, L-1, L, L, L+1, L+1, ..., H-1, H-1, H, H, H+1, H+1, ...
 	// ---  ---  ---  ---  ---  -  -  +++  +++  +++  +++  +++  +  +  ---  ---  ---
 	t := r.src
-	it, _, err := r.x.Seek([]interface{}{r.lval})
+	it, _, err := ctx.index(r.x).Seek([]interface{}{r.lval})
 	if err != nil {
 		return noEOF(err)
 	}
@@ -260,7 +265,7 @@ func (r *indexPlan) doIntervalCC(ctx *execCtx, f func(interface{}, []interface{}
 	// nil, nil, ..., L-1, L-1, L, L, L+1, L+1, ..., H-1, H-1, H, H, H+1, H+1, ...
 	// ---  ---  ---  ---  ---  +  +  +++  +++  +++  +++  +++  +  +  ---  ---  ---
 	t := r.src
-	it, _, err := r.x.Seek([]interface{}{r.lval})
+	it, _, err := ctx.index(r.x).Seek([]interface{}{r.lval})
 	if err != nil {
 		return noEOF(err)
 	}
@@ -295,7 +300,7 @@ func (r *indexPlan) doIntervalCO(ctx *execCtx, f func(interface{}, []interface{}
 	// nil, nil, ..., L-1, L-1, L, L, L+1, L+1, ..., H-1, H-1, H, H, H+1, H+1, ...
 	// ---  ---  ---  ---  ---  +  +  +++  +++  +++  +++  +++  -  -  ---  ---  ---
 	t := r.src
-	it, _, err := r.x.Seek([]interface{}{r.lval})
+	it, _, err := ctx.index(r.x).Seek([]interface{}{r.lval})
 	if err != nil {
 		return noEOF(err)
 	}
@@ -330,7 +335,7 @@ func (r *indexPlan) doLe(ctx *execCtx, f func(interface{}, []interface{}) (bool,
 	// nil, nil, ..., H-1, H-1, H, H, H+1, H+1, ...
 	// ---  ---  +++  +++  +++  +  +  ---  ---
 	t := r.src
-	it, err := r.x.SeekFirst()
+	it, err := ctx.index(r.x).SeekFirst()
 	if err != nil {
 		return noEOF(err)
 	}
@@ -369,7 +374,7 @@ func (r *indexPlan) doLt(ctx *execCtx, f func(interface{}, []interface{}) (bool,
 	// nil, nil, ..., H-1, H-1, H, H, H+1, H+1, ...
 	// ---  ---  +++  +++  +++  -  -  ---  ---
 	t := r.src
-	it, err := r.x.SeekFirst()
+	it, err := ctx.index(r.x).SeekFirst()
 	if err != nil {
 		return noEOF(err)
 	}
@@ -408,7 +413,7 @@ func (r *indexPlan) doEq(ctx *execCtx, f func(interface{}, []interface{}) (bool,
 	// nil, nil, ..., L-1, L-1, L, L, L+1, L+1, ...
 	// ---  ---  ---  ---  ---  +  +  ---  ---  ---
 	t := r.src
-	it, _, err := r.x.Seek([]interface{}{r.lval})
+	it, _, err := ctx.index(r.x).Seek([]interface{}{r.lval})
 	if err != nil {
 		return noEOF(err)
 	}
@@ -443,7 +448,7 @@ func (r *indexPlan) doNe(ctx *execCtx, f func(interface{}, []interface{}) (bool,
 	// nil, nil, ..., L-1, L-1, L, L, L+1, L+1, ...
 	// ---  ---  +++  +++  +++  -  -  +++  +++  +++
 	t := r.src
-	it, err := r.x.SeekFirst()
+	it, err := ctx.index(r.x).SeekFirst()
 	if err != nil {
 		return noEOF(err)
 	}
@@ -482,7 +487,7 @@ func (r *indexPlan) doIsNull(ctx *execCtx, f func(interface{}, []interface{}) (b
 	// nil, nil, ...
 	// +++  +++  ---
 	t := r.src
-	it, err := r.x.SeekFirst()
+	it, err := ctx.index(r.x).SeekFirst()
 	if err != nil {
 		return noEOF(err)
 	}
@@ -512,7 +517,7 @@ func (r *indexPlan) doIsNotNull(ctx *execCtx, f func(interface{}, []interface{})
 	// nil, nil, ...
 	// ---  ---  +++
 	t := r.src
-	it, _, err := r.x.Seek([]interface{}{false}) // lldb collates false right after NULL.
+	it, _, err := ctx.index(r.x).Seek([]interface{}{false}) // lldb collates false right after NULL.
 	if err != nil {
 		return noEOF(err)
 	}
@@ -536,7 +541,7 @@ func (r *indexPlan) doIsNotNull(ctx *execCtx, f func(interface{}, []interface{})
 
 func (r *indexPlan) doFalse(ctx *execCtx, f func(interface{}, []interface{}) (bool, error)) error {
 	t := r.src
-	it, _, err := r.x.Seek([]interface{}{false})
+	it, _, err := ctx.index(r.x).Seek([]interface{}{false})
 	if err != nil {
 		return noEOF(err)
 	}
@@ -565,7 +570,7 @@ func (r *indexPlan) doFalse(ctx *execCtx, f func(interface{}, []interface{}) (bo
 
 func (r *indexPlan) doTrue(ctx *execCtx, f func(interface{}, []interface{}) (bool, error)) error {
 	t := r.src
-	it, _, err := r.x.Seek([]interface{}{true})
+	it, _, err := ctx.index(r.x).Seek([]interface{}{true})
 	if err != nil {
 		return noEOF(err)
 	}
@@ -592,6 +597,27 @@ func (r *indexPlan) doTrue(ctx *execCtx, f func(interface{}, []interface{}) (boo
 }
 
 func (r *indexPlan) do(ctx *execCtx, f func(interface{}, []interface{}) (bool, error)) error {
+	if ctx.snap == nil {
+		return r.do0(ctx, f)
+	}
+
+	// A snapshot cannot read an index modified after it was taken. The rows
+	// not yet passed to f are then found by scanning the table.
+	seen := map[int64]bool{}
+	var ferr error
+	err := r.do0(ctx, func(id interface{}, data []interface{}) (more bool, err error) {
+		seen[id.(int64)] = true
+		more, ferr = f(id, data)
+		return more, ferr
+	})
+	if err != errIndexChanged || ferr != nil {
+		return err
+	}
+
+	return r.scan(ctx, seen, f)
+}
+
+func (r *indexPlan) do0(ctx *execCtx, f func(interface{}, []interface{}) (bool, error)) error {
 	switch r.kind {
 	case indexEq:
 		return r.doEq(ctx, f)
@@ -627,6 +653,86 @@ func (r *indexPlan) do(ctx *execCtx, f func(interface{}, []interface{}) (bool, e
 	}
 }
 
+// scan passes to f the rows of r.src not in seen having a value of the
+// indexed column or expression selected by r.
+func (r *indexPlan) scan(ctx *execCtx, seen map[int64]bool, f func(interface{}, []interface{}) (bool, error)) error {
+	i := -1
+	for j, c := range r.src.cols {
+		if c.name == r.cname {
+			i = j
+			break
+		}
+	}
+	x2 := r.src.indices2[r.xname]
+	return (&tableDefaultPlan{t: r.src}).do(ctx, func(id interface{}, data []interface{}) (bool, error) {
+		if seen[id.(int64)] {
+			return true, nil
+		}
+
+		v := id
+		switch {
+		case i >= 0:
+			v = data[i]
+		case x2 != nil:
+			vlist, err := x2.eval(ctx, r.src.cols, id.(int64), data)
+			if vlist == nil || err != nil {
+				return err == nil, err
+			}
+
+			v = vlist[0]
+		}
+		if !r.match(v) {
+			return true, nil
+		}
+
+		return f(id, data)
+	})
+}
+
+// match reports whether r selects the rows having the value v of the indexed
+// column.
+func (r *indexPlan) match(v interface{}) bool {
+	switch r.kind {
+	case indexIsNull:
+		return v == nil
+	case indexIsNotNull:
+		return v != nil
+	case indexFalse:
+		return v == false
+	case indexTrue:
+		return v == true
+	}
+
+	if v == nil {
+		return false
+	}
+
+	switch r.kind {
+	case indexEq:
+		return collate1(v, r.lval) == 0
+	case indexGe:
+		return collate1(v, r.lval) >= 0
+	case indexGt:
+		return collate1(v, r.lval) > 0
+	case indexLe:
+		return collate1(v, r.hval) <= 0
+	case indexLt:
+		return collate1(v, r.hval) < 0
+	case indexNe:
+		return collate1(v, r.hval) != 0
+	case indexIntervalOO:
+		return collate1(v, r.lval) > 0 && collate1(v, r.hval) < 0
+	case indexIntervalCC:
+		return collate1(v, r.lval) >= 0 && collate1(v, r.hval) <= 0
+	case indexIntervalOC:
+		return collate1(v, r.lval) > 0 && collate1(v, r.hval) <= 0
+	case indexIntervalCO:
+		return collate1(v, r.lval) >= 0 && collate1(v, r.hval) < 0
+	default:
+		panic("internal error 073")
+	}
+}
+
 func (r *indexPlan) explain(w strutil.Formatter) {
 	s := ""
 	if r.kind == indexFalse {
@@ -1357,6 +1463,123 @@ func (r *indexPlan) filter(expr expression) (plan, []string, error) {
 
 func (r *indexPlan) hasID() bool { return true }
 
+// indexIntersectionPlan produces the rows of the first plan having an ID
+// produced by all the other plans. All plans iterate the same table using
+// different indices.
+type indexIntersectionPlan struct {
+	plans  []plan
+	fields []string
+}
+
+func (r *indexIntersectionPlan) hasID() bool { return true }
+
+func (r *indexIntersectionPlan) explain(w strutil.Formatter) {
+	w.Format("┌Compute intersection of%i\n")
+	for _, v := range r.plans {
+		v.explain(w)
+	}
+	w.Format("%u└Output field names %v\n", qnames(r.fields))
+}
+
+func (r *indexIntersectionPlan) fieldNames() []string { return r.fields }
+
+func (r *indexIntersectionPlan) filter(expr expression) (plan, []string, error) {
+	for i, v := range r.plans {
+		p, _, err := v.filter(expr)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if p == nil {
+			continue
+		}
+
+		if _, ok := p.(*nullPlan); ok {
+			return p, nil, nil
+		}
+
+		r.plans[i] = p
+		return r, nil, nil
+	}
+	return nil, nil, nil
+}
+
+func (r *indexIntersectionPlan) do(ctx *execCtx, f func(id interface{}, data []interface{}) (bool, error)) error {
+	var ids map[interface{}]struct{}
+	for _, v := range r.plans[1:] {
+		m := map[interface{}]struct{}{}
+		if err := v.do(ctx, func(id interface{}, data []interface{}) (bool, error) {
+			if ids != nil {
+				if _, ok := ids[id]; !ok {
+					return true, nil
+				}
+			}
+
+			m[id] = struct{}{}
+			return true, nil
+		}); err != nil {
+			return err
+		}
+
+		if len(m) == 0 {
+			return nil
+		}
+
+		ids = m
+	}
+
+	return r.plans[0].do(ctx, func(id interface{}, data []interface{}) (bool, error) {
+		if _, ok := ids[id]; !ok {
+			return true, nil
+		}
+
+		return f(id, data)
+	})
+}
+
+// indexUnionPlan produces the rows of all its plans, every row only once. All
+// plans iterate the same table.
+type indexUnionPlan struct {
+	plans  []plan
+	fields []string
+}
+
+func (r *indexUnionPlan) hasID() bool { return true }
+
+func (r *indexUnionPlan) explain(w strutil.Formatter) {
+	w.Format("┌Compute union of%i\n")
+	for _, v := range r.plans {
+		v.explain(w)
+	}
+	w.Format("%u└Output field names %v\n", qnames(r.fields))
+}
+
+func (r *indexUnionPlan) fieldNames() []string { return r.fields }
+
+func (r *indexUnionPlan) filter(expr expression) (plan, []string, error) {
+	return nil, nil, nil
+}
+
+func (r *indexUnionPlan) do(ctx *execCtx, f func(id interface{}, data []interface{}) (bool, error)) error {
+	ids := map[interface{}]struct{}{}
+	for _, v := range r.plans {
+		more := true
+		if err := v.do(ctx, func(id interface{}, data []interface{}) (bool, error) {
+			if _, ok := ids[id]; ok {
+				return true, nil
+			}
+
+			ids[id] = struct{}{}
+			var err error
+			more, err = f(id, data)
+			return more, err
+		}); err != nil || !more {
+			return err
+		}
+	}
+	return nil
+}
+
 type explainDefaultPlan struct {
 	s stmt
 }
@@ -1390,6 +1613,235 @@ func (r *explainDefaultPlan) filter(expr expression) (plan, []string, error) {
 	return nil, nil, nil
 }
 
+// fulltextPlan produces the rows of a table having the terms of a full-text
+// query in a column of a full-text index. The phrases of the query are not
+// checked.
+type fulltextPlan struct {
+	src   *table
+	cname string
+	xname string
+	x     btreeIndex
+	col   int64 // Position of cname in the index column list.
+	query string
+	q     *ftQuery
+	expr  expression // The MATCH call.
+}
+
+func (r *fulltextPlan) hasID() bool { return true }
+
+func (r *fulltextPlan) explain(w strutil.Formatter) {
+	w.Format("┌Iterate all rows of table %q using full-text index %q where %s matches %q\n", r.src.name, r.xname, r.cname, r.query)
+	w.Format("└Output field names %v\n", qnames(r.fieldNames()))
+}
+
+func (r *fulltextPlan) fieldNames() []string { return r.src.fieldNames() }
+
+func (r *fulltextPlan) filter(expr expression) (plan, []string, error) {
+	return nil, nil, nil
+}
+
+func (r *fulltextPlan) do(ctx *execCtx, f func(id interface{}, data []interface{}) (bool, error)) error {
+	x := ctx.index(r.x)
+	m, err := r.q.handles(func(term string) (map[int64]bool, error) {
+		m := map[int64]bool{}
+		it, _, err := x.Seek([]interface{}{r.col, term})
+		if err != nil {
+			return m, noEOF(err)
+		}
+
+		for {
+			k, h, err := it.Next()
+			if err != nil {
+				return m, noEOF(err)
+			}
+
+			v, err := expand1(k[1], nil)
+			if err != nil {
+				return nil, err
+			}
+
+			if k[0] != r.col || v != term {
+				return m, nil
+			}
+
+			m[h] = true
+		}
+	})
+	if err == errIndexChanged {
+		// A snapshot cannot read an index modified after it was
+		// taken, evaluate MATCH on every row instead.
+		t := &tableDefaultPlan{t: r.src, fields: r.fieldNames()}
+		return (&filterDefaultPlan{t, r.expr, nil}).do(ctx, f)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	hs := make([]int64, 0, len(m))
+	for h := range m {
+		hs = append(hs, h)
+	}
+	sort.Slice(hs, func(i, j int) bool { return hs[i] < hs[j] })
+	for _, h := range hs {
+		id, data, err := r.src.row(ctx, h)
+		if err != nil {
+			return err
+		}
+
+		if more, err := f(id, data); err != nil || !more {
+			return err
+		}
+	}
+	return nil
+}
+
+// spatialPlan produces the rows of a table using a spatial index: the rows
+// having a point within box or, if nearest is true, all rows having a point
+// in the order of increasing distance from point.
+type spatialPlan struct {
+	src     *table
+	xname   string
+	x       *rtree
+	sources []string // The columns of the point.
+	box     rect
+	nearest bool
+	point   []float64
+}
+
+func (r *spatialPlan) hasID() bool { return true }
+
+func (r *spatialPlan) explain(w strutil.Formatter) {
+	w.Format("┌Iterate all rows of table %q using spatial index %q", r.src.name, r.xname)
+	switch {
+	case r.nearest:
+		w.Format(" by distance of point(%s, %s) from point(%v, %v)\n", r.sources[0], r.sources[1], r.point[0], r.point[1])
+	default:
+		w.Format(" where point(%s, %s) is within box(%v, %v, %v, %v)\n", r.sources[0], r.sources[1], r.box[0], r.box[1], r.box[2], r.box[3])
+	}
+	w.Format("└Output field names %v\n", qnames(r.fieldNames()))
+}
+
+func (r *spatialPlan) fieldNames() []string { return r.src.fieldNames() }
+
+func (r *spatialPlan) filter(expr expression) (plan, []string, error) {
+	return nil, nil, nil
+}
+
+func (r *spatialPlan) do(ctx *execCtx, f func(id interface{}, data []interface{}) (bool, error)) error {
+	row := func(h int64) (bool, error) {
+		id, data, err := r.src.row(ctx, h)
+		if err != nil {
+			return false, err
+		}
+
+		return f(id, data)
+	}
+	read := r.x.reader(ctx)
+	if r.nearest {
+		return r.x.nearest(read, r.point[0], r.point[1], row)
+	}
+
+	var hs []int64
+	if err := r.x.within(read, r.box, func(h int64) (bool, error) {
+		hs = append(hs, h)
+		return true, nil
+	}); err != nil {
+		return err
+	}
+
+	sort.Slice(hs, func(i, j int) bool { return hs[i] < hs[j] })
+	for _, h := range hs {
+		if more, err := row(h); err != nil || !more {
+			return err
+		}
+	}
+	return nil
+}
+
+// nearestDefaultPlan produces the k rows of src having the value of expr,
+// a point, nearest to point in the order of increasing distance. Rows at the
+// same distance are produced in the order of src.
+type nearestDefaultPlan struct {
+	src   plan
+	expr  expression
+	point []float64
+	k     int64
+}
+
+func (r *nearestDefaultPlan) hasID() bool { return r.src.hasID() }
+
+func (r *nearestDefaultPlan) explain(w strutil.Formatter) {
+	r.src.explain(w)
+	w.Format("┌Select %d rows by distance of %v from point(%v, %v)\n", r.k, r.expr, r.point[0], r.point[1])
+	w.Format("└Output field names %v\n", qnames(r.fieldNames()))
+}
+
+func (r *nearestDefaultPlan) fieldNames() []string { return r.src.fieldNames() }
+
+func (r *nearestDefaultPlan) filter(expr expression) (plan, []string, error) {
+	return nil, nil, nil
+}
+
+func (r *nearestDefaultPlan) do(ctx *execCtx, f func(id interface{}, data []interface{}) (bool, error)) error {
+	type row struct {
+		d    float64
+		id   interface{}
+		data []interface{}
+	}
+
+	if r.k == 0 {
+		return nil
+	}
+
+	e := compileEval(r.expr, planTypes(r.src), ctx.arg)
+	m := map[interface{}]interface{}{}
+	fields := r.src.fieldNames()
+	var rows []row
+	if err := r.src.do(ctx, func(id interface{}, data []interface{}) (bool, error) {
+		for i, v := range fields {
+			m[v] = data[i]
+		}
+		m["$id"] = id
+		v, err := e(ctx, m)
+		if err != nil || v == nil {
+			return err == nil, err
+		}
+
+		p, err := pointArg(v, "nearest")
+		if err != nil {
+			return false, err
+		}
+
+		d := math.Hypot(p[0]-r.point[0], p[1]-r.point[1])
+		if math.IsNaN(d) {
+			return true, nil
+		}
+
+		i := sort.Search(len(rows), func(i int) bool { return rows[i].d > d })
+		if int64(i) == r.k {
+			return true, nil
+		}
+
+		rows = append(rows, row{})
+		copy(rows[i+1:], rows[i:])
+		rows[i] = row{d, cloneID(id), append([]interface{}(nil), data...)}
+		if int64(len(rows)) > r.k {
+			rows = rows[:r.k]
+		}
+		return true, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, v := range rows {
+		if more, err := f(v.id, v.data); err != nil || !more {
+			return err
+		}
+	}
+	return nil
+}
+
 type filterDefaultPlan struct {
 	plan
 	expr expression
@@ -1429,29 +1881,34 @@ func (r *filterDefaultPlan) explain(w strutil.Formatter) {
 	w.Format("└Output field names %v\n", qnames(r.plan.fieldNames()))
 }
 
+func (r *filterDefaultPlan) filter(expr expression) (plan, []string, error) {
+	p, is, err := r.plan.filter(expr)
+	if p == nil || err != nil {
+		return p, is, err
+	}
+
+	if _, ok := p.(*nullPlan); ok {
+		return p, is, nil
+	}
+
+	return &filterDefaultPlan{p, r.expr, r.is}, is, nil
+}
+
 func (r *filterDefaultPlan) do(ctx *execCtx, f func(id interface{}, data []interface{}) (bool, error)) (err error) {
-	m := map[interface{}]interface{}{}
+	if n := ctx.db.Parallelism(); n > 1 && canParallelize(r.expr) {
+		return r.doParallel(ctx, n, f)
+	}
+
 	fields := r.plan.fieldNames()
+	e := compileEval(collateExpression(r.expr, planCollations(r.plan)), planTypes(r.plan), ctx.arg)
+	m := map[interface{}]interface{}{}
 	return r.plan.do(ctx, func(rid interface{}, data []interface{}) (bool, error) {
-		for i, v := range fields {
-			m[v] = data[i]
-		}
-		m["$id"] = rid
-		val, err := r.expr.eval(ctx, m)
+		ok, err := r.eval(ctx, e, m, fields, rid, data)
 		if err != nil {
 			return false, err
 		}
 
-		if val == nil {
-			return true, nil
-		}
-
-		x, ok := val.(bool)
 		if !ok {
-			return false, fmt.Errorf("invalid boolean expression %s (value of type %T)", val, val)
-		}
-
-		if !x {
 			return true, nil
 		}
 
@@ -1459,12 +1916,60 @@ func (r *filterDefaultPlan) do(ctx *execCtx, f func(id interface{}, data []inter
 	})
 }
 
-type crossJoinDefaultPlan struct {
-	rsets  []plan
-	names  []string
-	fields []string
-}
-
+// eval reports whether the row data satisfies e, the compiled r.expr.
+func (r *filterDefaultPlan) eval(ctx *execCtx, e compiledExpr, m map[interface{}]interface{}, fields []string, rid interface{}, data []interface{}) (bool, error) {
+	for i, v := range fields {
+		m[v] = data[i]
+	}
+	m["$id"] = rid
+	val, err := e(ctx, m)
+	if err != nil {
+		return false, err
+	}
+
+	if val == nil {
+		return false, nil
+	}
+
+	x, ok := val.(bool)
+	if !ok {
+		return false, fmt.Errorf("invalid boolean expression %s (value of type %T)", val, val)
+	}
+
+	return x, nil
+}
+
+// pushFilter returns a plan producing the rows of p which satisfy expr. If
+// p.filter cannot provide such plan, expr is evaluated on every row of p. The
+// result is nil if expr mentions fields not produced by p.
+func pushFilter(p plan, expr expression) (plan, error) {
+	m := map[string]struct{}{}
+	mentionedColumns0(expr, true, true, m)
+	for _, v := range p.fieldNames() {
+		delete(m, v)
+	}
+	if len(m) != 0 {
+		return nil, nil
+	}
+
+	q, is, err := p.filter(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	if q != nil {
+		return q, nil
+	}
+
+	return &filterDefaultPlan{p, expr, is}, nil
+}
+
+type crossJoinDefaultPlan struct {
+	rsets  []plan
+	names  []string
+	fields []string
+}
+
 func (r *crossJoinDefaultPlan) hasID() bool { return false }
 
 func (r *crossJoinDefaultPlan) explain(w strutil.Formatter) {
@@ -1510,25 +2015,53 @@ func (r *crossJoinDefaultPlan) do(ctx *execCtx, f func(id interface{}, data []in
 	}
 
 	ids := map[string]interface{}{}
-	var g func([]interface{}, []plan, int) error
-	g = func(prefix []interface{}, rsets []plan, x int) (err error) {
-		return rsets[0].do(ctx, func(id interface{}, in []interface{}) (bool, error) {
-			ids[r.names[x]] = id
-			if len(rsets) > 1 {
-				return true, g(append(prefix, in...), rsets[1:], x+1)
+	rows := make([][]interface{}, len(r.rsets))
+	var g func(int) error
+	g = func(i int) (err error) {
+		h := func(id interface{}, in []interface{}) (bool, error) {
+			ids[r.names[i]] = id
+			rows[i] = in
+			if i < len(r.rsets)-1 {
+				return true, g(i + 1)
 			}
 
-			return f(ids, append(prefix, in...))
-		})
+			var out []interface{}
+			for _, v := range rows {
+				out = append(out, v...)
+			}
+			return f(ids, out)
+		}
+		if u, ok := r.rsets[i].(*unnestDefaultPlan); ok && u.lateral {
+			return u.doIn(ctx, r.env(rows[:i]), h)
+		}
+
+		return r.rsets[i].do(ctx, h)
 	}
-	return g(nil, r.rsets, 0)
+	return g(0)
+}
+
+// env returns the fields of rows, the current rows of the first len(rows)
+// record sets, by their names.
+func (r *crossJoinDefaultPlan) env(rows [][]interface{}) map[interface{}]interface{} {
+	m := map[interface{}]interface{}{}
+	j := 0
+	for i, row := range rows {
+		for k, v := range row {
+			if j+k < len(r.fields) {
+				m[r.fields[j+k]] = v
+			}
+		}
+		j += len(r.rsets[i].fieldNames())
+	}
+	return m
 }
 
 func (r *crossJoinDefaultPlan) fieldNames() []string { return r.fields }
 
 type distinctDefaultPlan struct {
-	src    plan
-	fields []string
+	src        plan
+	fields     []string
+	collations []string // Of fields, nil if none has a collation.
 }
 
 func (r *distinctDefaultPlan) hasID() bool { return false }
@@ -1539,13 +2072,18 @@ func (r *distinctDefaultPlan) explain(w strutil.Formatter) {
 }
 
 func (r *distinctDefaultPlan) filter(expr expression) (plan, []string, error) {
-	return nil, nil, nil
+	p, err := pushFilter(r.src, expr)
+	if p == nil || err != nil {
+		return p, nil, err
+	}
+
+	return &distinctDefaultPlan{src: p, fields: r.fields, collations: r.collations}, nil, nil
 }
 
 func (r *distinctDefaultPlan) fieldNames() []string { return r.fields }
 
 func (r *distinctDefaultPlan) do(ctx *execCtx, f func(id interface{}, data []interface{}) (bool, error)) (err error) {
-	t, err := ctx.db.store.CreateTemp(true)
+	t, err := ctx.createTemp(true)
 	if err != nil {
 		return
 	}
@@ -1557,33 +2095,53 @@ func (r *distinctDefaultPlan) do(ctx *execCtx, f func(id interface{}, data []int
 	}()
 
 	if err = r.src.do(ctx, func(id interface{}, in []interface{}) (bool, error) {
-		if err = t.Set(in, nil); err != nil {
+		if r.collations == nil {
+			return true, t.Set(in, nil)
+		}
+
+		// Rows having equal collation keys are the same, the first
+		// one found is produced.
+		k, err := collateRow(in, r.collations)
+		if err != nil {
 			return false, err
 		}
 
-		return true, nil
+		v, err := t.Get(k)
+		if len(v) != 0 || err != nil {
+			return err == nil, err
+		}
+
+		return true, t.Set(k, in)
 	}); err != nil {
 		return
 	}
 
-	var data []interface{}
+	var k, v []interface{}
 	more := true
 	it, err := t.SeekFirst()
 	for more && err == nil {
-		data, _, err = it.Next()
+		if err = ctx.canceled(); err != nil {
+			break
+		}
+
+		k, v, err = it.Next()
 		if err != nil {
 			break
 		}
 
-		more, err = f(nil, data)
+		if r.collations != nil {
+			k = v
+		}
+		more, err = f(nil, k)
 	}
 	return noEOF(err)
 }
 
 type groupByDefaultPlan struct {
-	colNames []string
-	src      plan
-	fields   []string
+	colNames   []string
+	src        plan
+	fields     []string
+	collations map[string]string // Of the fields of src.
 }
 
 func (r *groupByDefaultPlan) hasID() bool { return false }
@@ -1607,7 +2165,7 @@ func (r *groupByDefaultPlan) filter(expr expression) (plan, []string, error) {
 }
 
 func (r *groupByDefaultPlan) do(ctx *execCtx, f func(id interface{}, data []interface{}) (bool, error)) (err error) {
-	t, err := ctx.db.store.CreateTemp(true)
+	t, err := ctx.createTemp(true)
 	if err != nil {
 		return
 	}
@@ -1630,13 +2188,15 @@ func (r *groupByDefaultPlan) do(ctx *execCtx, f func(id interface{}, data []inte
 			return fmt.Errorf("unknown field %s", c)
 		}
 
-		gcols = append(gcols, &col{name: c, index: i})
+		gcols = append(gcols, &col{name: c, index: i, collation: r.collations[c]})
 	}
 	k := make([]interface{}, len(r.colNames)) //TODO optimize when len(r.cols) == 0, should become tableDefaultPlan
 	if err = r.src.do(ctx, func(rid interface{}, in []interface{}) (more bool, err error) {
 		infer(in, &cols)
 		for i, c := range gcols {
-			k[i] = in[c.index]
+			if k[i], err = colKey(c, in[c.index]); err != nil {
+				return false, err
+			}
 		}
 		h0, err := t.Get(k)
 		if err != nil {
@@ -1653,7 +2213,9 @@ func (r *groupByDefaultPlan) do(ctx *execCtx, f func(id interface{}, data []inte
 		}
 
 		for i, c := range gcols {
-			k[i] = in[c.index]
+			if k[i], err = colKey(c, in[c.index]); err != nil {
+				return false, err
+			}
 		}
 		err = t.Set(k, []interface{}{nh})
 		if err != nil {
@@ -1677,6 +2239,10 @@ func (r *groupByDefaultPlan) do(ctx *execCtx, f func(id interface{}, data []inte
 	more := true
 	var data []interface{}
 	for more && err == nil {
+		if err = ctx.canceled(); err != nil {
+			break
+		}
+
 		if _, data, err = it.Next(); err != nil {
 			break
 		}
@@ -1714,13 +2280,17 @@ func (r *selectIndexDefaultPlan) do(ctx *execCtx, f func(id interface{}, data []
 		panic("internal error 007")
 	}
 
-	en, err := x.SeekFirst()
+	en, err := ctx.index(x).SeekFirst()
 	if err != nil {
 		return noEOF(err)
 	}
 
 	var id int64
 	for {
+		if err := ctx.canceled(); err != nil {
+			return err
+		}
+
 		k, _, err := en.Next()
 		if err != nil {
 			return noEOF(err)
@@ -1852,7 +2422,8 @@ type orderByDefaultPlan struct {
 	asc    bool
 	by     []expression
 	src    plan
-	fields []string
+	fields []string // Of src.
+	hidden int      // Number of the last fields not produced.
 }
 
 func (r *orderByDefaultPlan) hasID() bool { return r.src.hasID() }
@@ -1863,17 +2434,22 @@ func (r *orderByDefaultPlan) explain(w strutil.Formatter) {
 	for _, v := range r.by {
 		w.Format(" %s,", v)
 	}
-	w.Format("\n└Output field names %v\n", qnames(r.fields))
+	w.Format("\n└Output field names %v\n", qnames(r.fieldNames()))
 }
 
 func (r *orderByDefaultPlan) filter(expr expression) (plan, []string, error) {
-	return nil, nil, nil
+	p, err := pushFilter(r.src, expr)
+	if p == nil || err != nil {
+		return p, nil, err
+	}
+
+	return &orderByDefaultPlan{asc: r.asc, by: r.by, src: p, fields: r.fields, hidden: r.hidden}, nil, nil
 }
 
-func (r *orderByDefaultPlan) fieldNames() []string { return r.fields }
+func (r *orderByDefaultPlan) fieldNames() []string { return r.fields[:len(r.fields)-r.hidden] }
 
 func (r *orderByDefaultPlan) do(ctx *execCtx, f func(id interface{}, data []interface{}) (bool, error)) (err error) {
-	t, err := ctx.db.store.CreateTemp(r.asc)
+	t, err := ctx.createTemp(r.asc)
 	if err != nil {
 		return
 	}
@@ -1917,7 +2493,7 @@ func (r *orderByDefaultPlan) do(ctx *execCtx, f func(id interface{}, data []inte
 			k[i] = val
 		}
 		k[len(r.by)] = id
-		if err = t.Set(k, in); err != nil {
+		if err = t.Set(k, in[:len(in)-r.hidden]); err != nil {
 			return false, err
 		}
 
@@ -1934,6 +2510,10 @@ func (r *orderByDefaultPlan) do(ctx *execCtx, f func(id interface{}, data []inte
 	var data []interface{}
 	more := true
 	for more && err == nil {
+		if err = ctx.canceled(); err != nil {
+			break
+		}
+
 		if _, data, err = it.Next(); err != nil {
 			break
 		}
@@ -1962,30 +2542,100 @@ func (r *selectFieldsDefaultPlan) explain(w strutil.Formatter) {
 }
 
 func (r *selectFieldsDefaultPlan) filter(expr expression) (plan, []string, error) {
-	return nil, nil, nil
+	m := map[string]struct{}{}
+	mentionedColumns0(expr, true, true, m)
+	names := map[string]string{}
+	for k := range m {
+		var x *ident
+		for _, v := range r.flds {
+			if v.name != k {
+				continue
+			}
+
+			if x != nil {
+				return nil, nil, nil // Ambiguous.
+			}
+
+			var ok bool
+			if x, ok = v.expr.(*ident); !ok {
+				return nil, nil, nil // Computed field.
+			}
+		}
+		if x == nil {
+			return nil, nil, nil
+		}
+
+		names[k] = x.s
+	}
+
+	e, err := renameColumns(expr, names)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	p, err := pushFilter(r.src, e)
+	if p == nil || err != nil {
+		return p, nil, err
+	}
+
+	return &selectFieldsDefaultPlan{flds: r.flds, src: p, fields: r.fields}, nil, nil
+}
+
+// project returns a plan producing only the fields in m, preserving their
+// order, or r if all fields of r are in m.
+func (r *selectFieldsDefaultPlan) project(m map[string]struct{}) plan {
+	var flds []*fld
+	var fields []string
+	for _, v := range r.flds {
+		if _, ok := m[v.name]; ok {
+			flds = append(flds, v)
+			fields = append(fields, v.name)
+		}
+	}
+	if len(flds) == len(r.flds) {
+		return r
+	}
+
+	return &selectFieldsDefaultPlan{flds: flds, src: r.src, fields: fields}
 }
 
 func (r *selectFieldsDefaultPlan) do(ctx *execCtx, f func(id interface{}, data []interface{}) (bool, error)) error {
 	fields := r.src.fieldNames()
+	types := planTypes(r.src)
+	colls := planCollations(r.src)
+	flds := make([]compiledExpr, len(r.flds))
+	for i, fld := range r.flds {
+		flds[i] = compileEval(collateExpression(fld.expr, colls), types, ctx.arg)
+	}
 	m := map[interface{}]interface{}{}
 	return r.src.do(ctx, func(rid interface{}, in []interface{}) (bool, error) {
-		for i, nm := range fields {
-			if nm != "" {
-				m[nm] = in[i]
-			}
-		}
-		m["$id"] = rid
-		out := make([]interface{}, len(r.flds))
-		for i, fld := range r.flds {
-			var err error
-			if out[i], err = fld.expr.eval(ctx, m); err != nil {
-				return false, err
-			}
+		out, err := r.eval(ctx, flds, m, fields, rid, in)
+		if err != nil {
+			return false, err
 		}
+
 		return f(rid, out)
 	})
 }
 
+// eval returns the values of flds, the compiled r.flds, for the row in.
+func (r *selectFieldsDefaultPlan) eval(ctx *execCtx, flds []compiledExpr, m map[interface{}]interface{}, fields []string, rid interface{}, in []interface{}) ([]interface{}, error) {
+	for i, nm := range fields {
+		if nm != "" {
+			m[nm] = in[i]
+		}
+	}
+	m["$id"] = rid
+	out := make([]interface{}, len(flds))
+	for i, fld := range flds {
+		var err error
+		if out[i], err = fld(ctx, m); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
 func (r *selectFieldsDefaultPlan) fieldNames() []string { return r.fields }
 
 type selectFieldsGroupPlan struct {
@@ -2016,41 +2666,21 @@ func (r *selectFieldsGroupPlan) do(ctx *execCtx, f func(id interface{}, data []i
 	var t temp
 	var cols []*col
 	var err error
+	var p *parallelGroupPlan
 	out := make([]interface{}, len(r.flds))
 	ok := false
 	rows := false
 	if err = r.src.do(ctx, func(rid interface{}, in []interface{}) (bool, error) {
 		if ok {
-			h := in[0].(int64)
-			m := map[interface{}]interface{}{}
-			for h != 0 {
-				in, err = t.Read(nil, h, cols...)
-				if err != nil {
-					return false, err
-				}
-
-				rec := in[2:]
-				for i, c := range cols {
-					if nm := c.name; nm != "" {
-						m[nm] = rec[i]
-					}
-				}
-				m["$id"] = rid
-				for _, fld := range r.flds {
-					if _, err = fld.expr.eval(ctx, m); err != nil {
-						return false, err
-					}
-				}
-
-				h = in[0].(int64)
+			rows = true
+			if p != nil {
+				return p.add(rid, in[0].(int64), f)
 			}
-			m["$agg"] = true
-			for i, fld := range r.flds {
-				if out[i], err = fld.expr.eval(ctx, m); err != nil {
-					return false, err
-				}
+
+			if err = r.aggregate(ctx, t, cols, rid, in[0].(int64), out); err != nil {
+				return false, err
 			}
-			rows = true
+
 			return f(nil, out)
 		}
 
@@ -2064,13 +2694,21 @@ func (r *selectFieldsGroupPlan) do(ctx *execCtx, f func(id interface{}, data []i
 			}
 			out = make([]interface{}, len(r.flds))
 		}
+		if n := ctx.db.Parallelism(); n > 1 && r.canParallelize() {
+			if p, err = newParallelGroupPlan(ctx, r, t, cols, n, out); err != nil {
+				return false, err
+			}
+		}
 		return true, nil
 	}); err != nil {
 		return err
 	}
 
 	if rows {
-		return nil
+		if p != nil {
+			_, err = p.flush(f)
+		}
+		return err
 	}
 
 	m := map[interface{}]interface{}{"$agg0": true} // aggregate empty record set
@@ -2084,6 +2722,53 @@ func (r *selectFieldsGroupPlan) do(ctx *execCtx, f func(id interface{}, data []i
 	return err
 }
 
+func (r *selectFieldsGroupPlan) canParallelize() bool {
+	for _, v := range r.flds {
+		if !canParallelize(v.expr) {
+			return false
+		}
+	}
+	return true
+}
+
+// aggregate computes into out the fields of the group whose row chain starts
+// at h.
+func (r *selectFieldsGroupPlan) aggregate(ctx *execCtx, t temp, cols []*col, rid interface{}, h int64, out []interface{}) (err error) {
+	m := map[interface{}]interface{}{}
+	for h != 0 {
+		if err := ctx.canceled(); err != nil {
+			return err
+		}
+
+		in, err := t.Read(nil, h, cols...)
+		if err != nil {
+			return err
+		}
+
+		rec := in[2:]
+		for i, c := range cols {
+			if nm := c.name; nm != "" {
+				m[nm] = rec[i]
+			}
+		}
+		m["$id"] = rid
+		for _, fld := range r.flds {
+			if _, err = fld.expr.eval(ctx, m); err != nil {
+				return err
+			}
+		}
+
+		h = in[0].(int64)
+	}
+	m["$agg"] = true
+	for i, fld := range r.flds {
+		if out[i], err = fld.expr.eval(ctx, m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 type sysColumnDefaultPlan struct{}
 
 func (r *sysColumnDefaultPlan) hasID() bool { return false }
@@ -2102,7 +2787,7 @@ func (r *sysColumnDefaultPlan) fieldNames() []string {
 
 func (r *sysColumnDefaultPlan) do(ctx *execCtx, f func(id interface{}, data []interface{}) (bool, error)) error {
 	rec := make([]interface{}, 4)
-	di, err := ctx.db.info()
+	di, err := ctx.db.info(ctx)
 	if err != nil {
 		return err
 	}
@@ -2115,7 +2800,7 @@ func (r *sysColumnDefaultPlan) do(ctx *execCtx, f func(id interface{}, data []in
 			ix++
 			rec[1] = ix
 			rec[2] = ci.Name
-			rec[3] = ci.Type.String()
+			rec[3] = ci.typeStr()
 			id++
 			if more, err := f(id, rec); !more || err != nil {
 				return err
@@ -2143,7 +2828,7 @@ func (r *sysIndexDefaultPlan) fieldNames() []string {
 
 func (r *sysIndexDefaultPlan) do(ctx *execCtx, f func(id interface{}, data []interface{}) (bool, error)) error {
 	rec := make([]interface{}, 4)
-	di, err := ctx.db.info()
+	di, err := ctx.db.info(ctx)
 	if err != nil {
 		return err
 	}
@@ -2178,7 +2863,7 @@ func (r *sysTableDefaultPlan) fieldNames() []string { return []string{"Name", "S
 
 func (r *sysTableDefaultPlan) do(ctx *execCtx, f func(id interface{}, data []interface{}) (bool, error)) error {
 	rec := make([]interface{}, 2)
-	di, err := ctx.db.info()
+	di, err := ctx.db.info(ctx)
 	if err != nil {
 		return err
 	}
@@ -2186,21 +2871,7 @@ func (r *sysTableDefaultPlan) do(ctx *execCtx, f func(id interface{}, data []int
 	var id int64
 	for _, ti := range di.Tables {
 		rec[0] = ti.Name
-		a := []string{}
-		for _, ci := range ti.Columns {
-			s := ""
-			if ci.NotNull {
-				s += " NOT NULL"
-			}
-			if c := ci.Constraint; c != "" {
-				s += " " + c
-			}
-			if d := ci.Default; d != "" {
-				s += " DEFAULT " + d
-			}
-			a = append(a, fmt.Sprintf("%s %s%s", ci.Name, ci.Type, s))
-		}
-		rec[1] = fmt.Sprintf("CREATE TABLE %s (%s);", ti.Name, strings.Join(a, ", "))
+		rec[1] = ti.createStmt()
 		id++
 		if more, err := f(id, rec); !more || err != nil {
 			return err
@@ -2226,11 +2897,15 @@ func (r *tableNilPlan) filter(expr expression) (plan, []string, error) {
 }
 
 func (r *tableNilPlan) do(ctx *execCtx, f func(id interface{}, data []interface{}) (bool, error)) (err error) {
-	t := r.t
+	t := ctx.table(r.t)
 	h := t.head
 	cols := t.cols
 	for h > 0 {
-		rec, err := t.store.Read(nil, h, cols...) // 0:next, 1:id, 2...: data
+		if err := ctx.canceled(); err != nil {
+			return err
+		}
+
+		rec, err := ctx.read(nil, h, cols...) // 0:next, 1:id, 2...: data
 		if err != nil {
 			return err
 		}
@@ -2247,6 +2922,41 @@ func (r *tableNilPlan) do(ctx *execCtx, f func(id interface{}, data []interface{
 type tableDefaultPlan struct {
 	t      *table
 	fields []string
+	use    map[string]bool // USE INDEX hint, nil if none.
+	ignore map[string]bool // IGNORE INDEX hint.
+}
+
+func (r *tableDefaultPlan) hint(h *recordSetHint) {
+	m := &r.ignore
+	if h.kind == useIndexHint {
+		m = &r.use
+	}
+	if *m == nil {
+		*m = map[string]bool{}
+	}
+	for _, v := range h.indices {
+		(*m)[v] = true
+	}
+}
+
+func (r *tableDefaultPlan) mayUse(xname string) bool {
+	return !r.ignore[xname] && (r.use == nil || r.use[xname])
+}
+
+// findIndexByColName is like table.findIndexByColName but it honors the index
+// hints. If column cn has no index, the result includes the index suggestion.
+func (r *tableDefaultPlan) findIndexByColName(cn string) (*col, *indexedCol, []string) {
+	t := r.t
+	c, ix := t.findIndexByColName(cn)
+	if ix == nil { // Column cn has no index.
+		return nil, nil, []string{fmt.Sprintf("%s(%s)", t.name, cn)}
+	}
+
+	if !r.mayUse(ix.name) {
+		return nil, nil, nil
+	}
+
+	return c, ix, nil
 }
 
 func (r *tableDefaultPlan) hasID() bool { return true }
@@ -2262,19 +2972,34 @@ func (r *tableDefaultPlan) filterBinOp(x *binaryOperation) (plan, []string, erro
 	}
 
 	if !ok {
-		return nil, nil, nil
+		return r.filterIndex2(x)
 	}
 
 	t := r.t
-	c, ix := t.findIndexByColName(cn)
-	if ix == nil { // Column cn has no index.
-		return nil, []string{fmt.Sprintf("%s(%s)", t.name, cn)}, nil
+	c, ix, is := r.findIndexByColName(cn)
+	if ix == nil {
+		return nil, is, nil
 	}
 
 	if rval, err = typeCheck1(rval, c); err != nil {
 		return nil, nil, err
 	}
 
+	if c.collation != "" {
+		// The index is ordered by the collation keys, only the rows
+		// having the key of rval are looked up in it.
+		if x.op != eq {
+			return nil, nil, nil
+		}
+
+		k, err := collationKey(c.collation, rval)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return &filterDefaultPlan{&indexPlan{t, cn, ix.name, ix.x, indexEq, k, k}, x, nil}, nil, nil
+	}
+
 	switch x.op {
 	case eq:
 		return &indexPlan{t, cn, ix.name, ix.x, indexEq, rval, rval}, nil, nil
@@ -2293,6 +3018,144 @@ func (r *tableDefaultPlan) filterBinOp(x *binaryOperation) (plan, []string, erro
 	}
 }
 
+// stringFuncs are the builtin functions whose non NULL values are strings.
+var stringFuncs = map[string]bool{
+	"concat":    true,
+	"lower":     true,
+	"lpad":      true,
+	"ltrim":     true,
+	"repeat":    true,
+	"replace":   true,
+	"rpad":      true,
+	"rtrim":     true,
+	"splitPart": true,
+	"substr":    true,
+	"trim":      true,
+	"upper":     true,
+}
+
+// filterIndex2 returns a plan using an index on the expression x.l if x
+// compares a jsonExtract value with a constant or the value of a string
+// function with a string constant. The values of other expressions may not
+// collate with the constant.
+func (r *tableDefaultPlan) filterIndex2(x *binaryOperation) (plan, []string, error) {
+	f, ok := x.l.(*call)
+	if !ok {
+		return nil, nil, nil
+	}
+
+	if stringFuncs[f.f] {
+		return r.filterStringIndex2(x)
+	}
+
+	if f.f != "jsonExtract" {
+		return nil, nil, nil
+	}
+
+	v, ok := x.r.(value)
+	if !ok {
+		return nil, nil, nil
+	}
+
+	rval := v.val
+	switch rval.(type) {
+	case idealFloat, idealInt, idealRune, idealUint:
+		var err error
+		if rval, err = convert(rval, qFloat64); err != nil {
+			return nil, nil, err
+		}
+	case bool, float64, string:
+		// nop
+	default:
+		return nil, nil, nil
+	}
+
+	// The values of other types collate before or after rval, see
+	// jsonKind, so the range of rval's type bounds ordered comparisons.
+	// Strings collate last.
+	var lo, hi interface{}
+	switch rval.(type) {
+	case bool:
+		lo, hi = false, true
+	case float64:
+		lo, hi = math.Inf(-1), math.Inf(1)
+	case string:
+		lo = ""
+	}
+
+	var kind int
+	var lval, hval interface{}
+	switch x.op {
+	case eq:
+		kind, lval, hval = indexEq, rval, rval
+	case '<':
+		kind, lval, hval = indexIntervalCO, lo, rval
+	case le:
+		kind, lval, hval = indexIntervalCC, lo, rval
+	case ge:
+		kind, lval, hval = indexIntervalCC, rval, hi
+		if hi == nil {
+			kind = indexGe
+		}
+	case '>':
+		kind, lval, hval = indexIntervalOC, rval, hi
+		if hi == nil {
+			kind = indexGt
+		}
+	default:
+		return nil, nil, nil
+	}
+
+	return r.index2Plan(x.l.String(), kind, lval, hval), nil, nil
+}
+
+func (r *tableDefaultPlan) filterStringIndex2(x *binaryOperation) (plan, []string, error) {
+	v, ok := x.r.(value)
+	if !ok {
+		return nil, nil, nil
+	}
+
+	s, ok := v.val.(string)
+	if !ok {
+		return nil, nil, nil
+	}
+
+	var kind int
+	var lval, hval interface{}
+	switch x.op {
+	case eq:
+		kind, lval, hval = indexEq, s, s
+	case '<':
+		kind, hval = indexLt, s
+	case le:
+		kind, hval = indexLe, s
+	case ge:
+		kind, lval = indexGe, s
+	case '>':
+		kind, lval = indexGt, s
+	default:
+		return nil, nil, nil
+	}
+
+	return r.index2Plan(x.l.String(), kind, lval, hval), nil, nil
+}
+
+// index2Plan returns a plan using the first, by name, usable index on the
+// expression sexpr or nil if there is no such index.
+func (r *tableDefaultPlan) index2Plan(sexpr string, kind int, lval, hval interface{}) plan {
+	xname := ""
+	for nm, ix := range r.t.indices2 {
+		if len(ix.sources) == 1 && ix.sources[0] == sexpr && r.mayUse(nm) && (xname == "" || nm < xname) {
+			xname = nm
+		}
+	}
+	if xname == "" {
+		return nil
+	}
+
+	return &indexPlan{r.t, sexpr, xname, r.t.indices2[xname].x, kind, lval, hval}
+}
+
 func (r *tableDefaultPlan) filterIdent(x *ident, trueValue bool) (plan, []string, error) {
 	cn := x.s
 	t := r.t
@@ -2315,6 +3178,10 @@ func (r *tableDefaultPlan) filterIdent(x *ident, trueValue bool) (plan, []string
 			return nil, []string{fmt.Sprintf("%s(%s)", t.name, cn)}, nil
 		}
 
+		if !r.mayUse(ix.name) {
+			return nil, nil, nil
+		}
+
 		kind := indexFalse
 		if trueValue {
 			kind = indexTrue
@@ -2331,9 +3198,9 @@ func (r *tableDefaultPlan) filterIsNull(x *isNull) (plan, []string, error) {
 	}
 
 	t := r.t
-	_, ix := t.findIndexByColName(cn)
-	if ix == nil { // Column cn has no index.
-		return nil, []string{fmt.Sprintf("%s(%s)", t.name, cn)}, nil
+	_, ix, is := r.findIndexByColName(cn)
+	if ix == nil {
+		return nil, is, nil
 	}
 
 	switch {
@@ -2344,6 +3211,243 @@ func (r *tableDefaultPlan) filterIsNull(x *isNull) (plan, []string, error) {
 	}
 }
 
+func (r *tableDefaultPlan) filterIn(x *pIn) (plan, []string, error) {
+	if x.not || x.sel != nil {
+		return nil, nil, nil
+	}
+
+	ok, cn := isColumnExpression(x.expr)
+	if !ok {
+		return nil, nil, nil
+	}
+
+	t := r.t
+	c, ix, is := r.findIndexByColName(cn)
+	if ix == nil {
+		return nil, is, nil
+	}
+
+	if c.collation != "" {
+		return nil, nil, nil
+	}
+
+	var vals []interface{}
+	for _, v := range x.list {
+		val := isConstValue(v)
+		if val == nil {
+			return nil, nil, nil
+		}
+
+		val, err := typeCheck1(val, c)
+		if err != nil {
+			return nil, nil, nil
+		}
+
+		vals = append(vals, val)
+	}
+
+	u := &indexUnionPlan{fields: r.fields}
+	for i, v := range vals {
+		dup := false
+		for _, w := range vals[:i] {
+			if collate1(v, w) == 0 {
+				dup = true
+				break
+			}
+		}
+		if !dup {
+			u.plans = append(u.plans, &indexPlan{t, cn, ix.name, ix.x, indexEq, v, v})
+		}
+	}
+	if len(u.plans) == 1 {
+		return u.plans[0], nil, nil
+	}
+
+	return u, nil, nil
+}
+
+func (r *tableDefaultPlan) filterLike(x *pLike) (plan, []string, error) {
+	ok, cn := isColumnExpression(x.expr)
+	if !ok {
+		return nil, nil, nil
+	}
+
+	pattern, ok := isConstValue(x.pattern).(string)
+	if !ok {
+		return nil, nil, nil
+	}
+
+	pattern, err := x.regexpSource(pattern)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	prefix := regexpPrefix(pattern)
+	if prefix == "" {
+		return nil, nil, nil
+	}
+
+	t := r.t
+	c, ix, is := r.findIndexByColName(cn)
+	if ix == nil {
+		return nil, is, nil
+	}
+
+	if c.typ != qString || c.collation != "" {
+		return nil, nil, nil
+	}
+
+	p := &indexPlan{t, cn, ix.name, ix.x, indexGe, prefix, nil}
+	if hval := prefixSuccessor(prefix); hval != "" {
+		p.kind = indexIntervalCO
+		p.hval = hval
+	}
+	return &filterDefaultPlan{p, x, nil}, nil, nil
+}
+
+// filterMatch returns a plan using a full-text index if x is MATCH of a
+// column with a constant query.
+func (r *tableDefaultPlan) filterMatch(x *call) (plan, []string, error) {
+	ok, cn := isColumnExpression(x.arg[0])
+	if !ok {
+		return nil, nil, nil
+	}
+
+	query, ok := isConstValue(x.arg[1]).(string)
+	if !ok {
+		return nil, nil, nil
+	}
+
+	tokenizer := "simple"
+	if len(x.arg) > 2 {
+		if tokenizer, ok = isConstValue(x.arg[2]).(string); !ok {
+			return nil, nil, nil
+		}
+	}
+
+	q, err := parseFullTextQuery(tokenizer, query)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	xname, col := "", -1
+	for nm, ix := range r.t.indices2 {
+		if ix.tokenizer != tokenizer || !r.mayUse(nm) || xname != "" && nm > xname {
+			continue
+		}
+
+		for i, v := range ix.sources {
+			if v == cn {
+				xname, col = nm, i
+				break
+			}
+		}
+	}
+	if xname == "" {
+		return nil, nil, nil
+	}
+
+	p := &fulltextPlan{r.t, cn, xname, r.t.indices2[xname].x, int64(col), query, q, x}
+	if q.hasPhrase() {
+		return &filterDefaultPlan{p, x, nil}, nil, nil
+	}
+
+	return p, nil, nil
+}
+
+// filterWithin returns a plan using a spatial index if x is within of the
+// point of its columns and a constant box.
+func (r *tableDefaultPlan) filterWithin(x *call) (plan, []string, error) {
+	xname, ix := r.spatialIndex(x.arg[0])
+	if ix == nil {
+		return nil, nil, nil
+	}
+
+	v, err := staticValue(x.arg[1])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	box, err := boxArg(v, "within")
+	if err != nil {
+		return nil, nil, nil
+	}
+
+	return &spatialPlan{src: r.t, xname: xname, x: ix.x.(*rtree), sources: ix.sources, box: box}, nil, nil
+}
+
+// spatialIndex returns the name of a spatial index usable for the point e
+// and the index, or nil if there is none. The lowest named index is
+// preferred.
+func (r *tableDefaultPlan) spatialIndex(e expression) (string, *index2) {
+	c1, c2, ok := isSpatialPoint(e)
+	if !ok {
+		return "", nil
+	}
+
+	xname := ""
+	for nm, ix := range r.t.indices2 {
+		if ix.spatial && ix.sources[0] == c1 && ix.sources[1] == c2 && r.mayUse(nm) && (xname == "" || nm < xname) {
+			xname = nm
+		}
+	}
+	if xname == "" {
+		return "", nil
+	}
+
+	return xname, r.t.indices2[xname]
+}
+
+func (r *tableDefaultPlan) filterOrOp(x *binaryOperation) (plan, []string, error) {
+	var in []expression
+	var f func(expression)
+	f = func(e expression) {
+		b, ok := e.(*binaryOperation)
+		if !ok || b.op != oror {
+			in = append(in, e)
+			return
+		}
+
+		f(b.l)
+		f(b.r)
+	}
+	f(x)
+	var is []string
+	u := &indexUnionPlan{fields: r.fields}
+	for _, e := range in {
+		p, is2, err := r.filter(e)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		switch y := p.(type) {
+		case nil:
+			is = append(is, is2...)
+			u = nil
+		case *nullPlan:
+			// nop
+		case *indexUnionPlan:
+			if u != nil {
+				u.plans = append(u.plans, y.plans...)
+			}
+		default:
+			if u != nil {
+				u.plans = append(u.plans, p)
+			}
+		}
+	}
+	switch {
+	case u == nil:
+		return nil, is, nil
+	case len(u.plans) == 0:
+		return &nullPlan{r.fields}, nil, nil
+	case len(u.plans) == 1:
+		return u.plans[0], nil, nil
+	default:
+		return u, nil, nil
+	}
+}
+
 func (r *tableDefaultPlan) filter(expr expression) (plan, []string, error) {
 	cols := mentionedColumns(expr)
 	for _, v := range r.fields {
@@ -2372,11 +3476,26 @@ func (r *tableDefaultPlan) filter(expr expression) (plan, []string, error) {
 
 	switch x := expr.(type) {
 	case *binaryOperation:
+		if x.op == oror {
+			return r.filterOrOp(x)
+		}
+
 		return r.filterBinOp(x)
+	case *call:
+		switch x.f {
+		case "match":
+			return r.filterMatch(x)
+		case "within":
+			return r.filterWithin(x)
+		}
 	case *ident:
 		return r.filterIdent(x, true)
 	case *isNull:
 		return r.filterIsNull(x)
+	case *pIn:
+		return r.filterIn(x)
+	case *pLike:
+		return r.filterLike(x)
 	case *unaryOperation:
 		if x.op != '!' {
 			break
@@ -2394,7 +3513,7 @@ func (r *tableDefaultPlan) filter(expr expression) (plan, []string, error) {
 }
 
 func (r *tableDefaultPlan) do(ctx *execCtx, f func(interface{}, []interface{}) (bool, error)) (err error) {
-	t := r.t
+	t := ctx.table(r.t)
 	cols := t.cols
 	h := t.head
 	for h > 0 {
@@ -2577,6 +3696,7 @@ func (r *fullJoinDefaultPlan) filter(expr expression) (plan, []string, error) {
 func (r *leftJoinDefaultPlan) fieldNames() []string { return r.fields }
 
 func (r *leftJoinDefaultPlan) do(ctx *execCtx, f func(id interface{}, data []interface{}) (more bool, err error)) error {
+	on := collateExpression(r.on, joinCollations(r.rsets, r.fields))
 	m := map[interface{}]interface{}{}
 	ids := map[string]interface{}{}
 	var g func([]interface{}, []plan, int) error
@@ -2607,7 +3727,7 @@ func (r *leftJoinDefaultPlan) do(ctx *execCtx, f func(id interface{}, data []int
 				}
 			}
 
-			val, err := r.on.eval(ctx, m)
+			val, err := on.eval(ctx, m)
 			if err != nil {
 				return false, err
 			}
@@ -2633,6 +3753,7 @@ func (r *leftJoinDefaultPlan) do(ctx *execCtx, f func(id interface{}, data []int
 }
 
 func (r *rightJoinDefaultPlan) do(ctx *execCtx, f func(id interface{}, data []interface{}) (more bool, err error)) error {
+	on := collateExpression(r.on, joinCollations(r.rsets, r.fields))
 	right := r.right
 	left := len(r.fields) - right
 	n := len(r.rsets)
@@ -2672,7 +3793,7 @@ func (r *rightJoinDefaultPlan) do(ctx *execCtx, f func(id interface{}, data []in
 				}
 			}
 
-			val, err := r.on.eval(ctx, m)
+			val, err := on.eval(ctx, m)
 			if err != nil {
 				return false, err
 			}
@@ -2712,6 +3833,7 @@ func (r *fullJoinDefaultPlan) do(ctx *execCtx, f func(id interface{}, data []int
 
 		return 1
 	})
+	on := collateExpression(r.on, joinCollations(r.rsets, r.fields))
 	m := map[interface{}]interface{}{}
 	ids := map[string]interface{}{}
 	var g func([]interface{}, []plan, int) error
@@ -2752,7 +3874,7 @@ func (r *fullJoinDefaultPlan) do(ctx *execCtx, f func(id interface{}, data []int
 				}
 			}
 
-			val, err := r.on.eval(ctx, m)
+			val, err := on.eval(ctx, m)
 			if err != nil {
 				return false, err
 			}
@@ -2786,6 +3908,10 @@ func (r *fullJoinDefaultPlan) do(ctx *execCtx, f func(id interface{}, data []int
 
 	pref := make([]interface{}, len(r.fields)-r.right)
 	for {
+		if err := ctx.canceled(); err != nil {
+			return err
+		}
+
 		_, v, err := it.Next()
 		if err != nil {
 			return noEOF(err)
@@ -2827,3 +3953,44 @@ func (r *selectDummyPlan) do(ctx *execCtx, f func(id interface{}, data []interfa
 	_, err = f(nil, data)
 	return
 }
+
+type unnestDefaultPlan struct {
+	expr    expression
+	fields  []string
+	lateral bool // expr refers to the fields of the preceding record sets of a cross join.
+}
+
+func (r *unnestDefaultPlan) hasID() bool { return false }
+
+func (r *unnestDefaultPlan) explain(w strutil.Formatter) {
+	w.Format("┌Iterate all elements of %v\n└Output field names %v\n", r.expr, qnames(r.fields))
+}
+
+func (r *unnestDefaultPlan) fieldNames() []string { return r.fields }
+
+func (r *unnestDefaultPlan) filter(expr expression) (plan, []string, error) {
+	return nil, nil, nil
+}
+
+func (r *unnestDefaultPlan) do(ctx *execCtx, f func(id interface{}, data []interface{}) (bool, error)) error {
+	return r.doIn(ctx, map[interface{}]interface{}{}, f)
+}
+
+// doIn calls f for every element of the array r.expr evaluates to in m.
+func (r *unnestDefaultPlan) doIn(ctx *execCtx, m map[interface{}]interface{}, f func(id interface{}, data []interface{}) (bool, error)) error {
+	v, err := expand1(r.expr.eval(ctx, m))
+	if err != nil || v == nil {
+		return err
+	}
+
+	if arrayElem(v) == 0 {
+		return invArg(ideal(v), "unnest")
+	}
+
+	for _, x := range arrayValues(v) {
+		if more, err := f(nil, []interface{}{x}); !more || err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,182 @@
+// Copyright 2026 The ql Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ql
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// planCacheSize is the maximum number of plans cached by a DB.
+const planCacheSize = 1000
+
+type planKey struct {
+	s     *selectStmt
+	types string // Types of the arguments.
+}
+
+// cachedPlan is a plan of a SELECT statement valid for a version of the DB
+// schema. The plan refers to the tables of the root it was built for, it
+// reads the tables of the same name of the root it is executed with, see
+// execCtx.table.
+type cachedPlan struct {
+	plan
+	schema int64
+	bound  bool          // The plan depends on the values of arg.
+	arg    []interface{} // Arguments used when bound is true.
+}
+
+// invalidatePlans gives the root of the open transaction a new schema
+// version, making stale the cached plans of other versions. It must be called
+// whenever the tables, indices or types of the DB change. Versions are never
+// reused, a rollback restores the version of the parent root.
+func (db *DB) invalidatePlans() {
+	db.plansMu.Lock()
+	db.planVer++
+	db.root.schema = db.planVer
+	db.plansMu.Unlock()
+}
+
+// changesSchema reports whether s changes the tables, indices or types of the
+// DB.
+func changesSchema(s stmt) bool {
+	switch s.(type) {
+	case
+		*alterTableAddStmt,
+		*alterTableDropColumnStmt,
+		*createIndexStmt,
+		*createTableStmt,
+		*createTypeStmt,
+		*dropIndexStmt,
+		*dropTableStmt,
+		*dropTypeStmt,
+		*truncateTableStmt:
+		return true
+	}
+
+	return false
+}
+
+// plan returns the plan of s. Plans are built once for a schema version and
+// the types of the arguments of s. Plans where WHERE picks an index or
+// evaluates a condition using the values of the arguments are reused only for
+// the same values.
+func (db *DB) plan(ctx *execCtx, s *selectStmt) (plan, error) {
+	k := planKey{s, argTypes(ctx.arg)}
+	schema := ctx.root().schema
+	db.plansMu.Lock()
+	c := db.plans[k]
+	db.plansMu.Unlock()
+	if c != nil && c.schema == schema && (!c.bound || sameArgs(c.arg, ctx.arg)) {
+		return c.plan, nil
+	}
+
+	p, err := s.plan(ctx)
+	if err != nil || ctx.noCache {
+		return p, err
+	}
+
+	c = &cachedPlan{plan: p, schema: schema, bound: ctx.boundArgs}
+	if c.bound {
+		c.arg = copyArgs(ctx.arg)
+	}
+	db.plansMu.Lock()
+	for k := range db.plans {
+		if len(db.plans) < planCacheSize {
+			break
+		}
+
+		delete(db.plans, k)
+	}
+	db.plans[k] = c
+	db.plansMu.Unlock()
+	return p, nil
+}
+
+func argTypes(arg []interface{}) string {
+	if len(arg) == 0 {
+		return ""
+	}
+
+	a := make([]string, len(arg))
+	for i, v := range arg {
+		a[i] = fmt.Sprintf("%T", v)
+	}
+	return strings.Join(a, ",")
+}
+
+// copyArgs returns a copy of arg not sharing memory with values the caller
+// may later modify.
+func copyArgs(arg []interface{}) []interface{} {
+	r := make([]interface{}, len(arg))
+	for i, v := range arg {
+		switch x := v.(type) {
+		case []byte:
+			v = append([]byte(nil), x...)
+		case json.RawMessage:
+			v = append(json.RawMessage(nil), x...)
+		case *big.Int:
+			v = new(big.Int).Set(x)
+		case *big.Rat:
+			v = new(big.Rat).Set(x)
+		default:
+			if arrayElem(x) != 0 {
+				v = cloneArray(x)
+			}
+		}
+		r[i] = v
+	}
+	return r
+}
+
+// sameArgs reports whether a and b, having values of the same types, are
+// equal.
+func sameArgs(a, b []interface{}) bool {
+	for i, v := range a {
+		switch x := v.(type) {
+		case []byte:
+			if !bytes.Equal(x, b[i].([]byte)) {
+				return false
+			}
+		case json.RawMessage:
+			if !bytes.Equal(x, b[i].(json.RawMessage)) {
+				return false
+			}
+		case *big.Int:
+			if x.Cmp(b[i].(*big.Int)) != 0 {
+				return false
+			}
+		case *big.Rat:
+			if x.Cmp(b[i].(*big.Rat)) != 0 {
+				return false
+			}
+		case Dec:
+			if y := b[i].(Dec); x.Cmp(y) != 0 || x.Scale() != y.Scale() {
+				return false
+			}
+		case time.Time:
+			if !x.Equal(b[i].(time.Time)) || x.Location() != b[i].(time.Time).Location() {
+				return false
+			}
+		default:
+			if arrayElem(v) != 0 {
+				if collateArrays(v, b[i]) != 0 {
+					return false
+				}
+
+				break
+			}
+
+			if v != b[i] {
+				return false
+			}
+		}
+	}
+	return true
+}
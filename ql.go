@@ -10,12 +10,16 @@ package ql
 
 import (
 	"bytes"
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math/big"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/cznic/strutil"
@@ -28,6 +32,12 @@ const (
 	fullJoin
 )
 
+const (
+	useIndexHint = iota
+	ignoreIndexHint
+	forceJoinOrderHint
+)
+
 // NOTE: all rset implementations must be safe for concurrent use by multiple
 // goroutines.  If the do method requires any execution domain local data, they
 // must be held out of the implementing instance.
@@ -53,7 +63,9 @@ type rset interface {
 type recordset struct {
 	ctx *execCtx
 	plan
-	tx *TCtx
+	tx   *TCtx
+	root *root       // The root plan was built for, nil if plan does not depend on it.
+	s    *selectStmt // The statement planned, if root != nil.
 }
 
 func (r recordset) fieldNames() []interface{} {
@@ -184,7 +196,7 @@ func (r *groupByRset) plan(ctx *execCtx) (plan, error) {
 			return nil, fmt.Errorf("unknown field %s", v)
 		}
 	}
-	return &groupByDefaultPlan{colNames: r.colNames, src: r.src, fields: fields}, nil
+	return &groupByDefaultPlan{colNames: r.colNames, src: r.src, fields: fields, collations: planCollations(r.src)}, nil
 }
 
 // TCtx represents transaction context. It enables to execute multiple
@@ -205,6 +217,12 @@ func (r *groupByRset) plan(ctx *execCtx) (plan, error) {
 type TCtx struct {
 	LastInsertID int64
 	RowsAffected int64
+
+	// Limits, if not nil, replace the limits set by DB.SetLimits for the
+	// statements executed using the context.
+	Limits *Limits
+
+	snap *snapshot // Non nil in a read-only transaction, guarded by DB.mu.
 }
 
 // NewRWCtx returns a new read/write transaction context.  NewRWCtx is safe for
@@ -270,13 +288,15 @@ type distinctRset struct {
 }
 
 func (r *distinctRset) plan(ctx *execCtx) (plan, error) {
-	return &distinctDefaultPlan{src: r.src, fields: r.src.fieldNames()}, nil
+	fields := r.src.fieldNames()
+	return &distinctDefaultPlan{src: r.src, fields: fields, collations: rowCollations(fields, planCollations(r.src))}, nil
 }
 
 type orderByRset struct {
-	asc bool
-	by  []expression
-	src plan
+	asc    bool
+	by     []expression
+	src    plan
+	hidden int // Number of the last fields of src used only for ordering.
 }
 
 func (r *orderByRset) String() string {
@@ -292,13 +312,17 @@ func (r *orderByRset) String() string {
 }
 
 func (r *orderByRset) plan(ctx *execCtx) (plan, error) {
+	fields := r.src.fieldNames()
 	if _, ok := r.src.(*nullPlan); ok {
-		return r.src, nil
+		return &nullPlan{fields[:len(fields)-r.hidden]}, nil
 	}
 
+	colls := planCollations(r.src)
 	var by []expression
-	fields := r.src.fieldNames()
 	for _, e := range r.by {
+		if nm := exprCollation(e, colls); nm != "" {
+			e = &collateExpr{expr: e, name: nm}
+		}
 		cols := mentionedColumns(e)
 		for k := range cols {
 			found := false
@@ -326,7 +350,7 @@ func (r *orderByRset) plan(ctx *execCtx) (plan, error) {
 
 		by = append(by, e)
 	}
-	return &orderByDefaultPlan{asc: r.asc, by: by, src: r.src, fields: fields}, nil
+	return &orderByDefaultPlan{asc: r.asc, by: by, src: r.src, fields: fields, hidden: r.hidden}, nil
 }
 
 type whereRset struct {
@@ -394,7 +418,7 @@ func (r *whereRset) planBinOp(x *binaryOperation) (plan, error) {
 	var p2 plan
 	var is []string
 	switch x.op {
-	case eq, ge, '>', le, '<', neq:
+	case eq, ge, '>', le, '<', neq, oror:
 		if p2, is, err = p.filter(x); err != nil {
 			return nil, err
 		}
@@ -425,6 +449,12 @@ func (r *whereRset) planBinOp(x *binaryOperation) (plan, error) {
 				return nil, err
 			}
 
+			if p2 == nil && isNewPlan {
+				if p2, err = r.intersect(p, e); err != nil {
+					return nil, err
+				}
+			}
+
 			if p2 == nil {
 				is = append(is, is2...)
 				out = append(out, e)
@@ -460,6 +490,34 @@ func (r *whereRset) planBinOp(x *binaryOperation) (plan, error) {
 	return &filterDefaultPlan{p, x, is}, nil
 }
 
+// intersect returns a plan producing the rows of p, which must be derived from
+// r.src, satisfying also e. The result is nil if e cannot be planned as an
+// index lookup of r.src.
+func (r *whereRset) intersect(p plan, e expression) (plan, error) {
+	if _, ok := r.src.(*tableDefaultPlan); !ok {
+		return nil, nil
+	}
+
+	q, _, err := r.src.filter(e)
+	if q == nil || err != nil {
+		return nil, err
+	}
+
+	switch x := q.(type) {
+	case *nullPlan:
+		return x, nil
+	case *indexUnionPlan:
+		return nil, nil
+	}
+
+	if x, ok := p.(*indexIntersectionPlan); ok {
+		x.plans = append(x.plans, q)
+		return x, nil
+	}
+
+	return &indexIntersectionPlan{[]plan{p, q}, p.fieldNames()}, nil
+}
+
 func (r *whereRset) planIdent(x *ident) (plan, error) {
 	p := r.src
 	p2, is, err := p.filter(x)
@@ -474,6 +532,20 @@ func (r *whereRset) planIdent(x *ident) (plan, error) {
 	return &filterDefaultPlan{p, x, is}, nil
 }
 
+func (r *whereRset) planIn(x *pIn) (plan, error) {
+	p := r.src
+	p2, is, err := p.filter(x)
+	if err != nil {
+		return nil, err
+	}
+
+	if p2 != nil {
+		return p2, nil
+	}
+
+	return &filterDefaultPlan{p, x, is}, nil
+}
+
 func (r *whereRset) planIsNull(x *isNull) (plan, error) {
 	p := r.src
 	ok, cn := isColumnExpression(x.expr)
@@ -512,6 +584,116 @@ func (r *whereRset) planIsNull(x *isNull) (plan, error) {
 	return &filterDefaultPlan{p, x, is}, nil
 }
 
+// planNearest returns the plan of a WHERE clause having a nearest predicate
+// as a term of its AND expression x. The rows satisfying the other terms are
+// searched for the nearest ones. The plan is nil if there is no nearest
+// predicate.
+func (r *whereRset) planNearest(ctx *execCtx, x expression) (plan, error) {
+	var in []expression
+	var f func(expression)
+	f = func(e expression) {
+		b, ok := e.(*binaryOperation)
+		if !ok || b.op != andand {
+			in = append(in, e)
+			return
+		}
+
+		f(b.l)
+		f(b.r)
+	}
+	f(x)
+	var near *call
+	var rest expression
+	for _, e := range in {
+		if c, ok := e.(*call); ok && c.f == "nearest" {
+			if near != nil {
+				return nil, fmt.Errorf("more than one nearest predicate in the WHERE clause")
+			}
+
+			near = c
+			continue
+		}
+
+		if rest == nil {
+			rest = e
+			continue
+		}
+
+		var err error
+		if rest, err = newBinaryOperation(andand, rest, e); err != nil {
+			return nil, err
+		}
+	}
+	if near == nil {
+		return nil, nil
+	}
+
+	v, err := staticValue(near.arg[1])
+	if err != nil {
+		return nil, err
+	}
+
+	point, err := pointArg(v, "nearest")
+	if err != nil {
+		return nil, err
+	}
+
+	if v, err = staticValue(near.arg[2]); err != nil {
+		return nil, err
+	}
+
+	k, err := intExpr(v)
+	if err != nil {
+		return nil, fmt.Errorf("invalid number of rows for nearest: %v", err)
+	}
+
+	if t, ok := r.src.(*tableDefaultPlan); ok {
+		if xname, ix := t.spatialIndex(near.arg[0]); ix != nil {
+			var p plan = &spatialPlan{src: t.t, xname: xname, x: ix.x.(*rtree), sources: ix.sources, nearest: true, point: point}
+			if rest != nil {
+				p = &filterDefaultPlan{p, rest, nil}
+			}
+			return &limitDefaultPlan{expr: value{k}, src: p, fields: p.fieldNames()}, nil
+		}
+	}
+
+	src := r.src
+	if rest != nil {
+		if src, err = (&whereRset{expr: rest, src: r.src}).planExpr(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return &nearestDefaultPlan{src, near.arg[0], point, k}, nil
+}
+
+func (r *whereRset) planCall(x *call) (plan, error) {
+	p := r.src
+	p2, is, err := p.filter(x)
+	if err != nil {
+		return nil, err
+	}
+
+	if p2 != nil {
+		return p2, nil
+	}
+
+	return &filterDefaultPlan{p, x, is}, nil
+}
+
+func (r *whereRset) planLike(x *pLike) (plan, error) {
+	p := r.src
+	p2, is, err := p.filter(x)
+	if err != nil {
+		return nil, err
+	}
+
+	if p2 != nil {
+		return p2, nil
+	}
+
+	return &filterDefaultPlan{p, x, is}, nil
+}
+
 func (r *whereRset) planUnaryOp(x *unaryOperation) (plan, error) {
 	p := r.src
 	p2, is, err := p.filter(x)
@@ -529,6 +711,7 @@ func (r *whereRset) planUnaryOp(x *unaryOperation) (plan, error) {
 func (r *whereRset) plan(ctx *execCtx) (plan, error) {
 	o := r.src
 	if r.sel != nil {
+		ctx.noCache = true // The plan depends on the data.
 		var exists bool
 		ctx.mu.RLock()
 		m, ok := ctx.cache[r.sel]
@@ -570,6 +753,15 @@ func (r *whereRset) planExpr(ctx *execCtx) (plan, error) {
 		return nil, err
 	}
 
+	visitExpression(r.expr, func(e expression) {
+		if _, ok := e.(parameter); ok {
+			ctx.boundArgs = true
+		}
+	})
+	if p, err := r.planNearest(ctx, expr); p != nil || err != nil {
+		return p, err
+	}
+
 	switch r.src.(type) {
 	case *leftJoinDefaultPlan, *rightJoinDefaultPlan, *fullJoinDefaultPlan:
 		return &filterDefaultPlan{r.src, expr, nil}, nil
@@ -578,15 +770,16 @@ func (r *whereRset) planExpr(ctx *execCtx) (plan, error) {
 	switch x := expr.(type) {
 	case *binaryOperation:
 		return r.planBinOp(x)
+	case *call:
+		return r.planCall(x)
 	case *ident:
 		return r.planIdent(x)
 	case *isNull:
 		return r.planIsNull(x)
 	case *pIn:
-		//TODO optimize
-		//TODO show plan
+		return r.planIn(x)
 	case *pLike:
-		//TODO optimize
+		return r.planLike(x)
 	case *unaryOperation:
 		return r.planUnaryOp(x)
 	}
@@ -648,7 +841,7 @@ func (r *selectRset) plan(ctx *execCtx) (plan, error) {
 			return &selectFieldsGroupPlan{flds: flds, src: x, fields: fields}, nil
 		}
 
-		p := &selectFieldsGroupPlan{flds: flds2, src: x}
+		p := &selectFieldsGroupPlan{flds: collateFields(flds2, planCollations(x)), src: x}
 		for _, v := range r.flds {
 			p.fields = append(p.fields, v.name)
 		}
@@ -659,7 +852,22 @@ func (r *selectRset) plan(ctx *execCtx) (plan, error) {
 		return r.src, nil
 	}
 
-	f0 := r.src.fieldNames()
+	src := r.src
+	m := map[string]struct{}{}
+	for _, v := range r.flds {
+		mentionedColumns0(v.expr, true, true, m)
+	}
+	switch x := src.(type) {
+	case *selectFieldsDefaultPlan:
+		src = x.project(m)
+	case *filterDefaultPlan:
+		if y, ok := x.plan.(*selectFieldsDefaultPlan); ok {
+			mentionedColumns0(x.expr, true, true, m)
+			src = &filterDefaultPlan{y.project(m), x.expr, x.is}
+		}
+	}
+
+	f0 := src.fieldNames()
 	if len(f0) == len(flds2) {
 		match := true
 		for i, v := range flds2 {
@@ -672,11 +880,10 @@ func (r *selectRset) plan(ctx *execCtx) (plan, error) {
 		}
 
 		if match {
-			return r.src, nil
+			return src, nil
 		}
 	}
 
-	src := r.src
 	if x, ok := src.(*tableDefaultPlan); ok {
 		isconst := true
 		for _, v := range flds2 {
@@ -709,9 +916,9 @@ func (r tableRset) plan(ctx *execCtx) (plan, error) {
 		return &sysIndexDefaultPlan{}, nil
 	}
 
-	t, ok := ctx.db.root.tables[string(r)]
+	t, ok := ctx.root().tables[string(r)]
 	if !ok && isTesting {
-		if _, x0 := ctx.db.root.findIndexByName(string(r)); x0 != nil {
+		if _, x0 := ctx.root().findIndexByName(string(r)); x0 != nil {
 			return &selectIndexDefaultPlan{nm: string(r), x: x0}, nil
 		}
 	}
@@ -743,6 +950,11 @@ type col struct {
 	typ        int
 	constraint *constraint
 	dflt       expression
+	prec       int       // Precision of a decimal column.
+	scale      int       // Scale of a decimal column.
+	enum       *enumType // Type of an enum column.
+	elem       int       // Element type of an array column.
+	collation  string    // Collation of a string column, "" if binary.
 }
 
 var idCol = &col{name: "id()", typ: qInt64}
@@ -808,6 +1020,14 @@ func (f *col) typeCheck(x interface{}) (ok bool) { //NTYPE
 		return f.typ == qTime
 	case time.Duration:
 		return f.typ == qDuration
+	case json.RawMessage:
+		return f.typ == qJSON
+	case Dec:
+		return f.typ == qDecimal
+	case Enum:
+		return f.typ == qEnum
+	case UUID:
+		return f.typ == qUUID
 	case chunk:
 		return true // was checked earlier
 	}
@@ -817,7 +1037,20 @@ func (f *col) typeCheck(x interface{}) (ok bool) { //NTYPE
 func cols2meta(f []*col) (s string) {
 	a := []string{}
 	for _, f := range f {
-		a = append(a, string(f.typ)+f.name)
+		s := string(f.typ)
+		switch f.typ {
+		case qDecimal:
+			s += fmt.Sprintf("(%d,%d)", f.prec, f.scale)
+		case qEnum:
+			s += "(" + f.enum.name + ")"
+		case qArray:
+			s += "(" + string(rune(f.elem)) + ")"
+		case qString:
+			if f.collation != "" {
+				s += "(" + f.collation + ")"
+			}
+		}
+		a = append(a, s+f.name)
 	}
 	return strings.Join(a, "|")
 }
@@ -825,31 +1058,44 @@ func cols2meta(f []*col) (s string) {
 // DB represent the database capable of executing QL statements.
 type DB struct {
 	cc          *TCtx // Current transaction context
+	committed   *root // Last committed root, guarded by snapMu.
 	exprCache   map[string]expression
 	exprCacheMu sync.Mutex
 	hasIndex2   int // 0: nope, 1: in progress, 2: yes.
 	isMem       bool
+	limits      atomic.Value // Limits.
 	mu          sync.Mutex
+	parallelism int32 // Accessed atomically.
+	planVer     int64 // Last schema version, guarded by plansMu.
+	plans       map[planKey]*cachedPlan
+	plansMu     sync.Mutex
 	queue       []chan struct{}
 	root        *root
 	rw          bool // DB FSM
-	rwmu        sync.RWMutex
+	snapMu      sync.Mutex
 	store       storage
 	tnl         int // Transaction nesting level
 }
 
-var selIndex2Expr = MustCompile("select Expr from __Index2_Expr where Index2_ID == $1")
+var selIndex2Expr = MustCompile("select id() as ID, Expr from __Index2_Expr where Index2_ID == $1 order by ID")
 
 func newDB(store storage) (db *DB, err error) {
+	store = newMVCC(store)
 	db0 := &DB{
 		exprCache: map[string]expression{},
+		plans:     map[planKey]*cachedPlan{},
 		store:     store,
 	}
 	if db0.root, err = newRoot(store); err != nil {
 		return
 	}
 
+	db0.committed = db0.root
 	ctx := newExecCtx(db0, nil)
+	if err := db0.loadEnums(ctx); err != nil {
+		return nil, err
+	}
+
 	for _, t := range db0.root.tables {
 		if err := t.constraintsAndDefaults(ctx); err != nil {
 			return nil, err
@@ -871,6 +1117,16 @@ func newDB(store storage) (db *DB, err error) {
 		return nil, err
 	}
 
+	tokenizers, err := db0.fullTextTokenizers()
+	if err != nil {
+		return nil, err
+	}
+
+	spatial, err := db0.spatialIndices()
+	if err != nil {
+		return nil, err
+	}
+
 	for _, row := range rows {
 		defer func() {
 			if e := recover(); e != nil {
@@ -889,9 +1145,14 @@ func newDB(store storage) (db *DB, err error) {
 			return nil, fmt.Errorf("DB index refers to nonexistent table: %s", tn)
 		}
 
-		x, err := store.OpenIndex(unique, xroot)
-		if err != nil {
-			return nil, err
+		var x btreeIndex
+		switch {
+		case spatial[xn]:
+			x = openRtree(store, xroot)
+		default:
+			if x, err = store.OpenIndex(unique, xroot); err != nil {
+				return nil, err
+			}
 		}
 
 		if v := t.indices2[xn]; v != nil {
@@ -899,9 +1160,11 @@ func newDB(store storage) (db *DB, err error) {
 		}
 
 		ix := &index2{
-			unique: unique,
-			x:      x,
-			xroot:  xroot,
+			unique:    unique,
+			x:         x,
+			xroot:     xroot,
+			tokenizer: tokenizers[xn],
+			spatial:   spatial[xn],
 		}
 
 		rss, _, err := db0.Execute(nil, selIndex2Expr, id)
@@ -921,9 +1184,9 @@ func newDB(store storage) (db *DB, err error) {
 		var sources []string
 		var list []expression
 		for _, row := range rows {
-			src, ok := row[0].(string)
+			src, ok := row[1].(string)
 			if !ok {
-				return nil, fmt.Errorf("index %s: expression of type %T", xn, row[0])
+				return nil, fmt.Errorf("index %s: expression of type %T", xn, row[1])
 			}
 
 			expr, err := db0.str2expr(src)
@@ -937,6 +1200,7 @@ func newDB(store storage) (db *DB, err error) {
 
 		ix.sources = sources
 		ix.exprList = list
+		ix.evals = compileEvals(list, colTypes(t.cols), nil)
 		if t.indices2 == nil {
 			t.indices2 = map[string]*index2{}
 		}
@@ -951,7 +1215,11 @@ func (db *DB) deleteIndex2ByIndexName(nm string) error {
 			return err
 		}
 	}
-	return nil
+	if err := db.deleteIndexMeta("__FullText", deleteFullTextByIndexName, nm); err != nil {
+		return err
+	}
+
+	return db.deleteIndexMeta("__Spatial", deleteSpatialByIndexName, nm)
 }
 
 func (db *DB) deleteIndex2ByTableName(nm string) error {
@@ -960,6 +1228,25 @@ func (db *DB) deleteIndex2ByTableName(nm string) error {
 			return err
 		}
 	}
+	if err := db.deleteIndexMeta("__FullText", deleteFullTextByTableName, nm); err != nil {
+		return err
+	}
+
+	return db.deleteIndexMeta("__Spatial", deleteSpatialByTableName, nm)
+}
+
+// deleteIndexMeta executes the DELETE statement list of the system table tn
+// for the name nm of an index or a table.
+func (db *DB) deleteIndexMeta(tn string, list List, nm string) error {
+	if _, ok := db.root.tables[tn]; !ok {
+		return nil
+	}
+
+	for _, s := range list.l {
+		if _, err := s.exec(newExecCtx(db, []interface{}{nm})); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -1076,6 +1363,17 @@ func (db *DB) Run(ctx *TCtx, ql string, arg ...interface{}) (rs []Recordset, ind
 	return db.Execute(ctx, l, arg...)
 }
 
+// RunContext is like Run but stops the execution of the statements when ctx
+// is done. See ExecuteContext for details.
+func (db *DB) RunContext(ctx context.Context, tctx *TCtx, ql string, arg ...interface{}) (rs []Recordset, index int, err error) {
+	l, err := Compile(ql)
+	if err != nil {
+		return nil, -1, err
+	}
+
+	return db.ExecuteContext(ctx, tctx, l, arg...)
+}
+
 func (db *DB) run(ctx *TCtx, ql string, arg ...interface{}) (rs []Recordset, index int, err error) {
 	l, err := compile(ql)
 	if err != nil {
@@ -1095,7 +1393,7 @@ func Compile(src string) (List, error) {
 		return List{}, err
 	}
 
-	if yyParse(l) != 0 {
+	if yyParse(l) != 0 || len(l.errs) != 0 {
 		return List{}, l.errs
 	}
 
@@ -1109,7 +1407,7 @@ func compileExpr(src string) (expression, error) {
 	}
 
 	l.inj = parseExpression
-	if yyParse(l) != 0 {
+	if yyParse(l) != 0 || len(l.errs) != 0 {
 		return nil, l.errs
 	}
 
@@ -1123,7 +1421,7 @@ func compile(src string) (List, error) {
 	}
 
 	l.root = true
-	if yyParse(l) != 0 {
+	if yyParse(l) != 0 || len(l.errs) != 0 {
 		return List{}, l.errs
 	}
 
@@ -1216,6 +1514,14 @@ func mustCompile(src string) List {
 // Execute is safe for concurrent use by multiple goroutines, but one must
 // consider the blocking issues as discussed above.
 //
+// The DB remembers the execution plans of the SELECT statements of l, so
+// executing the same List again avoids planning the statements. A plan is
+// reused while the schema of the DB does not change and only for arguments of
+// the same types. If the WHERE clause refers to parameters, the plan is
+// reused only for the same argument values. Statements changing the schema,
+// like CREATE TABLE or ALTER TABLE, discard the plans, other statements and
+// transactions do not.
+//
 // ACID
 //
 // Atomicity: Transactions are atomic. Transactions can be nested. Commit or
@@ -1226,44 +1532,77 @@ func mustCompile(src string) List {
 // Consistency: Transactions bring the DB from one structurally consistent
 // state to other structurally consistent state.
 //
-// Isolation: Transactions are isolated. Isolation is implemented by
-// serialization.
+// Isolation: Transactions are isolated. Transactions are serialized, only one
+// transaction can be open at any time and it sees its own updates. Statements
+// executed outside of a transaction read a snapshot of the DB as it was last
+// committed. Such reads do not wait for the open transaction and the
+// transaction does not wait for them. Every call of the Do method of a
+// Recordset obtained outside of a transaction reads the snapshot current at
+// the time of the call.
+//
+// Index entries are not versioned. A snapshot reading an index modified after
+// the snapshot was taken, by a commit or by the open transaction, finds the
+// remaining rows by scanning the whole table instead, including a MATCH
+// using a fulltext index. The result is the same but such a read can be
+// considerably slower, even though EXPLAIN shows the index.
 //
 // Durability: Transactions are durable. A two phase commit protocol and a
 // write ahead log is used. Database is recovered after a crash from the write
 // ahead log automatically on open.
 func (db *DB) Execute(ctx *TCtx, l List, arg ...interface{}) (rs []Recordset, index int, err error) {
+	return db.ExecuteContext(context.Background(), ctx, l, arg...)
+}
+
+// ExecuteContext is like Execute but stops the execution of l when ctx is
+// done, returning ctx.Err(). That includes waiting for other transactions to
+// finish and reading the records of the returned Recordsets.
+//
+// If ctx is done while a statement of l is executed inside a transaction, the
+// DB is rolled back like after any other failed statement.
+func (db *DB) ExecuteContext(ctx context.Context, tctx *TCtx, l List, arg ...interface{}) (rs []Recordset, index int, err error) {
 	// Sanitize args
 	for i, v := range arg {
 		switch x := v.(type) {
 		case nil, bool, complex64, complex128, float32, float64, string,
 			int8, int16, int32, int64, int,
 			uint8, uint16, uint32, uint64, uint,
-			*big.Int, *big.Rat, []byte, time.Duration, time.Time:
+			*big.Int, *big.Rat, []byte, time.Duration, time.Time, Dec, Enum, UUID:
 		case big.Int:
 			arg[i] = &x
 		case big.Rat:
 			arg[i] = &x
+		case json.RawMessage:
+			y, err := jsonValue(x)
+			if err != nil {
+				return nil, 0, fmt.Errorf("cannot use arg[%d]: %v", i, err)
+			}
+
+			arg[i] = y
 		default:
+			if arrayElem(v) != 0 {
+				arg[i] = cloneArray(v)
+				break
+			}
+
 			return nil, 0, fmt.Errorf("cannot use arg[%d] (type %T):unsupported type", i, v)
 		}
 	}
 
 	tnl0 := -1
-	if ctx != nil {
-		ctx.LastInsertID, ctx.RowsAffected = 0, 0
+	if tctx != nil {
+		tctx.LastInsertID, tctx.RowsAffected = 0, 0
 	}
 
 	list := l.l
 	for _, s := range list {
-		r, tnla, tnl, err := db.run1(ctx, s, arg...)
+		r, tnla, tnl, err := db.run1(ctx, tctx, s, arg...)
 		if tnl0 < 0 {
 			tnl0 = tnla
 		}
 		if err != nil {
 			for tnl > tnl0 {
 				var e2 error
-				if _, _, tnl, e2 = db.run1(ctx, rollbackStmt{}); e2 != nil {
+				if _, _, tnl, e2 = db.run1(context.Background(), tctx, rollbackStmt{}); e2 != nil {
 					err = e2
 				}
 			}
@@ -1272,7 +1611,7 @@ func (db *DB) Execute(ctx *TCtx, l List, arg ...interface{}) (rs []Recordset, in
 
 		if r != nil {
 			if x, ok := r.(recordset); ok {
-				x.tx = ctx
+				x.tx = tctx
 				r = x
 			}
 			rs = append(rs, r)
@@ -1281,6 +1620,16 @@ func (db *DB) Execute(ctx *TCtx, l List, arg ...interface{}) (rs []Recordset, in
 	return
 }
 
+// dequeue removes ch from the queue of transactions waiting for the DB.
+func (db *DB) dequeue(ch chan struct{}) {
+	for i, v := range db.queue {
+		if v == ch {
+			db.queue = append(db.queue[:i], db.queue[i+1:]...)
+			return
+		}
+	}
+}
+
 func (db *DB) muUnlock() {
 	if n := len(db.queue); n != 0 {
 		db.queue[0] <- struct{}{}
@@ -1290,8 +1639,18 @@ func (db *DB) muUnlock() {
 	db.mu.Unlock()
 }
 
-func (db *DB) run1(pc *TCtx, s stmt, arg ...interface{}) (rs Recordset, tnla, tnlb int, err error) {
+func (db *DB) run1(ctx context.Context, pc *TCtx, s stmt, arg ...interface{}) (rs Recordset, tnla, tnlb int, err error) {
+	x := newExecCtx(db, arg).withContext(ctx).withLimits(db.limitsFor(pc))
+	defer x.stop()
 	db.mu.Lock()
+	if pc != nil && pc.snap != nil {
+		snap := pc.snap.retain()
+		db.muUnlock()
+		defer snap.release()
+		rs, err = db.readSnapshot(x, s, snap)
+		return rs, 0, 0, err
+	}
+
 	tnla = db.tnl
 	tnlb = db.tnl
 	switch db.rw {
@@ -1303,11 +1662,14 @@ func (db *DB) run1(pc *TCtx, s stmt, arg ...interface{}) (rs Recordset, tnla, tn
 				return nil, tnla, tnlb, errors.New("BEGIN TRANSACTION: cannot start a transaction in nil TransactionCtx")
 			}
 
+			if err = x.canceled(); err != nil {
+				return
+			}
+
 			if err = db.store.BeginTransaction(); err != nil {
 				return
 			}
 
-			db.rwmu.Lock()
 			db.beginTransaction()
 			db.cc = pc
 			db.tnl++
@@ -1326,10 +1688,8 @@ func (db *DB) run1(pc *TCtx, s stmt, arg ...interface{}) (rs Recordset, tnla, tn
 				return nil, tnla, tnlb, fmt.Errorf("attempt to update the DB outside of a transaction")
 			}
 
-			db.rwmu.RLock() // can safely grab before Unlock
 			db.muUnlock()
-			defer db.rwmu.RUnlock()
-			rs, err = s.exec(newExecCtx(db, arg)) // R/O tctx
+			rs, err = db.readOnly(x, s) // R/O tctx
 			return rs, tnla, tnlb, err
 		}
 	default: // case true:
@@ -1346,13 +1706,23 @@ func (db *DB) run1(pc *TCtx, s stmt, arg ...interface{}) (rs Recordset, tnla, tn
 					ch := make(chan struct{}, 1)
 					db.queue = append(db.queue, ch)
 					db.mu.Unlock()
-					<-ch
-					db.mu.Lock()
+					select {
+					case <-ch:
+						db.mu.Lock()
+					case <-x.done:
+						db.mu.Lock()
+						// The deferred muUnlock wakes up the next
+						// waiter if ch was already signaled.
+						db.dequeue(ch)
+						return nil, tnla, tnlb, x.context.Err()
+					case <-x.timeout:
+						db.mu.Lock()
+						db.dequeue(ch)
+						return nil, tnla, tnlb, ErrMaxStatementTime
+					}
 				}
 
 				db.rw = true
-				db.rwmu.Lock()
-
 			}
 
 			if err = db.store.BeginTransaction(); err != nil {
@@ -1371,16 +1741,20 @@ func (db *DB) run1(pc *TCtx, s stmt, arg ...interface{}) (rs Recordset, tnla, tn
 			}
 
 			db.commit()
+			db.snapMu.Lock()
 			err = db.store.Commit()
 			db.tnl--
 			tnlb = db.tnl
+			if db.tnl == 0 {
+				db.committed = db.root
+			}
+			db.snapMu.Unlock()
 			if db.tnl != 0 {
 				return
 			}
 
 			db.cc = nil
 			db.rw = false
-			db.rwmu.Unlock()
 			return
 		case rollbackStmt:
 			defer db.muUnlock()
@@ -1399,7 +1773,6 @@ func (db *DB) run1(pc *TCtx, s stmt, arg ...interface{}) (rs Recordset, tnla, tn
 
 			db.cc = nil
 			db.rw = false
-			db.rwmu.Unlock()
 			return
 		default:
 			if pc == nil {
@@ -1408,10 +1781,8 @@ func (db *DB) run1(pc *TCtx, s stmt, arg ...interface{}) (rs Recordset, tnla, tn
 					return nil, tnla, tnlb, fmt.Errorf("attempt to update the DB outside of a transaction")
 				}
 
-				db.muUnlock() // must Unlock before RLock
-				db.rwmu.RLock()
-				defer db.rwmu.RUnlock()
-				rs, err = s.exec(newExecCtx(db, arg))
+				db.muUnlock()
+				rs, err = db.readOnly(x, s)
 				return rs, tnla, tnlb, err
 			}
 
@@ -1421,7 +1792,16 @@ func (db *DB) run1(pc *TCtx, s stmt, arg ...interface{}) (rs Recordset, tnla, tn
 				return nil, tnla, tnlb, fmt.Errorf("invalid passed transaction context")
 			}
 
-			rs, err = s.exec(newExecCtx(db, arg))
+			// Plans built while s runs, like those of the statements
+			// updating the system tables, get a version of their own.
+			ddl := changesSchema(s)
+			if ddl {
+				db.invalidatePlans()
+			}
+			rs, err = s.exec(x)
+			if ddl {
+				db.invalidatePlans()
+			}
 			return rs, tnla, tnlb, err
 		}
 	}
@@ -1455,28 +1835,117 @@ func (db *DB) Close() error {
 	return err
 }
 
+// readOnly executes s, which does not update the DB, on a snapshot of the DB.
+func (db *DB) readOnly(x *execCtx, s stmt) (Recordset, error) {
+	if err := x.canceled(); err != nil {
+		return nil, err
+	}
+
+	x.snap = db.snapshot()
+	defer x.snap.release()
+	return s.exec(x)
+}
+
+// readSnapshot executes s in the read-only transaction reading snap.
+func (db *DB) readSnapshot(x *execCtx, s stmt, snap *snapshot) (Recordset, error) {
+	switch s.(type) {
+	case beginTransactionStmt, commitStmt, rollbackStmt:
+		return nil, fmt.Errorf("%s cannot be nested in a read-only transaction", strings.TrimSuffix(s.String(), ";"))
+	}
+
+	if s.isUpdating() {
+		return nil, fmt.Errorf("attempt to update the DB in a read-only transaction")
+	}
+
+	if err := x.canceled(); err != nil {
+		return nil, err
+	}
+
+	x.snap = snap
+	return s.exec(x)
+}
+
+// beginReadOnly starts a read-only transaction in tctx. Until endReadOnly is
+// called, the statements executed using tctx, and the Recordsets they return,
+// read the snapshot of the DB taken by beginReadOnly. They do not wait for
+// other transactions and other transactions do not wait for them.
+func (db *DB) beginReadOnly(tctx *TCtx) error {
+	db.mu.Lock()
+	defer db.muUnlock()
+	if db.store == nil {
+		return fmt.Errorf("DB is closed")
+	}
+
+	if tctx.snap != nil || db.rw && tctx == db.cc {
+		return fmt.Errorf("cannot start a read-only transaction in an open transaction")
+	}
+
+	tctx.snap = db.snapshot()
+	return nil
+}
+
+// endReadOnly ends the read-only transaction started in tctx by
+// beginReadOnly.
+func (db *DB) endReadOnly(tctx *TCtx) error {
+	db.mu.Lock()
+	snap := tctx.snap
+	tctx.snap = nil
+	db.muUnlock()
+	if snap == nil {
+		return fmt.Errorf("not in a read-only transaction")
+	}
+
+	snap.release()
+	return nil
+}
+
+// snapshot returns a snapshot of the last committed state of db. It must be
+// released when no more used.
+func (db *DB) snapshot() *snapshot {
+	db.snapMu.Lock()
+	defer db.snapMu.Unlock()
+	s := db.store.(*mvcc)
+	return &snapshot{s, s.acquire(), db.committed}
+}
+
 func (db *DB) do(r recordset, f func(data []interface{}) (bool, error)) (err error) {
+	var snap *snapshot
 	db.mu.Lock()
-	switch db.rw {
-	case false:
-		db.rwmu.RLock() // can safely grab before Unlock
+	switch {
+	case r.tx != nil && r.tx.snap != nil:
+		snap = r.tx.snap.retain()
 		db.muUnlock()
-		defer db.rwmu.RUnlock()
-	default: // case true:
-		if r.tx == nil {
-			db.muUnlock() // must Unlock before RLock
-			db.rwmu.RLock()
-			defer db.rwmu.RUnlock()
-			break
-		}
-
+		defer snap.release()
+	case db.rw && r.tx != nil:
 		defer db.muUnlock()
 		if r.tx != db.cc {
 			return fmt.Errorf("invalid passed transaction context")
 		}
+	default:
+		db.muUnlock()
+		snap = db.snapshot()
+		defer snap.release()
 	}
 
-	return r.do(r.ctx, func(id interface{}, data []interface{}) (bool, error) {
+	ctx := r.ctx.at(snap)
+	defer ctx.stop()
+	p := r.plan
+	if r.root != nil && r.root.schema != ctx.root().schema {
+		// The schema changed since r was planned.
+		if p, err = db.plan(ctx, r.s); err != nil {
+			return err
+		}
+	}
+
+	return p.do(ctx, func(id interface{}, data []interface{}) (bool, error) {
+		if err = ctx.canceled(); err != nil {
+			return false, err
+		}
+
+		if err = ctx.result(); err != nil {
+			return false, err
+		}
+
 		if err = expand(data); err != nil {
 			return false, err
 		}
@@ -1518,25 +1987,30 @@ type Type int
 
 // Values of ColumnInfo.Type.
 const (
-	BigInt     Type = qBigInt
+	Array      Type = qArray
+	BigInt          = qBigInt
 	BigRat          = qBigRat
 	Blob            = qBlob
 	Bool            = qBool
 	Complex128      = qComplex128
 	Complex64       = qComplex64
+	Decimal         = qDecimal
 	Duration        = qDuration
+	EnumType        = qEnum
 	Float32         = qFloat32
 	Float64         = qFloat64
 	Int16           = qInt16
 	Int32           = qInt32
 	Int64           = qInt64
 	Int8            = qInt8
+	JSON            = qJSON
 	String          = qString
 	Time            = qTime
 	Uint16          = qUint16
 	Uint32          = qUint32
 	Uint64          = qUint64
 	Uint8           = qUint8
+	UUIDType        = qUUID
 )
 
 // String implements fmt.Stringer.
@@ -1551,6 +2025,23 @@ type ColumnInfo struct {
 	NotNull    bool   // Column cannot be NULL.
 	Constraint string // Constraint expression, if any.
 	Default    string // Default expression, if any.
+	Precision  int    // Precision of a Decimal column.
+	Scale      int    // Scale of a Decimal column.
+	Enum       string // Type name of an EnumType column.
+	Elem       Type   // Element type of an Array column.
+	Collation  string // Collation of a String column, empty if binary.
+}
+
+// typeStr returns the type of the column as written in a column definition.
+func (ci *ColumnInfo) typeStr() string {
+	switch ci.Type {
+	case EnumType:
+		return ci.Enum
+	case Array:
+		return arrayTypeStr(int(ci.Elem))
+	}
+
+	return colTypeStr(int(ci.Type), ci.Precision, ci.Scale)
 }
 
 // TableInfo provides meta data describing a DB table.
@@ -1563,6 +2054,25 @@ type TableInfo struct {
 	Columns []ColumnInfo
 }
 
+// createStmt returns the CREATE TABLE statement of the table.
+func (t *TableInfo) createStmt() string {
+	a := []string{}
+	for _, ci := range t.Columns {
+		s := ""
+		if ci.NotNull {
+			s += " NOT NULL"
+		}
+		if c := ci.Constraint; c != "" {
+			s += " " + c
+		}
+		if d := ci.Default; d != "" {
+			s += " DEFAULT " + d
+		}
+		a = append(a, fmt.Sprintf("%s %s%s%s", ci.Name, ci.typeStr(), collateClause(ci.Collation), s))
+	}
+	return fmt.Sprintf("CREATE TABLE %s (%s);", t.Name, strings.Join(a, ", "))
+}
+
 // IndexInfo provides meta data describing a DB index.  It corresponds to the
 // statement
 //
@@ -1573,6 +2083,40 @@ type IndexInfo struct {
 	Column         string   // Column name.
 	Unique         bool     // Whether the index is unique.
 	ExpressionList []string // Index expression list.
+	Tokenizer      string   // Tokenizer of a full-text index, empty otherwise.
+	Spatial        bool     // Whether the index is a spatial index.
+}
+
+// createStmt returns the CREATE INDEX statement of the index.
+func (x *IndexInfo) createStmt() string {
+	if x.Tokenizer != "" {
+		return fmt.Sprintf("CREATE FULLTEXT INDEX %s ON %s (%s)%s;", x.Name, x.Table, strings.Join(x.ExpressionList, ", "), usingTokenizer(x.Tokenizer))
+	}
+
+	if x.Spatial {
+		return fmt.Sprintf("CREATE SPATIAL INDEX %s ON %s (%s);", x.Name, x.Table, strings.Join(x.ExpressionList, ", "))
+	}
+
+	u := ""
+	if x.Unique {
+		u = "UNIQUE "
+	}
+	return fmt.Sprintf("CREATE %sINDEX %s ON %s (%s);", u, x.Name, x.Table, strings.Join(x.ExpressionList, ", "))
+}
+
+// EnumInfo provides meta data describing an enum type.
+type EnumInfo struct {
+	Name   string   // Type name.
+	Labels []string // Labels in the order of declaration.
+}
+
+// createStmt returns the CREATE TYPE statement of the enum type.
+func (e *EnumInfo) createStmt() string {
+	a := make([]string, len(e.Labels))
+	for i, v := range e.Labels {
+		a[i] = strconv.Quote(v)
+	}
+	return fmt.Sprintf("CREATE TYPE %s AS ENUM (%s);", e.Name, strings.Join(a, ", "))
 }
 
 // DbInfo provides meta data describing a DB.
@@ -1580,22 +2124,26 @@ type DbInfo struct {
 	Name    string      // DB name.
 	Tables  []TableInfo // Tables in the DB.
 	Indices []IndexInfo // Indices in the DB.
+	Enums   []EnumInfo  // Enum types in the DB.
 }
 
-func (db *DB) info() (r *DbInfo, err error) {
-	_, hasColumn2 := db.root.tables["__Column2"]
+func (db *DB) info(x *execCtx) (r *DbInfo, err error) {
+	root := x.root()
+	_, hasColumn2 := root.tables["__Column2"]
 	r = &DbInfo{Name: db.Name()}
-	for nm, t := range db.root.tables {
+	for nm, t := range root.tables {
 		ti := TableInfo{Name: nm}
 		m := map[string]*ColumnInfo{}
 		if hasColumn2 {
-			rs, err := selectColumn2.l[0].exec(newExecCtx(db, []interface{}{nm}))
+			ctx := newExecCtx(db, []interface{}{nm})
+			ctx.snap = x.snap
+			rs, err := selectColumn2.l[0].exec(ctx)
 			if err != nil {
 				return nil, err
 			}
 
 			if err := rs.(recordset).do(
-				newExecCtx(db, []interface{}{nm}),
+				ctx,
 				func(id interface{}, data []interface{}) (bool, error) {
 					ci := &ColumnInfo{NotNull: data[1].(bool), Constraint: data[2].(string), Default: data[3].(string)}
 					m[data[0].(string)] = ci
@@ -1606,7 +2154,10 @@ func (db *DB) info() (r *DbInfo, err error) {
 			}
 		}
 		for _, c := range t.cols {
-			ci := ColumnInfo{Name: c.name, Type: Type(c.typ)}
+			ci := ColumnInfo{Name: c.name, Type: Type(c.typ), Precision: c.prec, Scale: c.scale, Elem: Type(c.elem), Collation: c.collation}
+			if c.typ == qEnum {
+				ci.Enum = c.enum.name
+			}
 			if c2 := m[c.name]; c2 != nil {
 				ci.NotNull = c2.NotNull
 				ci.Constraint = c2.Constraint
@@ -1627,7 +2178,7 @@ func (db *DB) info() (r *DbInfo, err error) {
 			default:
 				cn = t.cols0[i-1].name
 			}
-			r.Indices = append(r.Indices, IndexInfo{x.name, nm, cn, x.unique, []string{cn}})
+			r.Indices = append(r.Indices, IndexInfo{x.name, nm, cn, x.unique, []string{cn}, "", false})
 		}
 		var a []string
 		for k := range t.indices2 {
@@ -1639,13 +2190,28 @@ func (db *DB) info() (r *DbInfo, err error) {
 				continue
 			}
 
-			a = a[:0]
+			var l []string
 			for _, e := range x.exprList {
-				a = append(a, e.String())
+				l = append(l, e.String())
 			}
-			r.Indices = append(r.Indices, IndexInfo{k, nm, "", x.unique, a})
+			r.Indices = append(r.Indices, IndexInfo{k, nm, "", x.unique, l, x.tokenizer, x.spatial})
 		}
 	}
+	if _, ok := root.tables["__Enum"]; !ok {
+		return
+	}
+
+	ctx := newExecCtx(db, nil)
+	ctx.snap = x.snap
+	m, err := db.enumTypes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, e := range m {
+		r.Enums = append(r.Enums, EnumInfo{e.name, e.labels})
+	}
+	sort.Slice(r.Enums, func(i, j int) bool { return r.Enums[i].Name < r.Enums[j].Name })
 	return
 }
 
@@ -1654,7 +2220,7 @@ func (db *DB) info() (r *DbInfo, err error) {
 func (db *DB) Info() (r *DbInfo, err error) {
 	db.mu.Lock()
 	defer db.muUnlock()
-	return db.info()
+	return db.info(newExecCtx(db, nil))
 }
 
 type constraint struct {
@@ -1673,6 +2239,36 @@ func (c *constraint) clone() *constraint {
 	return &constraint{e}
 }
 
+type recordSetHint struct {
+	kind    int
+	indices []string
+}
+
+func (h *recordSetHint) String() string {
+	switch h.kind {
+	case useIndexHint:
+		return fmt.Sprintf("USE INDEX (%s)", strings.Join(h.indices, ", "))
+	case ignoreIndexHint:
+		return fmt.Sprintf("IGNORE INDEX (%s)", strings.Join(h.indices, ", "))
+	case forceJoinOrderHint:
+		return "FORCE JOIN ORDER"
+	default:
+		panic("internal error 071")
+	}
+}
+
+// unnestRset is the table function unnest(expr) of a FROM clause. It has a
+// row for every element of the array expr, in a field named value.
+type unnestRset struct {
+	expr expression
+}
+
+func (r *unnestRset) String() string { return fmt.Sprintf("unnest(%s)", r.expr) }
+
+func (r *unnestRset) plan(ctx *execCtx) (plan, error) {
+	return &unnestDefaultPlan{expr: r.expr, fields: []string{"value"}}, nil
+}
+
 type joinRset struct {
 	sources []interface{}
 	typ     int
@@ -1707,9 +2303,19 @@ func (r *joinRset) String() string {
 			default:
 				a[i] = fmt.Sprintf("(%s) AS %s", x, altName)
 			}
+		case *unnestRset:
+			switch {
+			case altName == "":
+				a[i] = x.String()
+			default:
+				a[i] = fmt.Sprintf("%s AS %s", x, altName)
+			}
 		default:
 			panic("internal error 054")
 		}
+		for _, h := range pair[2].([]*recordSetHint) {
+			a[i] += " " + h.String()
+		}
 	}
 	n := len(a)
 	a2 := a[:n-1]
@@ -1768,6 +2374,14 @@ func (r *joinRset) plan(ctx *execCtx) (plan, error) {
 			panic("internal error 008")
 		}
 
+		if u, ok := q.(*unnestDefaultPlan); ok && i != 0 && r.typ == crossJoin {
+			m := map[string]struct{}{}
+			mentionedColumns0(u.expr, true, true, m)
+			if len(m) != 0 { // Evaluated for every row of the preceding record sets.
+				u.lateral = true
+			}
+		}
+
 		switch {
 		case len(r.sources) == 1:
 			fields = q.fieldNames()
@@ -1783,6 +2397,41 @@ func (r *joinRset) plan(ctx *execCtx) (plan, error) {
 				fields = append(fields, f)
 			}
 		}
+		for _, h := range pair[2].([]*recordSetHint) {
+			switch h.kind {
+			case forceJoinOrderHint:
+				if r.typ != crossJoin {
+					return nil, fmt.Errorf("%s: %s: not allowed in an outer join", r.String(), h)
+				}
+
+				continue
+			}
+
+			_, isTable := pair[0].(string)
+			t, ok := q.(*tableDefaultPlan)
+			if !isTable || !ok {
+				s := nm
+				if s == "" {
+					s = fmt.Sprintf("#%d", i+1)
+				}
+				return nil, fmt.Errorf("%s: %s: record set %s is not a table", r.String(), h, s)
+			}
+
+			other := t.ignore
+			if h.kind != useIndexHint {
+				other = t.use
+			}
+			for _, xn := range h.indices {
+				if t.t.findIndexByName(xn) == nil {
+					return nil, fmt.Errorf("%s: table %s has no index %s", h, t.t.name, xn)
+				}
+
+				if other[xn] {
+					return nil, fmt.Errorf("%s: index %s of table %s is both used and ignored", h, xn, t.t.name)
+				}
+			}
+			t.hint(h)
+		}
 		rsets[i] = q
 	}
 
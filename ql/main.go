@@ -12,6 +12,11 @@
 // Usage:
 //
 //	ql [-db name] [-schema regexp] [-tables regexp] [-fld] statement_list
+//	ql [-db name] -backup dest
+//	ql [-db name] [-tables regexp] -dump
+//	ql [-db name] -restore src
+//	ql [-db name] [-header] [-comma c] [-null s] [-batch n] -import src table
+//	ql [-db name] [-header] [-comma c] [-null s] -export dest query
 //
 // Options:
 //
@@ -30,6 +35,30 @@
 //
 //	-t		Report and measure time to execute, including creating/opening and closing the DB.
 //
+//	-backup dest	Write a backup of the DB to the new file dest and exit.
+//			Other processes may use the DB meanwhile.
+//
+//	-dump		Write the DB to stdout as a QL script and exit.
+//			If -tables re is given, only the matching tables are written.
+//			Other processes may use the DB meanwhile.
+//
+//	-restore src	Create the DB, which must not exist, from the backup src and exit.
+//			If src is not a DB file but a QL script, for example written
+//			by -dump, it is executed in a new DB instead.
+//
+//	-import src	Insert the records of the CSV file src into table and exit.
+//
+//	-export dest	Write the result of the SELECT statement query to the CSV file dest and exit.
+//
+//	-header		The first CSV record holds column names.
+//
+//	-comma c	The CSV field delimiter. Defaults to ",".
+//
+//	-null s		The CSV text of NULL values. Defaults to "".
+//
+//	-batch n	Import at most n rows per transaction. Defaults to 0, meaning all rows
+//			are imported in a single transaction.
+//
 // Example:
 //
 //	$ ql 'create table t (i int, s string)'
@@ -86,13 +115,22 @@ func main() {
 }
 
 type config struct {
+	backup      string
+	batch       int
+	comma       string
 	db          string
+	dump        bool
+	export      string
 	flds        bool
 	schema      string
 	tables      string
 	time        bool
+	header      bool
 	help        bool
+	import_     string
 	interactive bool
+	null        string
+	restore     string
 }
 
 func (c *config) parse() {
@@ -103,14 +141,32 @@ func (c *config) parse() {
 	time := flag.Bool("t", false, "Measure and report time to execute the statement(s) including DB create/open/close.")
 	help := flag.Bool("h", false, "Shows this help text.")
 	interactive := flag.Bool("i", false, "runs in interactive mode")
+	backup := flag.String("backup", "", "If non empty, write a backup of the DB to the new file and exit.")
+	dump := flag.Bool("dump", false, "Write the DB to stdout as a QL script and exit.")
+	restore := flag.String("restore", "", "If non empty, create the DB from the backup file or QL script and exit.")
+	import_ := flag.String("import", "", "If non empty, insert the records of the CSV file into the table named by the argument and exit.")
+	export := flag.String("export", "", "If non empty, write the result of the query argument to the CSV file and exit.")
+	header := flag.Bool("header", false, "The first CSV record holds column names.")
+	comma := flag.String("comma", ",", "The CSV field delimiter.")
+	null := flag.String("null", "", "The CSV text of NULL values.")
+	batch := flag.Int("batch", 0, "The maximum number of rows imported per transaction. Zero means no limit.")
 	flag.Parse()
+	c.batch = *batch
+	c.comma = *comma
+	c.export = *export
+	c.header = *header
+	c.import_ = *import_
+	c.null = *null
+	c.backup = *backup
 	c.flds = *flds
 	c.db = *db
+	c.dump = *dump
 	c.schema = *schema
 	c.tables = *tables
 	c.time = *time
 	c.help = *help
 	c.interactive = *interactive
+	c.restore = *restore
 }
 
 func do() (err error) {
@@ -120,6 +176,21 @@ func do() (err error) {
 		flag.PrintDefaults()
 		return nil
 	}
+	if cfg.backup != "" {
+		return backup(cfg)
+	}
+	if cfg.dump {
+		return dump(cfg)
+	}
+	if cfg.import_ != "" {
+		return importCSV(cfg)
+	}
+	if cfg.export != "" {
+		return exportCSV(cfg)
+	}
+	if cfg.restore != "" {
+		return restore(cfg)
+	}
 	if flag.NArg() == 0 && !cfg.interactive {
 
 		// Somehow we expect input to the ql tool.
@@ -183,6 +254,168 @@ func do() (err error) {
 	return run(cfg, o, src, db)
 }
 
+func backup(cfg *config) error {
+	db, err := ql.OpenFile(cfg.db, &ql.Options{})
+	if err != nil {
+		return err
+	}
+
+	if err := db.BackupTo(cfg.backup); err != nil {
+		db.Close()
+		return err
+	}
+
+	return db.Close()
+}
+
+func dump(cfg *config) error {
+	opts := &ql.DumpOptions{}
+	if pat := cfg.tables; pat != "" {
+		re, err := regexp.Compile(pat)
+		if err != nil {
+			return err
+		}
+
+		opts.Tables = re
+	}
+
+	db, err := ql.OpenFile(cfg.db, &ql.Options{})
+	if err != nil {
+		return err
+	}
+
+	o := bufio.NewWriter(os.Stdout)
+	if err = db.Dump(o, opts); err == nil {
+		err = o.Flush()
+	}
+	if ec := db.Close(); err == nil {
+		err = ec
+	}
+	return err
+}
+
+func restore(cfg *config) error {
+	src, err := os.Open(cfg.restore)
+	if err != nil {
+		return err
+	}
+
+	defer src.Close()
+
+	// A DB file starts with "\x60\xdbql" or "\x61\xdbql". The second byte
+	// cannot appear in a QL script, which is UTF-8 text.
+	r := bufio.NewReader(src)
+	if b, _ := r.Peek(4); len(b) == 4 && string(b[1:]) == "\xdbql" {
+		return ql.Restore(cfg.db, r)
+	}
+
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+
+	list, err := ql.Compile(string(b))
+	if err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(cfg.db, os.O_CREATE|os.O_EXCL|os.O_RDWR, 0666)
+	if err != nil {
+		return err
+	}
+
+	db, err := ql.OpenFile(cfg.db, &ql.Options{OSFile: f, RemoveEmptyWAL: true})
+	if err != nil {
+		f.Close()
+		os.Remove(cfg.db)
+		return err
+	}
+
+	_, _, err = db.Execute(ql.NewRWCtx(), list)
+	if ec := db.Close(); err == nil {
+		err = ec
+	}
+	if err != nil {
+		os.Remove(cfg.db)
+	}
+	return err
+}
+
+func (c *config) csvOptions() (*ql.CSVOptions, error) {
+	comma := []rune(c.comma)
+	if len(comma) != 1 {
+		return nil, fmt.Errorf("invalid CSV delimiter %q", c.comma)
+	}
+
+	return &ql.CSVOptions{Comma: comma[0], Header: c.header, Null: c.null, BatchSize: c.batch}, nil
+}
+
+func importCSV(cfg *config) error {
+	if flag.NArg() != 1 {
+		return fmt.Errorf("-import: expected a table name")
+	}
+
+	opts, err := cfg.csvOptions()
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Open(cfg.import_)
+	if err != nil {
+		return err
+	}
+
+	defer f.Close()
+
+	db, err := ql.OpenFile(cfg.db, &ql.Options{CanCreate: true})
+	if err != nil {
+		return err
+	}
+
+	_, err = db.ImportCSV(flag.Arg(0), bufio.NewReader(f), opts)
+	if ec := db.Close(); err == nil {
+		err = ec
+	}
+	return err
+}
+
+func exportCSV(cfg *config) error {
+	if flag.NArg() != 1 {
+		return fmt.Errorf("-export: expected a query")
+	}
+
+	opts, err := cfg.csvOptions()
+	if err != nil {
+		return err
+	}
+
+	db, err := ql.OpenFile(cfg.db, &ql.Options{})
+	if err != nil {
+		return err
+	}
+
+	defer db.Close()
+
+	f, err := os.Create(cfg.export)
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriter(f)
+	if err = db.ExportCSV(w, flag.Arg(0), opts); err == nil {
+		err = w.Flush()
+	}
+	if ec := f.Close(); err == nil {
+		err = ec
+	}
+	if err != nil {
+		os.Remove(cfg.export)
+		return err
+	}
+
+	return db.Close()
+}
+
 func readSrc(i bool, in *bufio.Reader) (string, error) {
 	if i {
 		return in.ReadString('\n')
@@ -0,0 +1,569 @@
+// Copyright 2026 The ql Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ql
+
+import (
+	"container/heap"
+	"errors"
+	"fmt"
+	"math"
+)
+
+const (
+	rtreeMaxEntries = 16 // Maximum number of entries of a node.
+	rtreeMinEntries = 6  // Minimum number of entries of a non root node.
+)
+
+var errSpatialOrder = errors.New("spatial index cannot be iterated in order")
+
+// rect is an axis aligned rectangle {minX, minY, maxX, maxY}. A point is a
+// rect of zero area.
+type rect [4]float64
+
+func (r rect) union(s rect) rect {
+	return rect{math.Min(r[0], s[0]), math.Min(r[1], s[1]), math.Max(r[2], s[2]), math.Max(r[3], s[3])}
+}
+
+func (r rect) area() float64 { return (r[2] - r[0]) * (r[3] - r[1]) }
+
+func (r rect) contains(s rect) bool {
+	return r[0] <= s[0] && r[1] <= s[1] && r[2] >= s[2] && r[3] >= s[3]
+}
+
+func (r rect) intersects(s rect) bool {
+	return r[0] <= s[2] && s[0] <= r[2] && r[1] <= s[3] && s[1] <= r[3]
+}
+
+// dist2 returns the squared distance of the point {x, y} from r.
+func (r rect) dist2(x, y float64) float64 {
+	dx := math.Max(0, math.Max(r[0]-x, x-r[2]))
+	dy := math.Max(0, math.Max(r[1]-y, y-r[3]))
+	return dx*dx + dy*dy
+}
+
+type rtreeEntry struct {
+	r rect
+	h int64 // Handle of the child node or of the indexed record.
+}
+
+type rtreeNode struct {
+	leaf    bool
+	entries []rtreeEntry
+}
+
+func (n *rtreeNode) bbox() rect {
+	if len(n.entries) == 0 {
+		return rect{}
+	}
+
+	r := n.entries[0].r
+	for _, v := range n.entries[1:] {
+		r = r.union(v.r)
+	}
+	return r
+}
+
+func (n *rtreeNode) encode() []interface{} {
+	data := make([]interface{}, 0, 1+5*len(n.entries))
+	data = append(data, n.leaf)
+	for _, v := range n.entries {
+		data = append(data, v.r[0], v.r[1], v.r[2], v.r[3], v.h)
+	}
+	return data
+}
+
+// rtree is a spatial index of points. Its nodes are records of the DB
+// storage, so the index is updated and read in transactions and snapshots
+// like the table records. The root node has a fixed handle, which is the root
+// of the index.
+//
+// rtree implements btreeIndex. The indexed values are {x, y float64}.
+type rtree struct {
+	s storage
+	h int64
+}
+
+// newRtree returns a new, empty rtree stored in s.
+func newRtree(s storage) (int64, *rtree, error) {
+	h, err := s.Create((&rtreeNode{leaf: true}).encode()...)
+	if err != nil {
+		return -1, nil, err
+	}
+
+	return h, &rtree{s, h}, nil
+}
+
+func openRtree(s storage, h int64) *rtree { return &rtree{s, h} }
+
+// readNode returns the node h read by read.
+func readNode(read func(int64) ([]interface{}, error), h int64) (*rtreeNode, error) {
+	data, err := read(h)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) == 0 || (len(data)-1)%5 != 0 {
+		return nil, fmt.Errorf("corrupted spatial index node %d", h)
+	}
+
+	leaf, ok := data[0].(bool)
+	if !ok {
+		return nil, fmt.Errorf("corrupted spatial index node %d", h)
+	}
+
+	n := &rtreeNode{leaf: leaf, entries: make([]rtreeEntry, (len(data)-1)/5)}
+	for i := range n.entries {
+		e := &n.entries[i]
+		for j := range e.r {
+			if e.r[j], ok = data[1+5*i+j].(float64); !ok {
+				return nil, fmt.Errorf("corrupted spatial index node %d", h)
+			}
+		}
+		if e.h, ok = data[5+5*i].(int64); !ok {
+			return nil, fmt.Errorf("corrupted spatial index node %d", h)
+		}
+	}
+	return n, nil
+}
+
+func (t *rtree) read(h int64) ([]interface{}, error) { return t.s.Read(nil, h) }
+
+func (t *rtree) node(h int64) (*rtreeNode, error) { return readNode(t.read, h) }
+
+func (t *rtree) update(h int64, n *rtreeNode) error { return t.s.Update(h, n.encode()...) }
+
+func (t *rtree) point(indexedValues []interface{}) (rect, error) {
+	if len(indexedValues) != 2 {
+		return rect{}, fmt.Errorf("spatial index value %v is not a point", indexedValues)
+	}
+
+	x, ok := indexedValues[0].(float64)
+	y, ok2 := indexedValues[1].(float64)
+	if !ok || !ok2 {
+		return rect{}, fmt.Errorf("spatial index value %v is not a point", indexedValues)
+	}
+
+	return rect{x, y, x, y}, nil
+}
+
+// Clear implements btreeIndex.
+func (t *rtree) Clear() error {
+	if err := t.free(t.h, false); err != nil {
+		return err
+	}
+
+	return t.update(t.h, &rtreeNode{leaf: true})
+}
+
+// Create implements btreeIndex.
+func (t *rtree) Create(indexedValues []interface{}, h int64) error {
+	r, err := t.point(indexedValues)
+	if err != nil {
+		return err
+	}
+
+	return t.insert(rtreeEntry{r, h})
+}
+
+// Delete implements btreeIndex.
+func (t *rtree) Delete(indexedValues []interface{}, h int64) error {
+	r, err := t.point(indexedValues)
+	if err != nil {
+		return err
+	}
+
+	var orphans []rtreeEntry
+	found, _, _, err := t.remove(t.h, rtreeEntry{r, h}, &orphans)
+	if err != nil || !found {
+		return err
+	}
+
+	// Shrink the tree while the root has a single child.
+	for {
+		root, err := t.node(t.h)
+		if err != nil {
+			return err
+		}
+
+		if root.leaf || len(root.entries) > 1 {
+			break
+		}
+
+		if len(root.entries) == 0 {
+			if err := t.update(t.h, &rtreeNode{leaf: true}); err != nil {
+				return err
+			}
+
+			break
+		}
+
+		ch := root.entries[0].h
+		child, err := t.node(ch)
+		if err != nil {
+			return err
+		}
+
+		if err := t.update(t.h, child); err != nil {
+			return err
+		}
+
+		if err := t.s.Delete(ch); err != nil {
+			return err
+		}
+	}
+
+	for _, v := range orphans {
+		if err := t.insert(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Drop implements btreeIndex.
+func (t *rtree) Drop() error { return t.free(t.h, true) }
+
+// Seek implements btreeIndex.
+func (t *rtree) Seek(indexedValues []interface{}) (iter indexIterator, hit bool, err error) {
+	return nil, false, errSpatialOrder
+}
+
+// SeekFirst implements btreeIndex.
+func (t *rtree) SeekFirst() (iter indexIterator, err error) { return nil, errSpatialOrder }
+
+// SeekLast implements btreeIndex.
+func (t *rtree) SeekLast() (iter indexIterator, err error) { return nil, errSpatialOrder }
+
+// free deletes the nodes of the subtree h, including h if self is true.
+func (t *rtree) free(h int64, self bool) error {
+	n, err := t.node(h)
+	if err != nil {
+		return err
+	}
+
+	if !n.leaf {
+		for _, v := range n.entries {
+			if err := t.free(v.h, true); err != nil {
+				return err
+			}
+		}
+	}
+	if self {
+		return t.s.Delete(h)
+	}
+
+	return nil
+}
+
+func (t *rtree) insert(e rtreeEntry) error {
+	_, split, err := t.insertAt(t.h, e)
+	if err != nil || split == nil {
+		return err
+	}
+
+	// The root was split. Move its entries to a new node, the root handle
+	// does not change.
+	root, err := t.node(t.h)
+	if err != nil {
+		return err
+	}
+
+	h, err := t.s.Create(root.encode()...)
+	if err != nil {
+		return err
+	}
+
+	return t.update(t.h, &rtreeNode{entries: []rtreeEntry{{root.bbox(), h}, *split}})
+}
+
+// insertAt adds e to the subtree of the node h. It returns the bounding box
+// of the node and the entry of its new sibling if the node was split.
+func (t *rtree) insertAt(h int64, e rtreeEntry) (rect, *rtreeEntry, error) {
+	n, err := t.node(h)
+	if err != nil {
+		return rect{}, nil, err
+	}
+
+	switch {
+	case n.leaf:
+		n.entries = append(n.entries, e)
+	default:
+		i := chooseSubtree(n.entries, e.r)
+		r, split, err := t.insertAt(n.entries[i].h, e)
+		if err != nil {
+			return rect{}, nil, err
+		}
+
+		n.entries[i].r = r
+		if split != nil {
+			n.entries = append(n.entries, *split)
+		}
+	}
+
+	var split *rtreeEntry
+	if len(n.entries) > rtreeMaxEntries {
+		a, b := splitEntries(n.entries)
+		sibling := &rtreeNode{leaf: n.leaf, entries: b}
+		sh, err := t.s.Create(sibling.encode()...)
+		if err != nil {
+			return rect{}, nil, err
+		}
+
+		n.entries = a
+		split = &rtreeEntry{sibling.bbox(), sh}
+	}
+	return n.bbox(), split, t.update(h, n)
+}
+
+// chooseSubtree returns the index of the entry needing the least enlargement
+// to include r, resolving ties by the smallest area.
+func chooseSubtree(entries []rtreeEntry, r rect) int {
+	best, bestGrowth, bestArea := 0, math.Inf(1), math.Inf(1)
+	for i, v := range entries {
+		area := v.r.area()
+		growth := v.r.union(r).area() - area
+		if growth < bestGrowth || growth == bestGrowth && area < bestArea {
+			best, bestGrowth, bestArea = i, growth, area
+		}
+	}
+	return best
+}
+
+// splitEntries divides entries into two groups using the quadratic split of
+// Guttman.
+func splitEntries(entries []rtreeEntry) (a, b []rtreeEntry) {
+	// Pick the two entries wasting the most area when put together.
+	s1, s2, worst := 0, 1, math.Inf(-1)
+	for i := range entries {
+		for j := i + 1; j < len(entries); j++ {
+			if d := entries[i].r.union(entries[j].r).area() - entries[i].r.area() - entries[j].r.area(); d > worst {
+				s1, s2, worst = i, j, d
+			}
+		}
+	}
+
+	a, b = []rtreeEntry{entries[s1]}, []rtreeEntry{entries[s2]}
+	ra, rb := entries[s1].r, entries[s2].r
+	var rest []rtreeEntry
+	for i, v := range entries {
+		if i != s1 && i != s2 {
+			rest = append(rest, v)
+		}
+	}
+	for len(rest) != 0 {
+		// Make sure both groups get the minimum number of entries.
+		if len(a)+len(rest) == rtreeMinEntries {
+			return append(a, rest...), b
+		}
+
+		if len(b)+len(rest) == rtreeMinEntries {
+			return a, append(b, rest...)
+		}
+
+		// Assign the entry with the greatest preference for a group.
+		next, da, db, pref := 0, 0., 0., math.Inf(-1)
+		for i, v := range rest {
+			ga := ra.union(v.r).area() - ra.area()
+			gb := rb.union(v.r).area() - rb.area()
+			if d := math.Abs(ga - gb); d > pref {
+				next, da, db, pref = i, ga, gb, d
+			}
+		}
+		e := rest[next]
+		rest = append(rest[:next], rest[next+1:]...)
+		if da < db || da == db && len(a) <= len(b) {
+			a = append(a, e)
+			ra = ra.union(e.r)
+			continue
+		}
+
+		b = append(b, e)
+		rb = rb.union(e.r)
+	}
+	return a, b
+}
+
+// remove deletes e from the subtree of the node h. The leaf entries of the
+// nodes removed because of having too few entries are added to orphans. It
+// returns whether e was found, the new bounding box of the node and whether
+// the node has too few entries.
+func (t *rtree) remove(h int64, e rtreeEntry, orphans *[]rtreeEntry) (found bool, r rect, underflow bool, err error) {
+	n, err := t.node(h)
+	if err != nil {
+		return false, rect{}, false, err
+	}
+
+	switch {
+	case n.leaf:
+		for i, v := range n.entries {
+			if v == e {
+				n.entries = append(n.entries[:i], n.entries[i+1:]...)
+				found = true
+				break
+			}
+		}
+	default:
+		for i, v := range n.entries {
+			if !v.r.contains(e.r) {
+				continue
+			}
+
+			ok, r, underflow, err := t.remove(v.h, e, orphans)
+			if err != nil {
+				return false, rect{}, false, err
+			}
+
+			if !ok {
+				continue
+			}
+
+			found = true
+			if !underflow {
+				n.entries[i].r = r
+				break
+			}
+
+			if err := t.collect(v.h, orphans); err != nil {
+				return false, rect{}, false, err
+			}
+
+			n.entries = append(n.entries[:i], n.entries[i+1:]...)
+			break
+		}
+	}
+	if !found {
+		return false, rect{}, false, nil
+	}
+
+	return true, n.bbox(), len(n.entries) < rtreeMinEntries, t.update(h, n)
+}
+
+// collect adds the leaf entries of the subtree h to list and deletes the
+// nodes of the subtree.
+func (t *rtree) collect(h int64, list *[]rtreeEntry) error {
+	n, err := t.node(h)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case n.leaf:
+		*list = append(*list, n.entries...)
+	default:
+		for _, v := range n.entries {
+			if err := t.collect(v.h, list); err != nil {
+				return err
+			}
+		}
+	}
+	return t.s.Delete(h)
+}
+
+// within passes to f the handles of the records having a point inside box.
+// The nodes are read by read.
+func (t *rtree) within(read func(int64) ([]interface{}, error), box rect, f func(h int64) (bool, error)) error {
+	_, err := t.within0(read, t.h, box, f)
+	return err
+}
+
+func (t *rtree) within0(read func(int64) ([]interface{}, error), h int64, box rect, f func(h int64) (bool, error)) (bool, error) {
+	n, err := readNode(read, h)
+	if err != nil {
+		return false, err
+	}
+
+	for _, v := range n.entries {
+		if !box.intersects(v.r) {
+			continue
+		}
+
+		more := true
+		switch {
+		case n.leaf:
+			more, err = f(v.h)
+		default:
+			more, err = t.within0(read, v.h, box, f)
+		}
+		if err != nil || !more {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// nearest passes to f the handles of the records in the order of increasing
+// distance of their point from {x, y}. Records at the same distance are
+// ordered by handle. The nodes are read by read.
+func (t *rtree) nearest(read func(int64) ([]interface{}, error), x, y float64, f func(h int64) (bool, error)) error {
+	q := &rtreeQueue{{h: t.h, node: true}}
+	for q.Len() != 0 {
+		it := heap.Pop(q).(rtreeItem)
+		if !it.node {
+			if more, err := f(it.h); err != nil || !more {
+				return err
+			}
+
+			continue
+		}
+
+		n, err := readNode(read, it.h)
+		if err != nil {
+			return err
+		}
+
+		for _, v := range n.entries {
+			heap.Push(q, rtreeItem{v.r.dist2(x, y), v.h, !n.leaf})
+		}
+	}
+	return nil
+}
+
+type rtreeItem struct {
+	d    float64 // Squared distance.
+	h    int64
+	node bool
+}
+
+// rtreeQueue is a priority queue of nodes and records by distance. Nodes go
+// first so all records at a distance are queued before any of them is
+// produced.
+type rtreeQueue []rtreeItem
+
+func (q rtreeQueue) Len() int { return len(q) }
+
+func (q rtreeQueue) Less(i, j int) bool {
+	a, b := q[i], q[j]
+	switch {
+	case a.d != b.d:
+		return a.d < b.d
+	case a.node != b.node:
+		return a.node
+	default:
+		return a.h < b.h
+	}
+}
+
+func (q rtreeQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *rtreeQueue) Push(x interface{}) { *q = append(*q, x.(rtreeItem)) }
+
+func (q *rtreeQueue) Pop() interface{} {
+	old := *q
+	n := len(old)
+	x := old[n-1]
+	*q = old[:n-1]
+	return x
+}
+
+// reader returns a function reading the nodes of t as seen by ctx.
+func (t *rtree) reader(ctx *execCtx) func(int64) ([]interface{}, error) {
+	return func(h int64) ([]interface{}, error) {
+		if ctx.snap != nil {
+			return ctx.snap.read(nil, h)
+		}
+
+		return t.s.Read(nil, h)
+	}
+}
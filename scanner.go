@@ -42,9 +42,9 @@ yystate0:
 	case 0: // start condition: INITIAL
 		goto yystart1
 	case 1: // start condition: S1
-		goto yystart315
+		goto yystart362
 	case 2: // start condition: S2
-		goto yystart321
+		goto yystart368
 	}
 
 	goto yystate0 // silence unused label error
@@ -251,6 +251,24 @@ yyAction:
 		goto yyrule99
 	case 100:
 		goto yyrule100
+	case 101:
+		goto yyrule101
+	case 102:
+		goto yyrule102
+	case 103:
+		goto yyrule103
+	case 104:
+		goto yyrule104
+	case 105:
+		goto yyrule105
+	case 106:
+		goto yyrule106
+	case 107:
+		goto yyrule107
+	case 108:
+		goto yyrule108
+	case 109:
+		goto yyrule109
 	}
 	goto yystate1 // silence unused label error
 yystate1:
@@ -288,47 +306,49 @@ yystart1:
 	case c == 'C' || c == 'c':
 		goto yystate83
 	case c == 'D' || c == 'd':
-		goto yystate107
+		goto yystate111
 	case c == 'E' || c == 'e':
-		goto yystate137
+		goto yystate146
 	case c == 'F' || c == 'f':
-		goto yystate148
+		goto yystate162
 	case c == 'G' || c == 'g':
-		goto yystate167
-	case c == 'H' || c == 'K' || c == 'M' || c == 'P' || c == 'Q' || c >= 'X' && c <= 'Z' || c == '_' || c == 'h' || c == 'k' || c == 'm' || c == 'p' || c == 'q' || c >= 'x' && c <= 'z' || c == '\u0081':
+		goto yystate181
+	case c == 'H' || c == 'K' || c == 'P' || c == 'Q' || c >= 'X' && c <= 'Z' || c == '_' || c == 'h' || c == 'k' || c == 'p' || c == 'q' || c >= 'x' && c <= 'z' || c == '\u0081':
 		goto yystate45
 	case c == 'I' || c == 'i':
-		goto yystate172
+		goto yystate186
 	case c == 'J' || c == 'j':
-		goto yystate192
+		goto yystate221
 	case c == 'L' || c == 'l':
-		goto yystate196
+		goto yystate228
+	case c == 'M' || c == 'm':
+		goto yystate238
 	case c == 'N' || c == 'n':
-		goto yystate206
+		goto yystate245
 	case c == 'O' || c == 'o':
-		goto yystate212
+		goto yystate251
 	case c == 'R' || c == 'r':
-		goto yystate227
+		goto yystate266
 	case c == 'S' || c == 's':
-		goto yystate242
+		goto yystate286
 	case c == 'T' || c == 't':
-		goto yystate254
+		goto yystate298
 	case c == 'U' || c == 'u':
-		goto yystate279
+		goto yystate323
 	case c == 'V' || c == 'v':
-		goto yystate300
+		goto yystate347
 	case c == 'W' || c == 'w':
-		goto yystate306
+		goto yystate353
 	case c == '\'':
 		goto yystate12
 	case c == '\t' || c == '\n' || c == '\r' || c == ' ':
 		goto yystate2
 	case c == '\u0080':
-		goto yystate314
+		goto yystate361
 	case c == '`':
-		goto yystate311
+		goto yystate358
 	case c == '|':
-		goto yystate312
+		goto yystate359
 	case c >= '1' && c <= '9':
 		goto yystate35
 	}
@@ -355,15 +375,15 @@ yystate3:
 
 yystate4:
 	c = l.Next()
-	yyrule = 15
+	yyrule = 16
 	l.Mark()
-	goto yyrule15
+	goto yyrule16
 
 yystate5:
 	c = l.Next()
-	yyrule = 10
+	yyrule = 11
 	l.Mark()
-	goto yyrule10
+	goto yyrule11
 
 yystate6:
 	c = l.Next()
@@ -378,22 +398,22 @@ yystate6:
 
 yystate7:
 	c = l.Next()
-	yyrule = 100
+	yyrule = 109
 	l.Mark()
 	switch {
 	default:
-		goto yyrule100
+		goto yyrule109
 	case c >= '0' && c <= '9':
 		goto yystate7
 	}
 
 yystate8:
 	c = l.Next()
-	yyrule = 100
+	yyrule = 109
 	l.Mark()
 	switch {
 	default:
-		goto yyrule100
+		goto yyrule109
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate8
 	}
@@ -411,15 +431,15 @@ yystate9:
 
 yystate10:
 	c = l.Next()
-	yyrule = 16
+	yyrule = 17
 	l.Mark()
-	goto yyrule16
+	goto yyrule17
 
 yystate11:
 	c = l.Next()
-	yyrule = 17
+	yyrule = 18
 	l.Mark()
-	goto yyrule17
+	goto yyrule18
 
 yystate12:
 	c = l.Next()
@@ -436,9 +456,9 @@ yystate12:
 
 yystate13:
 	c = l.Next()
-	yyrule = 12
+	yyrule = 13
 	l.Mark()
-	goto yyrule12
+	goto yyrule13
 
 yystate14:
 	c = l.Next()
@@ -455,11 +475,11 @@ yystate14:
 
 yystate15:
 	c = l.Next()
-	yyrule = 12
+	yyrule = 13
 	l.Mark()
 	switch {
 	default:
-		goto yyrule12
+		goto yyrule13
 	case c == '\'':
 		goto yystate13
 	case c == '\\':
@@ -499,11 +519,11 @@ yystate18:
 
 yystate19:
 	c = l.Next()
-	yyrule = 9
+	yyrule = 10
 	l.Mark()
 	switch {
 	default:
-		goto yyrule9
+		goto yyrule10
 	case c == 'E' || c == 'e':
 		goto yystate20
 	case c == 'I' || c == 'i':
@@ -534,11 +554,11 @@ yystate21:
 
 yystate22:
 	c = l.Next()
-	yyrule = 9
+	yyrule = 10
 	l.Mark()
 	switch {
 	default:
-		goto yyrule9
+		goto yyrule10
 	case c == 'I' || c == 'i':
 		goto yystate23
 	case c >= '0' && c <= '9':
@@ -547,9 +567,9 @@ yystate22:
 
 yystate23:
 	c = l.Next()
-	yyrule = 7
+	yyrule = 8
 	l.Mark()
-	goto yyrule7
+	goto yyrule8
 
 yystate24:
 	c = l.Next()
@@ -605,11 +625,11 @@ yystate28:
 
 yystate29:
 	c = l.Next()
-	yyrule = 8
+	yyrule = 9
 	l.Mark()
 	switch {
 	default:
-		goto yyrule8
+		goto yyrule9
 	case c == '.':
 		goto yystate19
 	case c == '8' || c == '9':
@@ -626,11 +646,11 @@ yystate29:
 
 yystate30:
 	c = l.Next()
-	yyrule = 8
+	yyrule = 9
 	l.Mark()
 	switch {
 	default:
-		goto yyrule8
+		goto yyrule9
 	case c == '.':
 		goto yystate19
 	case c == '8' || c == '9':
@@ -660,9 +680,9 @@ yystate31:
 
 yystate32:
 	c = l.Next()
-	yyrule = 6
+	yyrule = 7
 	l.Mark()
-	goto yyrule6
+	goto yyrule7
 
 yystate33:
 	c = l.Next()
@@ -675,22 +695,22 @@ yystate33:
 
 yystate34:
 	c = l.Next()
-	yyrule = 8
+	yyrule = 9
 	l.Mark()
 	switch {
 	default:
-		goto yyrule8
+		goto yyrule9
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'F' || c >= 'a' && c <= 'f':
 		goto yystate34
 	}
 
 yystate35:
 	c = l.Next()
-	yyrule = 8
+	yyrule = 9
 	l.Mark()
 	switch {
 	default:
-		goto yyrule8
+		goto yyrule9
 	case c == '.':
 		goto yystate19
 	case c == 'E' || c == 'e':
@@ -714,15 +734,15 @@ yystate36:
 
 yystate37:
 	c = l.Next()
-	yyrule = 18
+	yyrule = 19
 	l.Mark()
-	goto yyrule18
+	goto yyrule19
 
 yystate38:
 	c = l.Next()
-	yyrule = 19
+	yyrule = 20
 	l.Mark()
-	goto yyrule19
+	goto yyrule20
 
 yystate39:
 	c = l.Next()
@@ -735,9 +755,9 @@ yystate39:
 
 yystate40:
 	c = l.Next()
-	yyrule = 20
+	yyrule = 21
 	l.Mark()
-	goto yyrule20
+	goto yyrule21
 
 yystate41:
 	c = l.Next()
@@ -752,23 +772,23 @@ yystate41:
 
 yystate42:
 	c = l.Next()
-	yyrule = 21
+	yyrule = 22
 	l.Mark()
-	goto yyrule21
+	goto yyrule22
 
 yystate43:
 	c = l.Next()
-	yyrule = 22
+	yyrule = 23
 	l.Mark()
-	goto yyrule22
+	goto yyrule23
 
 yystate44:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'D' || c == 'd':
 		goto yystate46
 	case c == 'L' || c == 'l':
@@ -783,22 +803,22 @@ yystate44:
 
 yystate45:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate46:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'D' || c == 'd':
 		goto yystate47
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'C' || c >= 'E' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'c' || c >= 'e' && c <= 'z' || c == '\u0081' || c == '\u0082':
@@ -807,22 +827,22 @@ yystate46:
 
 yystate47:
 	c = l.Next()
-	yyrule = 24
+	yyrule = 25
 	l.Mark()
 	switch {
 	default:
-		goto yyrule24
+		goto yyrule25
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate48:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'T' || c == 't':
 		goto yystate49
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0081' || c == '\u0082':
@@ -831,11 +851,11 @@ yystate48:
 
 yystate49:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'E' || c == 'e':
 		goto yystate50
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0081' || c == '\u0082':
@@ -844,11 +864,11 @@ yystate49:
 
 yystate50:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'R' || c == 'r':
 		goto yystate51
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Q' || c >= 'S' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'q' || c >= 's' && c <= 'z' || c == '\u0081' || c == '\u0082':
@@ -857,22 +877,22 @@ yystate50:
 
 yystate51:
 	c = l.Next()
-	yyrule = 25
+	yyrule = 26
 	l.Mark()
 	switch {
 	default:
-		goto yyrule25
+		goto yyrule26
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate52:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'D' || c == 'd':
 		goto yystate53
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'C' || c >= 'E' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'c' || c >= 'e' && c <= 'z' || c == '\u0081' || c == '\u0082':
@@ -881,22 +901,22 @@ yystate52:
 
 yystate53:
 	c = l.Next()
-	yyrule = 26
+	yyrule = 27
 	l.Mark()
 	switch {
 	default:
-		goto yyrule26
+		goto yyrule27
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate54:
 	c = l.Next()
-	yyrule = 28
+	yyrule = 29
 	l.Mark()
 	switch {
 	default:
-		goto yyrule28
+		goto yyrule29
 	case c == 'C' || c == 'c':
 		goto yystate55
 	case c >= '0' && c <= '9' || c == 'A' || c == 'B' || c >= 'D' && c <= 'Z' || c == '_' || c == 'a' || c == 'b' || c >= 'd' && c <= 'z' || c == '\u0081' || c == '\u0082':
@@ -905,22 +925,22 @@ yystate54:
 
 yystate55:
 	c = l.Next()
-	yyrule = 27
+	yyrule = 28
 	l.Mark()
 	switch {
 	default:
-		goto yyrule27
+		goto yyrule28
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate56:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'E' || c == 'e':
 		goto yystate57
 	case c == 'I' || c == 'i':
@@ -937,11 +957,11 @@ yystate56:
 
 yystate57:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'G' || c == 'g':
 		goto yystate58
 	case c == 'T' || c == 't':
@@ -952,11 +972,11 @@ yystate57:
 
 yystate58:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'I' || c == 'i':
 		goto yystate59
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'H' || c >= 'J' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'z' || c == '\u0081' || c == '\u0082':
@@ -965,11 +985,11 @@ yystate58:
 
 yystate59:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'N' || c == 'n':
 		goto yystate60
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'M' || c >= 'O' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'm' || c >= 'o' && c <= 'z' || c == '\u0081' || c == '\u0082':
@@ -978,22 +998,22 @@ yystate59:
 
 yystate60:
 	c = l.Next()
-	yyrule = 29
+	yyrule = 30
 	l.Mark()
 	switch {
 	default:
-		goto yyrule29
+		goto yyrule30
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate61:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'W' || c == 'w':
 		goto yystate62
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'V' || c >= 'X' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'v' || c >= 'x' && c <= 'z' || c == '\u0081' || c == '\u0082':
@@ -1002,11 +1022,11 @@ yystate61:
 
 yystate62:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'E' || c == 'e':
 		goto yystate63
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0081' || c == '\u0082':
@@ -1015,11 +1035,11 @@ yystate62:
 
 yystate63:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'E' || c == 'e':
 		goto yystate64
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0081' || c == '\u0082':
@@ -1028,11 +1048,11 @@ yystate63:
 
 yystate64:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'N' || c == 'n':
 		goto yystate65
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'M' || c >= 'O' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'm' || c >= 'o' && c <= 'z' || c == '\u0081' || c == '\u0082':
@@ -1041,22 +1061,22 @@ yystate64:
 
 yystate65:
 	c = l.Next()
-	yyrule = 30
+	yyrule = 31
 	l.Mark()
 	switch {
 	default:
-		goto yyrule30
+		goto yyrule31
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate66:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'G' || c == 'g':
 		goto yystate67
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'F' || c >= 'H' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'f' || c >= 'h' && c <= 'z' || c == '\u0081' || c == '\u0082':
@@ -1065,11 +1085,11 @@ yystate66:
 
 yystate67:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'I' || c == 'i':
 		goto yystate68
 	case c == 'R' || c == 'r':
@@ -1080,11 +1100,11 @@ yystate67:
 
 yystate68:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'N' || c == 'n':
 		goto yystate69
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'M' || c >= 'O' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'm' || c >= 'o' && c <= 'z' || c == '\u0081' || c == '\u0082':
@@ -1093,11 +1113,11 @@ yystate68:
 
 yystate69:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'T' || c == 't':
 		goto yystate70
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0081' || c == '\u0082':
@@ -1106,22 +1126,22 @@ yystate69:
 
 yystate70:
 	c = l.Next()
-	yyrule = 75
+	yyrule = 81
 	l.Mark()
 	switch {
 	default:
-		goto yyrule75
+		goto yyrule81
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate71:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'A' || c == 'a':
 		goto yystate72
 	case c >= '0' && c <= '9' || c >= 'B' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z' || c == '\u0081' || c == '\u0082':
@@ -1130,11 +1150,11 @@ yystate71:
 
 yystate72:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'T' || c == 't':
 		goto yystate73
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0081' || c == '\u0082':
@@ -1143,22 +1163,22 @@ yystate72:
 
 yystate73:
 	c = l.Next()
-	yyrule = 76
+	yyrule = 82
 	l.Mark()
 	switch {
 	default:
-		goto yyrule76
+		goto yyrule82
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate74:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'O' || c == 'o':
 		goto yystate75
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'N' || c >= 'P' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'n' || c >= 'p' && c <= 'z' || c == '\u0081' || c == '\u0082':
@@ -1167,11 +1187,11 @@ yystate74:
 
 yystate75:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'B' || c == 'b':
 		goto yystate76
 	case c >= '0' && c <= '9' || c == 'A' || c >= 'C' && c <= 'Z' || c == '_' || c == 'a' || c >= 'c' && c <= 'z' || c == '\u0081' || c == '\u0082':
@@ -1180,22 +1200,22 @@ yystate75:
 
 yystate76:
 	c = l.Next()
-	yyrule = 77
+	yyrule = 83
 	l.Mark()
 	switch {
 	default:
-		goto yyrule77
+		goto yyrule83
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate77:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'O' || c == 'o':
 		goto yystate78
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'N' || c >= 'P' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'n' || c >= 'p' && c <= 'z' || c == '\u0081' || c == '\u0082':
@@ -1204,11 +1224,11 @@ yystate77:
 
 yystate78:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'L' || c == 'l':
 		goto yystate79
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'K' || c >= 'M' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'z' || c == '\u0081' || c == '\u0082':
@@ -1217,22 +1237,22 @@ yystate78:
 
 yystate79:
 	c = l.Next()
-	yyrule = 78
+	yyrule = 84
 	l.Mark()
 	switch {
 	default:
-		goto yyrule78
+		goto yyrule84
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate80:
 	c = l.Next()
-	yyrule = 31
+	yyrule = 32
 	l.Mark()
 	switch {
 	default:
-		goto yyrule31
+		goto yyrule32
 	case c == 'T' || c == 't':
 		goto yystate81
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0081' || c == '\u0082':
@@ -1241,11 +1261,11 @@ yystate80:
 
 yystate81:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'E' || c == 'e':
 		goto yystate82
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0081' || c == '\u0082':
@@ -1254,518 +1274,524 @@ yystate81:
 
 yystate82:
 	c = l.Next()
-	yyrule = 79
+	yyrule = 85
 	l.Mark()
 	switch {
 	default:
-		goto yyrule79
+		goto yyrule85
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate83:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'O' || c == 'o':
 		goto yystate84
 	case c == 'R' || c == 'r':
-		goto yystate102
+		goto yystate106
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'N' || c == 'P' || c == 'Q' || c >= 'S' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'n' || c == 'p' || c == 'q' || c >= 's' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate84:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'L' || c == 'l':
 		goto yystate85
 	case c == 'M' || c == 'm':
-		goto yystate89
+		goto yystate93
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'K' || c >= 'N' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'n' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate85:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'U' || c == 'u':
+		goto yyrule108
+	case c == 'L' || c == 'l':
 		goto yystate86
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'T' || c >= 'V' && c <= 'Z' || c == '_' || c >= 'a' && c <= 't' || c >= 'v' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c == 'U' || c == 'u':
+		goto yystate90
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'K' || c >= 'M' && c <= 'T' || c >= 'V' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 't' || c >= 'v' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate86:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'M' || c == 'm':
+		goto yyrule108
+	case c == 'A' || c == 'a':
 		goto yystate87
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'L' || c >= 'N' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'l' || c >= 'n' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c >= '0' && c <= '9' || c >= 'B' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate87:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'N' || c == 'n':
+		goto yyrule108
+	case c == 'T' || c == 't':
 		goto yystate88
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'M' || c >= 'O' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'm' || c >= 'o' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate88:
 	c = l.Next()
-	yyrule = 32
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule32
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yyrule108
+	case c == 'E' || c == 'e':
+		goto yystate89
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate89:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 33
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'M' || c == 'm':
-		goto yystate90
-	case c == 'P' || c == 'p':
-		goto yystate93
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'L' || c == 'N' || c == 'O' || c >= 'Q' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'l' || c == 'n' || c == 'o' || c >= 'q' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yyrule33
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate90:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'I' || c == 'i':
+		goto yyrule108
+	case c == 'M' || c == 'm':
 		goto yystate91
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'H' || c >= 'J' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'L' || c >= 'N' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'l' || c >= 'n' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate91:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'T' || c == 't':
+		goto yyrule108
+	case c == 'N' || c == 'n':
 		goto yystate92
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'M' || c >= 'O' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'm' || c >= 'o' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate92:
 	c = l.Next()
-	yyrule = 33
+	yyrule = 34
 	l.Mark()
 	switch {
 	default:
-		goto yyrule33
+		goto yyrule34
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate93:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'L' || c == 'l':
+		goto yyrule108
+	case c == 'M' || c == 'm':
 		goto yystate94
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'K' || c >= 'M' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c == 'P' || c == 'p':
+		goto yystate97
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'L' || c == 'N' || c == 'O' || c >= 'Q' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'l' || c == 'n' || c == 'o' || c >= 'q' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate94:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'E' || c == 'e':
+		goto yyrule108
+	case c == 'I' || c == 'i':
 		goto yystate95
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'H' || c >= 'J' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate95:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'X' || c == 'x':
+		goto yyrule108
+	case c == 'T' || c == 't':
 		goto yystate96
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'W' || c == 'Y' || c == 'Z' || c == '_' || c >= 'a' && c <= 'w' || c == 'y' || c == 'z' || c == '\u0081' || c == '\u0082':
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate96:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 35
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == '0' || c >= '2' && c <= '5' || c >= '7' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yyrule35
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
-	case c == '1':
-		goto yystate97
-	case c == '6':
-		goto yystate100
 	}
 
 yystate97:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == '0' || c == '1' || c >= '3' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
-		goto yystate45
-	case c == '2':
+		goto yyrule108
+	case c == 'L' || c == 'l':
 		goto yystate98
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'K' || c >= 'M' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yystate45
 	}
 
 yystate98:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == '8':
+		goto yyrule108
+	case c == 'E' || c == 'e':
 		goto yystate99
-	case c >= '0' && c <= '7' || c == '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate99:
 	c = l.Next()
-	yyrule = 80
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule80
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yyrule108
+	case c == 'X' || c == 'x':
+		goto yystate100
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'W' || c == 'Y' || c == 'Z' || c == '_' || c >= 'a' && c <= 'w' || c == 'y' || c == 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate100:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == '4':
-		goto yystate101
-	case c >= '0' && c <= '3' || c >= '5' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yyrule108
+	case c == '0' || c >= '2' && c <= '5' || c >= '7' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
+	case c == '1':
+		goto yystate101
+	case c == '6':
+		goto yystate104
 	}
 
 yystate101:
 	c = l.Next()
-	yyrule = 81
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule81
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yyrule108
+	case c == '0' || c == '1' || c >= '3' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
+	case c == '2':
+		goto yystate102
 	}
 
 yystate102:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'E' || c == 'e':
+		goto yyrule108
+	case c == '8':
 		goto yystate103
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c >= '0' && c <= '7' || c == '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate103:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 86
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'A' || c == 'a':
-		goto yystate104
-	case c >= '0' && c <= '9' || c >= 'B' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yyrule86
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate104:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'T' || c == 't':
+		goto yyrule108
+	case c == '4':
 		goto yystate105
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c >= '0' && c <= '3' || c >= '5' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate105:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 87
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'E' || c == 'e':
-		goto yystate106
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yyrule87
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate106:
 	c = l.Next()
-	yyrule = 34
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule34
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yyrule108
+	case c == 'E' || c == 'e':
+		goto yystate107
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate107:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'E' || c == 'e':
+		goto yyrule108
+	case c == 'A' || c == 'a':
 		goto yystate108
-	case c == 'I' || c == 'i':
-		goto yystate120
-	case c == 'R' || c == 'r':
-		goto yystate127
-	case c == 'U' || c == 'u':
-		goto yystate130
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'H' || c >= 'J' && c <= 'Q' || c == 'S' || c == 'T' || c >= 'V' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'h' || c >= 'j' && c <= 'q' || c == 's' || c == 't' || c >= 'v' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c >= '0' && c <= '9' || c >= 'B' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate108:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'F' || c == 'f':
+		goto yyrule108
+	case c == 'T' || c == 't':
 		goto yystate109
-	case c == 'L' || c == 'l':
-		goto yystate114
-	case c == 'S' || c == 's':
-		goto yystate118
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'E' || c >= 'G' && c <= 'K' || c >= 'M' && c <= 'R' || c >= 'T' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'e' || c >= 'g' && c <= 'k' || c >= 'm' && c <= 'r' || c >= 't' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate109:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'A' || c == 'a':
+		goto yyrule108
+	case c == 'E' || c == 'e':
 		goto yystate110
-	case c >= '0' && c <= '9' || c >= 'B' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate110:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 36
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'U' || c == 'u':
-		goto yystate111
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'T' || c >= 'V' && c <= 'Z' || c == '_' || c >= 'a' && c <= 't' || c >= 'v' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yyrule36
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate111:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'L' || c == 'l':
+		goto yyrule108
+	case c == 'E' || c == 'e':
 		goto yystate112
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'K' || c >= 'M' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c == 'I' || c == 'i':
+		goto yystate129
+	case c == 'R' || c == 'r':
+		goto yystate136
+	case c == 'U' || c == 'u':
+		goto yystate139
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'H' || c >= 'J' && c <= 'Q' || c == 'S' || c == 'T' || c >= 'V' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'h' || c >= 'j' && c <= 'q' || c == 's' || c == 't' || c >= 'v' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate112:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'T' || c == 't':
+		goto yyrule108
+	case c == 'C' || c == 'c':
 		goto yystate113
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c == 'F' || c == 'f':
+		goto yystate118
+	case c == 'L' || c == 'l':
+		goto yystate123
+	case c == 'S' || c == 's':
+		goto yystate127
+	case c >= '0' && c <= '9' || c == 'A' || c == 'B' || c == 'D' || c == 'E' || c >= 'G' && c <= 'K' || c >= 'M' && c <= 'R' || c >= 'T' && c <= 'Z' || c == '_' || c == 'a' || c == 'b' || c == 'd' || c == 'e' || c >= 'g' && c <= 'k' || c >= 'm' && c <= 'r' || c >= 't' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate113:
 	c = l.Next()
-	yyrule = 35
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule35
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yyrule108
+	case c == 'I' || c == 'i':
+		goto yystate114
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'H' || c >= 'J' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate114:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'E' || c == 'e':
+		goto yyrule108
+	case c == 'M' || c == 'm':
 		goto yystate115
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'L' || c >= 'N' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'l' || c >= 'n' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate115:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'T' || c == 't':
+		goto yyrule108
+	case c == 'A' || c == 'a':
 		goto yystate116
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c >= '0' && c <= '9' || c >= 'B' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate116:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'E' || c == 'e':
+		goto yyrule108
+	case c == 'L' || c == 'l':
 		goto yystate117
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'K' || c >= 'M' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate117:
 	c = l.Next()
-	yyrule = 36
+	yyrule = 88
 	l.Mark()
 	switch {
 	default:
-		goto yyrule36
+		goto yyrule88
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate118:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'C' || c == 'c':
+		goto yyrule108
+	case c == 'A' || c == 'a':
 		goto yystate119
-	case c >= '0' && c <= '9' || c == 'A' || c == 'B' || c >= 'D' && c <= 'Z' || c == '_' || c == 'a' || c == 'b' || c >= 'd' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c >= '0' && c <= '9' || c >= 'B' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate119:
 	c = l.Next()
-	yyrule = 37
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule37
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yyrule108
+	case c == 'U' || c == 'u':
+		goto yystate120
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'T' || c >= 'V' && c <= 'Z' || c == '_' || c >= 'a' && c <= 't' || c >= 'v' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate120:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'S' || c == 's':
+		goto yyrule108
+	case c == 'L' || c == 'l':
 		goto yystate121
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'R' || c >= 'T' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'r' || c >= 't' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'K' || c >= 'M' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate121:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'T' || c == 't':
 		goto yystate122
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0081' || c == '\u0082':
@@ -1774,53 +1800,51 @@ yystate121:
 
 yystate122:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 37
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'I' || c == 'i':
-		goto yystate123
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'H' || c >= 'J' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yyrule37
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate123:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'N' || c == 'n':
+		goto yyrule108
+	case c == 'E' || c == 'e':
 		goto yystate124
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'M' || c >= 'O' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'm' || c >= 'o' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate124:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'C' || c == 'c':
+		goto yyrule108
+	case c == 'T' || c == 't':
 		goto yystate125
-	case c >= '0' && c <= '9' || c == 'A' || c == 'B' || c >= 'D' && c <= 'Z' || c == '_' || c == 'a' || c == 'b' || c >= 'd' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate125:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'T' || c == 't':
+		goto yyrule108
+	case c == 'E' || c == 'e':
 		goto yystate126
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
@@ -1837,922 +1861,924 @@ yystate126:
 
 yystate127:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'O' || c == 'o':
+		goto yyrule108
+	case c == 'C' || c == 'c':
 		goto yystate128
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'N' || c >= 'P' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'n' || c >= 'p' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c >= '0' && c <= '9' || c == 'A' || c == 'B' || c >= 'D' && c <= 'Z' || c == '_' || c == 'a' || c == 'b' || c >= 'd' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate128:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 39
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'P' || c == 'p':
-		goto yystate129
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'O' || c >= 'Q' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'o' || c >= 'q' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yyrule39
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate129:
 	c = l.Next()
-	yyrule = 39
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule39
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yyrule108
+	case c == 'S' || c == 's':
+		goto yystate130
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'R' || c >= 'T' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'r' || c >= 't' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate130:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'R' || c == 'r':
+		goto yyrule108
+	case c == 'T' || c == 't':
 		goto yystate131
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Q' || c >= 'S' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'q' || c >= 's' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate131:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'A' || c == 'a':
+		goto yyrule108
+	case c == 'I' || c == 'i':
 		goto yystate132
-	case c >= '0' && c <= '9' || c >= 'B' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'H' || c >= 'J' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate132:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'T' || c == 't':
+		goto yyrule108
+	case c == 'N' || c == 'n':
 		goto yystate133
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'M' || c >= 'O' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'm' || c >= 'o' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate133:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'I' || c == 'i':
+		goto yyrule108
+	case c == 'C' || c == 'c':
 		goto yystate134
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'H' || c >= 'J' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c >= '0' && c <= '9' || c == 'A' || c == 'B' || c >= 'D' && c <= 'Z' || c == '_' || c == 'a' || c == 'b' || c >= 'd' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate134:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'O' || c == 'o':
+		goto yyrule108
+	case c == 'T' || c == 't':
 		goto yystate135
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'N' || c >= 'P' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'n' || c >= 'p' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate135:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 40
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'N' || c == 'n':
-		goto yystate136
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'M' || c >= 'O' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'm' || c >= 'o' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yyrule40
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate136:
 	c = l.Next()
-	yyrule = 82
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule82
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yyrule108
+	case c == 'O' || c == 'o':
+		goto yystate137
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'N' || c >= 'P' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'n' || c >= 'p' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate137:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'X' || c == 'x':
+		goto yyrule108
+	case c == 'P' || c == 'p':
 		goto yystate138
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'W' || c == 'Y' || c == 'Z' || c == '_' || c >= 'a' && c <= 'w' || c == 'y' || c == 'z' || c == '\u0081' || c == '\u0082':
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'O' || c >= 'Q' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'o' || c >= 'q' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate138:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 41
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'I' || c == 'i':
-		goto yystate139
-	case c == 'P' || c == 'p':
-		goto yystate143
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'H' || c >= 'J' && c <= 'O' || c >= 'Q' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'o' || c >= 'q' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yyrule41
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate139:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'S' || c == 's':
+		goto yyrule108
+	case c == 'R' || c == 'r':
 		goto yystate140
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'R' || c >= 'T' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'r' || c >= 't' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Q' || c >= 'S' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'q' || c >= 's' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate140:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'T' || c == 't':
+		goto yyrule108
+	case c == 'A' || c == 'a':
 		goto yystate141
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c >= '0' && c <= '9' || c >= 'B' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate141:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'S' || c == 's':
+		goto yyrule108
+	case c == 'T' || c == 't':
 		goto yystate142
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'R' || c >= 'T' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'r' || c >= 't' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate142:
 	c = l.Next()
-	yyrule = 40
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule40
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yyrule108
+	case c == 'I' || c == 'i':
+		goto yystate143
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'H' || c >= 'J' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate143:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'L' || c == 'l':
+		goto yyrule108
+	case c == 'O' || c == 'o':
 		goto yystate144
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'K' || c >= 'M' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'N' || c >= 'P' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'n' || c >= 'p' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate144:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'A' || c == 'a':
+		goto yyrule108
+	case c == 'N' || c == 'n':
 		goto yystate145
-	case c >= '0' && c <= '9' || c >= 'B' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'M' || c >= 'O' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'm' || c >= 'o' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate145:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 89
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'I' || c == 'i':
-		goto yystate146
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'H' || c >= 'J' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yyrule89
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate146:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'N' || c == 'n':
+		goto yyrule108
+	case c == 'S' || c == 's':
 		goto yystate147
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'M' || c >= 'O' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'm' || c >= 'o' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c == 'X' || c == 'x':
+		goto yystate152
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'R' || c >= 'T' && c <= 'W' || c == 'Y' || c == 'Z' || c == '_' || c >= 'a' && c <= 'r' || c >= 't' && c <= 'w' || c == 'y' || c == 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate147:
 	c = l.Next()
-	yyrule = 41
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule41
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yyrule108
+	case c == 'C' || c == 'c':
+		goto yystate148
+	case c >= '0' && c <= '9' || c == 'A' || c == 'B' || c >= 'D' && c <= 'Z' || c == '_' || c == 'a' || c == 'b' || c >= 'd' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate148:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'A' || c == 'a':
 		goto yystate149
-	case c == 'L' || c == 'l':
-		goto yystate153
-	case c == 'R' || c == 'r':
-		goto yystate161
-	case c == 'U' || c == 'u':
-		goto yystate164
-	case c >= '0' && c <= '9' || c >= 'B' && c <= 'K' || c >= 'M' && c <= 'Q' || c == 'S' || c == 'T' || c >= 'V' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'k' || c >= 'm' && c <= 'q' || c == 's' || c == 't' || c >= 'v' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c >= '0' && c <= '9' || c >= 'B' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate149:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'L' || c == 'l':
+		goto yyrule108
+	case c == 'P' || c == 'p':
 		goto yystate150
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'K' || c >= 'M' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'O' || c >= 'Q' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'o' || c >= 'q' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate150:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'S' || c == 's':
+		goto yyrule108
+	case c == 'E' || c == 'e':
 		goto yystate151
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'R' || c >= 'T' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'r' || c >= 't' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate151:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 42
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'E' || c == 'e':
-		goto yystate152
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yyrule42
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate152:
 	c = l.Next()
-	yyrule = 73
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule73
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yyrule108
+	case c == 'I' || c == 'i':
+		goto yystate153
+	case c == 'P' || c == 'p':
+		goto yystate157
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'H' || c >= 'J' && c <= 'O' || c >= 'Q' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'o' || c >= 'q' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate153:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'O' || c == 'o':
+		goto yyrule108
+	case c == 'S' || c == 's':
 		goto yystate154
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'N' || c >= 'P' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'n' || c >= 'p' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'R' || c >= 'T' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'r' || c >= 't' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate154:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'A' || c == 'a':
+		goto yyrule108
+	case c == 'T' || c == 't':
 		goto yystate155
-	case c >= '0' && c <= '9' || c >= 'B' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate155:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'T' || c == 't':
+		goto yyrule108
+	case c == 'S' || c == 's':
 		goto yystate156
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'R' || c >= 'T' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'r' || c >= 't' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate156:
 	c = l.Next()
-	yyrule = 83
+	yyrule = 43
 	l.Mark()
 	switch {
 	default:
-		goto yyrule83
-	case c == '3':
-		goto yystate157
-	case c == '6':
-		goto yystate159
-	case c >= '0' && c <= '2' || c == '4' || c == '5' || c >= '7' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yyrule43
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate157:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == '0' || c == '1' || c >= '3' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
-		goto yystate45
-	case c == '2':
+		goto yyrule108
+	case c == 'L' || c == 'l':
 		goto yystate158
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'K' || c >= 'M' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yystate45
 	}
 
 yystate158:
 	c = l.Next()
-	yyrule = 84
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule84
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yyrule108
+	case c == 'A' || c == 'a':
+		goto yystate159
+	case c >= '0' && c <= '9' || c >= 'B' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate159:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == '4':
+		goto yyrule108
+	case c == 'I' || c == 'i':
 		goto yystate160
-	case c >= '0' && c <= '3' || c >= '5' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'H' || c >= 'J' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate160:
 	c = l.Next()
-	yyrule = 85
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule85
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yyrule108
+	case c == 'N' || c == 'n':
+		goto yystate161
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'M' || c >= 'O' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'm' || c >= 'o' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate161:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 44
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'O' || c == 'o':
-		goto yystate162
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'N' || c >= 'P' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'n' || c >= 'p' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yyrule44
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate162:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'M' || c == 'm':
+		goto yyrule108
+	case c == 'A' || c == 'a':
 		goto yystate163
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'L' || c >= 'N' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'l' || c >= 'n' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c == 'L' || c == 'l':
+		goto yystate167
+	case c == 'R' || c == 'r':
+		goto yystate175
+	case c == 'U' || c == 'u':
+		goto yystate178
+	case c >= '0' && c <= '9' || c >= 'B' && c <= 'K' || c >= 'M' && c <= 'Q' || c == 'S' || c == 'T' || c >= 'V' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'k' || c >= 'm' && c <= 'q' || c == 's' || c == 't' || c >= 'v' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate163:
 	c = l.Next()
-	yyrule = 42
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule42
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yyrule108
+	case c == 'L' || c == 'l':
+		goto yystate164
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'K' || c >= 'M' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate164:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'L' || c == 'l':
+		goto yyrule108
+	case c == 'S' || c == 's':
 		goto yystate165
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'K' || c >= 'M' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'R' || c >= 'T' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'r' || c >= 't' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate165:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'L' || c == 'l':
+		goto yyrule108
+	case c == 'E' || c == 'e':
 		goto yystate166
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'K' || c >= 'M' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate166:
 	c = l.Next()
-	yyrule = 43
+	yyrule = 79
 	l.Mark()
 	switch {
 	default:
-		goto yyrule43
+		goto yyrule79
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate167:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'R' || c == 'r':
+		goto yyrule108
+	case c == 'O' || c == 'o':
 		goto yystate168
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Q' || c >= 'S' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'q' || c >= 's' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'N' || c >= 'P' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'n' || c >= 'p' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate168:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'O' || c == 'o':
+		goto yyrule108
+	case c == 'A' || c == 'a':
 		goto yystate169
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'N' || c >= 'P' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'n' || c >= 'p' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c >= '0' && c <= '9' || c >= 'B' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate169:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'U' || c == 'u':
+		goto yyrule108
+	case c == 'T' || c == 't':
 		goto yystate170
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'T' || c >= 'V' && c <= 'Z' || c == '_' || c >= 'a' && c <= 't' || c >= 'v' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate170:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 90
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'P' || c == 'p':
+		goto yyrule90
+	case c == '3':
 		goto yystate171
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'O' || c >= 'Q' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'o' || c >= 'q' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c == '6':
+		goto yystate173
+	case c >= '0' && c <= '2' || c == '4' || c == '5' || c >= '7' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate171:
 	c = l.Next()
-	yyrule = 44
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule44
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yyrule108
+	case c == '0' || c == '1' || c >= '3' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
+	case c == '2':
+		goto yystate172
 	}
 
 yystate172:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 91
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'F' || c == 'f':
-		goto yystate173
-	case c == 'N' || c == 'n':
-		goto yystate174
-	case c == 'S' || c == 's':
-		goto yystate191
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'E' || c >= 'G' && c <= 'M' || c >= 'O' && c <= 'R' || c >= 'T' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'e' || c >= 'g' && c <= 'm' || c >= 'o' && c <= 'r' || c >= 't' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yyrule91
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate173:
 	c = l.Next()
-	yyrule = 45
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule45
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yyrule108
+	case c == '4':
+		goto yystate174
+	case c >= '0' && c <= '3' || c >= '5' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate174:
 	c = l.Next()
-	yyrule = 49
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule49
-	case c == 'D' || c == 'd':
-		goto yystate175
-	case c == 'S' || c == 's':
-		goto yystate178
-	case c == 'T' || c == 't':
-		goto yystate182
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'C' || c >= 'E' && c <= 'R' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'c' || c >= 'e' && c <= 'r' || c >= 'u' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yyrule92
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate175:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'E' || c == 'e':
+		goto yyrule108
+	case c == 'O' || c == 'o':
 		goto yystate176
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'N' || c >= 'P' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'n' || c >= 'p' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate176:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'X' || c == 'x':
+		goto yyrule108
+	case c == 'M' || c == 'm':
 		goto yystate177
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'W' || c == 'Y' || c == 'Z' || c == '_' || c >= 'a' && c <= 'w' || c == 'y' || c == 'z' || c == '\u0081' || c == '\u0082':
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'L' || c >= 'N' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'l' || c >= 'n' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate177:
 	c = l.Next()
-	yyrule = 46
+	yyrule = 45
 	l.Mark()
 	switch {
 	default:
-		goto yyrule46
+		goto yyrule45
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate178:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'E' || c == 'e':
+		goto yyrule108
+	case c == 'L' || c == 'l':
 		goto yystate179
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'K' || c >= 'M' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate179:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'R' || c == 'r':
+		goto yyrule108
+	case c == 'L' || c == 'l':
 		goto yystate180
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Q' || c >= 'S' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'q' || c >= 's' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'K' || c >= 'M' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate180:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 46
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'T' || c == 't':
-		goto yystate181
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yyrule46
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate181:
 	c = l.Next()
-	yyrule = 47
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule47
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yyrule108
+	case c == 'R' || c == 'r':
+		goto yystate182
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Q' || c >= 'S' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'q' || c >= 's' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate182:
 	c = l.Next()
-	yyrule = 86
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule86
-	case c == '0' || c == '2' || c == '4' || c == '5' || c == '7' || c == '9' || c >= 'A' && c <= 'N' || c >= 'P' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'n' || c >= 'p' && c <= 'z' || c == '\u0081' || c == '\u0082':
-		goto yystate45
-	case c == '1':
-		goto yystate183
-	case c == '3':
-		goto yystate185
-	case c == '6':
-		goto yystate187
-	case c == '8':
-		goto yystate189
+		goto yyrule108
 	case c == 'O' || c == 'o':
-		goto yystate190
+		goto yystate183
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'N' || c >= 'P' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'n' || c >= 'p' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yystate45
 	}
 
 yystate183:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == '6':
+		goto yyrule108
+	case c == 'U' || c == 'u':
 		goto yystate184
-	case c >= '0' && c <= '5' || c >= '7' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'T' || c >= 'V' && c <= 'Z' || c == '_' || c >= 'a' && c <= 't' || c >= 'v' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate184:
 	c = l.Next()
-	yyrule = 87
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule87
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yyrule108
+	case c == 'P' || c == 'p':
+		goto yystate185
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'O' || c >= 'Q' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'o' || c >= 'q' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate185:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 47
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == '0' || c == '1' || c >= '3' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yyrule47
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
-	case c == '2':
-		goto yystate186
 	}
 
 yystate186:
 	c = l.Next()
-	yyrule = 88
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule88
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yyrule108
+	case c == 'F' || c == 'f':
+		goto yystate187
+	case c == 'L' || c == 'l':
+		goto yystate188
+	case c == 'N' || c == 'n':
+		goto yystate192
+	case c == 'S' || c == 's':
+		goto yystate220
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'E' || c >= 'G' && c <= 'K' || c == 'M' || c >= 'O' && c <= 'R' || c >= 'T' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'e' || c >= 'g' && c <= 'k' || c == 'm' || c >= 'o' && c <= 'r' || c >= 't' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate187:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 48
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == '4':
-		goto yystate188
-	case c >= '0' && c <= '3' || c >= '5' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yyrule48
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate188:
 	c = l.Next()
-	yyrule = 89
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule89
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yyrule108
+	case c == 'I' || c == 'i':
+		goto yystate189
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'H' || c >= 'J' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate189:
 	c = l.Next()
-	yyrule = 90
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule90
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yyrule108
+	case c == 'K' || c == 'k':
+		goto yystate190
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'J' || c >= 'L' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'j' || c >= 'l' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate190:
 	c = l.Next()
-	yyrule = 48
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule48
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yyrule108
+	case c == 'E' || c == 'e':
+		goto yystate191
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate191:
 	c = l.Next()
-	yyrule = 50
+	yyrule = 49
 	l.Mark()
 	switch {
 	default:
-		goto yyrule50
+		goto yyrule49
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate192:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 53
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'O' || c == 'o':
+		goto yyrule53
+	case c == 'D' || c == 'd':
 		goto yystate193
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'N' || c >= 'P' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'n' || c >= 'p' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c == 'S' || c == 's':
+		goto yystate196
+	case c == 'T' || c == 't':
+		goto yystate200
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'C' || c >= 'E' && c <= 'R' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'c' || c >= 'e' && c <= 'r' || c >= 'u' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate193:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'I' || c == 'i':
+		goto yyrule108
+	case c == 'E' || c == 'e':
 		goto yystate194
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'H' || c >= 'J' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate194:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'N' || c == 'n':
+		goto yyrule108
+	case c == 'X' || c == 'x':
 		goto yystate195
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'M' || c >= 'O' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'm' || c >= 'o' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'W' || c == 'Y' || c == 'Z' || c == '_' || c >= 'a' && c <= 'w' || c == 'y' || c == 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate195:
 	c = l.Next()
-	yyrule = 51
+	yyrule = 50
 	l.Mark()
 	switch {
 	default:
-		goto yyrule51
+		goto yyrule50
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate196:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'E' || c == 'e':
 		goto yystate197
-	case c == 'I' || c == 'i':
-		goto yystate200
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'H' || c >= 'J' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'h' || c >= 'j' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate197:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'F' || c == 'f':
+		goto yyrule108
+	case c == 'R' || c == 'r':
 		goto yystate198
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'E' || c >= 'G' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'e' || c >= 'g' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Q' || c >= 'S' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'q' || c >= 's' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate198:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'T' || c == 't':
 		goto yystate199
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0081' || c == '\u0082':
@@ -2761,410 +2787,407 @@ yystate198:
 
 yystate199:
 	c = l.Next()
-	yyrule = 52
+	yyrule = 51
 	l.Mark()
 	switch {
 	default:
-		goto yyrule52
+		goto yyrule51
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate200:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 93
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'K' || c == 'k':
+		goto yyrule93
+	case c == '0' || c == '2' || c == '4' || c == '5' || c == '7' || c == '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'N' || c >= 'P' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'n' || c >= 'p' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yystate45
+	case c == '1':
 		goto yystate201
-	case c == 'M' || c == 'm':
+	case c == '3':
 		goto yystate203
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'J' || c == 'L' || c >= 'N' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'j' || c == 'l' || c >= 'n' && c <= 'z' || c == '\u0081' || c == '\u0082':
-		goto yystate45
+	case c == '6':
+		goto yystate205
+	case c == '8':
+		goto yystate207
+	case c == 'E' || c == 'e':
+		goto yystate208
+	case c == 'O' || c == 'o':
+		goto yystate219
 	}
 
 yystate201:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'E' || c == 'e':
+		goto yyrule108
+	case c == '6':
 		goto yystate202
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c >= '0' && c <= '5' || c >= '7' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate202:
 	c = l.Next()
-	yyrule = 53
+	yyrule = 94
 	l.Mark()
 	switch {
 	default:
-		goto yyrule53
+		goto yyrule94
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate203:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'I' || c == 'i':
-		goto yystate204
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'H' || c >= 'J' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yyrule108
+	case c == '0' || c == '1' || c >= '3' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
+	case c == '2':
+		goto yystate204
 	}
 
 yystate204:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 95
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'T' || c == 't':
-		goto yystate205
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yyrule95
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate205:
 	c = l.Next()
-	yyrule = 54
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule54
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yyrule108
+	case c == '4':
+		goto yystate206
+	case c >= '0' && c <= '3' || c >= '5' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate206:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 96
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'O' || c == 'o':
-		goto yystate207
-	case c == 'U' || c == 'u':
-		goto yystate209
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'N' || c >= 'P' && c <= 'T' || c >= 'V' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'n' || c >= 'p' && c <= 't' || c >= 'v' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yyrule96
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate207:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 97
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'T' || c == 't':
-		goto yystate208
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yyrule97
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate208:
 	c = l.Next()
-	yyrule = 55
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule55
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yyrule108
+	case c == 'R' || c == 'r':
+		goto yystate209
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Q' || c >= 'S' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'q' || c >= 's' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate209:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'L' || c == 'l':
+		goto yyrule108
+	case c == 'V' || c == 'v':
 		goto yystate210
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'K' || c >= 'M' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'U' || c >= 'W' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'u' || c >= 'w' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate210:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'L' || c == 'l':
+		goto yyrule108
+	case c == 'A' || c == 'a':
 		goto yystate211
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'K' || c >= 'M' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c >= '0' && c <= '9' || c >= 'B' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate211:
 	c = l.Next()
-	yyrule = 72
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule72
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yyrule108
+	case c == 'L' || c == 'l':
+		goto yystate212
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'K' || c >= 'M' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate212:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'F' || c == 'f':
-		goto yystate213
-	case c == 'N' || c == 'n':
+		goto yyrule108
+	case c == '"':
+		goto yystate214
+	case c == '\'':
 		goto yystate218
-	case c == 'R' || c == 'r':
-		goto yystate219
-	case c == 'U' || c == 'u':
-		goto yystate223
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'E' || c >= 'G' && c <= 'M' || c >= 'O' && c <= 'Q' || c == 'S' || c == 'T' || c >= 'V' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'e' || c >= 'g' && c <= 'm' || c >= 'o' && c <= 'q' || c == 's' || c == 't' || c >= 'v' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c == '\t' || c == '\n' || c == '\r' || c == ' ':
+		goto yystate213
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate213:
 	c = l.Next()
-	yyrule = 99
-	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'F' || c == 'f':
+		goto yyabort
+	case c == '"':
 		goto yystate214
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'E' || c >= 'G' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'e' || c >= 'g' && c <= 'z' || c == '\u0081' || c == '\u0082':
-		goto yystate45
+	case c == '\'':
+		goto yystate218
+	case c == '\t' || c == '\n' || c == '\r' || c == ' ':
+		goto yystate213
 	}
 
 yystate214:
 	c = l.Next()
-	yyrule = 99
-	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'S' || c == 's':
+		goto yyabort
+	case c == '"':
 		goto yystate215
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'R' || c >= 'T' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'r' || c >= 't' && c <= 'z' || c == '\u0081' || c == '\u0082':
-		goto yystate45
+	case c == '\\':
+		goto yystate216
+	case c >= '\x01' && c <= '\t' || c >= '\v' && c <= '!' || c >= '#' && c <= '[' || c >= ']' && c <= 'ÿ':
+		goto yystate214
 	}
 
 yystate215:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 6
 	l.Mark()
-	switch {
-	default:
-		goto yyrule99
-	case c == 'E' || c == 'e':
-		goto yystate216
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0081' || c == '\u0082':
-		goto yystate45
-	}
+	goto yyrule6
 
 yystate216:
 	c = l.Next()
-	yyrule = 99
-	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'T' || c == 't':
+		goto yyabort
+	case c == '"':
 		goto yystate217
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0081' || c == '\u0082':
-		goto yystate45
+	case c == '\\':
+		goto yystate216
+	case c >= '\x01' && c <= '\t' || c >= '\v' && c <= '!' || c >= '#' && c <= '[' || c >= ']' && c <= 'ÿ':
+		goto yystate214
 	}
 
 yystate217:
 	c = l.Next()
-	yyrule = 56
+	yyrule = 6
 	l.Mark()
 	switch {
 	default:
-		goto yyrule56
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
-		goto yystate45
+		goto yyrule6
+	case c == '"':
+		goto yystate215
+	case c == '\\':
+		goto yystate216
+	case c >= '\x01' && c <= '\t' || c >= '\v' && c <= '!' || c >= '#' && c <= '[' || c >= ']' && c <= 'ÿ':
+		goto yystate214
 	}
 
 yystate218:
 	c = l.Next()
-	yyrule = 57
-	l.Mark()
 	switch {
 	default:
-		goto yyrule57
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
-		goto yystate45
+		goto yyabort
+	case c == '\'':
+		goto yystate215
+	case c >= '\x01' && c <= '\t' || c >= '\v' && c <= '&' || c >= '(' && c <= 'ÿ':
+		goto yystate218
 	}
 
 yystate219:
 	c = l.Next()
-	yyrule = 59
+	yyrule = 52
 	l.Mark()
 	switch {
 	default:
-		goto yyrule59
-	case c == 'D' || c == 'd':
-		goto yystate220
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'C' || c >= 'E' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'c' || c >= 'e' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yyrule52
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate220:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 54
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'E' || c == 'e':
-		goto yystate221
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yyrule54
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate221:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'R' || c == 'r':
+		goto yyrule108
+	case c == 'O' || c == 'o':
 		goto yystate222
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Q' || c >= 'S' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'q' || c >= 's' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c == 'S' || c == 's':
+		goto yystate225
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'N' || c >= 'P' && c <= 'R' || c >= 'T' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'n' || c >= 'p' && c <= 'r' || c >= 't' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate222:
 	c = l.Next()
-	yyrule = 58
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule58
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yyrule108
+	case c == 'I' || c == 'i':
+		goto yystate223
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'H' || c >= 'J' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate223:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'T' || c == 't':
+		goto yyrule108
+	case c == 'N' || c == 'n':
 		goto yystate224
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'M' || c >= 'O' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'm' || c >= 'o' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate224:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 55
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'E' || c == 'e':
-		goto yystate225
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yyrule55
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate225:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'R' || c == 'r':
+		goto yyrule108
+	case c == 'O' || c == 'o':
 		goto yystate226
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Q' || c >= 'S' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'q' || c >= 's' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'N' || c >= 'P' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'n' || c >= 'p' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate226:
 	c = l.Next()
-	yyrule = 60
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule60
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yyrule108
+	case c == 'N' || c == 'n':
+		goto yystate227
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'M' || c >= 'O' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'm' || c >= 'o' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate227:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 98
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'I' || c == 'i':
-		goto yystate228
-	case c == 'O' || c == 'o':
-		goto yystate232
-	case c == 'U' || c == 'u':
-		goto yystate239
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'H' || c >= 'J' && c <= 'N' || c >= 'P' && c <= 'T' || c >= 'V' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'n' || c >= 'p' && c <= 't' || c >= 'v' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yyrule98
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate228:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'G' || c == 'g':
+		goto yyrule108
+	case c == 'E' || c == 'e':
 		goto yystate229
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'F' || c >= 'H' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'f' || c >= 'h' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c == 'I' || c == 'i':
+		goto yystate232
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'H' || c >= 'J' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'h' || c >= 'j' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate229:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'H' || c == 'h':
+		goto yyrule108
+	case c == 'F' || c == 'f':
 		goto yystate230
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'G' || c >= 'I' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'g' || c >= 'i' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'E' || c >= 'G' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'e' || c >= 'g' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate230:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'T' || c == 't':
 		goto yystate231
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0081' || c == '\u0082':
@@ -3173,1120 +3196,1725 @@ yystate230:
 
 yystate231:
 	c = l.Next()
-	yyrule = 61
+	yyrule = 56
 	l.Mark()
 	switch {
 	default:
-		goto yyrule61
+		goto yyrule56
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate232:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'L' || c == 'l':
+		goto yyrule108
+	case c == 'K' || c == 'k':
 		goto yystate233
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'K' || c >= 'M' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c == 'M' || c == 'm':
+		goto yystate235
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'J' || c == 'L' || c >= 'N' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'j' || c == 'l' || c >= 'n' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate233:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
-	case c == 'L' || c == 'l':
+		goto yyrule108
+	case c == 'E' || c == 'e':
 		goto yystate234
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'K' || c >= 'M' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'z' || c == '\u0081' || c == '\u0082':
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
 yystate234:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 57
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule57
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yystate45
+	}
+
+yystate235:
+	c = l.Next()
+	yyrule = 108
+	l.Mark()
+	switch {
+	default:
+		goto yyrule108
+	case c == 'I' || c == 'i':
+		goto yystate236
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'H' || c >= 'J' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yystate45
+	}
+
+yystate236:
+	c = l.Next()
+	yyrule = 108
+	l.Mark()
+	switch {
+	default:
+		goto yyrule108
+	case c == 'T' || c == 't':
+		goto yystate237
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yystate45
+	}
+
+yystate237:
+	c = l.Next()
+	yyrule = 58
+	l.Mark()
+	switch {
+	default:
+		goto yyrule58
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yystate45
+	}
+
+yystate238:
+	c = l.Next()
+	yyrule = 108
+	l.Mark()
+	switch {
+	default:
+		goto yyrule108
+	case c == 'A' || c == 'a':
+		goto yystate239
+	case c >= '0' && c <= '9' || c >= 'B' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yystate45
+	}
+
+yystate239:
+	c = l.Next()
+	yyrule = 108
+	l.Mark()
+	switch {
+	default:
+		goto yyrule108
+	case c == 'T' || c == 't':
+		goto yystate240
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yystate45
+	}
+
+yystate240:
+	c = l.Next()
+	yyrule = 108
+	l.Mark()
+	switch {
+	default:
+		goto yyrule108
+	case c == 'C' || c == 'c':
+		goto yystate241
+	case c >= '0' && c <= '9' || c == 'A' || c == 'B' || c >= 'D' && c <= 'Z' || c == '_' || c == 'a' || c == 'b' || c >= 'd' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yystate45
+	}
+
+yystate241:
+	c = l.Next()
+	yyrule = 108
+	l.Mark()
+	switch {
+	default:
+		goto yyrule108
+	case c == 'H' || c == 'h':
+		goto yystate242
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'G' || c >= 'I' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'g' || c >= 'i' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yystate45
+	}
+
+yystate242:
+	c = l.Next()
+	yyrule = 108
+	l.Mark()
+	switch {
+	default:
+		goto yyrule108
+	case c == 'E' || c == 'e':
+		goto yystate243
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yystate45
+	}
+
+yystate243:
+	c = l.Next()
+	yyrule = 108
+	l.Mark()
+	switch {
+	default:
+		goto yyrule108
+	case c == 'S' || c == 's':
+		goto yystate244
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'R' || c >= 'T' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'r' || c >= 't' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yystate45
+	}
+
+yystate244:
+	c = l.Next()
+	yyrule = 59
+	l.Mark()
+	switch {
+	default:
+		goto yyrule59
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yystate45
+	}
+
+yystate245:
+	c = l.Next()
+	yyrule = 108
+	l.Mark()
+	switch {
+	default:
+		goto yyrule108
+	case c == 'O' || c == 'o':
+		goto yystate246
+	case c == 'U' || c == 'u':
+		goto yystate248
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'N' || c >= 'P' && c <= 'T' || c >= 'V' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'n' || c >= 'p' && c <= 't' || c >= 'v' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yystate45
+	}
+
+yystate246:
+	c = l.Next()
+	yyrule = 108
+	l.Mark()
+	switch {
+	default:
+		goto yyrule108
+	case c == 'T' || c == 't':
+		goto yystate247
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yystate45
+	}
+
+yystate247:
+	c = l.Next()
+	yyrule = 60
+	l.Mark()
+	switch {
+	default:
+		goto yyrule60
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yystate45
+	}
+
+yystate248:
+	c = l.Next()
+	yyrule = 108
+	l.Mark()
+	switch {
+	default:
+		goto yyrule108
+	case c == 'L' || c == 'l':
+		goto yystate249
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'K' || c >= 'M' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yystate45
+	}
+
+yystate249:
+	c = l.Next()
+	yyrule = 108
+	l.Mark()
+	switch {
+	default:
+		goto yyrule108
+	case c == 'L' || c == 'l':
+		goto yystate250
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'K' || c >= 'M' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yystate45
+	}
+
+yystate250:
+	c = l.Next()
+	yyrule = 78
+	l.Mark()
+	switch {
+	default:
+		goto yyrule78
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yystate45
+	}
+
+yystate251:
+	c = l.Next()
+	yyrule = 108
+	l.Mark()
+	switch {
+	default:
+		goto yyrule108
+	case c == 'F' || c == 'f':
+		goto yystate252
+	case c == 'N' || c == 'n':
+		goto yystate257
+	case c == 'R' || c == 'r':
+		goto yystate258
+	case c == 'U' || c == 'u':
+		goto yystate262
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'E' || c >= 'G' && c <= 'M' || c >= 'O' && c <= 'Q' || c == 'S' || c == 'T' || c >= 'V' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'e' || c >= 'g' && c <= 'm' || c >= 'o' && c <= 'q' || c == 's' || c == 't' || c >= 'v' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yystate45
+	}
+
+yystate252:
+	c = l.Next()
+	yyrule = 108
+	l.Mark()
+	switch {
+	default:
+		goto yyrule108
+	case c == 'F' || c == 'f':
+		goto yystate253
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'E' || c >= 'G' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'e' || c >= 'g' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yystate45
+	}
+
+yystate253:
+	c = l.Next()
+	yyrule = 108
+	l.Mark()
+	switch {
+	default:
+		goto yyrule108
+	case c == 'S' || c == 's':
+		goto yystate254
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'R' || c >= 'T' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'r' || c >= 't' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yystate45
+	}
+
+yystate254:
+	c = l.Next()
+	yyrule = 108
+	l.Mark()
+	switch {
+	default:
+		goto yyrule108
+	case c == 'E' || c == 'e':
+		goto yystate255
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yystate45
+	}
+
+yystate255:
+	c = l.Next()
+	yyrule = 108
+	l.Mark()
+	switch {
+	default:
+		goto yyrule108
+	case c == 'T' || c == 't':
+		goto yystate256
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yystate45
+	}
+
+yystate256:
+	c = l.Next()
+	yyrule = 61
+	l.Mark()
+	switch {
+	default:
+		goto yyrule61
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yystate45
+	}
+
+yystate257:
+	c = l.Next()
+	yyrule = 62
+	l.Mark()
+	switch {
+	default:
+		goto yyrule62
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yystate45
+	}
+
+yystate258:
+	c = l.Next()
+	yyrule = 64
+	l.Mark()
+	switch {
+	default:
+		goto yyrule64
+	case c == 'D' || c == 'd':
+		goto yystate259
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'C' || c >= 'E' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'c' || c >= 'e' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yystate45
+	}
+
+yystate259:
+	c = l.Next()
+	yyrule = 108
+	l.Mark()
+	switch {
+	default:
+		goto yyrule108
+	case c == 'E' || c == 'e':
+		goto yystate260
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yystate45
+	}
+
+yystate260:
+	c = l.Next()
+	yyrule = 108
+	l.Mark()
+	switch {
+	default:
+		goto yyrule108
+	case c == 'R' || c == 'r':
+		goto yystate261
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Q' || c >= 'S' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'q' || c >= 's' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yystate45
+	}
+
+yystate261:
+	c = l.Next()
+	yyrule = 63
+	l.Mark()
+	switch {
+	default:
+		goto yyrule63
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yystate45
+	}
+
+yystate262:
+	c = l.Next()
+	yyrule = 108
+	l.Mark()
+	switch {
+	default:
+		goto yyrule108
+	case c == 'T' || c == 't':
+		goto yystate263
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yystate45
+	}
+
+yystate263:
+	c = l.Next()
+	yyrule = 108
+	l.Mark()
+	switch {
+	default:
+		goto yyrule108
+	case c == 'E' || c == 'e':
+		goto yystate264
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yystate45
+	}
+
+yystate264:
+	c = l.Next()
+	yyrule = 108
+	l.Mark()
+	switch {
+	default:
+		goto yyrule108
+	case c == 'R' || c == 'r':
+		goto yystate265
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Q' || c >= 'S' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'q' || c >= 's' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yystate45
+	}
+
+yystate265:
+	c = l.Next()
+	yyrule = 65
+	l.Mark()
+	switch {
+	default:
+		goto yyrule65
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yystate45
+	}
+
+yystate266:
+	c = l.Next()
+	yyrule = 108
+	l.Mark()
+	switch {
+	default:
+		goto yyrule108
+	case c == 'E' || c == 'e':
+		goto yystate267
+	case c == 'I' || c == 'i':
+		goto yystate272
+	case c == 'O' || c == 'o':
+		goto yystate276
+	case c == 'U' || c == 'u':
+		goto yystate283
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'H' || c >= 'J' && c <= 'N' || c >= 'P' && c <= 'T' || c >= 'V' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'h' || c >= 'j' && c <= 'n' || c >= 'p' && c <= 't' || c >= 'v' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yystate45
+	}
+
+yystate267:
+	c = l.Next()
+	yyrule = 108
+	l.Mark()
+	switch {
+	default:
+		goto yyrule108
+	case c == 'G' || c == 'g':
+		goto yystate268
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'F' || c >= 'H' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'f' || c >= 'h' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yystate45
+	}
+
+yystate268:
+	c = l.Next()
+	yyrule = 108
+	l.Mark()
+	switch {
+	default:
+		goto yyrule108
+	case c == 'E' || c == 'e':
+		goto yystate269
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yystate45
+	}
+
+yystate269:
+	c = l.Next()
+	yyrule = 108
+	l.Mark()
+	switch {
+	default:
+		goto yyrule108
+	case c == 'X' || c == 'x':
+		goto yystate270
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'W' || c == 'Y' || c == 'Z' || c == '_' || c >= 'a' && c <= 'w' || c == 'y' || c == 'z' || c == '\u0081' || c == '\u0082':
+		goto yystate45
+	}
+
+yystate270:
+	c = l.Next()
+	yyrule = 108
+	l.Mark()
+	switch {
+	default:
+		goto yyrule108
+	case c == 'P' || c == 'p':
+		goto yystate271
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'O' || c >= 'Q' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'o' || c >= 'q' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yystate45
+	}
+
+yystate271:
+	c = l.Next()
+	yyrule = 66
+	l.Mark()
+	switch {
+	default:
+		goto yyrule66
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yystate45
+	}
+
+yystate272:
+	c = l.Next()
+	yyrule = 108
+	l.Mark()
+	switch {
+	default:
+		goto yyrule108
+	case c == 'G' || c == 'g':
+		goto yystate273
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'F' || c >= 'H' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'f' || c >= 'h' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yystate45
+	}
+
+yystate273:
+	c = l.Next()
+	yyrule = 108
+	l.Mark()
+	switch {
+	default:
+		goto yyrule108
+	case c == 'H' || c == 'h':
+		goto yystate274
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'G' || c >= 'I' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'g' || c >= 'i' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yystate45
+	}
+
+yystate274:
+	c = l.Next()
+	yyrule = 108
+	l.Mark()
+	switch {
+	default:
+		goto yyrule108
+	case c == 'T' || c == 't':
+		goto yystate275
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yystate45
+	}
+
+yystate275:
+	c = l.Next()
+	yyrule = 67
+	l.Mark()
+	switch {
+	default:
+		goto yyrule67
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yystate45
+	}
+
+yystate276:
+	c = l.Next()
+	yyrule = 108
+	l.Mark()
+	switch {
+	default:
+		goto yyrule108
+	case c == 'L' || c == 'l':
+		goto yystate277
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'K' || c >= 'M' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yystate45
+	}
+
+yystate277:
+	c = l.Next()
+	yyrule = 108
+	l.Mark()
+	switch {
+	default:
+		goto yyrule108
+	case c == 'L' || c == 'l':
+		goto yystate278
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'K' || c >= 'M' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yystate45
+	}
+
+yystate278:
+	c = l.Next()
+	yyrule = 108
+	l.Mark()
+	switch {
+	default:
+		goto yyrule108
 	case c == 'B' || c == 'b':
-		goto yystate235
+		goto yystate279
 	case c >= '0' && c <= '9' || c == 'A' || c >= 'C' && c <= 'Z' || c == '_' || c == 'a' || c >= 'c' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate235:
+yystate279:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'A' || c == 'a':
-		goto yystate236
+		goto yystate280
 	case c >= '0' && c <= '9' || c >= 'B' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate236:
+yystate280:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'C' || c == 'c':
-		goto yystate237
+		goto yystate281
 	case c >= '0' && c <= '9' || c == 'A' || c == 'B' || c >= 'D' && c <= 'Z' || c == '_' || c == 'a' || c == 'b' || c >= 'd' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate237:
+yystate281:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'K' || c == 'k':
-		goto yystate238
+		goto yystate282
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'J' || c >= 'L' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'j' || c >= 'l' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate238:
+yystate282:
 	c = l.Next()
-	yyrule = 62
+	yyrule = 68
 	l.Mark()
 	switch {
 	default:
-		goto yyrule62
+		goto yyrule68
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate239:
+yystate283:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'N' || c == 'n':
-		goto yystate240
+		goto yystate284
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'M' || c >= 'O' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'm' || c >= 'o' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate240:
+yystate284:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'E' || c == 'e':
-		goto yystate241
+		goto yystate285
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate241:
+yystate285:
 	c = l.Next()
-	yyrule = 91
+	yyrule = 99
 	l.Mark()
 	switch {
 	default:
-		goto yyrule91
+		goto yyrule99
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate242:
+yystate286:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'E' || c == 'e':
-		goto yystate243
+		goto yystate287
 	case c == 'T' || c == 't':
-		goto yystate249
+		goto yystate293
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate243:
+yystate287:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'L' || c == 'l':
-		goto yystate244
+		goto yystate288
 	case c == 'T' || c == 't':
-		goto yystate248
+		goto yystate292
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'K' || c >= 'M' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate244:
+yystate288:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'E' || c == 'e':
-		goto yystate245
+		goto yystate289
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate245:
+yystate289:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'C' || c == 'c':
-		goto yystate246
+		goto yystate290
 	case c >= '0' && c <= '9' || c == 'A' || c == 'B' || c >= 'D' && c <= 'Z' || c == '_' || c == 'a' || c == 'b' || c >= 'd' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate246:
+yystate290:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'T' || c == 't':
-		goto yystate247
+		goto yystate291
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate247:
+yystate291:
 	c = l.Next()
-	yyrule = 63
+	yyrule = 69
 	l.Mark()
 	switch {
 	default:
-		goto yyrule63
+		goto yyrule69
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate248:
+yystate292:
 	c = l.Next()
-	yyrule = 64
+	yyrule = 70
 	l.Mark()
 	switch {
 	default:
-		goto yyrule64
+		goto yyrule70
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate249:
+yystate293:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'R' || c == 'r':
-		goto yystate250
+		goto yystate294
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Q' || c >= 'S' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'q' || c >= 's' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate250:
+yystate294:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'I' || c == 'i':
-		goto yystate251
+		goto yystate295
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'H' || c >= 'J' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate251:
+yystate295:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'N' || c == 'n':
-		goto yystate252
+		goto yystate296
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'M' || c >= 'O' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'm' || c >= 'o' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate252:
+yystate296:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'G' || c == 'g':
-		goto yystate253
+		goto yystate297
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'F' || c >= 'H' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'f' || c >= 'h' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate253:
+yystate297:
 	c = l.Next()
-	yyrule = 92
+	yyrule = 100
 	l.Mark()
 	switch {
 	default:
-		goto yyrule92
+		goto yyrule100
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate254:
+yystate298:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'A' || c == 'a':
-		goto yystate255
+		goto yystate299
 	case c == 'I' || c == 'i':
-		goto yystate259
+		goto yystate303
 	case c == 'R' || c == 'r':
-		goto yystate262
+		goto yystate306
 	case c >= '0' && c <= '9' || c >= 'B' && c <= 'H' || c >= 'J' && c <= 'Q' || c >= 'S' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'h' || c >= 'j' && c <= 'q' || c >= 's' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate255:
+yystate299:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'B' || c == 'b':
-		goto yystate256
+		goto yystate300
 	case c >= '0' && c <= '9' || c == 'A' || c >= 'C' && c <= 'Z' || c == '_' || c == 'a' || c >= 'c' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate256:
+yystate300:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'L' || c == 'l':
-		goto yystate257
+		goto yystate301
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'K' || c >= 'M' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate257:
+yystate301:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'E' || c == 'e':
-		goto yystate258
+		goto yystate302
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate258:
+yystate302:
 	c = l.Next()
-	yyrule = 65
+	yyrule = 71
 	l.Mark()
 	switch {
 	default:
-		goto yyrule65
+		goto yyrule71
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate259:
+yystate303:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'M' || c == 'm':
-		goto yystate260
+		goto yystate304
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'L' || c >= 'N' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'l' || c >= 'n' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate260:
+yystate304:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'E' || c == 'e':
-		goto yystate261
+		goto yystate305
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate261:
+yystate305:
 	c = l.Next()
-	yyrule = 93
+	yyrule = 101
 	l.Mark()
 	switch {
 	default:
-		goto yyrule93
+		goto yyrule101
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate262:
+yystate306:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'A' || c == 'a':
-		goto yystate263
+		goto yystate307
 	case c == 'U' || c == 'u':
-		goto yystate272
+		goto yystate316
 	case c >= '0' && c <= '9' || c >= 'B' && c <= 'T' || c >= 'V' && c <= 'Z' || c == '_' || c >= 'b' && c <= 't' || c >= 'v' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate263:
+yystate307:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'N' || c == 'n':
-		goto yystate264
+		goto yystate308
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'M' || c >= 'O' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'm' || c >= 'o' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate264:
+yystate308:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'S' || c == 's':
-		goto yystate265
+		goto yystate309
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'R' || c >= 'T' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'r' || c >= 't' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate265:
+yystate309:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'A' || c == 'a':
-		goto yystate266
+		goto yystate310
 	case c >= '0' && c <= '9' || c >= 'B' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate266:
+yystate310:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'C' || c == 'c':
-		goto yystate267
+		goto yystate311
 	case c >= '0' && c <= '9' || c == 'A' || c == 'B' || c >= 'D' && c <= 'Z' || c == '_' || c == 'a' || c == 'b' || c >= 'd' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate267:
+yystate311:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'T' || c == 't':
-		goto yystate268
+		goto yystate312
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate268:
+yystate312:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'I' || c == 'i':
-		goto yystate269
+		goto yystate313
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'H' || c >= 'J' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate269:
+yystate313:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'O' || c == 'o':
-		goto yystate270
+		goto yystate314
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'N' || c >= 'P' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'n' || c >= 'p' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate270:
+yystate314:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'N' || c == 'n':
-		goto yystate271
+		goto yystate315
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'M' || c >= 'O' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'm' || c >= 'o' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate271:
+yystate315:
 	c = l.Next()
-	yyrule = 66
+	yyrule = 72
 	l.Mark()
 	switch {
 	default:
-		goto yyrule66
+		goto yyrule72
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate272:
+yystate316:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'E' || c == 'e':
-		goto yystate273
+		goto yystate317
 	case c == 'N' || c == 'n':
-		goto yystate274
+		goto yystate318
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'M' || c >= 'O' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'm' || c >= 'o' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate273:
+yystate317:
 	c = l.Next()
-	yyrule = 74
+	yyrule = 80
 	l.Mark()
 	switch {
 	default:
-		goto yyrule74
+		goto yyrule80
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate274:
+yystate318:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'C' || c == 'c':
-		goto yystate275
+		goto yystate319
 	case c >= '0' && c <= '9' || c == 'A' || c == 'B' || c >= 'D' && c <= 'Z' || c == '_' || c == 'a' || c == 'b' || c >= 'd' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate275:
+yystate319:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'A' || c == 'a':
-		goto yystate276
+		goto yystate320
 	case c >= '0' && c <= '9' || c >= 'B' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate276:
+yystate320:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'T' || c == 't':
-		goto yystate277
+		goto yystate321
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate277:
+yystate321:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'E' || c == 'e':
-		goto yystate278
+		goto yystate322
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate278:
+yystate322:
 	c = l.Next()
-	yyrule = 67
+	yyrule = 73
 	l.Mark()
 	switch {
 	default:
-		goto yyrule67
+		goto yyrule73
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate279:
+yystate323:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'I' || c == 'i':
-		goto yystate280
+		goto yystate324
 	case c == 'N' || c == 'n':
-		goto yystate290
+		goto yystate334
 	case c == 'P' || c == 'p':
-		goto yystate295
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'H' || c >= 'J' && c <= 'M' || c == 'O' || c >= 'Q' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'm' || c == 'o' || c >= 'q' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yystate339
+	case c == 'U' || c == 'u':
+		goto yystate344
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'H' || c >= 'J' && c <= 'M' || c == 'O' || c >= 'Q' && c <= 'T' || c >= 'V' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'm' || c == 'o' || c >= 'q' && c <= 't' || c >= 'v' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate280:
+yystate324:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'N' || c == 'n':
-		goto yystate281
+		goto yystate325
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'M' || c >= 'O' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'm' || c >= 'o' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate281:
+yystate325:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'T' || c == 't':
-		goto yystate282
+		goto yystate326
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate282:
+yystate326:
 	c = l.Next()
-	yyrule = 94
+	yyrule = 102
 	l.Mark()
 	switch {
 	default:
-		goto yyrule94
+		goto yyrule102
 	case c == '0' || c == '2' || c == '4' || c == '5' || c == '7' || c == '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	case c == '1':
-		goto yystate283
+		goto yystate327
 	case c == '3':
-		goto yystate285
+		goto yystate329
 	case c == '6':
-		goto yystate287
+		goto yystate331
 	case c == '8':
-		goto yystate289
+		goto yystate333
 	}
 
-yystate283:
+yystate327:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == '6':
-		goto yystate284
+		goto yystate328
 	case c >= '0' && c <= '5' || c >= '7' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate284:
+yystate328:
 	c = l.Next()
-	yyrule = 95
+	yyrule = 103
 	l.Mark()
 	switch {
 	default:
-		goto yyrule95
+		goto yyrule103
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate285:
+yystate329:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == '0' || c == '1' || c >= '3' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	case c == '2':
-		goto yystate286
+		goto yystate330
 	}
 
-yystate286:
+yystate330:
 	c = l.Next()
-	yyrule = 96
+	yyrule = 104
 	l.Mark()
 	switch {
 	default:
-		goto yyrule96
+		goto yyrule104
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate287:
+yystate331:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == '4':
-		goto yystate288
+		goto yystate332
 	case c >= '0' && c <= '3' || c >= '5' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate288:
+yystate332:
 	c = l.Next()
-	yyrule = 97
+	yyrule = 105
 	l.Mark()
 	switch {
 	default:
-		goto yyrule97
+		goto yyrule105
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate289:
+yystate333:
 	c = l.Next()
-	yyrule = 98
+	yyrule = 106
 	l.Mark()
 	switch {
 	default:
-		goto yyrule98
+		goto yyrule106
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate290:
+yystate334:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'I' || c == 'i':
-		goto yystate291
+		goto yystate335
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'H' || c >= 'J' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate291:
+yystate335:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'Q' || c == 'q':
-		goto yystate292
+		goto yystate336
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'P' || c >= 'R' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'p' || c >= 'r' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate292:
+yystate336:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'U' || c == 'u':
-		goto yystate293
+		goto yystate337
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'T' || c >= 'V' && c <= 'Z' || c == '_' || c >= 'a' && c <= 't' || c >= 'v' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate293:
+yystate337:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'E' || c == 'e':
-		goto yystate294
+		goto yystate338
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate294:
+yystate338:
 	c = l.Next()
-	yyrule = 69
+	yyrule = 75
 	l.Mark()
 	switch {
 	default:
-		goto yyrule69
+		goto yyrule75
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate295:
+yystate339:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'D' || c == 'd':
-		goto yystate296
+		goto yystate340
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'C' || c >= 'E' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'c' || c >= 'e' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate296:
+yystate340:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'A' || c == 'a':
-		goto yystate297
+		goto yystate341
 	case c >= '0' && c <= '9' || c >= 'B' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate297:
+yystate341:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'T' || c == 't':
-		goto yystate298
+		goto yystate342
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate298:
+yystate342:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'E' || c == 'e':
-		goto yystate299
+		goto yystate343
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate299:
+yystate343:
 	c = l.Next()
-	yyrule = 68
+	yyrule = 74
 	l.Mark()
 	switch {
 	default:
-		goto yyrule68
+		goto yyrule74
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate300:
+yystate344:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
+	case c == 'I' || c == 'i':
+		goto yystate345
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'H' || c >= 'J' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yystate45
+	}
+
+yystate345:
+	c = l.Next()
+	yyrule = 108
+	l.Mark()
+	switch {
+	default:
+		goto yyrule108
+	case c == 'D' || c == 'd':
+		goto yystate346
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'C' || c >= 'E' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'c' || c >= 'e' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yystate45
+	}
+
+yystate346:
+	c = l.Next()
+	yyrule = 107
+	l.Mark()
+	switch {
+	default:
+		goto yyrule107
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
+		goto yystate45
+	}
+
+yystate347:
+	c = l.Next()
+	yyrule = 108
+	l.Mark()
+	switch {
+	default:
+		goto yyrule108
 	case c == 'A' || c == 'a':
-		goto yystate301
+		goto yystate348
 	case c >= '0' && c <= '9' || c >= 'B' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate301:
+yystate348:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'L' || c == 'l':
-		goto yystate302
+		goto yystate349
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'K' || c >= 'M' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate302:
+yystate349:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'U' || c == 'u':
-		goto yystate303
+		goto yystate350
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'T' || c >= 'V' && c <= 'Z' || c == '_' || c >= 'a' && c <= 't' || c >= 'v' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate303:
+yystate350:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'E' || c == 'e':
-		goto yystate304
+		goto yystate351
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate304:
+yystate351:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'S' || c == 's':
-		goto yystate305
+		goto yystate352
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'R' || c >= 'T' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'r' || c >= 't' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate305:
+yystate352:
 	c = l.Next()
-	yyrule = 70
+	yyrule = 76
 	l.Mark()
 	switch {
 	default:
-		goto yyrule70
+		goto yyrule76
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate306:
+yystate353:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'H' || c == 'h':
-		goto yystate307
+		goto yystate354
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'G' || c >= 'I' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'g' || c >= 'i' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate307:
+yystate354:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'E' || c == 'e':
-		goto yystate308
+		goto yystate355
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate308:
+yystate355:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'R' || c == 'r':
-		goto yystate309
+		goto yystate356
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Q' || c >= 'S' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'q' || c >= 's' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate309:
+yystate356:
 	c = l.Next()
-	yyrule = 99
+	yyrule = 108
 	l.Mark()
 	switch {
 	default:
-		goto yyrule99
+		goto yyrule108
 	case c == 'E' || c == 'e':
-		goto yystate310
+		goto yystate357
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate310:
+yystate357:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 77
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule77
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0081' || c == '\u0082':
 		goto yystate45
 	}
 
-yystate311:
+yystate358:
 	c = l.Next()
-	yyrule = 11
+	yyrule = 12
 	l.Mark()
-	goto yyrule11
+	goto yyrule12
 
-yystate312:
+yystate359:
 	c = l.Next()
 	switch {
 	default:
 		goto yyabort
 	case c == '|':
-		goto yystate313
+		goto yystate360
 	}
 
-yystate313:
+yystate360:
 	c = l.Next()
-	yyrule = 23
+	yyrule = 24
 	l.Mark()
-	goto yyrule23
+	goto yyrule24
 
-yystate314:
+yystate361:
 	c = l.Next()
 	yyrule = 1
 	l.Mark()
 	goto yyrule1
 
-	goto yystate315 // silence unused label error
-yystate315:
+	goto yystate362 // silence unused label error
+yystate362:
 	c = l.Next()
-yystart315:
+yystart362:
 	switch {
 	default:
 		goto yyabort
 	case c == '"':
-		goto yystate317
+		goto yystate364
 	case c == '\\':
-		goto yystate318
+		goto yystate365
 	case c == '\u0080':
-		goto yystate320
+		goto yystate367
 	case c >= '\x01' && c <= '!' || c >= '#' && c <= '[' || c >= ']' && c <= '\u007f' || c >= '\u0081' && c <= 'ÿ':
-		goto yystate316
+		goto yystate363
 	}
 
-yystate316:
+yystate363:
 	c = l.Next()
 	switch {
 	default:
 		goto yyabort
 	case c == '"':
-		goto yystate317
+		goto yystate364
 	case c == '\\':
-		goto yystate318
+		goto yystate365
 	case c >= '\x01' && c <= '!' || c >= '#' && c <= '[' || c >= ']' && c <= 'ÿ':
-		goto yystate316
+		goto yystate363
 	}
 
-yystate317:
+yystate364:
 	c = l.Next()
-	yyrule = 13
+	yyrule = 14
 	l.Mark()
-	goto yyrule13
+	goto yyrule14
 
-yystate318:
+yystate365:
 	c = l.Next()
 	switch {
 	default:
 		goto yyabort
 	case c == '"':
-		goto yystate319
+		goto yystate366
 	case c == '\\':
-		goto yystate318
+		goto yystate365
 	case c >= '\x01' && c <= '!' || c >= '#' && c <= '[' || c >= ']' && c <= 'ÿ':
-		goto yystate316
+		goto yystate363
 	}
 
-yystate319:
+yystate366:
 	c = l.Next()
-	yyrule = 13
+	yyrule = 14
 	l.Mark()
 	switch {
 	default:
-		goto yyrule13
+		goto yyrule14
 	case c == '"':
-		goto yystate317
+		goto yystate364
 	case c == '\\':
-		goto yystate318
+		goto yystate365
 	case c >= '\x01' && c <= '!' || c >= '#' && c <= '[' || c >= ']' && c <= 'ÿ':
-		goto yystate316
+		goto yystate363
 	}
 
-yystate320:
+yystate367:
 	c = l.Next()
 	yyrule = 1
 	l.Mark()
@@ -4294,46 +4922,46 @@ yystate320:
 	default:
 		goto yyrule1
 	case c == '"':
-		goto yystate317
+		goto yystate364
 	case c == '\\':
-		goto yystate318
+		goto yystate365
 	case c >= '\x01' && c <= '!' || c >= '#' && c <= '[' || c >= ']' && c <= 'ÿ':
-		goto yystate316
+		goto yystate363
 	}
 
-	goto yystate321 // silence unused label error
-yystate321:
+	goto yystate368 // silence unused label error
+yystate368:
 	c = l.Next()
-yystart321:
+yystart368:
 	switch {
 	default:
 		goto yyabort
 	case c == '\u0080':
-		goto yystate324
+		goto yystate371
 	case c == '`':
-		goto yystate323
+		goto yystate370
 	case c >= '\x01' && c <= '_' || c >= 'a' && c <= '\u007f' || c >= '\u0081' && c <= 'ÿ':
-		goto yystate322
+		goto yystate369
 	}
 
-yystate322:
+yystate369:
 	c = l.Next()
 	switch {
 	default:
 		goto yyabort
 	case c == '`':
-		goto yystate323
+		goto yystate370
 	case c >= '\x01' && c <= '_' || c >= 'a' && c <= 'ÿ':
-		goto yystate322
+		goto yystate369
 	}
 
-yystate323:
+yystate370:
 	c = l.Next()
-	yyrule = 14
+	yyrule = 15
 	l.Mark()
-	goto yyrule14
+	goto yyrule15
 
-yystate324:
+yystate371:
 	c = l.Next()
 	yyrule = 1
 	l.Mark()
@@ -4341,9 +4969,9 @@ yystate324:
 	default:
 		goto yyrule1
 	case c == '`':
-		goto yystate323
+		goto yystate370
 	case c >= '\x01' && c <= '_' || c >= 'a' && c <= 'ÿ':
-		goto yystate322
+		goto yystate369
 	}
 
 yyrule1: // \x80
@@ -4362,33 +4990,37 @@ yyrule4: // \/\/.*
 yyrule5: // \/\*([^*]|\*+[^*/])*\*+\/
 
 	goto yystate0
-yyrule6: // {imaginary_ilit}
+yyrule6: // {interval_lit}
+	{
+		return l.interval(lval)
+	}
+yyrule7: // {imaginary_ilit}
 	{
 		return l.int(lval, true)
 	}
-yyrule7: // {imaginary_lit}
+yyrule8: // {imaginary_lit}
 	{
 		return l.float(lval, true)
 	}
-yyrule8: // {int_lit}
+yyrule9: // {int_lit}
 	{
 		return l.int(lval, false)
 	}
-yyrule9: // {float_lit}
+yyrule10: // {float_lit}
 	{
 		return l.float(lval, false)
 	}
-yyrule10: // \"
+yyrule11: // \"
 	{
 		l.sc = S1
 		goto yystate0
 	}
-yyrule11: // `
+yyrule12: // `
 	{
 		l.sc = S2
 		goto yystate0
 	}
-yyrule12: // '(\\.|[^'])*'
+yyrule13: // '(\\.|[^'])*'
 	{
 		if ret := l.str(lval, ""); ret != stringLit {
 			return ret
@@ -4396,384 +5028,419 @@ yyrule12: // '(\\.|[^'])*'
 		lval.item = idealRune(lval.item.(string)[0])
 		return intLit
 	}
-yyrule13: // (\\.|[^\"])*\"
+yyrule14: // (\\.|[^\"])*\"
 	{
 		return l.str(lval, "\"")
 	}
-yyrule14: // ([^`]|\n)*`
+yyrule15: // ([^`]|\n)*`
 	{
 		return l.str(lval, "`")
 	}
-yyrule15: // "!="
+yyrule16: // "!="
 	{
 		return neq
 	}
-yyrule16: // "&&"
+yyrule17: // "&&"
 	{
 		return andand
 	}
-yyrule17: // "&^"
+yyrule18: // "&^"
 	{
 		return andnot
 	}
-yyrule18: // "<<"
+yyrule19: // "<<"
 	{
 		return lsh
 	}
-yyrule19: // "<="
+yyrule20: // "<="
 	{
 		return le
 	}
-yyrule20: // "=="
+yyrule21: // "=="
 	{
 		return eq
 	}
-yyrule21: // ">="
+yyrule22: // ">="
 	{
 		return ge
 	}
-yyrule22: // ">>"
+yyrule23: // ">>"
 	{
 		return rsh
 	}
-yyrule23: // "||"
+yyrule24: // "||"
 	{
 		return oror
 	}
-yyrule24: // {add}
+yyrule25: // {add}
 	{
 		return add
 	}
-yyrule25: // {alter}
+yyrule26: // {alter}
 	{
 		return alter
 	}
-yyrule26: // {and}
+yyrule27: // {and}
 	{
 		return and
 	}
-yyrule27: // {asc}
+yyrule28: // {asc}
 	{
 		return asc
 	}
-yyrule28: // {as}
+yyrule29: // {as}
 	{
 		return as
 	}
-yyrule29: // {begin}
+yyrule30: // {begin}
 	{
 		return begin
 	}
-yyrule30: // {between}
+yyrule31: // {between}
 	{
 		return between
 	}
-yyrule31: // {by}
+yyrule32: // {by}
 	{
 		return by
 	}
-yyrule32: // {column}
+yyrule33: // {collate}
+	{
+		return collateKwd
+	}
+yyrule34: // {column}
 	{
 		return column
 	}
-yyrule33: // {commit}
+yyrule35: // {commit}
 	{
 		return commit
 	}
-yyrule34: // {create}
+yyrule36: // {create}
 	{
 		return create
 	}
-yyrule35: // {default}
+yyrule37: // {default}
 	{
 		return defaultKwd
 	}
-yyrule36: // {delete}
+yyrule38: // {delete}
 	{
 		return deleteKwd
 	}
-yyrule37: // {desc}
+yyrule39: // {desc}
 	{
 		return desc
 	}
-yyrule38: // {distinct}
+yyrule40: // {distinct}
 	{
 		return distinct
 	}
-yyrule39: // {drop}
+yyrule41: // {drop}
 	{
 		return drop
 	}
-yyrule40: // {exists}
+yyrule42: // {escape}
+	{
+		return escape
+	}
+yyrule43: // {exists}
 	{
 		return exists
 	}
-yyrule41: // {explain}
+yyrule44: // {explain}
 	{
 		return explain
 	}
-yyrule42: // {from}
+yyrule45: // {from}
 	{
 		return from
 	}
-yyrule43: // {full}
+yyrule46: // {full}
 	{
 		return full
 	}
-yyrule44: // {group}
+yyrule47: // {group}
 	{
 		return group
 	}
-yyrule45: // {if}
+yyrule48: // {if}
 	{
 		return ifKwd
 	}
-yyrule46: // {index}
+yyrule49: // {ilike}
+	{
+		return ilike
+	}
+yyrule50: // {index}
 	{
 		return index
 	}
-yyrule47: // {insert}
+yyrule51: // {insert}
 	{
 		return insert
 	}
-yyrule48: // {into}
+yyrule52: // {into}
 	{
 		return into
 	}
-yyrule49: // {in}
+yyrule53: // {in}
 	{
 		return in
 	}
-yyrule50: // {is}
+yyrule54: // {is}
 	{
 		return is
 	}
-yyrule51: // {join}
+yyrule55: // {join}
 	{
 		return join
 	}
-yyrule52: // {left}
+yyrule56: // {left}
 	{
 		return left
 	}
-yyrule53: // {like}
+yyrule57: // {like}
 	{
 		return like
 	}
-yyrule54: // {limit}
+yyrule58: // {limit}
 	{
 		return limit
 	}
-yyrule55: // {not}
+yyrule59: // {matches}
+	{
+		return matches
+	}
+yyrule60: // {not}
 	{
 		return not
 	}
-yyrule56: // {offset}
+yyrule61: // {offset}
 	{
 		return offset
 	}
-yyrule57: // {on}
+yyrule62: // {on}
 	{
 		return on
 	}
-yyrule58: // {order}
+yyrule63: // {order}
 	{
 		return order
 	}
-yyrule59: // {or}
+yyrule64: // {or}
 	{
 		return or
 	}
-yyrule60: // {outer}
+yyrule65: // {outer}
 	{
 		return outer
 	}
-yyrule61: // {right}
+yyrule66: // {regexp}
+	{
+		return regexpKwd
+	}
+yyrule67: // {right}
 	{
 		return right
 	}
-yyrule62: // {rollback}
+yyrule68: // {rollback}
 	{
 		return rollback
 	}
-yyrule63: // {select}
+yyrule69: // {select}
 	{
 		l.agg = append(l.agg, false)
 		return selectKwd
 	}
-yyrule64: // {set}
+yyrule70: // {set}
 	{
 		return set
 	}
-yyrule65: // {table}
+yyrule71: // {table}
 	{
 		return tableKwd
 	}
-yyrule66: // {transaction}
+yyrule72: // {transaction}
 	{
 		return transaction
 	}
-yyrule67: // {truncate}
+yyrule73: // {truncate}
 	{
 		return truncate
 	}
-yyrule68: // {update}
+yyrule74: // {update}
 	{
 		return update
 	}
-yyrule69: // {unique}
+yyrule75: // {unique}
 	{
 		return unique
 	}
-yyrule70: // {values}
+yyrule76: // {values}
 	{
 		return values
 	}
-yyrule71: // {where}
+yyrule77: // {where}
 	{
 		return where
 	}
-yyrule72: // {null}
+yyrule78: // {null}
 	{
 		lval.item = nil
 		return null
 	}
-yyrule73: // {false}
+yyrule79: // {false}
 	{
 		lval.item = false
 		return falseKwd
 	}
-yyrule74: // {true}
+yyrule80: // {true}
 	{
 		lval.item = true
 		return trueKwd
 	}
-yyrule75: // {bigint}
+yyrule81: // {bigint}
 	{
 		lval.item = qBigInt
 		return bigIntType
 	}
-yyrule76: // {bigrat}
+yyrule82: // {bigrat}
 	{
 		lval.item = qBigRat
 		return bigRatType
 	}
-yyrule77: // {blob}
+yyrule83: // {blob}
 	{
 		lval.item = qBlob
 		return blobType
 	}
-yyrule78: // {bool}
+yyrule84: // {bool}
 	{
 		lval.item = qBool
 		return boolType
 	}
-yyrule79: // {byte}
+yyrule85: // {byte}
 	{
 		lval.item = qUint8
 		return byteType
 	}
-yyrule80: // {complex}128
+yyrule86: // {complex}128
 	{
 		lval.item = qComplex128
 		return complex128Type
 	}
-yyrule81: // {complex}64
+yyrule87: // {complex}64
 	{
 		lval.item = qComplex64
 		return complex64Type
 	}
-yyrule82: // {duration}
+yyrule88: // {decimal}
+	{
+		lval.item = qDecimal
+		return decimalType
+	}
+yyrule89: // {duration}
 	{
 		lval.item = qDuration
 		return durationType
 	}
-yyrule83: // {float}
+yyrule90: // {float}
 	{
 		lval.item = qFloat64
 		return floatType
 	}
-yyrule84: // {float}32
+yyrule91: // {float}32
 	{
 		lval.item = qFloat32
 		return float32Type
 	}
-yyrule85: // {float}64
+yyrule92: // {float}64
 	{
 		lval.item = qFloat64
 		return float64Type
 	}
-yyrule86: // {int}
+yyrule93: // {int}
 	{
 		lval.item = qInt64
 		return intType
 	}
-yyrule87: // {int}16
+yyrule94: // {int}16
 	{
 		lval.item = qInt16
 		return int16Type
 	}
-yyrule88: // {int}32
+yyrule95: // {int}32
 	{
 		lval.item = qInt32
 		return int32Type
 	}
-yyrule89: // {int}64
+yyrule96: // {int}64
 	{
 		lval.item = qInt64
 		return int64Type
 	}
-yyrule90: // {int}8
+yyrule97: // {int}8
 	{
 		lval.item = qInt8
 		return int8Type
 	}
-yyrule91: // {rune}
+yyrule98: // {json}
+	{
+		lval.item = qJSON
+		return jsonType
+	}
+yyrule99: // {rune}
 	{
 		lval.item = qInt32
 		return runeType
 	}
-yyrule92: // {string}
+yyrule100: // {string}
 	{
 		lval.item = qString
 		return stringType
 	}
-yyrule93: // {time}
+yyrule101: // {time}
 	{
 		lval.item = qTime
 		return timeType
 	}
-yyrule94: // {uint}
+yyrule102: // {uint}
 	{
 		lval.item = qUint64
 		return uintType
 	}
-yyrule95: // {uint}16
+yyrule103: // {uint}16
 	{
 		lval.item = qUint16
 		return uint16Type
 	}
-yyrule96: // {uint}32
+yyrule104: // {uint}32
 	{
 		lval.item = qUint32
 		return uint32Type
 	}
-yyrule97: // {uint}64
+yyrule105: // {uint}64
 	{
 		lval.item = qUint64
 		return uint64Type
 	}
-yyrule98: // {uint}8
+yyrule106: // {uint}8
 	{
 		lval.item = qUint8
 		return uint8Type
 	}
-yyrule99: // {ident}
+yyrule107: // {uuid}
+	{
+		lval.item = qUUID
+		return uuidType
+	}
+yyrule108: // {ident}
 	{
 		lval.item = string(l.TokenBytes(nil))
 		return identifier
 	}
-yyrule100: // ($|\?)({D}|{ident})
+yyrule109: // ($|\?)({D}|{ident})
 	{
 		s := string(l.TokenBytes(nil)[1:])
 		lval.item, _ = strconv.Atoi(s)
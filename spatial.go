@@ -0,0 +1,120 @@
+// Copyright 2026 The ql Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ql
+
+import (
+	"fmt"
+	"math"
+)
+
+// spatialKeys returns the keys of a spatial index for the values vlist of its
+// two columns. There is no key if any value is NULL or NaN.
+func spatialKeys(vlist []interface{}) [][]interface{} {
+	for _, v := range vlist {
+		if f, ok := v.(float64); !ok || math.IsNaN(f) {
+			return nil
+		}
+	}
+
+	return [][]interface{}{vlist}
+}
+
+// float64Arg returns the numeric argument v of the function fn as a float64.
+func float64Arg(v interface{}, fn string) (float64, error) {
+	switch v.(type) {
+	case idealFloat, idealInt, idealRune, idealUint,
+		float32, float64,
+		int8, int16, int32, int64,
+		uint8, uint16, uint32, uint64:
+		f, err := convert(v, qFloat64)
+		if err != nil {
+			return 0, err
+		}
+
+		return f.(float64), nil
+	default:
+		return 0, invArg(v, fn)
+	}
+}
+
+// pointArg returns the point, a []float64{x, y}, v passed to the function fn.
+func pointArg(v interface{}, fn string) ([]float64, error) {
+	if p, ok := v.([]float64); ok && len(p) == 2 {
+		return p, nil
+	}
+
+	return nil, fmt.Errorf("invalid argument %v (type %T) for %s: not a point", v, v, fn)
+}
+
+// boxArg returns the box, a []float64{minX, minY, maxX, maxY}, v passed to
+// the function fn.
+func boxArg(v interface{}, fn string) (rect, error) {
+	if b, ok := v.([]float64); ok && len(b) == 4 && b[0] <= b[2] && b[1] <= b[3] {
+		return rect{b[0], b[1], b[2], b[3]}, nil
+	}
+
+	return rect{}, fmt.Errorf("invalid argument %v (type %T) for %s: not a box", v, v, fn)
+}
+
+// staticValue returns the value of e if it does not depend on the row, like
+// point($1, $2) after the parameters are bound. Otherwise it returns nil.
+func staticValue(e expression) (interface{}, error) {
+	if v := isConstValue(e); v != nil || !e.isStatic() {
+		return v, nil
+	}
+
+	return e.eval(nil, nil)
+}
+
+// isSpatialPoint reports whether e is point(c1, c2) for the columns c1, c2.
+func isSpatialPoint(e expression) (c1, c2 string, ok bool) {
+	c, ok := e.(*call)
+	if !ok || c.f != "point" {
+		return "", "", false
+	}
+
+	x, ok := c.arg[0].(*ident)
+	y, ok2 := c.arg[1].(*ident)
+	if !ok || !ok2 {
+		return "", "", false
+	}
+
+	return x.s, y.s, true
+}
+
+// spatialIndices returns the names of the spatial indices of the DB.
+func (db *DB) spatialIndices() (map[string]bool, error) {
+	m := map[string]bool{}
+	if _, ok := db.root.tables["__Spatial"]; !ok {
+		return m, nil
+	}
+
+	ctx := newExecCtx(db, nil)
+	rs, err := selectSpatial.l[0].exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := rs.(recordset).do(ctx, func(id interface{}, data []interface{}) (bool, error) {
+		m[data[0].(string)] = true
+		return true, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+func (db *DB) insertSpatial(tableName, indexName string) error {
+	ctx := newExecCtx(db, []interface{}{tableName, indexName})
+	for _, s := range createSpatial.l {
+		if _, err := s.exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	_, err := insertSpatial.l[0].exec(ctx)
+	return err
+}
@@ -6,10 +6,12 @@ package ql
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"strings"
 
 	"sync"
+	"time"
 
 	"github.com/cznic/strutil"
 )
@@ -22,9 +24,11 @@ var (
 	_ stmt = (*alterTableDropColumnStmt)(nil)
 	_ stmt = (*createIndexStmt)(nil)
 	_ stmt = (*createTableStmt)(nil)
+	_ stmt = (*createTypeStmt)(nil)
 	_ stmt = (*deleteStmt)(nil) //TODO optimizer plan
 	_ stmt = (*dropIndexStmt)(nil)
 	_ stmt = (*dropTableStmt)(nil)
+	_ stmt = (*dropTypeStmt)(nil)
 	_ stmt = (*explainStmt)(nil)
 	_ stmt = (*insertIntoStmt)(nil)
 	_ stmt = (*selectStmt)(nil)
@@ -60,6 +64,57 @@ var (
 		where TableName == $1 && Name == $2
 	`)
 
+	createEnum = mustCompile(`
+		// Labels of enum types. Ordinal is the position of Label in the
+		// declaration of the type.
+		create table if not exists __Enum (
+			Name    string,
+			Ordinal int,
+			Label   string,
+		);
+	`)
+
+	insertEnum = mustCompile(`insert into __Enum values($1, $2, $3)`)
+
+	selectEnum = mustCompile(`select Name, Ordinal, Label from __Enum`)
+
+	deleteEnum = mustCompile(`delete from __Enum where Name == $1`)
+
+	createFullText = mustCompile(`
+		// Tokenizers of full-text indices. The indices are registered
+		// in __Index2 as well.
+		create table if not exists __FullText (
+			TableName string,
+			IndexName string,
+			Tokenizer string,
+		);
+	`)
+
+	insertFullText = mustCompile(`insert into __FullText values($1, $2, $3)`)
+
+	selectFullText = mustCompile(`select IndexName, Tokenizer from __FullText`)
+
+	deleteFullTextByIndexName = mustCompile(`delete from __FullText where IndexName == $1`)
+
+	deleteFullTextByTableName = mustCompile(`delete from __FullText where TableName == $1`)
+
+	createSpatial = mustCompile(`
+		// Spatial indices. The indices are registered in __Index2 as
+		// well.
+		create table if not exists __Spatial (
+			TableName string,
+			IndexName string,
+		);
+	`)
+
+	insertSpatial = mustCompile(`insert into __Spatial values($1, $2)`)
+
+	selectSpatial = mustCompile(`select IndexName from __Spatial`)
+
+	deleteSpatialByIndexName = mustCompile(`delete from __Spatial where IndexName == $1`)
+
+	deleteSpatialByTableName = mustCompile(`delete from __Spatial where TableName == $1`)
+
 	createIndex2 = mustCompile(`
 		// Index register 2.
 		create table if not exists __Index2(
@@ -123,10 +178,21 @@ type stmt interface {
 }
 
 type execCtx struct { //LATER +shared temp
-	db    *DB
-	arg   []interface{}
-	cache map[interface{}]interface{}
-	mu    sync.RWMutex
+	db        *DB
+	arg       []interface{}
+	boundArgs bool // The plan built depends on the values of arg.
+	cache     map[interface{}]interface{}
+	context   context.Context // Nil if not cancellable.
+	done      <-chan struct{} // context.Done().
+	examined  int64           // Rows read, see Limits.
+	limits    Limits
+	mu        sync.RWMutex
+	noCache   bool            // The plan built cannot be reused.
+	results   int64           // Rows produced, see Limits.
+	snap      *snapshot       // Nil if reading the current state of the DB.
+	tempBytes int64           // Size of temporary storage, see Limits.
+	timeout   <-chan struct{} // Closed when MaxStatementTime elapses.
+	timer     *time.Timer
 }
 
 func newExecCtx(db *DB, arg []interface{}) *execCtx {
@@ -137,6 +203,79 @@ func newExecCtx(db *DB, arg []interface{}) *execCtx {
 	}
 }
 
+// withContext makes the execution of x stop when ctx is done.
+func (x *execCtx) withContext(ctx context.Context) *execCtx {
+	if ctx != nil {
+		if done := ctx.Done(); done != nil {
+			x.context, x.done = ctx, done
+		}
+	}
+	return x
+}
+
+// at returns a context for executing the plans built using x, reading snap.
+// The returned context must be stopped when no more used.
+func (x *execCtx) at(snap *snapshot) *execCtx {
+	r := newExecCtx(x.db, x.arg).withContext(x.context).withLimits(x.limits)
+	r.snap = snap
+	return r
+}
+
+// canceled returns the error of the context of x if it is done or
+// ErrMaxStatementTime if x runs out of time.
+func (x *execCtx) canceled() error {
+	select {
+	case <-x.done:
+		return x.context.Err()
+	case <-x.timeout:
+		return ErrMaxStatementTime
+	default:
+		return nil
+	}
+}
+
+// root returns the tables and indices read by x.
+func (x *execCtx) root() *root {
+	if x.snap != nil {
+		return x.snap.root
+	}
+
+	return x.db.root
+}
+
+// table returns the table t as read by x. Plans keep the tables of the root
+// they were built for, a table of another root of the same schema version
+// differs only in its records.
+func (x *execCtx) table(t *table) *table {
+	if u := x.root().tables[t.name]; u != nil {
+		return u
+	}
+
+	return t
+}
+
+// read reads the record h of the DB storage.
+func (x *execCtx) read(dst []interface{}, h int64, cols ...*col) ([]interface{}, error) {
+	if err := x.examine(); err != nil {
+		return nil, err
+	}
+
+	if x.snap != nil {
+		return x.snap.read(dst, h, cols...)
+	}
+
+	return x.db.store.Read(dst, h, cols...)
+}
+
+// index returns the index ix as read by x.
+func (x *execCtx) index(ix btreeIndex) btreeIndex {
+	if x.snap != nil {
+		return x.snap.index(ix)
+	}
+
+	return ix
+}
+
 type explainStmt struct {
 	s stmt
 }
@@ -160,7 +299,7 @@ func (s *explainStmt) String() string {
 func (*explainStmt) isUpdating() bool { return false }
 
 func (s *explainStmt) exec(ctx *execCtx) (_ Recordset, err error) {
-	return recordset{ctx, &explainDefaultPlan{s.s}, ctx.db.cc}, nil
+	return recordset{ctx: ctx, plan: &explainDefaultPlan{s.s}}, nil
 }
 
 type updateStmt struct {
@@ -203,7 +342,7 @@ func (s *updateStmt) exec(ctx *execCtx) (_ Recordset, err error) {
 
 	m := map[interface{}]interface{}{}
 	var nh int64
-	expr := s.where
+	expr := collateExpression(s.where, colCollations(t.cols))
 	blobCols := t.blobCols()
 	cc := ctx.db.cc
 	var old []interface{}
@@ -213,6 +352,10 @@ func (s *updateStmt) exec(ctx *execCtx) (_ Recordset, err error) {
 		touched = make([]bool, len(t.cols0))
 	}
 	for h := t.head; h != 0; h = nh {
+		if err := ctx.examine(); err != nil {
+			return nil, err
+		}
+
 		// Read can return lazily expanded chunks
 		data, err := t.store.Read(nil, h, t.cols...)
 		if err != nil {
@@ -226,7 +369,7 @@ func (s *updateStmt) exec(ctx *execCtx) (_ Recordset, err error) {
 		id := data[1].(int64)
 		m["$id"] = id
 		if expr != nil {
-			val, err := s.where.eval(ctx, m)
+			val, err := expr.eval(ctx, m)
 			if err != nil {
 				return nil, err
 			}
@@ -247,12 +390,7 @@ func (s *updateStmt) exec(ctx *execCtx) (_ Recordset, err error) {
 
 		// hit
 		for _, ix := range t.indices2 {
-			vlist, err := ix.eval(ctx, t.cols, id, data[2:])
-			if err != nil {
-				return nil, err
-			}
-
-			if err := ix.x.Delete(vlist, h); err != nil {
+			if err := ix.delete(ctx, t.cols, id, data[2:], h); err != nil {
 				return nil, err
 			}
 		}
@@ -309,12 +447,7 @@ func (s *updateStmt) exec(ctx *execCtx) (_ Recordset, err error) {
 			}
 		}
 		for _, ix := range t.indices2 {
-			vlist, err := ix.eval(ctx, t.cols, id, data[2:])
-			if err != nil {
-				return nil, err
-			}
-
-			if err := ix.x.Create(vlist, h); err != nil {
+			if err := ix.create(ctx, t.cols, id, data[2:], h); err != nil {
 				return nil, err
 			}
 		}
@@ -353,6 +486,7 @@ func (s *deleteStmt) exec(ctx *execCtx) (_ Recordset, err error) {
 	m := map[interface{}]interface{}{}
 	var ph, h, nh int64
 	var data []interface{}
+	expr := collateExpression(s.where, colCollations(t.cols))
 	blobCols := t.blobCols()
 	cc := ctx.db.cc
 	for h = t.head; h != 0; ph, h = h, nh {
@@ -364,6 +498,10 @@ func (s *deleteStmt) exec(ctx *execCtx) (_ Recordset, err error) {
 
 			data[i] = c.b
 		}
+		if err = ctx.examine(); err != nil {
+			return nil, err
+		}
+
 		// Read can return lazily expanded chunks
 		data, err = t.store.Read(nil, h, t.cols...)
 		if err != nil {
@@ -376,7 +514,7 @@ func (s *deleteStmt) exec(ctx *execCtx) (_ Recordset, err error) {
 		}
 		id := data[1].(int64)
 		m["$id"] = id
-		val, err := s.where.eval(ctx, m)
+		val, err := expr.eval(ctx, m)
 		if err != nil {
 			return nil, err
 		}
@@ -406,12 +544,7 @@ func (s *deleteStmt) exec(ctx *execCtx) (_ Recordset, err error) {
 			}
 		}
 		for _, ix := range t.indices2 {
-			vlist, err := ix.eval(ctx, t.cols, id, data[2:])
-			if err != nil {
-				return nil, err
-			}
-
-			if err := ix.x.Delete(vlist, h); err != nil {
+			if err := ix.delete(ctx, t.cols, id, data[2:], h); err != nil {
 				return nil, err
 			}
 		}
@@ -557,6 +690,45 @@ func (s *dropTableStmt) exec(ctx *execCtx) (Recordset, error) {
 
 func (s *dropTableStmt) isUpdating() bool { return true }
 
+type dropTypeStmt struct {
+	ifExists bool
+	typeName string
+}
+
+func (s *dropTypeStmt) explain(ctx *execCtx, w strutil.Formatter) {
+	w.Format("%s\n", s)
+}
+
+func (s *dropTypeStmt) String() string { return fmt.Sprintf("DROP TYPE %s;", s.typeName) }
+
+func (s *dropTypeStmt) exec(ctx *execCtx) (Recordset, error) {
+	m, err := ctx.db.enumTypes(newExecCtx(ctx.db, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	if m[s.typeName] == nil {
+		if s.ifExists {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("DROP TYPE: type %s does not exist", s.typeName)
+	}
+
+	for _, t := range ctx.db.root.tables {
+		for _, c := range t.cols {
+			if c.typ == qEnum && c.enum.name == s.typeName {
+				return nil, fmt.Errorf("DROP TYPE: type %s is used by column %s.%s", s.typeName, t.name, c.name)
+			}
+		}
+	}
+
+	_, err = deleteEnum.l[0].exec(newExecCtx(ctx.db, []interface{}{s.typeName}))
+	return nil, err
+}
+
+func (s *dropTypeStmt) isUpdating() bool { return true }
+
 type alterTableDropColumnStmt struct {
 	tableName, colName string
 }
@@ -646,7 +818,7 @@ func (s *alterTableAddStmt) explain(ctx *execCtx, w strutil.Formatter) {
 }
 
 func (s *alterTableAddStmt) String() string {
-	r := fmt.Sprintf("ALTER TABLE %s ADD %s %s", s.tableName, s.c.name, typeStr(s.c.typ))
+	r := fmt.Sprintf("ALTER TABLE %s ADD %s %s%s", s.tableName, s.c.name, s.c.typeStr(), collateClause(s.c.collation))
 	c := s.c
 	if x := c.constraint; x != nil { //TODO add (*col).String()
 		switch e := x.expr; {
@@ -670,6 +842,12 @@ func (s *alterTableAddStmt) exec(ctx *execCtx) (Recordset, error) {
 
 	hasRecords := t.head != 0
 	c := s.c
+	if c.collation != "" {
+		if _, err := findCollation(c.collation); err != nil {
+			return nil, fmt.Errorf("ALTER TABLE %s ADD %s: %v", s.tableName, c.name, err)
+		}
+	}
+
 	if c.constraint != nil && hasRecords {
 		return nil, fmt.Errorf("ALTER TABLE %s ADD %s: cannot add constrained column to table with existing data", s.tableName, c.name)
 	}
@@ -710,7 +888,16 @@ func (s *alterTableAddStmt) exec(ctx *execCtx) (Recordset, error) {
 		}
 	}
 
-	t.cols0 = append(t.cols0, s.c)
+	if c.typ == qEnum {
+		e, err := ctx.db.findEnumType(c.enum.name)
+		if err != nil {
+			return nil, fmt.Errorf("ALTER TABLE %s ADD %s: %v", s.tableName, c.name, err)
+		}
+
+		c = c.clone()
+		c.enum = e
+	}
+	t.cols0 = append(t.cols0, c)
 	if err := t.constraintsAndDefaults(ctx); err != nil {
 		return nil, err
 	}
@@ -825,7 +1012,11 @@ func (s *selectStmt) plan(ctx *execCtx) (plan, error) { //LATER overlapping goro
 			return nil, err
 		}
 	}
-	if r, err = (&selectRset{flds: s.flds, src: r}).plan(ctx); err != nil {
+	flds := s.flds
+	if s.order != nil && len(flds) != 0 && !s.distinct && !s.hasAggregates && s.group == nil {
+		flds = rankFields(flds, s.order.by)
+	}
+	if r, err = (&selectRset{flds: flds, src: r}).plan(ctx); err != nil {
 		return nil, err
 	}
 
@@ -834,8 +1025,8 @@ func (s *selectStmt) plan(ctx *execCtx) (plan, error) { //LATER overlapping goro
 			return nil, err
 		}
 	}
-	if s := s.order; s != nil {
-		if r, err = (&orderByRset{asc: s.asc, by: s.by, src: r}).plan(ctx); err != nil {
+	if o := s.order; o != nil {
+		if r, err = (&orderByRset{asc: o.asc, by: o.by, src: r, hidden: len(flds) - len(s.flds)}).plan(ctx); err != nil {
 			return nil, err
 		}
 	}
@@ -853,18 +1044,18 @@ func (s *selectStmt) plan(ctx *execCtx) (plan, error) { //LATER overlapping goro
 }
 
 func (s *selectStmt) exec(ctx *execCtx) (rs Recordset, err error) {
-	r, err := s.plan(ctx)
+	r, err := ctx.db.plan(ctx, s)
 	if err != nil {
 		return nil, err
 	}
 
-	return recordset{ctx, r, nil}, nil
+	return recordset{ctx: ctx, plan: r, root: ctx.root(), s: s}, nil
 }
 
 func (s *selectStmt) isUpdating() bool { return false }
 
 type insertIntoStmt struct {
-	colNames  []string
+	colNames  []string // May start with "id()".
 	lists     [][]expression
 	sel       *selectStmt
 	tableName string
@@ -895,7 +1086,7 @@ func (s *insertIntoStmt) String() string {
 	}
 }
 
-func (s *insertIntoStmt) execSelect(t *table, cols []*col, ctx *execCtx) (_ Recordset, err error) {
+func (s *insertIntoStmt) execSelect(t *table, cols []*col, withID bool, ctx *execCtx) (_ Recordset, err error) {
 	//TODO missing rs column number eq check
 	r, err := s.sel.plan(ctx)
 	if err != nil {
@@ -906,7 +1097,16 @@ func (s *insertIntoStmt) execSelect(t *table, cols []*col, ctx *execCtx) (_ Reco
 	data0 := make([]interface{}, len(t.cols0)+2)
 	cc := ctx.db.cc
 	m := map[interface{}]interface{}{}
+	ids := &idSet{t: t}
 	if err = r.do(ctx, func(_ interface{}, data []interface{}) (more bool, err error) {
+		var id int64
+		if withID {
+			if id, err = ids.use(data[0]); err != nil {
+				return false, err
+			}
+
+			data = data[1:]
+		}
 		for i, d := range data {
 			data0[cols[i].index+2] = d
 		}
@@ -918,9 +1118,10 @@ func (s *insertIntoStmt) execSelect(t *table, cols []*col, ctx *execCtx) (_ Reco
 			return false, err
 		}
 
-		id, err := t.store.ID()
-		if err != nil {
-			return false, err
+		if !withID {
+			if id, err = t.store.ID(); err != nil {
+				return false, err
+			}
 		}
 
 		data0[0] = h
@@ -942,12 +1143,7 @@ func (s *insertIntoStmt) execSelect(t *table, cols []*col, ctx *execCtx) (_ Reco
 			}
 		}
 		for _, ix := range t.indices2 {
-			vlist, err := ix.eval(ctx, t.cols, id, data0[2:])
-			if err != nil {
-				return false, err
-			}
-
-			if err := ix.x.Create(vlist, h); err != nil {
+			if err := ix.create(ctx, t.cols, id, data0[2:], h); err != nil {
 				return false, err
 			}
 		}
@@ -970,12 +1166,17 @@ func (s *insertIntoStmt) exec(ctx *execCtx) (_ Recordset, err error) {
 		return nil, fmt.Errorf("INSERT INTO %s: table does not exist", s.tableName)
 	}
 
+	names := s.colNames
+	withID := len(names) != 0 && names[0] == "id()"
+	if withID {
+		names = names[1:]
+	}
 	var cols []*col
-	switch len(s.colNames) {
+	switch len(names) {
 	case 0:
 		cols = t.cols
 	default:
-		for _, colName := range s.colNames {
+		for _, colName := range names {
 			if col := findCol(t.cols, colName); col != nil {
 				cols = append(cols, col)
 				continue
@@ -986,11 +1187,15 @@ func (s *insertIntoStmt) exec(ctx *execCtx) (_ Recordset, err error) {
 	}
 
 	if s.sel != nil {
-		return s.execSelect(t, cols, ctx)
+		return s.execSelect(t, cols, withID, ctx)
 	}
 
+	n := len(cols)
+	if withID {
+		n++
+	}
 	for _, list := range s.lists {
-		if g, e := len(list), len(cols); g != e {
+		if g, e := len(list), n; g != e {
 			return nil, fmt.Errorf("INSERT INTO %s: expected %d value(s), have %d", s.tableName, e, g)
 		}
 	}
@@ -998,7 +1203,16 @@ func (s *insertIntoStmt) exec(ctx *execCtx) (_ Recordset, err error) {
 	cc := ctx.db.cc
 	r := make([]interface{}, len(t.cols0))
 	m := map[interface{}]interface{}{}
+	ids := &idSet{t: t}
 	for _, list := range s.lists {
+		var idv interface{}
+		if withID {
+			if idv, err = list[0].eval(ctx, m); err != nil {
+				return nil, err
+			}
+
+			list = list[1:]
+		}
 		for i, expr := range list {
 			val, err := expr.eval(ctx, m)
 			if err != nil {
@@ -1015,7 +1229,15 @@ func (s *insertIntoStmt) exec(ctx *execCtx) (_ Recordset, err error) {
 			return nil, err
 		}
 
-		id, err := t.addRecord(ctx, r)
+		var id int64
+		switch {
+		case withID:
+			if id, err = ids.use(idv); err == nil {
+				err = t.addRecordID(ctx, id, r)
+			}
+		default:
+			id, err = t.addRecord(ctx, r)
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -1077,6 +1299,8 @@ type createIndexStmt struct {
 	tableName   string
 	unique      bool
 	exprList    []expression
+	tokenizer   string // Non empty for a full-text index.
+	spatial     bool
 }
 
 func (s *createIndexStmt) explain(ctx *execCtx, w strutil.Formatter) {
@@ -1102,6 +1326,14 @@ func (s *createIndexStmt) String() string {
 		}
 		expr = strings.Join(a, ", ")
 	}
+	if s.tokenizer != "" {
+		return fmt.Sprintf("CREATE FULLTEXT INDEX %s%s ON %s (%s)%s;", e, s.indexName, s.tableName, expr, usingTokenizer(s.tokenizer))
+	}
+
+	if s.spatial {
+		return fmt.Sprintf("CREATE SPATIAL INDEX %s%s ON %s (%s);", e, s.indexName, s.tableName, expr)
+	}
+
 	return fmt.Sprintf("CREATE %sINDEX %s%s ON %s (%s);", u, e, s.indexName, s.tableName, expr)
 }
 
@@ -1151,15 +1383,37 @@ func (s *createIndexStmt) exec(ctx *execCtx) (Recordset, error) {
 		}
 	default:
 		for _, e := range s.exprList {
+			if err := checkIndexFuncs(e); err != nil {
+				return nil, fmt.Errorf("CREATE INDEX: %v", err)
+			}
+
 			m := mentionedColumns(e)
 			for colName := range m {
 				c := findCol(t.cols, colName)
 				if c == nil {
 					return nil, fmt.Errorf("CREATE INDEX: column does not exist: %s", colName)
 				}
+
+				if s.tokenizer != "" && c.typ != qString {
+					return nil, fmt.Errorf("CREATE INDEX: full-text index column %s is not a string: %s", colName, c.typeStr())
+				}
+
+				if s.spatial && c.typ != qFloat64 {
+					return nil, fmt.Errorf("CREATE INDEX: spatial index column %s is not a float64: %s", colName, c.typeStr())
+				}
 			}
 		}
-		if h, err = t.addIndex2(ctx, s.unique, s.indexName, s.exprList); err != nil {
+		if s.spatial && len(s.exprList) != 2 {
+			return nil, fmt.Errorf("CREATE INDEX: spatial index %s must have two columns", s.indexName)
+		}
+
+		if s.tokenizer != "" {
+			if _, err := findTokenizer(s.tokenizer); err != nil {
+				return nil, fmt.Errorf("CREATE INDEX: %v", err)
+			}
+		}
+
+		if h, err = t.addIndex2(ctx, s.unique, s.indexName, s.exprList, s.tokenizer, s.spatial); err != nil {
 			return nil, fmt.Errorf("CREATE INDEX: %v", err)
 		}
 	}
@@ -1187,7 +1441,18 @@ func (s *createIndexStmt) exec(ctx *execCtx) (Recordset, error) {
 	for _, e := range s.exprList {
 		exprList = append(exprList, e.String())
 	}
-	return nil, ctx.db.insertIndex2(s.tableName, s.indexName, exprList, s.unique, false, h)
+	if err := ctx.db.insertIndex2(s.tableName, s.indexName, exprList, s.unique, false, h); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case s.tokenizer != "":
+		return nil, ctx.db.insertFullText(s.tableName, s.indexName, s.tokenizer)
+	case s.spatial:
+		return nil, ctx.db.insertSpatial(s.tableName, s.indexName)
+	default:
+		return nil, nil
+	}
 }
 
 func (s *createIndexStmt) isUpdating() bool { return true }
@@ -1217,7 +1482,7 @@ func (s *createTableStmt) String() string {
 		if x := v.dflt; x != nil {
 			d = " DEFAULT " + x.String()
 		}
-		a[i] = fmt.Sprintf("%s %s%s%s", v.name, typeStr(v.typ), c, d)
+		a[i] = fmt.Sprintf("%s %s%s%s%s", v.name, v.typeStr(), collateClause(v.collation), c, d)
 	}
 	e := ""
 	if s.ifNotExists {
@@ -1254,6 +1519,16 @@ func (s *createTableStmt) exec(ctx *execCtx) (_ Recordset, err error) {
 
 		m[nm] = true
 		c.index = i
+		if c.typ == qEnum {
+			if c.enum, err = ctx.db.findEnumType(c.enum.name); err != nil {
+				return nil, fmt.Errorf("CREATE TABLE: column %s: %v", nm, err)
+			}
+		}
+		if c.collation != "" {
+			if _, err := findCollation(c.collation); err != nil {
+				return nil, fmt.Errorf("CREATE TABLE: column %s: %v", nm, err)
+			}
+		}
 		if c.constraint != nil || c.dflt != nil {
 			if mustCreateColumn2 {
 				for _, stmt := range createColumn2.l {
@@ -1287,3 +1562,45 @@ func (s *createTableStmt) exec(ctx *execCtx) (_ Recordset, err error) {
 }
 
 func (s *createTableStmt) isUpdating() bool { return true }
+
+type createTypeStmt struct {
+	typeName string
+	labels   []string
+}
+
+func (s *createTypeStmt) explain(ctx *execCtx, w strutil.Formatter) {
+	w.Format("%s\n", s)
+}
+
+func (s *createTypeStmt) String() string {
+	return (&EnumInfo{s.typeName, s.labels}).createStmt()
+}
+
+func (s *createTypeStmt) exec(ctx *execCtx) (Recordset, error) {
+	if _, err := newEnumType(s.typeName, s.labels); err != nil {
+		return nil, fmt.Errorf("CREATE TYPE: %v", err)
+	}
+
+	m, err := ctx.db.enumTypes(newExecCtx(ctx.db, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	if m[s.typeName] != nil {
+		return nil, fmt.Errorf("CREATE TYPE: type exists %s", s.typeName)
+	}
+
+	for _, stmt := range createEnum.l {
+		if _, err := stmt.exec(newExecCtx(ctx.db, nil)); err != nil {
+			return nil, err
+		}
+	}
+	for i, v := range s.labels {
+		if _, err := insertEnum.l[0].exec(newExecCtx(ctx.db, []interface{}{s.typeName, int64(i), v})); err != nil {
+			return nil, err
+		}
+	}
+	return nil, nil
+}
+
+func (s *createTypeStmt) isUpdating() bool { return true }
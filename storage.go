@@ -5,6 +5,7 @@
 package ql
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 )
@@ -24,8 +25,10 @@ type storage interface {
 	Read(dst []interface{}, h int64, cols ...*col) (data []interface{}, err error)
 	ResetID() (err error)
 	Rollback() error
+	SetID(id int64) error // The next ID will be id+1.
 	Update(h int64, data ...interface{}) error
 	UpdateRow(h int64, blobCols []*col, data ...interface{}) error
+	UseID(id int64) (fresh bool, err error) // The next ID will be at least id+1, fresh reports id was greater than the last one.
 	Verify() (allocs int64, err error)
 }
 
@@ -66,15 +69,21 @@ type indexedCol struct { // Column name or id() index.
 }
 
 type index2 struct { // Expression list index.
-	unique   bool
-	x        btreeIndex
-	xroot    int64
-	sources  []string
-	exprList []expression
+	unique    bool
+	x         btreeIndex
+	xroot     int64
+	sources   []string
+	exprList  []expression
+	evals     []compiledExpr // Compiled exprList.
+	tokenizer string         // Non empty for a full-text index.
+	spatial   bool
 }
 
+// eval returns the values of the expressions of x for the record r. The
+// result is nil if the record has no entry in x because a jsonExtract
+// expression selects a JSON array or object.
 func (x *index2) eval(ctx *execCtx, cols []*col, id int64, r []interface{}) ([]interface{}, error) {
-	f, isFile := ctx.db.store.(*file)
+	f, isFile := ctx.db.store.(*mvcc).storage.(*file)
 	vlist := make([]interface{}, len(x.exprList))
 	m := map[interface{}]interface{}{"$id": id}
 	for _, col := range cols {
@@ -91,12 +100,18 @@ func (x *index2) eval(ctx *execCtx, cols []*col, id int64, r []interface{}) ([]i
 		}
 		m[col.name] = v
 	}
-	for i, e := range x.exprList {
-		v, err := e.eval(ctx, m)
+	for i, e := range x.evals {
+		v, err := e(ctx, m)
 		if err != nil {
 			return nil, err
 		}
 
+		if _, ok := v.(json.RawMessage); ok {
+			if c, ok := x.exprList[i].(*call); ok && c.f == "jsonExtract" {
+				return nil, nil
+			}
+		}
+
 		if ok, typ := isBlobType(v); ok {
 			return nil, fmt.Errorf("value of a complex index cannot be of blob-like type: %v", typ)
 		}
@@ -106,6 +121,54 @@ func (x *index2) eval(ctx *execCtx, cols []*col, id int64, r []interface{}) ([]i
 	return vlist, nil
 }
 
+// keys returns the keys of the record r in x. A full-text index has a key for
+// every distinct term of every indexed column.
+func (x *index2) keys(ctx *execCtx, cols []*col, id int64, r []interface{}) ([][]interface{}, error) {
+	vlist, err := x.eval(ctx, cols, id, r)
+	if vlist == nil || err != nil {
+		return nil, err
+	}
+
+	switch {
+	case x.tokenizer != "":
+		return fullTextKeys(x.tokenizer, vlist)
+	case x.spatial:
+		return spatialKeys(vlist), nil
+	default:
+		return [][]interface{}{vlist}, nil
+	}
+}
+
+// create adds the record r, having handle h, to x.
+func (x *index2) create(ctx *execCtx, cols []*col, id int64, r []interface{}, h int64) error {
+	keys, err := x.keys(ctx, cols, id, r)
+	if err != nil {
+		return err
+	}
+
+	for _, k := range keys {
+		if err := x.x.Create(k, h); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// delete removes the record r, having handle h, from x.
+func (x *index2) delete(ctx *execCtx, cols []*col, id int64, r []interface{}, h int64) error {
+	keys, err := x.keys(ctx, cols, id, r)
+	if err != nil {
+		return err
+	}
+
+	for _, k := range keys {
+		if err := x.x.Delete(k, h); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 type indexKey struct {
 	value []interface{}
 	h     int64
@@ -135,6 +198,8 @@ type table struct {
 	xroots      []interface{}
 	constraints []*constraint
 	defaults    []expression
+	cevals      []compiledExpr // Compiled constraints.
+	devals      []compiledExpr // Compiled defaults.
 }
 
 func (t *table) hasIndices() bool { return len(t.indices) != 0 || len(t.indices2) != 0 }
@@ -199,9 +264,21 @@ func (t *table) constraintsAndDefaults(ctx *execCtx) error {
 			}
 		}
 	}
+	t.compileConstraintsAndDefaults()
 	return nil
 }
 
+func (t *table) compileConstraintsAndDefaults() {
+	types := colTypes(t.cols)
+	t.cevals = make([]compiledExpr, len(t.constraints))
+	for i, v := range t.constraints {
+		if v != nil && v.expr != nil {
+			t.cevals[i] = compileEval(v.expr, types, nil)
+		}
+	}
+	t.devals = compileEvals(t.defaults, types, nil)
+}
+
 func (t *table) checkConstraintsAndDefaults(ctx *execCtx, row []interface{}, m map[interface{}]interface{}) error {
 	cols := t.cols
 
@@ -223,7 +300,11 @@ func (t *table) checkConstraintsAndDefaults(ctx *execCtx, row []interface{}, m m
 				continue
 			}
 
-			dval, err := expr.eval(ctx, m)
+			eval := expr.eval
+			if i < len(t.devals) && t.devals[i] != nil {
+				eval = t.devals[i]
+			}
+			dval, err := eval(ctx, m)
 			if err != nil {
 				return err
 			}
@@ -263,7 +344,11 @@ func (t *table) checkConstraintsAndDefaults(ctx *execCtx, row []interface{}, m m
 			}
 
 			// Constraint is an expression
-			cval, err := expr.eval(ctx, m)
+			eval := expr.eval
+			if i < len(t.cevals) && t.cevals[i] != nil {
+				eval = t.cevals[i]
+			}
+			cval, err := eval(ctx, m)
 			if err != nil {
 				return err
 			}
@@ -414,6 +499,46 @@ func (t *table) load() (err error) {
 		}
 
 		col := &col{name: v[1:], typ: int(v[0]), index: i}
+		switch col.typ {
+		case qDecimal:
+			n := strings.IndexByte(col.name, ')')
+			if n < 0 {
+				return fmt.Errorf("corrupted DB: field info %q", v)
+			}
+
+			if _, err := fmt.Sscanf(col.name[:n+1], "(%d,%d)", &col.prec, &col.scale); err != nil {
+				return fmt.Errorf("corrupted DB: field info %q", v)
+			}
+
+			col.name = col.name[n+1:]
+		case qEnum: // Resolved by loadEnums.
+			n := strings.IndexByte(col.name, ')')
+			if n < 2 || col.name[0] != '(' {
+				return fmt.Errorf("corrupted DB: field info %q", v)
+			}
+
+			col.enum = &enumType{name: col.name[1:n]}
+			col.name = col.name[n+1:]
+		case qArray:
+			if len(col.name) < 3 || col.name[0] != '(' || col.name[2] != ')' || arrayElemType(int(col.name[1])) != nil {
+				return fmt.Errorf("corrupted DB: field info %q", v)
+			}
+
+			col.elem = int(col.name[1])
+			col.name = col.name[3:]
+		case qString:
+			if !strings.HasPrefix(col.name, "(") {
+				break
+			}
+
+			n := strings.IndexByte(col.name, ')')
+			if n < 2 {
+				return fmt.Errorf("corrupted DB: field info %q", v)
+			}
+
+			col.collation = col.name[1:n]
+			col.name = col.name[n+1:]
+		}
 		t.cols0[i] = col
 		if col.name != "" {
 			t.cols = append(t.cols, col)
@@ -473,6 +598,9 @@ func (t *table) load() (err error) {
 			return err
 		}
 
+		if i > 0 {
+			x = collateIndex(x, t.cols0[i-1])
+		}
 		t.indices[i] = &indexedCol{nm, unique, x, h}
 	}
 	t.xroots = xroots
@@ -508,7 +636,7 @@ func newTable(store storage, name string, next int64, cols []*col, tprev, tnext
 func (t *table) blobCols() (r []*col) {
 	for _, c := range t.cols0 {
 		switch c.typ {
-		case qBlob, qBigInt, qBigRat, qTime, qDuration:
+		case qBlob, qBigInt, qBigRat, qTime, qDuration, qJSON, qDecimal, qUUID, qArray:
 			r = append(r, c)
 		}
 	}
@@ -563,6 +691,10 @@ func (t *table) addIndex0(unique bool, indexName string, colIndex int) (int64, b
 			return -1, nil, err
 		}
 
+		if colIndex >= 0 {
+			x = collateIndex(x, t.cols0[colIndex])
+		}
+
 		indices[colIndex+1] = &indexedCol{indexName, unique, x, h}
 		xroots := make([]interface{}, len(indices))
 		xroots[colIndex+1] = h
@@ -588,6 +720,10 @@ func (t *table) addIndex0(unique bool, indexName string, colIndex int) (int64, b
 			return -1, nil, err
 		}
 
+		if colIndex >= 0 {
+			x = collateIndex(x, t.cols0[colIndex])
+		}
+
 		t.xroots[colIndex+1] = h
 		if err := t.store.Update(t.hxroots, t.xroots...); err != nil {
 			return -1, nil, err
@@ -626,12 +762,20 @@ func (t *table) addIndex(unique bool, indexName string, colIndex int) (int64, er
 	return hx, nil
 }
 
-func (t *table) addIndex2(execCtx *execCtx, unique bool, indexName string, exprList []expression) (int64, error) {
+func (t *table) addIndex2(execCtx *execCtx, unique bool, indexName string, exprList []expression, tokenizer string, spatial bool) (int64, error) {
 	if _, ok := t.indices2[indexName]; ok {
 		panic("internal error 009")
 	}
 
-	hx, x, err := t.store.CreateIndex(unique)
+	var hx int64
+	var x btreeIndex
+	var err error
+	switch {
+	case spatial:
+		hx, x, err = newRtree(t.store)
+	default:
+		hx, x, err = t.store.CreateIndex(unique)
+	}
 	if err != nil {
 		return -1, err
 	}
@@ -639,7 +783,7 @@ func (t *table) addIndex2(execCtx *execCtx, unique bool, indexName string, exprL
 	for _, v := range exprList {
 		a = append(a, v.String())
 	}
-	x2 := &index2{unique, x, hx, a, exprList}
+	x2 := &index2{unique, x, hx, a, exprList, compileEvals(exprList, colTypes(t.cols), nil), tokenizer, spatial}
 	if t.indices2 == nil {
 		t.indices2 = map[string]*index2{}
 	}
@@ -664,12 +808,7 @@ func (t *table) addIndex2(execCtx *execCtx, unique bool, indexName string, exprL
 		}
 
 		id := rec[1].(int64)
-		vlist, err := x2.eval(execCtx, t.cols, id, rec[2:])
-		if err != nil {
-			return -1, err
-		}
-
-		if err := x2.x.Create(vlist, h); err != nil {
+		if err := x2.create(execCtx, t.cols, id, rec[2:], h); err != nil {
 			return -1, err
 		}
 
@@ -719,6 +858,80 @@ func (t *table) addRecord(execCtx *execCtx, r []interface{}) (id int64, err erro
 		return
 	}
 
+	return id, t.addRecordID(execCtx, id, r)
+}
+
+// idSet checks the IDs given by an INSERT INTO statement having id() in its
+// column list for duplicates.
+type idSet struct {
+	t   *table
+	ids map[int64]struct{} // The IDs of the records of t, nil until read.
+}
+
+// use checks that v, the value of id() given by INSERT INTO, can be the id of
+// a new record of s.t and returns it. An id greater than any used before is
+// not checked further, otherwise it is looked up in the index of id(), if
+// any, or in the IDs of the records of the table, read once.
+func (s *idSet) use(v interface{}) (int64, error) {
+	t := s.t
+	v, err := convert(v, qInt64)
+	if err != nil {
+		return 0, fmt.Errorf("INSERT INTO %s: id(): %v", t.name, err)
+	}
+
+	id, ok := v.(int64)
+	if !ok || id <= 0 {
+		return 0, fmt.Errorf("INSERT INTO %s: invalid id() %v", t.name, v)
+	}
+
+	fresh, err := t.store.UseID(id)
+	if err != nil {
+		return 0, err
+	}
+
+	dup := false
+	switch {
+	case fresh:
+		// nop
+	case len(t.indices) != 0 && t.indices[0] != nil:
+		it, _, err := t.indices[0].x.Seek([]interface{}{id})
+		if err != nil {
+			return 0, err
+		}
+
+		k, _, err := it.Next()
+		if noEOF(err) != nil {
+			return 0, err
+		}
+
+		dup = err == nil && k[0] == id
+	default:
+		if s.ids == nil {
+			s.ids = map[int64]struct{}{}
+			for h := t.head; h != 0; {
+				rec, err := t.store.Read(nil, h)
+				if err != nil {
+					return 0, err
+				}
+
+				s.ids[rec[1].(int64)] = struct{}{}
+				h = rec[0].(int64)
+			}
+		}
+		_, dup = s.ids[id]
+	}
+	if dup {
+		return 0, fmt.Errorf("INSERT INTO %s: duplicate id() %v", t.name, id)
+	}
+
+	if s.ids != nil {
+		s.ids[id] = struct{}{}
+	}
+	return id, nil
+}
+
+// addRecordID is like addRecord but the id of the new record is id.
+func (t *table) addRecordID(execCtx *execCtx, id int64, r []interface{}) (err error) {
 	r = append([]interface{}{t.head, id}, r...)
 	h, err := t.store.Create(r...)
 	if err != nil {
@@ -736,13 +949,8 @@ func (t *table) addRecord(execCtx *execCtx, r []interface{}) (id int64, err erro
 	}
 
 	for _, ix := range t.indices2 {
-		vlist, err := ix.eval(execCtx, t.cols, id, r[2:])
-		if err != nil {
-			return -1, err
-		}
-
-		if err := ix.x.Create(vlist, h); err != nil {
-			return -1, err
+		if err := ix.create(execCtx, t.cols, id, r[2:], h); err != nil {
+			return err
 		}
 	}
 
@@ -774,7 +982,11 @@ func (t *table) updateCols() *table {
 }
 
 func (t *table) row0(ctx *execCtx, h int64) ([]interface{}, error) {
-	rec, err := ctx.db.store.Read(nil, h, t.cols...)
+	if err := ctx.canceled(); err != nil {
+		return nil, err
+	}
+
+	rec, err := ctx.read(nil, h, t.cols...)
 	if err != nil {
 		return nil, err
 	}
@@ -805,6 +1017,7 @@ type root struct {
 	head         int64 // Single linked table list
 	lastInsertID int64
 	parent       *root
+	schema       int64 // Schema version, see DB.invalidatePlans.
 	//rowsAffected int64 //LATER implement
 	store  storage
 	tables map[string]*table
@@ -0,0 +1,207 @@
+// Copyright 2026 The ql Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ql
+
+import (
+	"bytes"
+	"crypto/rand"
+	"database/sql/driver"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// UUID is a value of the QL uuid type, a 16 byte universally unique
+// identifier as defined by RFC 9562. UUIDs compare as their bytes.
+//
+// UUID implements driver.Valuer and sql.Scanner, so it can be passed to and
+// read from the database/sql driver as a string in the canonical form.
+type UUID [16]byte
+
+// uuidV7 keeps the values returned by newUUIDv7 ordered when the clock does
+// not advance between calls.
+var uuidV7 struct {
+	sync.Mutex
+	ms  int64  // Unix time in milliseconds of the last value.
+	seq uint16 // 12 bit counter of the last value.
+}
+
+// ParseUUID returns the UUID represented by s. Besides the canonical form,
+// like 6ba7b810-9dad-11d1-80b4-00c04fd430c8, ParseUUID accepts the 32 hex
+// digits without hyphens, both forms enclosed in braces and the canonical
+// form prefixed by urn:uuid:. Hex digits may be upper or lower case.
+func ParseUUID(s string) (UUID, error) {
+	var u UUID
+	t := s
+	switch {
+	case strings.HasPrefix(t, "{") && strings.HasSuffix(t, "}"):
+		t = t[1 : len(t)-1]
+	case len(t) == 45 && strings.EqualFold(t[:9], "urn:uuid:"):
+		t = t[9:]
+	}
+	if len(t) == 36 {
+		if t[8] != '-' || t[13] != '-' || t[18] != '-' || t[23] != '-' {
+			return u, fmt.Errorf("invalid UUID %q", s)
+		}
+
+		t = t[:8] + t[9:13] + t[14:18] + t[19:23] + t[24:]
+	}
+	if len(t) != 32 {
+		return u, fmt.Errorf("invalid UUID %q", s)
+	}
+
+	if _, err := hex.Decode(u[:], []byte(t)); err != nil {
+		return u, fmt.Errorf("invalid UUID %q", s)
+	}
+
+	return u, nil
+}
+
+// String returns u in the canonical form using lower case hex digits.
+func (u UUID) String() string {
+	var b [36]byte
+	hex.Encode(b[:8], u[:4])
+	b[8] = '-'
+	hex.Encode(b[9:13], u[4:6])
+	b[13] = '-'
+	hex.Encode(b[14:18], u[6:8])
+	b[18] = '-'
+	hex.Encode(b[19:23], u[8:10])
+	b[23] = '-'
+	hex.Encode(b[24:], u[10:])
+	return string(b[:])
+}
+
+// Value implements driver.Valuer.
+func (u UUID) Value() (driver.Value, error) { return u.String(), nil }
+
+// Scan implements sql.Scanner. It accepts the strings ParseUUID accepts,
+// []byte holding such a string and []byte of length 16 holding the UUID
+// bytes.
+func (u *UUID) Scan(src interface{}) (err error) {
+	switch x := src.(type) {
+	case string:
+		*u, err = ParseUUID(x)
+	case []byte:
+		if len(x) == len(u) {
+			copy(u[:], x)
+			break
+		}
+
+		*u, err = ParseUUID(string(x))
+	default:
+		err = fmt.Errorf("cannot scan %v (type %T) into UUID", src, src)
+	}
+	return err
+}
+
+// newUUIDv4 returns a random UUID, version 4.
+func newUUIDv4() (UUID, error) {
+	var u UUID
+	if _, err := rand.Read(u[:]); err != nil {
+		return u, err
+	}
+
+	u[6] = u[6]&0x0f | 0x40
+	u[8] = u[8]&0x3f | 0x80
+	return u, nil
+}
+
+// newUUIDv7 returns a time ordered UUID, version 7. The first 48 bits hold
+// the Unix time in milliseconds, the 12 bits following the version hold a
+// counter which orders the values returned within the same millisecond. The
+// counter starts at a random value not exceeding 0x7ff.
+func newUUIDv7() (UUID, error) {
+	var u UUID
+	if _, err := rand.Read(u[:]); err != nil {
+		return u, err
+	}
+
+	ms := time.Now().UnixMilli()
+	uuidV7.Lock()
+	switch {
+	case ms > uuidV7.ms:
+		uuidV7.ms = ms
+		uuidV7.seq = binary.BigEndian.Uint16(u[6:]) & 0x7ff
+	default:
+		if uuidV7.seq++; uuidV7.seq > 0xfff {
+			uuidV7.ms++
+			uuidV7.seq = 0
+		}
+	}
+	ms, seq := uuidV7.ms, uuidV7.seq
+	uuidV7.Unlock()
+
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], uint64(ms))
+	copy(u[:6], b[2:])
+	u[6] = 0x70 | byte(seq>>8)
+	u[7] = byte(seq)
+	u[8] = u[8]&0x3f | 0x80
+	return u, nil
+}
+
+// uuidConvert returns v converted to a UUID. Strings must be accepted by
+// ParseUUID, blobs must have 16 bytes.
+func uuidConvert(v interface{}) (interface{}, error) {
+	switch x := v.(type) {
+	case nil:
+		return nil, nil
+	case UUID:
+		return x, nil
+	case string:
+		return ParseUUID(x)
+	case []byte:
+		var u UUID
+		if len(x) != len(u) {
+			return nil, fmt.Errorf("cannot convert blob of length %d to uuid", len(x))
+		}
+
+		copy(u[:], x)
+		return u, nil
+	default:
+		return invConv(v, qUUID)
+	}
+}
+
+// uuid returns v checked for assignment to, or comparison with, the uuid
+// column c. Strings are parsed by ParseUUID.
+func (c *col) uuid(v interface{}) (interface{}, error) {
+	switch x := v.(type) {
+	case nil:
+		return nil, nil
+	case chunk:
+		return v, nil // Stored value.
+	case UUID:
+		return x, nil
+	case string:
+		return ParseUUID(x)
+	default:
+		return nil, fmt.Errorf("cannot use %v (type %T) in assignment to, or comparison with, column %s (type %s)", v, ideal(v), c.name, c.typeStr())
+	}
+}
+
+// cmpUUID compares a and b, one of which is a UUID. It reports false if the
+// other one is not a UUID or a string.
+func cmpUUID(a, b interface{}) (int, bool, error) {
+	var u [2]UUID
+	for i, v := range []interface{}{a, b} {
+		switch x := v.(type) {
+		case UUID:
+			u[i] = x
+		case string:
+			var err error
+			if u[i], err = ParseUUID(x); err != nil {
+				return 0, true, err
+			}
+		default:
+			return 0, false, nil
+		}
+	}
+	return bytes.Compare(u[0][:], u[1][:]), true, nil
+}